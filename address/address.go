@@ -219,20 +219,26 @@ type Address struct {
 	State        BrazilianState
 	CEP          string
 	Country      string
+	Version      int64
 	CreatedAt    time.Time
 	UpdatedAt    time.Time
 	DeletedAt    time.Time
 }
 
+// ImmutableFields cannot be changed by a patch once the address is created.
+var ImmutableFields = []string{"id"}
+
 type Reading interface {
 	GetByID(ctx context.Context, id int64) (*Address, error)
 	GetByUserID(ctx context.Context, userID int64) (*Address, error)
+	ListByUserIDs(ctx context.Context, userIDs []int64) (*[]Address, error)
 }
 
 type Writing interface {
 	Create(ctx context.Context, a *Address) (int64, error)
 	Update(ctx context.Context, a *Address) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, a *Address, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
 }
 
 type Repository interface {
@@ -243,7 +249,9 @@ type Repository interface {
 type UseCase interface {
 	GetByID(ctx context.Context, id int64) (*Address, error)
 	GetByUserID(ctx context.Context, userID int64) (*Address, error)
+	ListByUserIDs(ctx context.Context, userIDs []int64) (*[]Address, error)
 	Create(ctx context.Context, a *Address) (int64, error)
 	Update(ctx context.Context, a *Address) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, a *Address, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
 }
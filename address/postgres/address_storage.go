@@ -6,6 +6,7 @@ import (
 	"github.com/LucasMateus-eng/operations-service/address"
 	"github.com/LucasMateus-eng/operations-service/address/postgres/dto"
 	"github.com/LucasMateus-eng/operations-service/address/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
 	"github.com/uptrace/bun"
 )
 
@@ -19,10 +20,14 @@ func New(db *bun.DB) *addressPostgresRepo {
 	}
 }
 
+func (ar *addressPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, ar.db)
+}
+
 func (ar *addressPostgresRepo) GetByID(ctx context.Context, id int64) (*address.Address, error) {
 	var addressDTO dto.AddressDTO
 
-	err := ar.db.NewSelect().Model(&addressDTO).Where("id = ?", id).Scan(ctx)
+	err := ar.conn(ctx).NewSelect().Model(&addressDTO).Where("id = ?", id).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -38,7 +43,7 @@ func (ar *addressPostgresRepo) GetByID(ctx context.Context, id int64) (*address.
 func (ar *addressPostgresRepo) GetByUserID(ctx context.Context, userID int64) (*address.Address, error) {
 	var addressDTO dto.AddressDTO
 
-	err := ar.db.NewSelect().Model(&addressDTO).Where("user_id = ?", userID).Scan(ctx)
+	err := ar.conn(ctx).NewSelect().Model(&addressDTO).Where("user_id = ?", userID).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -51,12 +56,35 @@ func (ar *addressPostgresRepo) GetByUserID(ctx context.Context, userID int64) (*
 	return mappedValue, nil
 }
 
+// ListByUserIDs returns the addresses of the given users in a single query.
+// Users without an address are left out of the result.
+func (ar *addressPostgresRepo) ListByUserIDs(ctx context.Context, userIDs []int64) (*[]address.Address, error) {
+	var addressDTOs []dto.AddressDTO
+
+	err := ar.conn(ctx).NewSelect().Model(&addressDTOs).Where("user_id IN (?)", bun.In(userIDs)).Order("id ASC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []address.Address
+	for _, dto := range addressDTOs {
+		mappedValue, err := mapping.MapDTOToAddress(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, *mappedValue)
+	}
+
+	return &addresses, nil
+}
+
 func (ar *addressPostgresRepo) Create(ctx context.Context, a *address.Address) (int64, error) {
 	var addressID int64
 
 	addressDTO := mapping.MapAddressToDTO(a)
 
-	query := ar.db.NewInsert().Model(addressDTO).On("CONFLICT (id) DO UPDATE").Returning("id")
+	query := ar.conn(ctx).NewInsert().Model(addressDTO).Returning("id")
 
 	err := query.Scan(ctx, &addressID)
 	if err != nil {
@@ -69,16 +97,65 @@ func (ar *addressPostgresRepo) Create(ctx context.Context, a *address.Address) (
 func (ar *addressPostgresRepo) Update(ctx context.Context, a *address.Address) error {
 	addressDTO := mapping.MapAddressToDTO(a)
 
-	_, err := ar.db.NewUpdate().Model(addressDTO).
+	res, err := ar.conn(ctx).NewUpdate().Model(addressDTO).
 		OmitZero().
 		ExcludeColumn("deleted_at").
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
+		Where("id = ?", addressDTO.ID).
+		Where("version = ?", a.Version).
+		Returning("version").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, ar.conn(ctx), (*dto.AddressDTO)(nil), addressDTO.ID, res); err != nil {
+		return err
+	}
+
+	a.Version = addressDTO.Version
+
+	return nil
+}
+
+func (ar *addressPostgresRepo) Patch(ctx context.Context, a *address.Address, fields []string) error {
+	columns, err := db_postgres.PatchColumns(fields, "locality", "number", "complement", "neighborhood", "city", "state", "cep", "country")
+	if err != nil {
+		return err
+	}
+
+	addressDTO := mapping.MapAddressToDTO(a)
+
+	res, err := ar.conn(ctx).NewUpdate().Model(addressDTO).
+		Column(columns...).
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
 		Where("id = ?", addressDTO.ID).
+		Where("version = ?", a.Version).
+		Returning("version").
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, ar.conn(ctx), (*dto.AddressDTO)(nil), addressDTO.ID, res); err != nil {
+		return err
+	}
+
+	a.Version = addressDTO.Version
 
-	return err
+	return nil
 }
 
-func (ar *addressPostgresRepo) Delete(ctx context.Context, id int64) error {
-	_, err := ar.db.NewDelete().Model((*dto.AddressDTO)(nil)).Where("id = ?", id).Exec(ctx)
-	return err
+func (ar *addressPostgresRepo) Delete(ctx context.Context, id, version int64) error {
+	res, err := ar.conn(ctx).NewDelete().Model((*dto.AddressDTO)(nil)).
+		Where("id = ?", id).
+		Where("version = ?", version).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db_postgres.CheckVersion(ctx, ar.conn(ctx), (*dto.AddressDTO)(nil), id, res)
 }
@@ -19,7 +19,8 @@ type AddressDTO struct {
 	CEP          string    `bun:"cep,notnull"`
 	Country      string    `bun:"country,notnull"`
 	UserID       int64     `bun:"user_id,notnull,unique"`
+	Version      int64     `bun:"version,nullzero,notnull,default:1"`
 	CreatedAt    time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt    time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
-	DeletedAt    time.Time `bun:"deleted_at,soft_delete,nullzero,notnull,default:'0001-01-01 00:00:00+00'"`
+	DeletedAt    time.Time `bun:"deleted_at,soft_delete,notnull,default:'0001-01-01 00:00:00+00'"`
 }
@@ -17,6 +17,7 @@ func MapAddressToDTO(address *address.Address) *address_dto.AddressDTO {
 		CEP:          address.CEP,
 		Country:      address.Country,
 		UserID:       address.UserID,
+		Version:      address.Version,
 		CreatedAt:    address.CreatedAt,
 		UpdatedAt:    address.UpdatedAt,
 		DeletedAt:    address.DeletedAt,
@@ -40,6 +41,7 @@ func MapDTOToAddress(addressDTO *address_dto.AddressDTO) (*address.Address, erro
 		CEP:          addressDTO.CEP,
 		Country:      addressDTO.Country,
 		UserID:       addressDTO.UserID,
+		Version:      addressDTO.Version,
 		CreatedAt:    addressDTO.CreatedAt,
 		UpdatedAt:    addressDTO.UpdatedAt,
 		DeletedAt:    addressDTO.DeletedAt,
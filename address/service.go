@@ -3,18 +3,22 @@ package address
 import (
 	"context"
 
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
 )
 
 type Service struct {
-	repo   Repository
-	logger *logging.Logging
+	repo    Repository
+	auditor audit.Recorder
+	logger  *logging.Logging
 }
 
-func NewService(r Repository, l *logging.Logging) *Service {
+func NewService(r Repository, au audit.Recorder, l *logging.Logging) *Service {
 	return &Service{
-		repo:   r,
-		logger: l,
+		repo:    r,
+		auditor: au,
+		logger:  l,
 	}
 }
 
@@ -48,11 +52,35 @@ func (s *Service) GetByUserID(ctx context.Context, userID int64) (*Address, erro
 	return address, nil
 }
 
+func (s *Service) ListByUserIDs(ctx context.Context, userIDs []int64) (*[]Address, error) {
+	s.logger.Debug("[ADDRESS] ListByUserIDs - DEBUG: ", map[string]any{
+		"userIDs": userIDs,
+	})
+	addresses, err := s.repo.ListByUserIDs(ctx, userIDs)
+	if err != nil {
+		s.logger.Error("[ADDRESS] ListByUserIDs - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return addresses, nil
+}
+
 func (s *Service) Create(ctx context.Context, a *Address) (int64, error) {
 	s.logger.Debug("[ADDRESS] Create - DEBUG: ", map[string]any{
 		"address": a,
 	})
-	addressID, err := s.repo.Create(ctx, a)
+	var addressID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		addressID, err = s.repo.Create(ctx, a)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.ADDRESS, addressID, audit.CREATE, nil, a)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[ADDRESS] Create - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -67,7 +95,23 @@ func (s *Service) Update(ctx context.Context, a *Address) error {
 	s.logger.Debug("[ADDRESS] Update - DEBUG: ", map[string]any{
 		"address": a,
 	})
-	err := s.repo.Update(ctx, a)
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Update(ctx, a); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetByID(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.ADDRESS, a.ID, audit.UPDATE, before, after)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[ADDRESS] Update - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -78,11 +122,61 @@ func (s *Service) Update(ctx context.Context, a *Address) error {
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context, id int64) error {
+// Patch writes only the given fields of the address, which must carry the
+// version it was read with.
+func (s *Service) Patch(ctx context.Context, a *Address, fields []string) error {
+	s.logger.Debug("[ADDRESS] Patch - DEBUG: ", map[string]any{
+		"address": a,
+		"fields":  fields,
+	})
+	if err := mergepatch.CheckImmutable(fields, ImmutableFields...); err != nil {
+		return err
+	}
+
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Patch(ctx, a, fields); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetByID(ctx, a.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.ADDRESS, a.ID, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[ADDRESS] Patch - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, id, version int64) error {
 	s.logger.Debug("[ADDRESS] Delete - DEBUG: ", map[string]any{
 		"addressID": id,
+		"version":   version,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Delete(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.ADDRESS, id, audit.DELETE, before, nil)}, nil
 	})
-	err := s.repo.Delete(ctx, id)
 	if err != nil {
 		s.logger.Error("[ADDRESS] Delete - ERROR: ", map[string]any{
 			"err": err.Error(),
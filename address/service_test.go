@@ -9,6 +9,7 @@ import (
 	"github.com/LucasMateus-eng/operations-service/config"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
 	address_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/address"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
 	"github.com/go-playground/assert/v2"
 	"go.uber.org/mock/gomock"
 )
@@ -23,8 +24,9 @@ var (
 
 func TestService_GetByID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *address_mocks.MockRepository
-		logger *logging.Logging
+		repo    *address_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -71,15 +73,16 @@ func TestService_GetByID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   address_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    address_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := address.NewService(sm.repo, sm.logger)
+			s := address.NewService(sm.repo, sm.auditor, sm.logger)
 
 			actualUser, err := s.GetByID(test.args.ctx, test.args.id)
 
@@ -91,8 +94,9 @@ func TestService_GetByID(t *testing.T) {
 
 func TestService_GetByUserID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *address_mocks.MockRepository
-		logger *logging.Logging
+		repo    *address_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -139,15 +143,16 @@ func TestService_GetByUserID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   address_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    address_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := address.NewService(sm.repo, sm.logger)
+			s := address.NewService(sm.repo, sm.auditor, sm.logger)
 
 			actualAddress, err := s.GetByUserID(test.args.ctx, test.args.userID)
 
@@ -159,8 +164,9 @@ func TestService_GetByUserID(t *testing.T) {
 
 func TestService_Create(t *testing.T) {
 	type serviceMocks struct {
-		repo   *address_mocks.MockRepository
-		logger *logging.Logging
+		repo    *address_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -207,15 +213,16 @@ func TestService_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   address_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    address_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := address.NewService(sm.repo, sm.logger)
+			s := address.NewService(sm.repo, sm.auditor, sm.logger)
 
 			actualID, err := s.Create(test.args.ctx, test.args.a)
 
@@ -227,8 +234,9 @@ func TestService_Create(t *testing.T) {
 
 func TestService_Update(t *testing.T) {
 	type serviceMocks struct {
-		repo   *address_mocks.MockRepository
-		logger *logging.Logging
+		repo    *address_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -249,6 +257,7 @@ func TestService_Update(t *testing.T) {
 				a:   &address.Address{ID: 1, Locality: "Nova Localidade"},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.a.ID).Return(p.a, nil).Times(2)
 				m.repo.EXPECT().Update(p.ctx, p.a).Return(nil)
 			},
 			wantErr: false,
@@ -260,6 +269,7 @@ func TestService_Update(t *testing.T) {
 				a:   &address.Address{ID: 0},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.a.ID).Return(p.a, nil)
 				m.repo.EXPECT().Update(p.ctx, p.a).Return(errMocked)
 			},
 			wantErr: true,
@@ -272,15 +282,16 @@ func TestService_Update(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   address_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    address_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := address.NewService(sm.repo, sm.logger)
+			s := address.NewService(sm.repo, sm.auditor, sm.logger)
 
 			err := s.Update(test.args.ctx, test.args.a)
 
@@ -289,15 +300,97 @@ func TestService_Update(t *testing.T) {
 	}
 }
 
+func TestService_Patch(t *testing.T) {
+	type serviceMocks struct {
+		repo    *address_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		a      *address.Address
+		fields []string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado campos mutáveis quando o método Patch é chamado então apenas esses campos são gravados",
+			args: args{
+				ctx:    mockedContext,
+				a:      &address.Address{ID: 1, Version: 1},
+				fields: []string{"complement"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.a.ID).Return(p.a, nil).Times(2)
+				m.repo.EXPECT().Patch(p.ctx, p.a, p.fields).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado um campo imutável quando o método Patch é chamado então um erro é retornado sem gravar nada",
+			args: args{
+				ctx:    mockedContext,
+				a:      &address.Address{ID: 1, Version: 1},
+				fields: []string{"id"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Patch é chamado então o erro é retornado",
+			args: args{
+				ctx:    mockedContext,
+				a:      &address.Address{ID: 1, Version: 1},
+				fields: []string{"complement"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.a.ID).Return(p.a, nil)
+				m.repo.EXPECT().Patch(p.ctx, p.a, p.fields).Return(errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    address_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := address.NewService(sm.repo, sm.auditor, sm.logger)
+
+			err := s.Patch(test.args.ctx, test.args.a, test.args.fields)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
 func TestService_Delete(t *testing.T) {
 	type serviceMocks struct {
-		repo   *address_mocks.MockRepository
-		logger *logging.Logging
+		repo    *address_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
-		ctx context.Context
-		id  int64
+		ctx     context.Context
+		id      int64
+		version int64
 	}
 
 	tests := []struct {
@@ -309,22 +402,26 @@ func TestService_Delete(t *testing.T) {
 		{
 			name: "Dado um ID válido quando o método Delete é chamado então o endereço é deletado",
 			args: args{
-				ctx: mockedContext,
-				id:  1,
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(nil)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&address.Address{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "Dado um ID inválido quando o método Delete é chamado então um erro é retornado",
 			args: args{
-				ctx: mockedContext,
-				id:  0,
+				ctx:     mockedContext,
+				id:      0,
+				version: 1,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(errMocked)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&address.Address{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(errMocked)
 			},
 			wantErr: true,
 		},
@@ -336,17 +433,18 @@ func TestService_Delete(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   address_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    address_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := address.NewService(sm.repo, sm.logger)
+			s := address.NewService(sm.repo, sm.auditor, sm.logger)
 
-			err := s.Delete(test.args.ctx, test.args.id)
+			err := s.Delete(test.args.ctx, test.args.id, test.args.version)
 
 			assert.Equal(tt, test.wantErr, err != nil)
 		})
@@ -0,0 +1,73 @@
+package address
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	ErrEmptyLocality     = errors.New("the address locality cannot be empty")
+	ErrEmptyNumber       = errors.New("the address number cannot be empty")
+	ErrEmptyNeighborhood = errors.New("the address neighborhood cannot be empty")
+	ErrEmptyCity         = errors.New("the address city cannot be empty")
+	ErrUndefinedState    = errors.New("the address state cannot be equal to undefined")
+	ErrInvalidCEP        = errors.New("the address cep must have 8 digits")
+	ErrEmptyCountry      = errors.New("the address country cannot be empty")
+
+	cepPattern = regexp.MustCompile(`^[0-9]{5}-?[0-9]{3}$`)
+
+	brazilianStateAcronymMap = map[string]BrazilianState{
+		"AC": AC, "AL": AL, "AP": AP, "AM": AM, "BA": BA, "CE": CE, "ES": ES,
+		"GO": GO, "MA": MA, "MT": MT, "MS": MS, "MG": MG, "PA": PA, "PB": PB,
+		"PR": PR, "PE": PE, "PI": PI, "RJ": RJ, "RN": RN, "RS": RS, "RO": RO,
+		"RR": RR, "SC": SC, "SP": SP, "SE": SE, "TO": TO, "DF": DF,
+	}
+)
+
+// ParseBrazilianState accepts either the full name of the state or its
+// two-letter acronym (SP, RJ, ...).
+func ParseBrazilianState(value string) (BrazilianState, error) {
+	value = strings.TrimSpace(value)
+	if state, ok := brazilianStateAcronymMap[strings.ToUpper(value)]; ok {
+		return state, nil
+	}
+
+	return GetBrazilianState(value)
+}
+
+// Validate returns every rule broken by the address joined in a single error.
+func (a *Address) Validate() error {
+	var errs []error
+
+	if len(strings.TrimSpace(a.Locality)) == 0 {
+		errs = append(errs, ErrEmptyLocality)
+	}
+
+	if len(strings.TrimSpace(a.Number)) == 0 {
+		errs = append(errs, ErrEmptyNumber)
+	}
+
+	if len(strings.TrimSpace(a.Neighborhood)) == 0 {
+		errs = append(errs, ErrEmptyNeighborhood)
+	}
+
+	if len(strings.TrimSpace(a.City)) == 0 {
+		errs = append(errs, ErrEmptyCity)
+	}
+
+	if a.State == UNDEFINED {
+		errs = append(errs, ErrUndefinedState)
+	}
+
+	if !cepPattern.MatchString(strings.TrimSpace(a.CEP)) {
+		errs = append(errs, fmt.Errorf("%w: [%s]", ErrInvalidCEP, a.CEP))
+	}
+
+	if len(strings.TrimSpace(a.Country)) == 0 {
+		errs = append(errs, ErrEmptyCountry)
+	}
+
+	return errors.Join(errs...)
+}
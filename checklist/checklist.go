@@ -0,0 +1,243 @@
+package checklist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+// Kind is when the vehicle is inspected: before the driver takes it out on
+// a trip or once they bring it back.
+type Kind string
+
+const (
+	CHECKOUT Kind = "CHECKOUT"
+	CHECKIN  Kind = "CHECKIN"
+)
+
+var (
+	kinds = []Kind{CHECKOUT, CHECKIN}
+
+	ErrInvalidKind        = errors.New("the checklist kind must be one of CHECKOUT or CHECKIN")
+	ErrNoTemplate         = errors.New("there is no checklist template for the category of the vehicle")
+	ErrTemplateExists     = errors.New("there is already a checklist template for the category and the kind")
+	ErrNotAssigned        = errors.New("the driver is not assigned to the vehicle")
+	ErrNotChecklistDriver = errors.New("a driver can only submit their own checklists")
+)
+
+func GetKind(name string) (Kind, error) {
+	kind := Kind(name)
+	if !slices.Contains(kinds, kind) {
+		return "", ErrInvalidKind
+	}
+
+	return kind, nil
+}
+
+// Item is something a driver inspects, such as the tyres, the lights or the
+// documents. A failed critical item opens a maintenance ticket.
+type Item struct {
+	Key      string
+	Label    string
+	Critical bool
+}
+
+// Template is the list of items inspected on the vehicles of a category. A
+// template without a category is the default of the categories without one.
+type Template struct {
+	ID        int64
+	Name      string
+	Category  vehicle.Category
+	Kind      Kind
+	Items     []Item
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Answer is the outcome of an item. Label and Critical are copied from the
+// template, so the checklist keeps them when the template changes.
+type Answer struct {
+	Key      string
+	Label    string
+	Critical bool
+	Passed   bool
+	Notes    string
+}
+
+// Photo is the metadata of a picture of the vehicle, such as of a damage,
+// taken during the inspection. The picture itself is stored elsewhere, at
+// URL. ItemKey is the item it shows, if any.
+type Photo struct {
+	ItemKey     string
+	URL         string
+	ContentType string
+	TakenAt     time.Time
+}
+
+// Checklist is an inspection a driver submitted for the vehicle they are
+// assigned to. FuelLevel is a percentage of the tank.
+type Checklist struct {
+	ID          int64
+	Kind        Kind
+	TemplateID  int64
+	DriverID    int64
+	VehicleID   int64
+	Odometer    int64
+	FuelLevel   int
+	Answers     []Answer
+	Photos      []Photo
+	Notes       string
+	SubmittedAt time.Time
+	CreatedAt   time.Time
+}
+
+// Failed returns the critical items that did not pass.
+func (c *Checklist) Failed() []Answer {
+	var failed []Answer
+	for _, a := range c.Answers {
+		if a.Critical && !a.Passed {
+			failed = append(failed, a)
+		}
+	}
+
+	return failed
+}
+
+// Answer fills in the answers of the checklist from the items of the
+// template, in the order of the template. Every item must be answered once
+// and every photo must show an item of the template, if any.
+func (t *Template) Answer(c *Checklist) error {
+	given := make(map[string]Answer, len(c.Answers))
+	for _, a := range c.Answers {
+		given[a.Key] = a
+	}
+
+	var errs []error
+	answers := make([]Answer, 0, len(t.Items))
+	keys := make(map[string]bool, len(t.Items))
+	for _, item := range t.Items {
+		keys[item.Key] = true
+
+		a, ok := given[item.Key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%w: [%s]", ErrMissingAnswer, item.Key))
+			continue
+		}
+
+		a.Label = item.Label
+		a.Critical = item.Critical
+		answers = append(answers, a)
+	}
+
+	for _, a := range c.Answers {
+		if !keys[a.Key] {
+			errs = append(errs, fmt.Errorf("%w: [%s]", ErrUnknownItem, a.Key))
+		}
+	}
+
+	for _, p := range c.Photos {
+		if len(p.ItemKey) > 0 && !keys[p.ItemKey] {
+			errs = append(errs, fmt.Errorf("%w: photo of [%s]", ErrUnknownItem, p.ItemKey))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidChecklist, errors.Join(errs...))
+	}
+
+	c.TemplateID = t.ID
+	c.Answers = answers
+
+	return nil
+}
+
+// ChecklistSpecification filters the checklists. From and To bound the
+// submission, either of which may be zero to leave the period open.
+type ChecklistSpecification struct {
+	VehicleID      int64
+	DriverID       int64
+	Kind           Kind
+	From, To       time.Time
+	Page, PageSize int
+}
+
+type TemplateSpecification struct {
+	Category       vehicle.Category
+	Kind           Kind
+	Page, PageSize int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Checklist, error)
+	List(ctx context.Context, specification *ChecklistSpecification) (*[]Checklist, error)
+	GetTemplate(ctx context.Context, id int64) (*Template, error)
+	ListTemplates(ctx context.Context, specification *TemplateSpecification) (*[]Template, error)
+	// FindTemplate returns the template of the category and the kind,
+	// falling back to the default one of the kind, or nil when there is
+	// neither.
+	FindTemplate(ctx context.Context, category vehicle.Category, kind Kind) (*Template, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, c *Checklist) (int64, error)
+	// CreateTemplate and UpdateTemplate fail with ErrTemplateExists when
+	// another template has the same category and kind.
+	CreateTemplate(ctx context.Context, t *Template) (int64, error)
+	UpdateTemplate(ctx context.Context, t *Template) error
+	DeleteTemplate(ctx context.Context, id int64) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// AssignmentReading is the part of the driver-vehicle use case needed to
+// check that the driver may inspect the vehicle. GetByID returns nil when
+// the driver is not assigned to the vehicle.
+type AssignmentReading interface {
+	GetByID(ctx context.Context, driverID, vehicleID int64) (*drivervehicle.DriverVehicle, error)
+}
+
+// VehicleReading is the part of the vehicle use case needed to find the
+// category of the vehicle inspected.
+type VehicleReading interface {
+	GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error)
+}
+
+// DriverReading is the part of the driver use case needed to find the
+// driver of the authenticated user.
+type DriverReading interface {
+	GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error)
+}
+
+// OdometerWriting is the part of the odometer use case needed to record the
+// odometer read during the inspection.
+type OdometerWriting interface {
+	Create(ctx context.Context, r *odometer.Record) (int64, error)
+}
+
+// TicketWriting is the part of the maintenance use case needed to open a
+// ticket for each failed critical item.
+type TicketWriting interface {
+	CreateTicket(ctx context.Context, t *maintenance.Ticket) (int64, error)
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Checklist, error)
+	List(ctx context.Context, specification *ChecklistSpecification) (*[]Checklist, error)
+	Submit(ctx context.Context, c *Checklist) (int64, error)
+	GetTemplate(ctx context.Context, id int64) (*Template, error)
+	ListTemplates(ctx context.Context, specification *TemplateSpecification) (*[]Template, error)
+	CreateTemplate(ctx context.Context, t *Template) (int64, error)
+	UpdateTemplate(ctx context.Context, t *Template) error
+	DeleteTemplate(ctx context.Context, id int64) error
+}
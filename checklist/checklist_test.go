@@ -0,0 +1,78 @@
+package checklist_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	"github.com/go-playground/assert/v2"
+)
+
+var template = &checklist.Template{
+	ID:   4,
+	Name: "Saída de caminhões",
+	Kind: checklist.CHECKOUT,
+	Items: []checklist.Item{
+		{Key: "tyres", Label: "Pneus", Critical: true},
+		{Key: "lights", Label: "Faróis e lanternas", Critical: true},
+		{Key: "documents", Label: "Documentos"},
+	},
+}
+
+func TestTemplate_Answer(t *testing.T) {
+	tests := []struct {
+		name        string
+		answers     []checklist.Answer
+		photos      []checklist.Photo
+		wantAnswers []checklist.Answer
+		wantFailed  []string
+		wantErrs    []error
+	}{
+		{
+			name: "Dado todos os itens respondidos fora de ordem quando o método Answer é chamado então as respostas seguem o modelo",
+			answers: []checklist.Answer{
+				{Key: "documents", Passed: false, Notes: "CRLV vencido"},
+				{Key: "lights", Passed: false, Notes: "Farol esquerdo queimado"},
+				{Key: "tyres", Passed: true},
+			},
+			photos: []checklist.Photo{{ItemKey: "lights", URL: "https://fotos.example.com/farol.jpg"}, {URL: "https://fotos.example.com/lataria.jpg"}},
+			wantAnswers: []checklist.Answer{
+				{Key: "tyres", Label: "Pneus", Critical: true, Passed: true},
+				{Key: "lights", Label: "Faróis e lanternas", Critical: true, Notes: "Farol esquerdo queimado"},
+				{Key: "documents", Label: "Documentos", Notes: "CRLV vencido"},
+			},
+			wantFailed: []string{"lights"},
+		},
+		{
+			name:     "Dado um item faltando e um item desconhecido quando o método Answer é chamado então os erros são retornados",
+			answers:  []checklist.Answer{{Key: "tyres", Passed: true}, {Key: "lights", Passed: true}, {Key: "horn", Passed: true}},
+			photos:   []checklist.Photo{{ItemKey: "mirror", URL: "https://fotos.example.com/espelho.jpg"}},
+			wantErrs: []error{checklist.ErrInvalidChecklist, checklist.ErrMissingAnswer, checklist.ErrUnknownItem},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			c := &checklist.Checklist{Answers: test.answers, Photos: test.photos}
+
+			err := template.Answer(c)
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+			if err != nil {
+				return
+			}
+
+			assert.Equal(tt, template.ID, c.TemplateID)
+			assert.Equal(tt, test.wantAnswers, c.Answers)
+
+			var failed []string
+			for _, a := range c.Failed() {
+				failed = append(failed, a.Key)
+			}
+			assert.Equal(tt, test.wantFailed, failed)
+		})
+	}
+}
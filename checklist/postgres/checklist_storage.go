@@ -0,0 +1,214 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	"github.com/LucasMateus-eng/operations-service/checklist/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/checklist/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/uptrace/bun"
+)
+
+type checklistPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *checklistPostgresRepo {
+	return &checklistPostgresRepo{
+		db: db,
+	}
+}
+
+func (cr *checklistPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, cr.db)
+}
+
+func (cr *checklistPostgresRepo) GetByID(ctx context.Context, id int64) (*checklist.Checklist, error) {
+	checklistDTO := new(dto.ChecklistDTO)
+
+	err := cr.conn(ctx).NewSelect().Model(checklistDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToChecklist(checklistDTO), nil
+}
+
+// List returns the checklists, the latest submitted first.
+func (cr *checklistPostgresRepo) List(ctx context.Context, specification *checklist.ChecklistSpecification) (*[]checklist.Checklist, error) {
+	var checklistDTOs []dto.ChecklistDTO
+
+	query := cr.conn(ctx).NewSelect().Model(&checklistDTOs).Order("submitted_at DESC", "id DESC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if specification.DriverID != 0 {
+		query = query.Where("driver_id = ?", specification.DriverID)
+	}
+
+	if len(specification.Kind) > 0 {
+		query = query.Where("kind = ?", specification.Kind)
+	}
+
+	if !specification.From.IsZero() {
+		query = query.Where("submitted_at >= ?", specification.From)
+	}
+
+	if !specification.To.IsZero() {
+		query = query.Where("submitted_at < ?", specification.To)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	checklists := make([]checklist.Checklist, 0, len(checklistDTOs))
+	for _, dto := range checklistDTOs {
+		checklists = append(checklists, *mapping.MapDTOToChecklist(&dto))
+	}
+
+	return &checklists, nil
+}
+
+func (cr *checklistPostgresRepo) GetTemplate(ctx context.Context, id int64) (*checklist.Template, error) {
+	templateDTO := new(dto.TemplateDTO)
+
+	err := cr.conn(ctx).NewSelect().Model(templateDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToTemplate(templateDTO), nil
+}
+
+func (cr *checklistPostgresRepo) ListTemplates(ctx context.Context, specification *checklist.TemplateSpecification) (*[]checklist.Template, error) {
+	var templateDTOs []dto.TemplateDTO
+
+	query := cr.conn(ctx).NewSelect().Model(&templateDTOs).Order("category ASC", "kind ASC", "id ASC")
+
+	if len(specification.Category) > 0 {
+		query = query.Where("category = ?", specification.Category)
+	}
+
+	if len(specification.Kind) > 0 {
+		query = query.Where("kind = ?", specification.Kind)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]checklist.Template, 0, len(templateDTOs))
+	for _, dto := range templateDTOs {
+		templates = append(templates, *mapping.MapDTOToTemplate(&dto))
+	}
+
+	return &templates, nil
+}
+
+// FindTemplate sorts the template of the category before the default one,
+// whose category is empty.
+func (cr *checklistPostgresRepo) FindTemplate(ctx context.Context, category vehicle.Category, kind checklist.Kind) (*checklist.Template, error) {
+	templateDTO := new(dto.TemplateDTO)
+
+	err := cr.conn(ctx).NewSelect().
+		Model(templateDTO).
+		Where("kind = ?", kind).
+		Where("category IN (?, '')", category).
+		Order("category DESC").
+		Limit(1).
+		Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return mapping.MapDTOToTemplate(templateDTO), nil
+}
+
+func (cr *checklistPostgresRepo) Create(ctx context.Context, c *checklist.Checklist) (int64, error) {
+	checklistDTO := mapping.MapChecklistToDTO(c)
+
+	_, err := cr.conn(ctx).NewInsert().Model(checklistDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return checklistDTO.ID, nil
+}
+
+func (cr *checklistPostgresRepo) CreateTemplate(ctx context.Context, t *checklist.Template) (int64, error) {
+	templateDTO := mapping.MapTemplateToDTO(t)
+
+	_, err := cr.conn(ctx).NewInsert().Model(templateDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return 0, checklist.ErrTemplateExists
+		}
+
+		return 0, err
+	}
+
+	return templateDTO.ID, nil
+}
+
+func (cr *checklistPostgresRepo) UpdateTemplate(ctx context.Context, t *checklist.Template) error {
+	templateDTO := mapping.MapTemplateToDTO(t)
+
+	res, err := cr.conn(ctx).NewUpdate().
+		Model(templateDTO).
+		Column("name", "category", "kind", "items", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return checklist.ErrTemplateExists
+		}
+
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (cr *checklistPostgresRepo) DeleteTemplate(ctx context.Context, id int64) error {
+	res, err := cr.conn(ctx).NewDelete().Model((*dto.TemplateDTO)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ItemDTO struct {
+	Key      string `json:"key"`
+	Label    string `json:"label"`
+	Critical bool   `json:"critical"`
+}
+
+// TemplateDTO keeps the default template of a kind under an empty category,
+// so the category takes part in its unique index.
+type TemplateDTO struct {
+	bun.BaseModel `bun:"table:checklist_templates"`
+
+	ID        int64     `bun:"id,pk,autoincrement"`
+	Name      string    `bun:"name,notnull"`
+	Category  string    `bun:"category,notnull"`
+	Kind      string    `bun:"kind,notnull"`
+	Items     []ItemDTO `bun:"items,type:jsonb,notnull"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type AnswerDTO struct {
+	Key      string `json:"key"`
+	Label    string `json:"label"`
+	Critical bool   `json:"critical"`
+	Passed   bool   `json:"passed"`
+	Notes    string `json:"notes,omitempty"`
+}
+
+type PhotoDTO struct {
+	ItemKey     string    `json:"item_key,omitempty"`
+	URL         string    `json:"url"`
+	ContentType string    `json:"content_type,omitempty"`
+	TakenAt     time.Time `json:"taken_at"`
+}
+
+type ChecklistDTO struct {
+	bun.BaseModel `bun:"table:checklists"`
+
+	ID          int64       `bun:"id,pk,autoincrement"`
+	Kind        string      `bun:"kind,notnull"`
+	TemplateID  int64       `bun:"template_id,nullzero"`
+	DriverID    int64       `bun:"driver_id,notnull"`
+	VehicleID   int64       `bun:"vehicle_id,notnull"`
+	Odometer    int64       `bun:"odometer,notnull"`
+	FuelLevel   int         `bun:"fuel_level,notnull"`
+	Answers     []AnswerDTO `bun:"answers,type:jsonb,notnull"`
+	Photos      []PhotoDTO  `bun:"photos,type:jsonb,notnull"`
+	Notes       string      `bun:"notes,nullzero"`
+	SubmittedAt time.Time   `bun:"submitted_at,notnull"`
+	CreatedAt   time.Time   `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,125 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	"github.com/LucasMateus-eng/operations-service/checklist/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+func MapTemplateToDTO(template *checklist.Template) *dto.TemplateDTO {
+	items := make([]dto.ItemDTO, 0, len(template.Items))
+	for _, item := range template.Items {
+		items = append(items, dto.ItemDTO{
+			Key:      item.Key,
+			Label:    item.Label,
+			Critical: item.Critical,
+		})
+	}
+
+	return &dto.TemplateDTO{
+		ID:        template.ID,
+		Name:      template.Name,
+		Category:  string(template.Category),
+		Kind:      string(template.Kind),
+		Items:     items,
+		CreatedAt: template.CreatedAt,
+		UpdatedAt: template.UpdatedAt,
+	}
+}
+
+func MapDTOToTemplate(templateDTO *dto.TemplateDTO) *checklist.Template {
+	items := make([]checklist.Item, 0, len(templateDTO.Items))
+	for _, item := range templateDTO.Items {
+		items = append(items, checklist.Item{
+			Key:      item.Key,
+			Label:    item.Label,
+			Critical: item.Critical,
+		})
+	}
+
+	return &checklist.Template{
+		ID:        templateDTO.ID,
+		Name:      templateDTO.Name,
+		Category:  vehicle.Category(templateDTO.Category),
+		Kind:      checklist.Kind(templateDTO.Kind),
+		Items:     items,
+		CreatedAt: templateDTO.CreatedAt,
+		UpdatedAt: templateDTO.UpdatedAt,
+	}
+}
+
+func MapChecklistToDTO(c *checklist.Checklist) *dto.ChecklistDTO {
+	answers := make([]dto.AnswerDTO, 0, len(c.Answers))
+	for _, a := range c.Answers {
+		answers = append(answers, dto.AnswerDTO{
+			Key:      a.Key,
+			Label:    a.Label,
+			Critical: a.Critical,
+			Passed:   a.Passed,
+			Notes:    a.Notes,
+		})
+	}
+
+	photos := make([]dto.PhotoDTO, 0, len(c.Photos))
+	for _, p := range c.Photos {
+		photos = append(photos, dto.PhotoDTO{
+			ItemKey:     p.ItemKey,
+			URL:         p.URL,
+			ContentType: p.ContentType,
+			TakenAt:     p.TakenAt,
+		})
+	}
+
+	return &dto.ChecklistDTO{
+		ID:          c.ID,
+		Kind:        string(c.Kind),
+		TemplateID:  c.TemplateID,
+		DriverID:    c.DriverID,
+		VehicleID:   c.VehicleID,
+		Odometer:    c.Odometer,
+		FuelLevel:   c.FuelLevel,
+		Answers:     answers,
+		Photos:      photos,
+		Notes:       c.Notes,
+		SubmittedAt: c.SubmittedAt,
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+func MapDTOToChecklist(checklistDTO *dto.ChecklistDTO) *checklist.Checklist {
+	answers := make([]checklist.Answer, 0, len(checklistDTO.Answers))
+	for _, a := range checklistDTO.Answers {
+		answers = append(answers, checklist.Answer{
+			Key:      a.Key,
+			Label:    a.Label,
+			Critical: a.Critical,
+			Passed:   a.Passed,
+			Notes:    a.Notes,
+		})
+	}
+
+	photos := make([]checklist.Photo, 0, len(checklistDTO.Photos))
+	for _, p := range checklistDTO.Photos {
+		photos = append(photos, checklist.Photo{
+			ItemKey:     p.ItemKey,
+			URL:         p.URL,
+			ContentType: p.ContentType,
+			TakenAt:     p.TakenAt,
+		})
+	}
+
+	return &checklist.Checklist{
+		ID:          checklistDTO.ID,
+		Kind:        checklist.Kind(checklistDTO.Kind),
+		TemplateID:  checklistDTO.TemplateID,
+		DriverID:    checklistDTO.DriverID,
+		VehicleID:   checklistDTO.VehicleID,
+		Odometer:    checklistDTO.Odometer,
+		FuelLevel:   checklistDTO.FuelLevel,
+		Answers:     answers,
+		Photos:      photos,
+		Notes:       checklistDTO.Notes,
+		SubmittedAt: checklistDTO.SubmittedAt,
+		CreatedAt:   checklistDTO.CreatedAt,
+	}
+}
@@ -0,0 +1,305 @@
+package checklist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/user"
+)
+
+type Service struct {
+	repo        Repository
+	auditor     audit.Recorder
+	assignments AssignmentReading
+	vehicles    VehicleReading
+	drivers     DriverReading
+	odometer    OdometerWriting
+	tickets     TicketWriting
+	logger      *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, ar AssignmentReading, vr VehicleReading, dr DriverReading, ow OdometerWriting, tw TicketWriting, l *logging.Logging) *Service {
+	return &Service{
+		repo:        r,
+		auditor:     au,
+		assignments: ar,
+		vehicles:    vr,
+		drivers:     dr,
+		odometer:    ow,
+		tickets:     tw,
+		logger:      l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Checklist, error) {
+	s.logger.Debug("[CHECKLIST] GetByID - DEBUG: ", map[string]any{
+		"checklistID": id,
+	})
+	checklist, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[CHECKLIST] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return checklist, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *ChecklistSpecification) (*[]Checklist, error) {
+	s.logger.Debug("[CHECKLIST] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	checklists, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[CHECKLIST] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return checklists, nil
+}
+
+// Submit saves the inspection of the vehicle by the driver assigned to it,
+// against the template of the category of the vehicle. The odometer read is
+// recorded and a maintenance ticket is opened for each failed critical item.
+func (s *Service) Submit(ctx context.Context, c *Checklist) (int64, error) {
+	s.logger.Debug("[CHECKLIST] Submit - DEBUG: ", map[string]any{
+		"checklist": c,
+	})
+	if err := c.Validate(); err != nil {
+		return 0, err
+	}
+
+	var checklistID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.authorize(ctx, c); err != nil {
+			return nil, err
+		}
+
+		assignment, err := s.assignments.GetByID(ctx, c.DriverID, c.VehicleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if assignment == nil {
+			return nil, fmt.Errorf("%w: driver [%d], vehicle [%d]", ErrNotAssigned, c.DriverID, c.VehicleID)
+		}
+
+		v, err := s.vehicles.GetByID(ctx, c.VehicleID)
+		if err != nil {
+			return nil, err
+		}
+
+		template, err := s.repo.FindTemplate(ctx, v.Attributes.Category, c.Kind)
+		if err != nil {
+			return nil, err
+		}
+
+		if template == nil {
+			return nil, fmt.Errorf("%w: category [%s], kind [%s]", ErrNoTemplate, v.Attributes.Category, c.Kind)
+		}
+
+		if err := template.Answer(c); err != nil {
+			return nil, err
+		}
+
+		c.SubmittedAt = time.Now()
+
+		checklistID, err = s.repo.Create(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = s.odometer.Create(ctx, &odometer.Record{
+			VehicleID: c.VehicleID,
+			ReadAt:    c.SubmittedAt,
+			Km:        c.Odometer,
+			Source:    odometer.CHECKLIST,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, a := range c.Failed() {
+			_, err := s.tickets.CreateTicket(ctx, &maintenance.Ticket{
+				VehicleID:   c.VehicleID,
+				ChecklistID: checklistID,
+				Description: describe(a),
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.CHECKLIST, checklistID, audit.CREATE, nil, c)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[CHECKLIST] Submit - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return checklistID, nil
+}
+
+func (s *Service) GetTemplate(ctx context.Context, id int64) (*Template, error) {
+	s.logger.Debug("[CHECKLIST] GetTemplate - DEBUG: ", map[string]any{
+		"templateID": id,
+	})
+	template, err := s.repo.GetTemplate(ctx, id)
+	if err != nil {
+		s.logger.Error("[CHECKLIST] GetTemplate - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return template, nil
+}
+
+func (s *Service) ListTemplates(ctx context.Context, specification *TemplateSpecification) (*[]Template, error) {
+	s.logger.Debug("[CHECKLIST] ListTemplates - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	templates, err := s.repo.ListTemplates(ctx, specification)
+	if err != nil {
+		s.logger.Error("[CHECKLIST] ListTemplates - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return templates, nil
+}
+
+func (s *Service) CreateTemplate(ctx context.Context, t *Template) (int64, error) {
+	s.logger.Debug("[CHECKLIST] CreateTemplate - DEBUG: ", map[string]any{
+		"template": t,
+	})
+	if err := t.Validate(); err != nil {
+		return 0, err
+	}
+
+	var templateID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		templateID, err = s.repo.CreateTemplate(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.CHECKLIST_TEMPLATE, templateID, audit.CREATE, nil, t)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[CHECKLIST] CreateTemplate - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return templateID, nil
+}
+
+// UpdateTemplate changes the items inspected from then on. The checklists
+// already submitted keep the items they were answered against.
+func (s *Service) UpdateTemplate(ctx context.Context, t *Template) error {
+	s.logger.Debug("[CHECKLIST] UpdateTemplate - DEBUG: ", map[string]any{
+		"template": t,
+	})
+	if err := t.Validate(); err != nil {
+		return err
+	}
+
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetTemplate(ctx, t.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.UpdateTemplate(ctx, t); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetTemplate(ctx, t.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.CHECKLIST_TEMPLATE, t.ID, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[CHECKLIST] UpdateTemplate - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+// DeleteTemplate keeps the checklists answered against the template, which
+// no longer point to it.
+func (s *Service) DeleteTemplate(ctx context.Context, id int64) error {
+	s.logger.Debug("[CHECKLIST] DeleteTemplate - DEBUG: ", map[string]any{
+		"templateID": id,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetTemplate(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.DeleteTemplate(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.CHECKLIST_TEMPLATE, id, audit.DELETE, before, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[CHECKLIST] DeleteTemplate - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+// authorize lets a driver submit only their own checklists. Other roles,
+// and requests without an actor, may submit on behalf of any driver.
+func (s *Service) authorize(ctx context.Context, c *Checklist) error {
+	a, ok := actor.FromContext(ctx)
+	if !ok || a.Role != user.DRIVER.String() {
+		return nil
+	}
+
+	d, err := s.drivers.GetByUserID(ctx, a.UserID)
+	if err != nil {
+		return err
+	}
+
+	if d == nil || d.ID != c.DriverID {
+		return fmt.Errorf("%w: driver [%d]", ErrNotChecklistDriver, c.DriverID)
+	}
+
+	return nil
+}
+
+// describe is the description of the ticket opened for a failed item.
+func describe(a Answer) string {
+	if notes := strings.TrimSpace(a.Notes); len(notes) > 0 {
+		return fmt.Sprintf("%s: %s", a.Label, notes)
+	}
+
+	return a.Label
+}
@@ -0,0 +1,222 @@
+package checklist_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	checklist_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/checklist"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	assignment    = &drivervehicle.DriverVehicle{DriverID: 1, VehicleID: 2}
+	truck         = &vehicle.Vehicle{ID: 2, Attributes: vehicle.VehicleAttributes{Category: vehicle.TRUCK}}
+)
+
+func TestService_Submit(t *testing.T) {
+	type serviceMocks struct {
+		repo        *checklist_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		assignments *checklist_mocks.MockAssignmentReading
+		vehicles    *checklist_mocks.MockVehicleReading
+		drivers     *checklist_mocks.MockDriverReading
+		odometer    *checklist_mocks.MockOdometerWriting
+		tickets     *checklist_mocks.MockTicketWriting
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		c   *checklist.Checklist
+	}
+
+	passed := []checklist.Answer{
+		{Key: "tyres", Passed: true},
+		{Key: "lights", Passed: true},
+		{Key: "documents", Passed: true},
+	}
+
+	driverContext := actor.WithActor(mockedContext, &actor.Actor{UserID: 10, Role: user.DRIVER.String()})
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        int64
+		wantErr     error
+	}{
+		{
+			name: "Dado um checklist aprovado quando o método Submit é chamado então o checklist e o odômetro são registrados sem chamados",
+			args: args{
+				ctx: mockedContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: passed},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.vehicles.EXPECT().GetByID(p.ctx, int64(2)).Return(truck, nil)
+				m.repo.EXPECT().FindTemplate(p.ctx, vehicle.TRUCK, checklist.CHECKOUT).Return(template, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(9), nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Cond(func(x any) bool {
+					r, ok := x.(*odometer.Record)
+					return ok && r.Source == odometer.CHECKLIST && r.Km == p.c.Odometer
+				})).Return(int64(1), nil)
+			},
+			want:    9,
+			wantErr: nil,
+		},
+		{
+			name: "Dado itens críticos reprovados quando o método Submit é chamado então um chamado de manutenção é aberto para cada um",
+			args: args{
+				ctx: driverContext,
+				c: &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: []checklist.Answer{
+					{Key: "tyres", Passed: false, Notes: "Pneu dianteiro careca"},
+					{Key: "lights", Passed: false},
+					{Key: "documents", Passed: false},
+				}},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.vehicles.EXPECT().GetByID(p.ctx, int64(2)).Return(truck, nil)
+				m.repo.EXPECT().FindTemplate(p.ctx, vehicle.TRUCK, checklist.CHECKOUT).Return(template, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(9), nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(1), nil)
+				m.tickets.EXPECT().CreateTicket(p.ctx, &maintenance.Ticket{VehicleID: 2, ChecklistID: 9, Description: "Pneus: Pneu dianteiro careca"}).Return(int64(1), nil)
+				m.tickets.EXPECT().CreateTicket(p.ctx, &maintenance.Ticket{VehicleID: 2, ChecklistID: 9, Description: "Faróis e lanternas"}).Return(int64(2), nil)
+			},
+			want:    9,
+			wantErr: nil,
+		},
+		{
+			name: "Dado um veículo sem modelo de checklist quando o método Submit é chamado então o checklist não é registrado",
+			args: args{
+				ctx: mockedContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: passed},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.vehicles.EXPECT().GetByID(p.ctx, int64(2)).Return(truck, nil)
+				m.repo.EXPECT().FindTemplate(p.ctx, vehicle.TRUCK, checklist.CHECKOUT).Return(nil, nil)
+			},
+			wantErr: checklist.ErrNoTemplate,
+		},
+		{
+			name: "Dado um item do modelo sem resposta quando o método Submit é chamado então o checklist não é registrado",
+			args: args{
+				ctx: mockedContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: passed[:2]},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.vehicles.EXPECT().GetByID(p.ctx, int64(2)).Return(truck, nil)
+				m.repo.EXPECT().FindTemplate(p.ctx, vehicle.TRUCK, checklist.CHECKOUT).Return(template, nil)
+			},
+			wantErr: checklist.ErrMissingAnswer,
+		},
+		{
+			name: "Dado um motorista não vinculado ao veículo quando o método Submit é chamado então o checklist não é registrado",
+			args: args{
+				ctx: mockedContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: passed},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(nil, nil)
+			},
+			wantErr: checklist.ErrNotAssigned,
+		},
+		{
+			name: "Dado uma falha ao buscar o vínculo quando o método Submit é chamado então o checklist não é registrado",
+			args: args{
+				ctx: mockedContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: passed},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado um motorista enviando o checklist de outro quando o método Submit é chamado então o checklist não é registrado",
+			args: args{
+				ctx: driverContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: passed},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 3}, nil)
+			},
+			wantErr: checklist.ErrNotChecklistDriver,
+		},
+		{
+			name: "Dado um erro ao abrir o chamado quando o método Submit é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKOUT, DriverID: 1, VehicleID: 2, Odometer: 15000, FuelLevel: 60, Answers: []checklist.Answer{{Key: "tyres"}, passed[1], passed[2]}},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.vehicles.EXPECT().GetByID(p.ctx, int64(2)).Return(truck, nil)
+				m.repo.EXPECT().FindTemplate(p.ctx, vehicle.TRUCK, checklist.CHECKOUT).Return(template, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(9), nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(1), nil)
+				m.tickets.EXPECT().CreateTicket(p.ctx, gomock.Any()).Return(int64(0), errMocked)
+			},
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado um checklist inválido quando o método Submit é chamado então o checklist não é registrado",
+			args: args{
+				ctx: mockedContext,
+				c:   &checklist.Checklist{Kind: checklist.CHECKIN, FuelLevel: 101},
+			},
+			wantErr: checklist.ErrInvalidChecklist,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        checklist_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				assignments: checklist_mocks.NewMockAssignmentReading(ctrl),
+				vehicles:    checklist_mocks.NewMockVehicleReading(ctrl),
+				drivers:     checklist_mocks.NewMockDriverReading(ctrl),
+				odometer:    checklist_mocks.NewMockOdometerWriting(ctrl),
+				tickets:     checklist_mocks.NewMockTicketWriting(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := checklist.NewService(sm.repo, sm.auditor, sm.assignments, sm.vehicles, sm.drivers, sm.odometer, sm.tickets, sm.logger)
+
+			actualID, err := s.Submit(test.args.ctx, test.args.c)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, template.ID, test.args.c.TemplateID)
+				assert.Equal(tt, false, test.args.c.SubmittedAt.IsZero())
+			}
+		})
+	}
+}
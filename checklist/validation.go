@@ -0,0 +1,129 @@
+package checklist
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+// MAXIMUM_FUEL_LEVEL is the fuel level of a full tank.
+const MAXIMUM_FUEL_LEVEL = 100
+
+var (
+	ErrInvalidChecklist = errors.New("the given checklist is invalid")
+	ErrInvalidTemplate  = errors.New("the given checklist template is invalid")
+
+	ErrMissingDriver     = errors.New("the checklist must have a driver")
+	ErrMissingVehicle    = errors.New("the checklist must have a vehicle")
+	ErrNegativeOdometer  = errors.New("the checklist odometer cannot be negative")
+	ErrInvalidFuelLevel  = errors.New("the checklist fuel level must be between 0 and 100")
+	ErrEmptyAnswerKey    = errors.New("every answer must have the key of an item")
+	ErrDuplicateAnswer   = errors.New("an item can only be answered once")
+	ErrMissingAnswer     = errors.New("every item of the template must be answered")
+	ErrUnknownItem       = errors.New("the item is not on the template")
+	ErrEmptyPhotoURL     = errors.New("every photo must have a URL")
+	ErrEmptyTemplateName = errors.New("the checklist template name cannot be empty")
+	ErrEmptyItems        = errors.New("the checklist template must have at least one item")
+	ErrInvalidItem       = errors.New("every item must have a key and a label")
+	ErrDuplicateItem     = errors.New("the keys of the items must be unique")
+)
+
+// Validate returns every rule broken by the checklist joined in a single
+// error. Whether the answers match the items is checked against the
+// template by Answer.
+func (c *Checklist) Validate() error {
+	var errs []error
+
+	if c.DriverID <= 0 {
+		errs = append(errs, ErrMissingDriver)
+	}
+
+	if c.VehicleID <= 0 {
+		errs = append(errs, ErrMissingVehicle)
+	}
+
+	if _, err := GetKind(string(c.Kind)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if c.Odometer < 0 {
+		errs = append(errs, ErrNegativeOdometer)
+	}
+
+	if c.FuelLevel < 0 || c.FuelLevel > MAXIMUM_FUEL_LEVEL {
+		errs = append(errs, ErrInvalidFuelLevel)
+	}
+
+	keys := make(map[string]bool, len(c.Answers))
+	for _, a := range c.Answers {
+		if len(strings.TrimSpace(a.Key)) == 0 {
+			errs = append(errs, ErrEmptyAnswerKey)
+			continue
+		}
+
+		if keys[a.Key] {
+			errs = append(errs, fmt.Errorf("%w: [%s]", ErrDuplicateAnswer, a.Key))
+		}
+
+		keys[a.Key] = true
+	}
+
+	for _, p := range c.Photos {
+		if len(strings.TrimSpace(p.URL)) == 0 {
+			errs = append(errs, ErrEmptyPhotoURL)
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidChecklist, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Validate returns every rule broken by the template joined in a single
+// error.
+func (t *Template) Validate() error {
+	var errs []error
+
+	if len(strings.TrimSpace(t.Name)) == 0 {
+		errs = append(errs, ErrEmptyTemplateName)
+	}
+
+	if len(t.Category) > 0 {
+		if _, err := vehicle.GetCategory(string(t.Category)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if _, err := GetKind(string(t.Kind)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(t.Items) == 0 {
+		errs = append(errs, ErrEmptyItems)
+	}
+
+	keys := make(map[string]bool, len(t.Items))
+	for _, item := range t.Items {
+		if len(strings.TrimSpace(item.Key)) == 0 || len(strings.TrimSpace(item.Label)) == 0 {
+			errs = append(errs, ErrInvalidItem)
+			continue
+		}
+
+		if keys[item.Key] {
+			errs = append(errs, fmt.Errorf("%w: [%s]", ErrDuplicateItem, item.Key))
+		}
+
+		keys[item.Key] = true
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidTemplate, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package checklist_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestChecklist_Validate(t *testing.T) {
+	tests := []struct {
+		name      string
+		checklist checklist.Checklist
+		wantErrs  []error
+	}{
+		{
+			name: "Dado um checklist válido quando a validação é chamada então nenhum erro é retornado",
+			checklist: checklist.Checklist{
+				Kind:      checklist.CHECKOUT,
+				DriverID:  1,
+				VehicleID: 2,
+				Odometer:  15000,
+				FuelLevel: 75,
+				Answers:   []checklist.Answer{{Key: "tyres", Passed: true}},
+				Photos:    []checklist.Photo{{ItemKey: "tyres", URL: "https://fotos.example.com/1.jpg"}},
+			},
+		},
+		{
+			name: "Dado um item respondido duas vezes quando a validação é chamada então um erro é retornado",
+			checklist: checklist.Checklist{
+				Kind:      checklist.CHECKIN,
+				DriverID:  1,
+				VehicleID: 2,
+				Answers:   []checklist.Answer{{Key: "tyres", Passed: true}, {Key: "tyres"}},
+			},
+			wantErrs: []error{checklist.ErrInvalidChecklist, checklist.ErrDuplicateAnswer},
+		},
+		{
+			name: "Dado um checklist vazio com nível de combustível acima do tanque quando a validação é chamada então todas as regras quebradas são retornadas",
+			checklist: checklist.Checklist{
+				Odometer:  -1,
+				FuelLevel: 120,
+				Answers:   []checklist.Answer{{Key: " "}},
+				Photos:    []checklist.Photo{{ItemKey: "tyres"}},
+			},
+			wantErrs: []error{
+				checklist.ErrInvalidChecklist, checklist.ErrMissingDriver, checklist.ErrMissingVehicle, checklist.ErrInvalidKind,
+				checklist.ErrNegativeOdometer, checklist.ErrInvalidFuelLevel, checklist.ErrEmptyAnswerKey, checklist.ErrEmptyPhotoURL,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.checklist.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
+
+func TestTemplate_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template checklist.Template
+		wantErrs []error
+	}{
+		{
+			name:     "Dado um modelo padrão válido quando a validação é chamada então nenhum erro é retornado",
+			template: checklist.Template{Name: "Saída", Kind: checklist.CHECKOUT, Items: []checklist.Item{{Key: "tyres", Label: "Pneus", Critical: true}}},
+		},
+		{
+			name:     "Dado um modelo de uma categoria inválida quando a validação é chamada então um erro é retornado",
+			template: checklist.Template{Name: "Saída", Category: "PLANE", Kind: checklist.CHECKOUT, Items: []checklist.Item{{Key: "tyres", Label: "Pneus"}}},
+			wantErrs: []error{checklist.ErrInvalidTemplate, vehicle.ErrInvalidCategory},
+		},
+		{
+			name: "Dado um modelo com itens repetidos e sem rótulo quando a validação é chamada então todas as regras quebradas são retornadas",
+			template: checklist.Template{
+				Category: vehicle.TRUCK,
+				Items:    []checklist.Item{{Key: "tyres", Label: "Pneus"}, {Key: "tyres", Label: "Pneus"}, {Key: "lights"}},
+			},
+			wantErrs: []error{
+				checklist.ErrInvalidTemplate, checklist.ErrEmptyTemplateName, checklist.ErrInvalidKind, checklist.ErrDuplicateItem, checklist.ErrInvalidItem,
+			},
+		},
+		{
+			name:     "Dado um modelo sem itens quando a validação é chamada então um erro é retornado",
+			template: checklist.Template{Name: "Retorno", Kind: checklist.CHECKIN},
+			wantErrs: []error{checklist.ErrInvalidTemplate, checklist.ErrEmptyItems},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.template.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
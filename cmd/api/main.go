@@ -1,12 +1,12 @@
 package main
 
 import (
-	"context"
 	"log"
 
 	"github.com/LucasMateus-eng/operations-service/config"
 	"github.com/LucasMateus-eng/operations-service/internal/api"
 	"github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/grpc"
 	"github.com/LucasMateus-eng/operations-service/internal/http/gin"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
 )
@@ -18,14 +18,19 @@ const (
 )
 
 func main() {
-	ctx := context.Background()
 	config := config.NewConfig(DEFAULT_CONFIG_TYPE, DEFAULT_CONFIG_FILE, DEFAULT_CONFIG_PATH)
 
 	db := postgres.InitPostgreSQL(config)
 	logger := logging.InitializerLogging(config)
 
-	h := gin.Handlers(ctx, db, logger)
-	err := api.Start(config.AppDefaultPort, logger, h)
+	h := gin.Handlers(config, db, logger)
+
+	options := []api.ServerOption{}
+	if config.AppGRPCPort != "" {
+		options = append(options, api.WithGRPCServer(config.AppGRPCPort, grpc.NewServer(config, db, logger)))
+	}
+
+	err := api.Start(config.AppDefaultPort, logger, h, options...)
 	if err != nil {
 		log.Fatalf("error when initializing an application: %s", err.Error())
 	}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	postgres_driver "github.com/LucasMateus-eng/operations-service/driver/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	postgres_audit "github.com/LucasMateus-eng/operations-service/internal/audit/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	postgres_outbox "github.com/LucasMateus-eng/operations-service/internal/outbox/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/requestid"
+	"github.com/LucasMateus-eng/operations-service/internal/spreadsheet"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	postgres_vehicle "github.com/LucasMateus-eng/operations-service/vehicle/postgres"
+)
+
+const (
+	DEFAULT_CONFIG_TYPE = "env"
+	DEFAULT_CONFIG_FILE = ".env"
+	DEFAULT_CONFIG_PATH = "./"
+)
+
+func main() {
+	resource := flag.String("resource", "vehicles", "the resource to be imported: vehicles or drivers")
+	filePath := flag.String("file", "", "path of the csv, xlsx or jsonl file to be imported")
+	format := flag.String("format", "", "format of the file (csv, xlsx or jsonl), detected from the extension when empty")
+	dryRun := flag.Bool("dry-run", false, "validate every vehicle row without committing anything")
+	output := flag.String("output", "", "path of the csv result file of a drivers import, with the generated credentials")
+	flag.Parse()
+
+	if len(*filePath) == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// Every audit entry of a run shares the same request ID.
+	ctx := requestid.WithRequestID(context.Background(), requestid.New())
+	config := config.NewConfig(DEFAULT_CONFIG_TYPE, DEFAULT_CONFIG_FILE, DEFAULT_CONFIG_PATH)
+
+	db := postgres.InitPostgreSQL(config)
+	logger := logging.InitializerLogging(config)
+	auditService := audit.NewService(postgres.NewTransactor(db), postgres_audit.New(db), logger)
+	outboxService := outbox.NewService(postgres_outbox.New(db), logger)
+
+	formatName := *format
+	if len(formatName) == 0 {
+		formatName = *filePath
+	}
+
+	fileFormat, err := spreadsheet.GetFormat(formatName)
+	if err != nil {
+		log.Fatalf("error when detecting the file format: %s", err.Error())
+	}
+
+	file, err := os.Open(*filePath)
+	if err != nil {
+		log.Fatalf("error when opening the file: %s", err.Error())
+	}
+	defer file.Close()
+
+	records, err := spreadsheet.Read(fileFormat, file)
+	if err != nil {
+		log.Fatalf("error when reading the file: %s", err.Error())
+	}
+
+	var report any
+	var hasErrors bool
+
+	switch *resource {
+	case "vehicles":
+		service := vehicle.NewService(postgres_vehicle.New(db), auditService, outboxService, logger)
+
+		vehicleReport, err := service.Import(ctx, gin_mapping.MapRecordsToVehicleImportRows(records), *dryRun)
+		if err != nil {
+			log.Fatalf("error when importing vehicles: %s", err.Error())
+		}
+
+		report, hasErrors = vehicleReport, vehicleReport.HasErrors()
+	case "drivers":
+		service := driver.NewService(postgres_driver.New(db), auditService, outboxService, logger)
+
+		driverReport, err := service.Import(ctx, gin_mapping.MapRecordsToDriverImportRows(records))
+		if err != nil {
+			log.Fatalf("error when importing drivers: %s", err.Error())
+		}
+
+		if len(*output) > 0 {
+			if err := writeDriverImportResult(*output, driverReport); err != nil {
+				log.Fatalf("error when writing the result file: %s", err.Error())
+			}
+		}
+
+		report, hasErrors = driverReport, driverReport.HasErrors()
+	default:
+		log.Fatalf("the given resource [%s] cannot be imported", *resource)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		log.Fatalf("error when writing the report: %s", err.Error())
+	}
+
+	if hasErrors {
+		os.Exit(1)
+	}
+}
+
+func writeDriverImportResult(path string, report *driver.ImportReport) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	writer, err := spreadsheet.NewWriter(spreadsheet.CSV, file)
+	if err != nil {
+		return err
+	}
+
+	if err := writer.Write(gin_mapping.DriverImportResultHeader); err != nil {
+		return err
+	}
+
+	for _, result := range report.Results {
+		if err := writer.Write(gin_mapping.MapDriverImportResultToRow(result)); err != nil {
+			return err
+		}
+	}
+
+	return writer.Flush()
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	postgres_outbox "github.com/LucasMateus-eng/operations-service/internal/outbox/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox/publisher"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	postgres_webhook "github.com/LucasMateus-eng/operations-service/internal/webhook/postgres"
+)
+
+const (
+	DEFAULT_CONFIG_TYPE     = "env"
+	DEFAULT_CONFIG_FILE     = ".env"
+	DEFAULT_CONFIG_PATH     = "./"
+	DEFAULT_WEBHOOK_TIMEOUT = 10 * time.Second
+)
+
+// The relay publishes the events stored in the outbox by the API and the
+// import command, queues them for the webhook subscriptions and sends the
+// due webhook deliveries. Several replicas can run; only one publishes at a
+// time, while the deliveries are shared between them.
+func main() {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	config := config.NewConfig(DEFAULT_CONFIG_TYPE, DEFAULT_CONFIG_FILE, DEFAULT_CONFIG_PATH)
+
+	db := postgres.InitPostgreSQL(config)
+	logger := logging.InitializerLogging(config)
+
+	p, closePublisher, err := newPublisher(ctx, config, logger)
+	if err != nil {
+		log.Fatalf("error when initializing the publisher: %s", err.Error())
+	}
+	defer closePublisher()
+
+	transactor := postgres.NewTransactor(db)
+	webhookRepo := postgres_webhook.New(db)
+	dispatcher := webhook.NewDispatcher(webhookRepo, logger)
+	relay := outbox.NewRelay(transactor, postgres_outbox.New(db), publisher.NewMulti(dispatcher, p), config.OutboxMaximumAttempts, config.OutboxBatchSize, logger)
+
+	timeout := config.WebhookTimeout
+	if timeout <= 0 {
+		timeout = webhook.DEFAULT_TIMEOUT
+	}
+	sender := webhook.NewSender(transactor, webhookRepo, &http.Client{Timeout: timeout}, config.WebhookMaximumAttempts, config.WebhookBatchSize, logger)
+
+	logger.Info("Outbox relay started", map[string]any{
+		"publisher": config.OutboxPublisher,
+	})
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		sender.Run(ctx, config.WebhookInterval)
+	}()
+
+	relay.Run(ctx, config.OutboxInterval)
+	wg.Wait()
+	logger.Info("Outbox relay stopped", nil)
+}
+
+func newPublisher(ctx context.Context, config *config.Config, logger *logging.Logging) (outbox.Publisher, func(), error) {
+	switch config.OutboxPublisher {
+	case "", "log":
+		return publisher.NewLog(logger), func() {}, nil
+	case "webhook":
+		if len(config.OutboxWebhookURL) == 0 {
+			return nil, nil, fmt.Errorf("OUTBOX_WEBHOOK_URL is required by the webhook publisher")
+		}
+
+		return publisher.NewWebhook(config.OutboxWebhookURL, &http.Client{Timeout: DEFAULT_WEBHOOK_TIMEOUT}), func() {}, nil
+	case "nats":
+		js, nc, err := publisher.ConnectJetStream(ctx, config.NATSURL, config.NATSSubjectPrefix)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return publisher.NewJetStream(js, config.NATSSubjectPrefix), func() { nc.Drain() }, nil
+	}
+
+	return nil, nil, fmt.Errorf("the outbox publisher [%s] must be one of log, webhook or nats", config.OutboxPublisher)
+}
@@ -2,20 +2,53 @@ package config
 
 import (
 	"log"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	AppName        string `mapstructure:"APP_NAME"`
-	AppEnv         string `mapstructure:"APP_ENV"`
-	AppLogLevel    string `mapstructure:"APP_LOG_LEVEL"`
-	AppDefaultPort string `mapstructure:"APP_DEFAULT_PORT"`
-	DBHost         string `mapstructure:"DB_HOST"`
-	DBPort         string `mapstructure:"DB_PORT"`
-	DBUser         string `mapstructure:"DB_USER"`
-	DBPass         string `mapstructure:"DB_PASS"`
-	DBName         string `mapstructure:"DB_NAME"`
+	AppName                        string        `mapstructure:"APP_NAME"`
+	AppEnv                         string        `mapstructure:"APP_ENV"`
+	AppLogLevel                    string        `mapstructure:"APP_LOG_LEVEL"`
+	AppDefaultPort                 string        `mapstructure:"APP_DEFAULT_PORT"`
+	AppGRPCPort                    string        `mapstructure:"APP_GRPC_PORT"`
+	IdempotencyTTL                 time.Duration `mapstructure:"IDEMPOTENCY_TTL"`
+	IdempotencyLockTimeout         time.Duration `mapstructure:"IDEMPOTENCY_LOCK_TIMEOUT"`
+	GraphQLMaximumCost             int           `mapstructure:"GRAPHQL_MAXIMUM_COST"`
+	GraphQLMaximumDepth            int           `mapstructure:"GRAPHQL_MAXIMUM_DEPTH"`
+	OutboxPublisher                string        `mapstructure:"OUTBOX_PUBLISHER"`
+	OutboxMaximumAttempts          int           `mapstructure:"OUTBOX_MAXIMUM_ATTEMPTS"`
+	OutboxBatchSize                int           `mapstructure:"OUTBOX_BATCH_SIZE"`
+	OutboxInterval                 time.Duration `mapstructure:"OUTBOX_INTERVAL"`
+	OutboxWebhookURL               string        `mapstructure:"OUTBOX_WEBHOOK_URL"`
+	NATSURL                        string        `mapstructure:"NATS_URL"`
+	NATSSubjectPrefix              string        `mapstructure:"NATS_SUBJECT_PREFIX"`
+	WebhookMaximumAttempts         int           `mapstructure:"WEBHOOK_MAXIMUM_ATTEMPTS"`
+	WebhookBatchSize               int           `mapstructure:"WEBHOOK_BATCH_SIZE"`
+	WebhookInterval                time.Duration `mapstructure:"WEBHOOK_INTERVAL"`
+	WebhookTimeout                 time.Duration `mapstructure:"WEBHOOK_TIMEOUT"`
+	OdometerMaximumDailyKm         int64         `mapstructure:"ODOMETER_MAXIMUM_DAILY_KM"`
+	FuelOutlierTolerance           int           `mapstructure:"FUEL_OUTLIER_TOLERANCE"`
+	FinePointsAlertMargin          int           `mapstructure:"FINE_POINTS_ALERT_MARGIN"`
+	InsuranceExpiryAlertDays       int           `mapstructure:"INSURANCE_EXPIRY_ALERT_DAYS"`
+	InsuranceRequiredForAssignment bool          `mapstructure:"INSURANCE_REQUIRED_FOR_ASSIGNMENT"`
+	DocumentStorage                string        `mapstructure:"DOCUMENT_STORAGE"`
+	DocumentStoragePath            string        `mapstructure:"DOCUMENT_STORAGE_PATH"`
+	DocumentPublicURL              string        `mapstructure:"DOCUMENT_PUBLIC_URL"`
+	DocumentURLSecret              string        `mapstructure:"DOCUMENT_URL_SECRET"`
+	DocumentURLTTL                 time.Duration `mapstructure:"DOCUMENT_URL_TTL"`
+	DocumentMaximumSize            int64         `mapstructure:"DOCUMENT_MAXIMUM_SIZE"`
+	DocumentS3Endpoint             string        `mapstructure:"DOCUMENT_S3_ENDPOINT"`
+	DocumentS3Bucket               string        `mapstructure:"DOCUMENT_S3_BUCKET"`
+	DocumentS3Region               string        `mapstructure:"DOCUMENT_S3_REGION"`
+	DocumentS3AccessKey            string        `mapstructure:"DOCUMENT_S3_ACCESS_KEY"`
+	DocumentS3SecretKey            string        `mapstructure:"DOCUMENT_S3_SECRET_KEY"`
+	DBHost                         string        `mapstructure:"DB_HOST"`
+	DBPort                         string        `mapstructure:"DB_PORT"`
+	DBUser                         string        `mapstructure:"DB_USER"`
+	DBPass                         string        `mapstructure:"DB_PASS"`
+	DBName                         string        `mapstructure:"DB_NAME"`
 }
 
 func NewConfig(configType, configName, configPath string) *Config {
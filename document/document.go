@@ -0,0 +1,162 @@
+package document
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+const (
+	DEFAULT_MAXIMUM_SIZE int64 = 10 << 20
+	DEFAULT_URL_TTL            = 15 * time.Minute
+)
+
+// OwnerType tells whether a document belongs to a driver or to a vehicle.
+type OwnerType string
+
+const (
+	DRIVER  OwnerType = "DRIVER"
+	VEHICLE OwnerType = "VEHICLE"
+)
+
+type Type string
+
+const (
+	CNH                Type = "CNH"
+	TOXICOLOGICAL_EXAM Type = "TOXICOLOGICAL_EXAM"
+	MEDICAL_EXAM       Type = "MEDICAL_EXAM"
+	CRLV               Type = "CRLV"
+)
+
+var (
+	// ownerTypes tells the types of document each owner may have.
+	ownerTypes = map[OwnerType][]Type{
+		DRIVER:  {CNH, TOXICOLOGICAL_EXAM, MEDICAL_EXAM},
+		VEHICLE: {CRLV},
+	}
+
+	// contentTypes are the formats accepted for the files, with the
+	// extension given to their storage keys.
+	contentTypes = map[string]string{
+		"application/pdf": ".pdf",
+		"image/jpeg":      ".jpg",
+		"image/png":       ".png",
+	}
+
+	ErrInvalidOwnerType = errors.New("the document owner must be one of DRIVER or VEHICLE")
+	ErrInvalidType      = errors.New("the document type must be one of CNH, TOXICOLOGICAL_EXAM, MEDICAL_EXAM or CRLV")
+	ErrObjectNotFound   = errors.New("the document file does not exist in the storage")
+)
+
+func GetOwnerType(name string) (OwnerType, error) {
+	o := OwnerType(name)
+	if _, ok := ownerTypes[o]; !ok {
+		return "", ErrInvalidOwnerType
+	}
+
+	return o, nil
+}
+
+func GetType(name string) (Type, error) {
+	t := Type(name)
+	for _, types := range ownerTypes {
+		if slices.Contains(types, t) {
+			return t, nil
+		}
+	}
+
+	return "", ErrInvalidType
+}
+
+// Allows tells whether the owner may have documents of the type, such as a
+// CNH for a driver or a CRLV for a vehicle.
+func (o OwnerType) Allows(t Type) bool {
+	return slices.Contains(ownerTypes[o], t)
+}
+
+// Document is a file kept for a driver or a vehicle, such as the scan of a
+// CNH, an exame toxicológico or a CRLV. The file itself is in the storage
+// under StorageKey; Checksum is its SHA-256 in hex. ValidUntil is zero for
+// the documents that do not expire.
+type Document struct {
+	ID          int64
+	OwnerType   OwnerType
+	OwnerID     int64
+	Type        Type
+	Name        string
+	ContentType string
+	Size        int64
+	Checksum    string
+	StorageKey  string
+	ValidFrom   time.Time
+	ValidUntil  time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// ExpiredAt tells whether the document is no longer valid at the time.
+func (d *Document) ExpiredAt(at time.Time) bool {
+	return !d.ValidUntil.IsZero() && !at.Before(d.ValidUntil)
+}
+
+// DocumentSpecification filters the documents. ExpiresBefore keeps those
+// whose validity ends before the time, expired ones included.
+type DocumentSpecification struct {
+	OwnerType      OwnerType
+	OwnerID        int64
+	Type           Type
+	ExpiresBefore  time.Time
+	Page, PageSize int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Document, error)
+	List(ctx context.Context, specification *DocumentSpecification) (*[]Document, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, d *Document) (int64, error)
+	Delete(ctx context.Context, id int64) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// Storage keeps the files of the documents. SignURL returns a URL from
+// which the file can be downloaded without credentials until it expires.
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	SignURL(ctx context.Context, key string, expiry time.Duration) (string, error)
+}
+
+// DriverReading is the part of the driver use case needed to check the
+// owner of a document.
+type DriverReading interface {
+	GetByID(ctx context.Context, id int64) (*driver.Driver, error)
+}
+
+// VehicleReading is the part of the vehicle use case needed to check the
+// owner of a document.
+type VehicleReading interface {
+	GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error)
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Document, error)
+	List(ctx context.Context, specification *DocumentSpecification) (*[]Document, error)
+	Upload(ctx context.Context, d *Document, content io.Reader) (int64, error)
+	SignURL(ctx context.Context, id int64) (string, time.Time, error)
+	Delete(ctx context.Context, id int64) error
+	DriverFiles(ctx context.Context, driverID int64) ([]string, error)
+	VehicleFiles(ctx context.Context, vehicleID int64) ([]string, error)
+	DiscardFiles(ctx context.Context, keys []string)
+}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/LucasMateus-eng/operations-service/document"
+	"github.com/LucasMateus-eng/operations-service/document/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/document/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/uptrace/bun"
+)
+
+type documentPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *documentPostgresRepo {
+	return &documentPostgresRepo{
+		db: db,
+	}
+}
+
+func (dr *documentPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, dr.db)
+}
+
+func (dr *documentPostgresRepo) GetByID(ctx context.Context, id int64) (*document.Document, error) {
+	documentDTO := new(dto.DocumentDTO)
+
+	err := dr.conn(ctx).NewSelect().Model(documentDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToDocument(documentDTO), nil
+}
+
+// List returns the documents, the first to expire first and those that do
+// not expire last.
+func (dr *documentPostgresRepo) List(ctx context.Context, specification *document.DocumentSpecification) (*[]document.Document, error) {
+	var documentDTOs []dto.DocumentDTO
+
+	query := dr.conn(ctx).NewSelect().Model(&documentDTOs).Order("valid_until ASC NULLS LAST", "id DESC")
+
+	if len(specification.OwnerType) > 0 {
+		query = query.Where("owner_type = ?", specification.OwnerType)
+	}
+
+	if specification.OwnerID != 0 {
+		switch specification.OwnerType {
+		case document.DRIVER:
+			query = query.Where("driver_id = ?", specification.OwnerID)
+		case document.VEHICLE:
+			query = query.Where("vehicle_id = ?", specification.OwnerID)
+		}
+	}
+
+	if len(specification.Type) > 0 {
+		query = query.Where("type = ?", specification.Type)
+	}
+
+	if !specification.ExpiresBefore.IsZero() {
+		query = query.Where("valid_until < ?", specification.ExpiresBefore)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	documents := make([]document.Document, 0, len(documentDTOs))
+	for _, dto := range documentDTOs {
+		documents = append(documents, *mapping.MapDTOToDocument(&dto))
+	}
+
+	return &documents, nil
+}
+
+func (dr *documentPostgresRepo) Create(ctx context.Context, d *document.Document) (int64, error) {
+	documentDTO := mapping.MapDocumentToDTO(d)
+
+	_, err := dr.conn(ctx).NewInsert().Model(documentDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return documentDTO.ID, nil
+}
+
+func (dr *documentPostgresRepo) Delete(ctx context.Context, id int64) error {
+	res, err := dr.conn(ctx).NewDelete().Model((*dto.DocumentDTO)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
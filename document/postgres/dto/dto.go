@@ -0,0 +1,28 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// DocumentDTO keeps the owner in driver_id or vehicle_id, whichever its
+// owner_type tells, so that the documents go away with their owner.
+type DocumentDTO struct {
+	bun.BaseModel `bun:"table:documents"`
+
+	ID          int64     `bun:"id,pk,autoincrement"`
+	OwnerType   string    `bun:"owner_type,notnull"`
+	DriverID    int64     `bun:"driver_id,nullzero"`
+	VehicleID   int64     `bun:"vehicle_id,nullzero"`
+	Type        string    `bun:"type,notnull"`
+	Name        string    `bun:"name,notnull"`
+	ContentType string    `bun:"content_type,notnull"`
+	Size        int64     `bun:"size,notnull"`
+	Checksum    string    `bun:"checksum,notnull"`
+	StorageKey  string    `bun:"storage_key,notnull"`
+	ValidFrom   time.Time `bun:"valid_from,nullzero"`
+	ValidUntil  time.Time `bun:"valid_until,nullzero"`
+	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,58 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/document"
+	"github.com/LucasMateus-eng/operations-service/document/postgres/dto"
+)
+
+func MapDocumentToDTO(d *document.Document) *dto.DocumentDTO {
+	documentDTO := &dto.DocumentDTO{
+		ID:          d.ID,
+		OwnerType:   string(d.OwnerType),
+		Type:        string(d.Type),
+		Name:        d.Name,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		Checksum:    d.Checksum,
+		StorageKey:  d.StorageKey,
+		ValidFrom:   d.ValidFrom,
+		ValidUntil:  d.ValidUntil,
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+
+	switch d.OwnerType {
+	case document.DRIVER:
+		documentDTO.DriverID = d.OwnerID
+	case document.VEHICLE:
+		documentDTO.VehicleID = d.OwnerID
+	}
+
+	return documentDTO
+}
+
+func MapDTOToDocument(documentDTO *dto.DocumentDTO) *document.Document {
+	d := &document.Document{
+		ID:          documentDTO.ID,
+		OwnerType:   document.OwnerType(documentDTO.OwnerType),
+		Type:        document.Type(documentDTO.Type),
+		Name:        documentDTO.Name,
+		ContentType: documentDTO.ContentType,
+		Size:        documentDTO.Size,
+		Checksum:    documentDTO.Checksum,
+		StorageKey:  documentDTO.StorageKey,
+		ValidFrom:   documentDTO.ValidFrom,
+		ValidUntil:  documentDTO.ValidUntil,
+		CreatedAt:   documentDTO.CreatedAt,
+		UpdatedAt:   documentDTO.UpdatedAt,
+	}
+
+	switch d.OwnerType {
+	case document.DRIVER:
+		d.OwnerID = documentDTO.DriverID
+	case document.VEHICLE:
+		d.OwnerID = documentDTO.VehicleID
+	}
+
+	return d
+}
@@ -0,0 +1,88 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/document"
+	document_dto "github.com/LucasMateus-eng/operations-service/document/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapDocumentToDTO(t *testing.T) {
+	tests := []struct {
+		name        string
+		document    *document.Document
+		expectedDTO *document_dto.DocumentDTO
+	}{
+		{
+			name: "Dado a CNH de um motorista quando ela é mapeada então o dono fica em driver_id",
+			document: &document.Document{
+				ID:          1,
+				OwnerType:   document.DRIVER,
+				OwnerID:     2,
+				Type:        document.CNH,
+				Name:        "cnh.pdf",
+				ContentType: "application/pdf",
+				Size:        2048,
+				Checksum:    "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+				StorageKey:  "drivers/2/a1b2.pdf",
+				ValidFrom:   mockedTime,
+				ValidUntil:  mockedTime.AddDate(5, 0, 0),
+				CreatedAt:   mockedTime,
+				UpdatedAt:   mockedTime,
+			},
+			expectedDTO: &document_dto.DocumentDTO{
+				ID:          1,
+				OwnerType:   "DRIVER",
+				DriverID:    2,
+				Type:        "CNH",
+				Name:        "cnh.pdf",
+				ContentType: "application/pdf",
+				Size:        2048,
+				Checksum:    "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+				StorageKey:  "drivers/2/a1b2.pdf",
+				ValidFrom:   mockedTime,
+				ValidUntil:  mockedTime.AddDate(5, 0, 0),
+				CreatedAt:   mockedTime,
+				UpdatedAt:   mockedTime,
+			},
+		},
+		{
+			name: "Dado o CRLV de um veículo quando ele é mapeado então o dono fica em vehicle_id",
+			document: &document.Document{
+				ID:          3,
+				OwnerType:   document.VEHICLE,
+				OwnerID:     4,
+				Type:        document.CRLV,
+				Name:        "crlv.png",
+				ContentType: "image/png",
+				Size:        1024,
+				StorageKey:  "vehicles/4/c3d4.png",
+			},
+			expectedDTO: &document_dto.DocumentDTO{
+				ID:          3,
+				OwnerType:   "VEHICLE",
+				VehicleID:   4,
+				Type:        "CRLV",
+				Name:        "crlv.png",
+				ContentType: "image/png",
+				Size:        1024,
+				StorageKey:  "vehicles/4/c3d4.png",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			actualDTO := MapDocumentToDTO(test.document)
+
+			assert.Equal(tt, test.expectedDTO, actualDTO)
+			assert.Equal(tt, test.document, MapDTOToDocument(actualDTO))
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+// SNIFF_LENGTH is how much of the file is read to detect its content type.
+const SNIFF_LENGTH = 512
+
+var (
+	ErrTooLarge         = errors.New("the document file is larger than allowed")
+	ErrContentMismatch  = errors.New("the document file content does not match its content type")
+	ErrChecksumMismatch = errors.New("the document file does not match the given checksum")
+)
+
+type Service struct {
+	repo        Repository
+	auditor     audit.Recorder
+	storage     Storage
+	drivers     DriverReading
+	vehicles    VehicleReading
+	maximumSize int64
+	urlTTL      time.Duration
+	logger      *logging.Logging
+}
+
+// NewService builds the document use case. A maximumSize or urlTTL that is
+// not positive falls back to DEFAULT_MAXIMUM_SIZE or DEFAULT_URL_TTL.
+func NewService(r Repository, au audit.Recorder, st Storage, dr DriverReading, vr VehicleReading, maximumSize int64, urlTTL time.Duration, l *logging.Logging) *Service {
+	if maximumSize <= 0 {
+		maximumSize = DEFAULT_MAXIMUM_SIZE
+	}
+
+	if urlTTL <= 0 {
+		urlTTL = DEFAULT_URL_TTL
+	}
+
+	return &Service{
+		repo:        r,
+		auditor:     au,
+		storage:     st,
+		drivers:     dr,
+		vehicles:    vr,
+		maximumSize: maximumSize,
+		urlTTL:      urlTTL,
+		logger:      l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Document, error) {
+	s.logger.Debug("[DOCUMENT] GetByID - DEBUG: ", map[string]any{
+		"documentID": id,
+	})
+	document, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[DOCUMENT] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return document, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *DocumentSpecification) (*[]Document, error) {
+	s.logger.Debug("[DOCUMENT] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	documents, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[DOCUMENT] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return documents, nil
+}
+
+// Upload keeps the file of the document in the storage and records it. The
+// start of the file must match its content type and, when the document
+// comes with a checksum, the file must match it; either way the checksum
+// of the stored file is recorded. Only d.Size bytes of content are read.
+func (s *Service) Upload(ctx context.Context, d *Document, content io.Reader) (int64, error) {
+	s.logger.Debug("[DOCUMENT] Upload - DEBUG: ", map[string]any{
+		"document": d,
+	})
+	documentID, err := s.upload(ctx, d, content)
+	if err != nil {
+		s.logger.Error("[DOCUMENT] Upload - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return documentID, nil
+}
+
+func (s *Service) upload(ctx context.Context, d *Document, content io.Reader) (int64, error) {
+	if err := d.Validate(); err != nil {
+		return 0, err
+	}
+
+	if d.Size > s.maximumSize {
+		return 0, fmt.Errorf("%w: the limit is %d bytes", ErrTooLarge, s.maximumSize)
+	}
+
+	if err := s.checkOwner(ctx, d); err != nil {
+		return 0, err
+	}
+
+	content, err := sniff(io.LimitReader(content, d.Size), d.ContentType)
+	if err != nil {
+		return 0, err
+	}
+
+	key, err := newStorageKey(d)
+	if err != nil {
+		return 0, err
+	}
+
+	hash := sha256.New()
+	if err := s.storage.Put(ctx, key, io.TeeReader(content, hash), d.Size, d.ContentType); err != nil {
+		return 0, err
+	}
+
+	checksum := hex.EncodeToString(hash.Sum(nil))
+	if len(d.Checksum) > 0 && !strings.EqualFold(d.Checksum, checksum) {
+		s.discard(ctx, key)
+		return 0, fmt.Errorf("%w: %w", ErrInvalidDocument, ErrChecksumMismatch)
+	}
+
+	d.Checksum = checksum
+	d.StorageKey = key
+
+	var documentID int64
+	err = s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		documentID, err = s.repo.Create(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.DOCUMENT, documentID, audit.CREATE, nil, d)}, nil
+	})
+	if err != nil {
+		s.discard(ctx, key)
+		return 0, err
+	}
+
+	return documentID, nil
+}
+
+// SignURL returns a URL from which the file of the document can be
+// downloaded without credentials, and when it expires.
+func (s *Service) SignURL(ctx context.Context, id int64) (string, time.Time, error) {
+	s.logger.Debug("[DOCUMENT] SignURL - DEBUG: ", map[string]any{
+		"documentID": id,
+	})
+	document, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[DOCUMENT] SignURL - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return "", time.Time{}, err
+	}
+
+	expiresAt := time.Now().Add(s.urlTTL)
+	url, err := s.storage.SignURL(ctx, document.StorageKey, s.urlTTL)
+	if err != nil {
+		s.logger.Error("[DOCUMENT] SignURL - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return "", time.Time{}, err
+	}
+
+	return url, expiresAt, nil
+}
+
+// Delete removes the record of the document and then its file. A file
+// that cannot be removed is only logged, as the document is already gone.
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	s.logger.Debug("[DOCUMENT] Delete - DEBUG: ", map[string]any{
+		"documentID": id,
+	})
+	var key string
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+
+		key = before.StorageKey
+
+		return []*audit.Entry{audit.NewEntry(audit.DOCUMENT, id, audit.DELETE, before, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[DOCUMENT] Delete - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	s.discard(ctx, key)
+
+	return nil
+}
+
+// DriverFiles returns the files of the documents of the driver, to be
+// discarded once the driver is purged and its documents cascade away.
+func (s *Service) DriverFiles(ctx context.Context, driverID int64) ([]string, error) {
+	return s.files(ctx, DRIVER, driverID)
+}
+
+// VehicleFiles is DriverFiles for a vehicle.
+func (s *Service) VehicleFiles(ctx context.Context, vehicleID int64) ([]string, error) {
+	return s.files(ctx, VEHICLE, vehicleID)
+}
+
+// DiscardFiles removes files left without a document. A file that cannot be
+// removed is only logged.
+func (s *Service) DiscardFiles(ctx context.Context, keys []string) {
+	for _, key := range keys {
+		s.discard(ctx, key)
+	}
+}
+
+func (s *Service) files(ctx context.Context, ownerType OwnerType, ownerID int64) ([]string, error) {
+	documents, err := s.repo.List(ctx, &DocumentSpecification{OwnerType: ownerType, OwnerID: ownerID})
+	if err != nil {
+		s.logger.Error("[DOCUMENT] files - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(*documents))
+	for _, d := range *documents {
+		keys = append(keys, d.StorageKey)
+	}
+
+	return keys, nil
+}
+
+func (s *Service) checkOwner(ctx context.Context, d *Document) error {
+	switch d.OwnerType {
+	case DRIVER:
+		_, err := s.drivers.GetByID(ctx, d.OwnerID)
+		return err
+	case VEHICLE:
+		_, err := s.vehicles.GetByID(ctx, d.OwnerID)
+		return err
+	}
+
+	return ErrInvalidOwnerType
+}
+
+// discard removes a file left without a document.
+func (s *Service) discard(ctx context.Context, key string) {
+	if err := s.storage.Delete(ctx, key); err != nil {
+		s.logger.Error("[DOCUMENT] discard - ERROR: ", map[string]any{
+			"key": key,
+			"err": err.Error(),
+		})
+	}
+}
+
+// sniff checks the start of the file against its declared content type, so
+// that a file is not kept as a PDF just because it was sent as one. The
+// returned reader still starts at the beginning of the file.
+func sniff(content io.Reader, contentType string) (io.Reader, error) {
+	head := make([]byte, SNIFF_LENGTH)
+	n, err := io.ReadFull(content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	head = head[:n]
+
+	detected, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil || detected != contentType {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidDocument, ErrContentMismatch)
+	}
+
+	return io.MultiReader(bytes.NewReader(head), content), nil
+}
+
+// newStorageKey places the file under its owner, such as
+// drivers/12/<random>.pdf, so that two uploads never overwrite each other.
+func newStorageKey(d *Document) (string, error) {
+	random := make([]byte, 16)
+	if _, err := rand.Read(random); err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%ss/%d/%s%s", strings.ToLower(string(d.OwnerType)), d.OwnerID, hex.EncodeToString(random), contentTypes[d.ContentType]), nil
+}
@@ -0,0 +1,456 @@
+package document_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/document"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	document_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/document"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	pdf           = []byte("%PDF-1.7\n1 0 obj << /Type /Catalog >> endobj\n%%EOF")
+	pdfChecksum   = checksum(pdf)
+)
+
+func checksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestService_Upload(t *testing.T) {
+	type serviceMocks struct {
+		repo     *document_mocks.MockRepository
+		auditor  *audit_mocks.MockRecorder
+		storage  *document_mocks.MockStorage
+		drivers  *document_mocks.MockDriverReading
+		vehicles *document_mocks.MockVehicleReading
+		logger   *logging.Logging
+	}
+
+	type args struct {
+		ctx     context.Context
+		d       *document.Document
+		content []byte
+	}
+
+	// put reads the uploaded file as the storage would.
+	put := func(_ context.Context, key string, content io.Reader, size int64, _ string) error {
+		written, _ := io.Copy(io.Discard, content)
+		if written != size {
+			return errMocked
+		}
+
+		return nil
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		maximumSize int64
+		prepareMock func(p args, m serviceMocks)
+		want        int64
+		wantErr     error
+	}{
+		{
+			name: "Dado a CNH de um motorista quando o método Upload é chamado então o arquivo é guardado com o seu checksum",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.DRIVER, OwnerID: 2, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len(pdf))},
+				content: pdf,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByID(p.ctx, p.d.OwnerID).Return(&driver.Driver{ID: 2}, nil)
+				m.storage.EXPECT().Put(p.ctx, gomock.Any(), gomock.Any(), gomock.Any(), "application/pdf").DoAndReturn(put)
+				m.repo.EXPECT().Create(p.ctx, gomock.Cond(func(x any) bool {
+					d, ok := x.(*document.Document)
+					return ok && d.Checksum == pdfChecksum && strings.HasPrefix(d.StorageKey, "drivers/2/") && strings.HasSuffix(d.StorageKey, ".pdf")
+				})).Return(int64(7), nil)
+			},
+			want:    7,
+			wantErr: nil,
+		},
+		{
+			name: "Dado o checksum correto informado quando o método Upload é chamado então o arquivo é guardado",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.DRIVER, OwnerID: 2, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len(pdf)), Checksum: strings.ToUpper(pdfChecksum)},
+				content: pdf,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByID(p.ctx, p.d.OwnerID).Return(&driver.Driver{ID: 2}, nil)
+				m.storage.EXPECT().Put(p.ctx, gomock.Any(), gomock.Any(), gomock.Any(), "application/pdf").DoAndReturn(put)
+				m.repo.EXPECT().Create(p.ctx, p.d).Return(int64(7), nil)
+			},
+			want:    7,
+			wantErr: nil,
+		},
+		{
+			name: "Dado um checksum diferente do arquivo quando o método Upload é chamado então o arquivo guardado é descartado",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.DRIVER, OwnerID: 2, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len(pdf)), Checksum: checksum([]byte("other"))},
+				content: pdf,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByID(p.ctx, p.d.OwnerID).Return(&driver.Driver{ID: 2}, nil)
+				m.storage.EXPECT().Put(p.ctx, gomock.Any(), gomock.Any(), gomock.Any(), "application/pdf").DoAndReturn(put)
+				m.storage.EXPECT().Delete(p.ctx, gomock.Any()).Return(nil)
+			},
+			want:    0,
+			wantErr: document.ErrChecksumMismatch,
+		},
+		{
+			name: "Dado um arquivo que não é PDF enviado como PDF quando o método Upload é chamado então nada é guardado",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.DRIVER, OwnerID: 2, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len("MZ\x90\x00executable"))},
+				content: []byte("MZ\x90\x00executable"),
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByID(p.ctx, p.d.OwnerID).Return(&driver.Driver{ID: 2}, nil)
+			},
+			want:    0,
+			wantErr: document.ErrContentMismatch,
+		},
+		{
+			name: "Dado um arquivo maior que o limite quando o método Upload é chamado então nada é guardado",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.DRIVER, OwnerID: 2, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len(pdf))},
+				content: pdf,
+			},
+			maximumSize: 10,
+			want:        0,
+			wantErr:     document.ErrTooLarge,
+		},
+		{
+			name: "Dado um motorista inexistente quando o método Upload é chamado então nada é guardado",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.DRIVER, OwnerID: 2, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len(pdf))},
+				content: pdf,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByID(p.ctx, p.d.OwnerID).Return(nil, sql.ErrNoRows)
+			},
+			want:    0,
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "Dado um erro ao registrar o documento quando o método Upload é chamado então o arquivo guardado é descartado",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.DRIVER, OwnerID: 2, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len(pdf))},
+				content: pdf,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.drivers.EXPECT().GetByID(p.ctx, p.d.OwnerID).Return(&driver.Driver{ID: 2}, nil)
+				m.storage.EXPECT().Put(p.ctx, gomock.Any(), gomock.Any(), gomock.Any(), "application/pdf").DoAndReturn(put)
+				m.repo.EXPECT().Create(p.ctx, p.d).Return(int64(0), errMocked)
+				m.storage.EXPECT().Delete(p.ctx, gomock.Any()).Return(nil)
+			},
+			want:    0,
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado uma CNH de um veículo quando o método Upload é chamado então nada é guardado",
+			args: args{
+				ctx:     mockedContext,
+				d:       &document.Document{OwnerType: document.VEHICLE, OwnerID: 3, Type: document.CNH, Name: "cnh.pdf", ContentType: "application/pdf", Size: int64(len(pdf))},
+				content: pdf,
+			},
+			want:    0,
+			wantErr: document.ErrTypeNotAllowed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:     document_mocks.NewMockRepository(ctrl),
+				auditor:  audit_mocks.NewPassThroughRecorder(ctrl),
+				storage:  document_mocks.NewMockStorage(ctrl),
+				drivers:  document_mocks.NewMockDriverReading(ctrl),
+				vehicles: document_mocks.NewMockVehicleReading(ctrl),
+				logger:   logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := document.NewService(sm.repo, sm.auditor, sm.storage, sm.drivers, sm.vehicles, test.maximumSize, time.Minute, sm.logger)
+
+			actualID, err := s.Upload(test.args.ctx, test.args.d, bytes.NewReader(test.args.content))
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	type serviceMocks struct {
+		repo     *document_mocks.MockRepository
+		auditor  *audit_mocks.MockRecorder
+		storage  *document_mocks.MockStorage
+		drivers  *document_mocks.MockDriverReading
+		vehicles *document_mocks.MockVehicleReading
+		logger   *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado um documento quando o método Delete é chamado então o registro e o arquivo são removidos",
+			args: args{
+				ctx: mockedContext,
+				id:  7,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&document.Document{ID: 7, StorageKey: "drivers/2/a1b2.pdf"}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id).Return(nil)
+				m.storage.EXPECT().Delete(p.ctx, "drivers/2/a1b2.pdf").Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um erro ao remover o arquivo quando o método Delete é chamado então o documento é removido mesmo assim",
+			args: args{
+				ctx: mockedContext,
+				id:  7,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&document.Document{ID: 7, StorageKey: "drivers/2/a1b2.pdf"}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id).Return(nil)
+				m.storage.EXPECT().Delete(p.ctx, "drivers/2/a1b2.pdf").Return(errMocked)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um documento inexistente quando o método Delete é chamado então o arquivo não é tocado",
+			args: args{
+				ctx: mockedContext,
+				id:  7,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:     document_mocks.NewMockRepository(ctrl),
+				auditor:  audit_mocks.NewPassThroughRecorder(ctrl),
+				storage:  document_mocks.NewMockStorage(ctrl),
+				drivers:  document_mocks.NewMockDriverReading(ctrl),
+				vehicles: document_mocks.NewMockVehicleReading(ctrl),
+				logger:   logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := document.NewService(sm.repo, sm.auditor, sm.storage, sm.drivers, sm.vehicles, 0, time.Minute, sm.logger)
+
+			err := s.Delete(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_SignURL(t *testing.T) {
+	type serviceMocks struct {
+		repo     *document_mocks.MockRepository
+		auditor  *audit_mocks.MockRecorder
+		storage  *document_mocks.MockStorage
+		drivers  *document_mocks.MockDriverReading
+		vehicles *document_mocks.MockVehicleReading
+		logger   *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        string
+		wantErr     error
+	}{
+		{
+			name: "Dado um documento quando o método SignURL é chamado então um link temporário do arquivo é retornado",
+			args: args{
+				ctx: mockedContext,
+				id:  7,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&document.Document{ID: 7, StorageKey: "drivers/2/a1b2.pdf"}, nil)
+				m.storage.EXPECT().SignURL(p.ctx, "drivers/2/a1b2.pdf", time.Minute).Return("http://localhost/files/documents/drivers/2/a1b2.pdf?signature=x", nil)
+			},
+			want:    "http://localhost/files/documents/drivers/2/a1b2.pdf?signature=x",
+			wantErr: nil,
+		},
+		{
+			name: "Dado um documento inexistente quando o método SignURL é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				id:  7,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(nil, sql.ErrNoRows)
+			},
+			want:    "",
+			wantErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:     document_mocks.NewMockRepository(ctrl),
+				auditor:  audit_mocks.NewPassThroughRecorder(ctrl),
+				storage:  document_mocks.NewMockStorage(ctrl),
+				drivers:  document_mocks.NewMockDriverReading(ctrl),
+				vehicles: document_mocks.NewMockVehicleReading(ctrl),
+				logger:   logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := document.NewService(sm.repo, sm.auditor, sm.storage, sm.drivers, sm.vehicles, 0, time.Minute, sm.logger)
+
+			url, expiresAt, err := s.SignURL(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, test.want, url)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, true, expiresAt.After(time.Now()))
+			}
+		})
+	}
+}
+
+func TestService_DriverFiles(t *testing.T) {
+	type serviceMocks struct {
+		repo     *document_mocks.MockRepository
+		auditor  *audit_mocks.MockRecorder
+		storage  *document_mocks.MockStorage
+		drivers  *document_mocks.MockDriverReading
+		vehicles *document_mocks.MockVehicleReading
+		logger   *logging.Logging
+	}
+
+	type args struct {
+		ctx      context.Context
+		driverID int64
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        []string
+		wantErr     error
+	}{
+		{
+			name: "Dado um motorista com documentos quando o método DriverFiles é chamado então os arquivos deles são retornados",
+			args: args{
+				ctx:      mockedContext,
+				driverID: 2,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().List(p.ctx, &document.DocumentSpecification{OwnerType: document.DRIVER, OwnerID: p.driverID}).Return(&[]document.Document{
+					{ID: 7, StorageKey: "drivers/2/a1b2.pdf"},
+					{ID: 8, StorageKey: "drivers/2/c3d4.jpg"},
+				}, nil)
+			},
+			want:    []string{"drivers/2/a1b2.pdf", "drivers/2/c3d4.jpg"},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um erro ao listar os documentos quando o método DriverFiles é chamado então o erro é retornado",
+			args: args{
+				ctx:      mockedContext,
+				driverID: 2,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().List(p.ctx, gomock.Any()).Return(nil, errMocked)
+			},
+			want:    nil,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:     document_mocks.NewMockRepository(ctrl),
+				auditor:  audit_mocks.NewPassThroughRecorder(ctrl),
+				storage:  document_mocks.NewMockStorage(ctrl),
+				drivers:  document_mocks.NewMockDriverReading(ctrl),
+				vehicles: document_mocks.NewMockVehicleReading(ctrl),
+				logger:   logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := document.NewService(sm.repo, sm.auditor, sm.storage, sm.drivers, sm.vehicles, 0, time.Minute, sm.logger)
+
+			got, err := s.DriverFiles(test.args.ctx, test.args.driverID)
+
+			assert.Equal(tt, test.want, got)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
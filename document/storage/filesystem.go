@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/document"
+)
+
+const (
+	// FILES_PATH is where the API serves the files signed by the
+	// filesystem storage.
+	FILES_PATH = "/files/documents"
+
+	DEFAULT_ROOT = "documents"
+)
+
+var (
+	ErrInvalidKey       = errors.New("the storage key is invalid")
+	ErrInvalidSignature = errors.New("the signature of the URL is invalid")
+	ErrExpiredURL       = errors.New("the URL has expired")
+)
+
+// filesystem keeps the files under a local directory. Its signed URLs point
+// to FILES_PATH in the API, prefixed by baseURL, and carry an HMAC of the key
+// and of the expiry that Verify checks before the file is served.
+type filesystem struct {
+	root    string
+	baseURL string
+	secret  []byte
+}
+
+func NewFilesystem(root, baseURL string, secret []byte) *filesystem {
+	if len(root) == 0 {
+		root = DEFAULT_ROOT
+	}
+
+	return &filesystem{
+		root:    root,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		secret:  secret,
+	}
+}
+
+// Put writes the file to a temporary name first, so that a failed upload
+// never leaves a partial file under the key.
+func (f *filesystem) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	name, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(name), 0o750); err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(name), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	if written != size {
+		return fmt.Errorf("the file of [%s] has %d bytes instead of %d", key, written, size)
+	}
+
+	return os.Rename(tmp.Name(), name)
+}
+
+func (f *filesystem) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	name, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(name)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, document.ErrObjectNotFound
+	}
+
+	return file, err
+}
+
+// Delete removes the file, succeeding when it is already gone.
+func (f *filesystem) Delete(ctx context.Context, key string) error {
+	name, err := f.path(key)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func (f *filesystem) SignURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+
+	expires := time.Now().Add(expiry).Unix()
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires, 10))
+	query.Set("signature", f.sign(key, expires))
+
+	return f.baseURL + FILES_PATH + "/" + escapePath(key) + "?" + query.Encode(), nil
+}
+
+// Verify checks the expires and signature query parameters of a URL built
+// by SignURL for the key.
+func (f *filesystem) Verify(key, expires, signature string) error {
+	expiresAt, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	if !hmac.Equal([]byte(signature), []byte(f.sign(key, expiresAt))) {
+		return ErrInvalidSignature
+	}
+
+	if time.Now().Unix() > expiresAt {
+		return ErrExpiredURL
+	}
+
+	return nil
+}
+
+func (f *filesystem) sign(key string, expires int64) string {
+	mac := hmac.New(sha256.New, f.secret)
+	mac.Write([]byte(key + "\n" + strconv.FormatInt(expires, 10)))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (f *filesystem) path(key string) (string, error) {
+	if !validKey(key) {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(f.root, filepath.FromSlash(key)), nil
+}
+
+// validKey refuses the keys that would leave the root, such as ../x or /x.
+func validKey(key string) bool {
+	return len(key) > 0 && path.Clean(key) == key && !path.IsAbs(key) && key != ".." && !strings.HasPrefix(key, "../")
+}
@@ -0,0 +1,291 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/document"
+)
+
+const (
+	SIGNATURE_ALGORITHM = "AWS4-HMAC-SHA256"
+	UNSIGNED_PAYLOAD    = "UNSIGNED-PAYLOAD"
+	DEFAULT_REGION      = "us-east-1"
+
+	// MAXIMUM_URL_EXPIRY is the longest a presigned URL may last in
+	// Signature Version 4.
+	MAXIMUM_URL_EXPIRY = 7 * 24 * time.Hour
+
+	amzDateFormat = "20060102T150405Z"
+	dateFormat    = "20060102"
+)
+
+// s3 keeps the files in a bucket of an S3-compatible object storage, such
+// as MinIO, addressed in path style: {endpoint}/{bucket}/{key}. Requests
+// are signed with AWS Signature Version 4 and the signed URLs are
+// presigned GETs, served by the object storage itself.
+type s3 struct {
+	endpoint  *url.URL
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+	client    *http.Client
+}
+
+func NewS3(endpoint, bucket, region, accessKey, secretKey string, client *http.Client) (*s3, error) {
+	u, err := url.Parse(strings.TrimSuffix(endpoint, "/"))
+	if err != nil || len(u.Scheme) == 0 || len(u.Host) == 0 {
+		return nil, fmt.Errorf("the S3 endpoint [%s] must be an absolute URL", endpoint)
+	}
+
+	if len(bucket) == 0 {
+		return nil, errors.New("the S3 bucket cannot be empty")
+	}
+
+	if len(region) == 0 {
+		region = DEFAULT_REGION
+	}
+
+	return &s3{
+		endpoint:  u,
+		bucket:    bucket,
+		region:    region,
+		accessKey: accessKey,
+		secretKey: secretKey,
+		client:    client,
+	}, nil
+}
+
+func (s *s3) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	req, err := s.newRequest(ctx, http.MethodPut, key, content)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", contentType)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	return checkStatus(res, http.StatusOK)
+}
+
+func (s *s3) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkStatus(res, http.StatusOK); err != nil {
+		res.Body.Close()
+		return nil, err
+	}
+
+	return res.Body, nil
+}
+
+// Delete removes the object. S3 answers 204 whether or not it existed.
+func (s *s3) Delete(ctx context.Context, key string) error {
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	err = checkStatus(res, http.StatusNoContent, http.StatusOK)
+	if errors.Is(err, document.ErrObjectNotFound) {
+		return nil
+	}
+
+	return err
+}
+
+func (s *s3) SignURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	if expiry < time.Second || expiry > MAXIMUM_URL_EXPIRY {
+		return "", fmt.Errorf("the URL expiry must be between 1s and %s", MAXIMUM_URL_EXPIRY)
+	}
+
+	return s.presign(s.objectURL(key), time.Now().UTC(), expiry).String(), nil
+}
+
+func (s *s3) newRequest(ctx context.Context, method, key string, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, method, s.objectURL(key).String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	s.sign(req, time.Now().UTC())
+
+	return req, nil
+}
+
+func (s *s3) objectURL(key string) *url.URL {
+	u := *s.endpoint
+	u.Path = s.endpoint.Path + "/" + s.bucket + "/" + key
+	u.RawPath = s.endpoint.EscapedPath() + "/" + escapePath(s.bucket) + "/" + escapePath(key)
+
+	return &u
+}
+
+// sign adds the Authorization header of Signature Version 4 to the
+// request, signing its host and date but not its payload.
+func (s *s3) sign(req *http.Request, at time.Time) {
+	amzDate := at.Format(amzDateFormat)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", UNSIGNED_PAYLOAD)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalHeaders := "host:" + req.URL.Host + "\n" +
+		"x-amz-content-sha256:" + UNSIGNED_PAYLOAD + "\n" +
+		"x-amz-date:" + amzDate + "\n"
+
+	scope := s.scope(at)
+	signature := s.signature(at, scope, strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		UNSIGNED_PAYLOAD,
+	}, "\n"))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s", SIGNATURE_ALGORITHM, s.accessKey, scope, signedHeaders, signature))
+}
+
+// presign returns the URL with the query parameters of Signature Version 4,
+// which let anyone GET it until it expires.
+func (s *s3) presign(u *url.URL, at time.Time, expiry time.Duration) *url.URL {
+	scope := s.scope(at)
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", SIGNATURE_ALGORITHM)
+	query.Set("X-Amz-Credential", s.accessKey+"/"+scope)
+	query.Set("X-Amz-Date", at.Format(amzDateFormat))
+	query.Set("X-Amz-Expires", strconv.FormatInt(int64(expiry/time.Second), 10))
+	query.Set("X-Amz-SignedHeaders", "host")
+
+	signature := s.signature(at, scope, strings.Join([]string{
+		http.MethodGet,
+		u.EscapedPath(),
+		canonicalQuery(query),
+		"host:" + u.Host + "\n",
+		"host",
+		UNSIGNED_PAYLOAD,
+	}, "\n"))
+	query.Set("X-Amz-Signature", signature)
+
+	presigned := *u
+	presigned.RawQuery = canonicalQuery(query)
+
+	return &presigned
+}
+
+func (s *s3) scope(at time.Time) string {
+	return at.Format(dateFormat) + "/" + s.region + "/s3/aws4_request"
+}
+
+func (s *s3) signature(at time.Time, scope, canonicalRequest string) string {
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := SIGNATURE_ALGORITHM + "\n" + at.Format(amzDateFormat) + "\n" + scope + "\n" + hex.EncodeToString(hash[:])
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), at.Format(dateFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+
+	return hex.EncodeToString(hmacSHA256(key, stringToSign))
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
+
+// canonicalQuery sorts the parameters by name and then by value, encoding
+// them as Signature Version 4 requires.
+func canonicalQuery(query url.Values) string {
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var pairs []string
+	for _, name := range names {
+		values := append([]string(nil), query[name]...)
+		sort.Strings(values)
+		for _, value := range values {
+			pairs = append(pairs, escape(name, true)+"="+escape(value, true))
+		}
+	}
+
+	return strings.Join(pairs, "&")
+}
+
+// checkStatus turns a status other than the expected ones into an error,
+// document.ErrObjectNotFound for 404.
+func checkStatus(res *http.Response, expected ...int) error {
+	for _, status := range expected {
+		if res.StatusCode == status {
+			return nil
+		}
+	}
+
+	if res.StatusCode == http.StatusNotFound {
+		return document.ErrObjectNotFound
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(res.Body, 1024))
+
+	return fmt.Errorf("the object storage answered %s %s with status %d: %s", res.Request.Method, res.Request.URL.Path, res.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// escapePath encodes every byte but the unreserved ones and the slashes.
+func escapePath(p string) string {
+	return escape(p, false)
+}
+
+// escape encodes every byte but the unreserved ones of RFC 3986, and the
+// slashes unless encodeSlash, in upper case hex as Signature Version 4
+// requires.
+func escape(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			b.WriteByte(c)
+			continue
+		}
+
+		fmt.Fprintf(&b, "%%%02X", c)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"crypto/rand"
+	"fmt"
+	"net/http"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/document"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+// New builds the storage chosen by DOCUMENT_STORAGE. Without
+// DOCUMENT_URL_SECRET the filesystem storage signs its URLs with a random
+// secret, so they do not survive a restart nor work across replicas.
+func New(config *config.Config, logger *logging.Logging) (document.Storage, error) {
+	switch config.DocumentStorage {
+	case "", "filesystem":
+		secret := []byte(config.DocumentURLSecret)
+		if len(secret) == 0 {
+			secret = make([]byte, 32)
+			if _, err := rand.Read(secret); err != nil {
+				return nil, err
+			}
+
+			logger.Warn("DOCUMENT_URL_SECRET is empty, the document URLs will not survive a restart", nil)
+		}
+
+		return NewFilesystem(config.DocumentStoragePath, config.DocumentPublicURL, secret), nil
+	case "s3":
+		s3, err := NewS3(config.DocumentS3Endpoint, config.DocumentS3Bucket, config.DocumentS3Region, config.DocumentS3AccessKey, config.DocumentS3SecretKey, &http.Client{})
+		if err != nil {
+			return nil, err
+		}
+
+		return s3, nil
+	}
+
+	return nil, fmt.Errorf("the document storage [%s] must be one of filesystem or s3", config.DocumentStorage)
+}
@@ -0,0 +1,69 @@
+package document
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidDocument = errors.New("the given document is invalid")
+
+	ErrMissingOwner         = errors.New("the document must have an owner")
+	ErrTypeNotAllowed       = errors.New("the document type is not allowed for its owner: drivers have CNH, TOXICOLOGICAL_EXAM and MEDICAL_EXAM, vehicles have CRLV")
+	ErrEmptyName            = errors.New("the document name cannot be empty")
+	ErrInvalidContentType   = errors.New("the document file must be a PDF, JPEG or PNG")
+	ErrEmptyFile            = errors.New("the document file cannot be empty")
+	ErrInvalidChecksum      = errors.New("the document checksum must be a SHA-256 in hex")
+	ErrInvalidValidityRange = errors.New("the document validity must end after it starts")
+)
+
+// Validate returns every rule broken by the document joined in a single
+// error. The maximum size of the file is checked by the service, which
+// knows the configured limit.
+func (d *Document) Validate() error {
+	var errs []error
+
+	if _, err := GetOwnerType(string(d.OwnerType)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if d.OwnerID <= 0 {
+		errs = append(errs, ErrMissingOwner)
+	}
+
+	if _, err := GetType(string(d.Type)); err != nil {
+		errs = append(errs, err)
+	} else if _, err := GetOwnerType(string(d.OwnerType)); err == nil && !d.OwnerType.Allows(d.Type) {
+		errs = append(errs, ErrTypeNotAllowed)
+	}
+
+	if strings.TrimSpace(d.Name) == "" {
+		errs = append(errs, ErrEmptyName)
+	}
+
+	if _, ok := contentTypes[d.ContentType]; !ok {
+		errs = append(errs, ErrInvalidContentType)
+	}
+
+	if d.Size <= 0 {
+		errs = append(errs, ErrEmptyFile)
+	}
+
+	if len(d.Checksum) > 0 {
+		if sum, err := hex.DecodeString(d.Checksum); err != nil || len(sum) != 32 {
+			errs = append(errs, ErrInvalidChecksum)
+		}
+	}
+
+	if !d.ValidFrom.IsZero() && !d.ValidUntil.IsZero() && !d.ValidUntil.After(d.ValidFrom) {
+		errs = append(errs, ErrInvalidValidityRange)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidDocument, errors.Join(errs...))
+	}
+
+	return nil
+}
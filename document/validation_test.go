@@ -0,0 +1,73 @@
+package document_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/document"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestDocument_Validate(t *testing.T) {
+	validFrom := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		document document.Document
+		wantErrs []error
+	}{
+		{
+			name: "Dado uma CNH válida quando a validação é chamada então nenhum erro é retornado",
+			document: document.Document{
+				OwnerType:   document.DRIVER,
+				OwnerID:     2,
+				Type:        document.CNH,
+				Name:        "cnh.pdf",
+				ContentType: "application/pdf",
+				Size:        2048,
+				Checksum:    "9F86D081884C7D659A2FEAA0C55AD015A3BF4F1B2B0B822CD15D6C15B0F00A08",
+				ValidFrom:   validFrom,
+				ValidUntil:  validFrom.AddDate(5, 0, 0),
+			},
+		},
+		{
+			name: "Dado um CRLV de um motorista quando a validação é chamada então um erro é retornado",
+			document: document.Document{
+				OwnerType:   document.DRIVER,
+				OwnerID:     2,
+				Type:        document.CRLV,
+				Name:        "crlv.png",
+				ContentType: "image/png",
+				Size:        1024,
+			},
+			wantErrs: []error{document.ErrInvalidDocument, document.ErrTypeNotAllowed},
+		},
+		{
+			name: "Dado um documento vazio com arquivo e validade inválidos quando a validação é chamada então todas as regras quebradas são retornadas",
+			document: document.Document{
+				Name:        " ",
+				ContentType: "application/x-msdownload",
+				Checksum:    "abc",
+				ValidFrom:   validFrom,
+				ValidUntil:  validFrom,
+			},
+			wantErrs: []error{
+				document.ErrInvalidDocument, document.ErrInvalidOwnerType, document.ErrMissingOwner, document.ErrInvalidType,
+				document.ErrEmptyName, document.ErrInvalidContentType, document.ErrEmptyFile, document.ErrInvalidChecksum,
+				document.ErrInvalidValidityRange,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.document.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
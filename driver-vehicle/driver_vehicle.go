@@ -2,12 +2,19 @@ package drivervehicle
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/LucasMateus-eng/operations-service/driver"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 )
 
+var (
+	ErrAlreadyAssigned    = errors.New("the vehicle is already assigned to the driver")
+	ErrOverdueMaintenance = errors.New("the vehicle is overdue on a critical maintenance and cannot be assigned")
+	ErrUninsured          = errors.New("the vehicle has no insurance policy in force and cannot be assigned")
+)
+
 type DriverVehicle struct {
 	DriverID  int64
 	VehicleID int64
@@ -25,11 +32,22 @@ type Reading interface {
 	GetByID(ctx context.Context, driverID, vehicleID int64) (*DriverVehicle, error)
 	GetDriverListByVehicleID(ctx context.Context, specification *DriverVehicleSpecification) (*[]driver.Driver, error)
 	GetVehicleListByDriverID(ctx context.Context, specification *DriverVehicleSpecification) (*[]vehicle.Vehicle, error)
+	ListByDriverIDs(ctx context.Context, driverIDs []int64) (*[]DriverVehicle, error)
+	ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]DriverVehicle, error)
+	// ListByVehicleIDAt returns the assignments of the vehicle that were in
+	// force at the given time, ended or not.
+	ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]DriverVehicle, error)
+	ListVehicleIDsByDriverID(ctx context.Context, driverID int64) ([]int64, error)
+	ListDriverIDsByVehicleID(ctx context.Context, vehicleID int64) ([]int64, error)
 }
 
 type Writing interface {
 	Create(ctx context.Context, dv *DriverVehicle) (*DriverVehicle, error)
 	Delete(ctx context.Context, driverID, vehicleID int64) error
+	EndByDriverID(ctx context.Context, driverID int64) error
+	EndByVehicleID(ctx context.Context, vehicleID int64) error
+	PurgeByDriverID(ctx context.Context, driverID int64) error
+	PurgeByVehicleID(ctx context.Context, vehicleID int64) error
 }
 
 type Repository interface {
@@ -37,10 +55,25 @@ type Repository interface {
 	Writing
 }
 
+// MaintenanceReading is the part of the maintenance use case needed to
+// assign a vehicle.
+type MaintenanceReading interface {
+	HasOverdueCritical(ctx context.Context, vehicleID int64) (bool, error)
+}
+
+// InsuranceReading is the part of the insurance repository needed to assign
+// a vehicle when insurance is required.
+type InsuranceReading interface {
+	HasValidPolicy(ctx context.Context, vehicleID int64, at time.Time) (bool, error)
+}
+
 type UseCase interface {
 	GetByID(ctx context.Context, driverID, vehicleID int64) (*DriverVehicle, error)
 	GetDriverListByVehicleID(ctx context.Context, specification *DriverVehicleSpecification) (*[]driver.Driver, error)
 	GetVehicleListByDriverID(ctx context.Context, specification *DriverVehicleSpecification) (*[]vehicle.Vehicle, error)
-	Create(ctx context.Context, dv *DriverVehicle) (int64, error)
+	ListByDriverIDs(ctx context.Context, driverIDs []int64) (*[]DriverVehicle, error)
+	ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]DriverVehicle, error)
+	ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]DriverVehicle, error)
+	Create(ctx context.Context, dv *DriverVehicle) (*DriverVehicle, error)
 	Delete(ctx context.Context, driverID, vehicleID int64) error
 }
@@ -0,0 +1,13 @@
+package drivervehicle
+
+import (
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// EventPayload is the body of the driver_vehicle.assigned and
+// driver_vehicle.unassigned events.
+type EventPayload = outbox.AssignmentPayload
+
+func newEvent(eventType string, driverID, vehicleID int64) (*outbox.Event, error) {
+	return outbox.NewAssignmentEvent(driverID, vehicleID, eventType, EventPayload{DriverID: driverID, VehicleID: vehicleID})
+}
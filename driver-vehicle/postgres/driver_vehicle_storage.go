@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"time"
 
 	"github.com/LucasMateus-eng/operations-service/driver"
 	driver_vehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
@@ -11,6 +12,7 @@ import (
 	"github.com/LucasMateus-eng/operations-service/driver-vehicle/postgres/mapping"
 	driver_dto "github.com/LucasMateus-eng/operations-service/driver/postgres/dto"
 	mapping_driver "github.com/LucasMateus-eng/operations-service/driver/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 	vehicle_dto "github.com/LucasMateus-eng/operations-service/vehicle/postgres/dto"
 	mapping_vehicle "github.com/LucasMateus-eng/operations-service/vehicle/postgres/mapping"
@@ -29,10 +31,14 @@ func New(db *bun.DB) *driverVehiclePostgresRepo {
 	}
 }
 
+func (dr *driverVehiclePostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, dr.db)
+}
+
 func (dr *driverVehiclePostgresRepo) GetByID(ctx context.Context, driverID, vehicleID int64) (*driver_vehicle.DriverVehicle, error) {
 	var driverVehicleDTO dto.DriverVehicleDTO
 
-	err := dr.db.NewSelect().
+	err := dr.conn(ctx).NewSelect().
 		Model(&driverVehicleDTO).
 		Where("driver_id = ? AND vehicle_id = ?", driverID, vehicleID).
 		Scan(ctx)
@@ -40,6 +46,8 @@ func (dr *driverVehiclePostgresRepo) GetByID(ctx context.Context, driverID, vehi
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
 		}
+
+		return nil, err
 	}
 
 	mappedValue := mapping.MapDTOToDriverVehicle(&driverVehicleDTO)
@@ -50,7 +58,7 @@ func (dr *driverVehiclePostgresRepo) GetByID(ctx context.Context, driverID, vehi
 func (dr *driverVehiclePostgresRepo) GetDriverListByVehicleID(ctx context.Context, specification *driver_vehicle.DriverVehicleSpecification) (*[]driver.Driver, error) {
 	var driverVehicleDTOs []dto.DriverVehicleDTO
 
-	query := dr.db.NewSelect().
+	query := dr.conn(ctx).NewSelect().
 		Model(&driverVehicleDTOs).
 		Relation("Driver").
 		Where("vehicle_id = ?", specification.VehicleID).
@@ -82,7 +90,7 @@ func (dr *driverVehiclePostgresRepo) GetDriverListByVehicleID(ctx context.Contex
 func (dv *driverVehiclePostgresRepo) GetVehicleListByDriverID(ctx context.Context, specification *driver_vehicle.DriverVehicleSpecification) (*[]vehicle.Vehicle, error) {
 	var driverVehicleDTOs []dto.DriverVehicleDTO
 
-	query := dv.db.NewSelect().
+	query := dv.conn(ctx).NewSelect().
 		Model(&driverVehicleDTOs).
 		Relation("Vehicle").
 		Where("driver_id = ?", specification.DriverID).
@@ -111,46 +119,210 @@ func (dv *driverVehiclePostgresRepo) GetVehicleListByDriverID(ctx context.Contex
 	return &vehicles, nil
 }
 
-func (dr *driverVehiclePostgresRepo) Create(ctx context.Context, dv *driver_vehicle.DriverVehicle) (*driver_vehicle.DriverVehicle, error) {
-	tx, err := dr.db.BeginTx(ctx, nil)
+// ListByDriverIDs returns the current assignments of the given drivers in a
+// single query.
+func (dr *driverVehiclePostgresRepo) ListByDriverIDs(ctx context.Context, driverIDs []int64) (*[]driver_vehicle.DriverVehicle, error) {
+	return dr.listBy(ctx, "driver_id", driverIDs)
+}
+
+// ListByVehicleIDs returns the current assignments of the given vehicles in a
+// single query.
+func (dr *driverVehiclePostgresRepo) ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]driver_vehicle.DriverVehicle, error) {
+	return dr.listBy(ctx, "vehicle_id", vehicleIDs)
+}
+
+// ListByVehicleIDAt returns the assignments of the vehicle that began at or
+// before at and had not ended by then. An assignment that was ended and
+// later brought back keeps its first start, so the time it was ended in
+// between is not told apart.
+func (dr *driverVehiclePostgresRepo) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]driver_vehicle.DriverVehicle, error) {
+	var driverVehicleDTOs []dto.DriverVehicleDTO
+
+	err := dr.conn(ctx).NewSelect().
+		Model(&driverVehicleDTOs).
+		WhereAllWithDeleted().
+		Where("vehicle_id = ?", vehicleID).
+		Where("created_at <= ?", at).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("deleted_at = ?", time.Time{}).WhereOr("deleted_at > ?", at)
+		}).
+		Order("driver_id ASC").
+		Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
-	defer tx.Rollback()
 
-	driverExists, err := tx.NewSelect().Model((*driver_dto.DriverDTO)(nil)).Where("id = ?", dv.DriverID).Exists(ctx)
-	if err != nil {
-		return nil, err
+	driverVehicles := make([]driver_vehicle.DriverVehicle, 0, len(driverVehicleDTOs))
+	for _, dto := range driverVehicleDTOs {
+		driverVehicles = append(driverVehicles, *mapping.MapDTOToDriverVehicle(&dto))
 	}
 
-	vehicleExists, err := tx.NewSelect().Model((*vehicle_dto.VehicleDTO)(nil)).Where("id = ?", dv.VehicleID).Exists(ctx)
+	return &driverVehicles, nil
+}
+
+func (dr *driverVehiclePostgresRepo) listBy(ctx context.Context, column string, ids []int64) (*[]driver_vehicle.DriverVehicle, error) {
+	var driverVehicleDTOs []dto.DriverVehicleDTO
+
+	err := dr.conn(ctx).NewSelect().
+		Model(&driverVehicleDTOs).
+		Where("? IN (?)", bun.Ident(column), bun.In(ids)).
+		Order("driver_id ASC", "vehicle_id ASC").
+		Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	if driverExists && vehicleExists {
-		driverVehicleDTO := mapping.MapDriverVehicleToDTO(dv)
+	driverVehicles := make([]driver_vehicle.DriverVehicle, 0, len(driverVehicleDTOs))
+	for _, dto := range driverVehicleDTOs {
+		driverVehicles = append(driverVehicles, *mapping.MapDTOToDriverVehicle(&dto))
+	}
+
+	return &driverVehicles, nil
+}
+
+func (dr *driverVehiclePostgresRepo) Create(ctx context.Context, dv *driver_vehicle.DriverVehicle) (*driver_vehicle.DriverVehicle, error) {
+	driverVehicleDTO := mapping.MapDriverVehicleToDTO(dv)
 
-		_, err := tx.NewInsert().Model(driverVehicleDTO).Exec(ctx)
+	err := db_postgres.WithinTransaction(ctx, dr.db, func(ctx context.Context) error {
+		driverExists, err := dr.conn(ctx).NewSelect().Model((*driver_dto.DriverDTO)(nil)).Where("id = ?", dv.DriverID).Exists(ctx)
 		if err != nil {
-			return nil, err
+			return err
 		}
 
-		if err := tx.Commit(); err != nil {
-			return nil, err
+		vehicleExists, err := dr.conn(ctx).NewSelect().Model((*vehicle_dto.VehicleDTO)(nil)).Where("id = ?", dv.VehicleID).Exists(ctx)
+		if err != nil {
+			return err
 		}
 
-		mappedValue := mapping.MapDTOToDriverVehicle(driverVehicleDTO)
+		if !driverExists || !vehicleExists {
+			return errors.New("driver or vehicle does not exist")
+		}
+
+		// An assignment that was ended is brought back instead of clashing with
+		// the primary key of the soft deleted row.
+		res, err := dr.conn(ctx).NewInsert().
+			Model(driverVehicleDTO).
+			On("CONFLICT (driver_id, vehicle_id) DO UPDATE").
+			Set("deleted_at = DEFAULT").
+			Set("updated_at = current_timestamp").
+			Where("?TableAlias.deleted_at != ?", time.Time{}).
+			Exec(ctx)
+		if err != nil {
+			return err
+		}
+
+		rowsAffected, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
 
-		return mappedValue, nil
+		if rowsAffected == 0 {
+			return driver_vehicle.ErrAlreadyAssigned
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil, errors.New("driver or vehicle does not exist")
+	mappedValue := mapping.MapDTOToDriverVehicle(driverVehicleDTO)
+
+	return mappedValue, nil
 }
 
+// Delete ends the active assignment of the vehicle to the driver, returning
+// sql.ErrNoRows when there is none.
 func (dr *driverVehiclePostgresRepo) Delete(ctx context.Context, driverID, vehicleID int64) error {
-	_, err := dr.db.NewDelete().Model((*dto.DriverVehicleDTO)(nil)).
+	res, err := dr.conn(ctx).NewDelete().Model((*dto.DriverVehicleDTO)(nil)).
 		Where("driver_id = ? AND vehicle_id = ?", driverID, vehicleID).
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+// ListVehicleIDsByDriverID returns the vehicles actively assigned to the
+// driver.
+func (dr *driverVehiclePostgresRepo) ListVehicleIDsByDriverID(ctx context.Context, driverID int64) ([]int64, error) {
+	var vehicleIDs []int64
+	err := dr.conn(ctx).NewSelect().Model((*dto.DriverVehicleDTO)(nil)).
+		Column("vehicle_id").
+		Where("driver_id = ?", driverID).
+		Order("vehicle_id ASC").
+		Scan(ctx, &vehicleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return vehicleIDs, nil
+}
+
+// ListDriverIDsByVehicleID returns the drivers actively assigned to the
+// vehicle.
+func (dr *driverVehiclePostgresRepo) ListDriverIDsByVehicleID(ctx context.Context, vehicleID int64) ([]int64, error) {
+	var driverIDs []int64
+	err := dr.conn(ctx).NewSelect().Model((*dto.DriverVehicleDTO)(nil)).
+		Column("driver_id").
+		Where("vehicle_id = ?", vehicleID).
+		Order("driver_id ASC").
+		Scan(ctx, &driverIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return driverIDs, nil
+}
+
+// EndByDriverID soft deletes every active assignment of the driver.
+func (dr *driverVehiclePostgresRepo) EndByDriverID(ctx context.Context, driverID int64) error {
+	_, err := dr.conn(ctx).NewDelete().Model((*dto.DriverVehicleDTO)(nil)).
+		Where("driver_id = ?", driverID).
+		Exec(ctx)
+	return err
+}
+
+// EndByVehicleID soft deletes every active assignment of the vehicle.
+func (dr *driverVehiclePostgresRepo) EndByVehicleID(ctx context.Context, vehicleID int64) error {
+	_, err := dr.conn(ctx).NewDelete().Model((*dto.DriverVehicleDTO)(nil)).
+		Where("vehicle_id = ?", vehicleID).
+		Exec(ctx)
+	return err
+}
+
+// PurgeByDriverID removes every assignment of the driver for good, ended or
+// not.
+func (dr *driverVehiclePostgresRepo) PurgeByDriverID(ctx context.Context, driverID int64) error {
+	_, err := dr.conn(ctx).NewDelete().Model((*dto.DriverVehicleDTO)(nil)).
+		WhereAllWithDeleted().
+		Where("driver_id = ?", driverID).
+		ForceDelete().
+		Exec(ctx)
+	return err
+}
+
+// PurgeByVehicleID removes every assignment of the vehicle for good, ended
+// or not.
+func (dr *driverVehiclePostgresRepo) PurgeByVehicleID(ctx context.Context, vehicleID int64) error {
+	_, err := dr.conn(ctx).NewDelete().Model((*dto.DriverVehicleDTO)(nil)).
+		WhereAllWithDeleted().
+		Where("vehicle_id = ?", vehicleID).
+		ForceDelete().
+		Exec(ctx)
 	return err
 }
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -17,5 +17,5 @@ type DriverVehicleDTO struct {
 	Vehicle   vehicle_dto.VehicleDTO `bun:"rel:belongs-to,join:vehicle_id=id"`
 	CreatedAt time.Time              `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt time.Time              `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
-	DeletedAt time.Time              `bun:"deleted_at,soft_delete,nullzero,notnull,default:'0001-01-01 00:00:00+00'"`
+	DeletedAt time.Time              `bun:"deleted_at,soft_delete,notnull,default:'0001-01-01 00:00:00+00'"`
 }
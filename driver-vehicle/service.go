@@ -2,21 +2,37 @@ package drivervehicle
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 )
 
 type Service struct {
-	repo   Repository
-	logger *logging.Logging
+	repo             Repository
+	auditor          audit.Recorder
+	events           outbox.Emitter
+	maintenance      MaintenanceReading
+	insurance        InsuranceReading
+	requireInsurance bool
+	logger           *logging.Logging
 }
 
-func NewService(r Repository, l *logging.Logging) *Service {
+// NewService builds the use case of the assignments. With requireInsurance,
+// only vehicles with an insurance policy in force can be assigned.
+func NewService(r Repository, au audit.Recorder, em outbox.Emitter, mr MaintenanceReading, ir InsuranceReading, requireInsurance bool, l *logging.Logging) *Service {
 	return &Service{
-		repo:   r,
-		logger: l,
+		repo:             r,
+		auditor:          au,
+		events:           em,
+		maintenance:      mr,
+		insurance:        ir,
+		requireInsurance: requireInsurance,
+		logger:           l,
 	}
 }
 
@@ -66,11 +82,83 @@ func (s *Service) GetVehicleListByDriverID(ctx context.Context, specification *D
 	return vehicles, nil
 }
 
+func (s *Service) ListByDriverIDs(ctx context.Context, driverIDs []int64) (*[]DriverVehicle, error) {
+	s.logger.Debug("[DRIVER-VEHICLE] ListByDriverIDs - DEBUG: ", map[string]any{
+		"driverIDs": driverIDs,
+	})
+	driverVehicles, err := s.repo.ListByDriverIDs(ctx, driverIDs)
+	if err != nil {
+		s.logger.Error("[DRIVER-VEHICLE] ListByDriverIDs - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return driverVehicles, nil
+}
+
+func (s *Service) ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]DriverVehicle, error) {
+	s.logger.Debug("[DRIVER-VEHICLE] ListByVehicleIDs - DEBUG: ", map[string]any{
+		"vehicleIDs": vehicleIDs,
+	})
+	driverVehicles, err := s.repo.ListByVehicleIDs(ctx, vehicleIDs)
+	if err != nil {
+		s.logger.Error("[DRIVER-VEHICLE] ListByVehicleIDs - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return driverVehicles, nil
+}
+
+func (s *Service) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]DriverVehicle, error) {
+	s.logger.Debug("[DRIVER-VEHICLE] ListByVehicleIDAt - DEBUG: ", map[string]any{
+		"vehicleID": vehicleID,
+		"at":        at,
+	})
+	driverVehicles, err := s.repo.ListByVehicleIDAt(ctx, vehicleID, at)
+	if err != nil {
+		s.logger.Error("[DRIVER-VEHICLE] ListByVehicleIDAt - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return driverVehicles, nil
+}
+
 func (s *Service) Create(ctx context.Context, dv *DriverVehicle) (*DriverVehicle, error) {
 	s.logger.Debug("[DRIVER-VEHICLE] Create - DEBUG: ", map[string]any{
 		"driverVehicle": dv,
 	})
-	driverVehicle, err := s.repo.Create(ctx, dv)
+	err := s.checkAssignable(ctx, dv.VehicleID)
+	if err != nil {
+		s.logger.Error("[DRIVER-VEHICLE] Create - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	var driverVehicle *DriverVehicle
+	err = s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		driverVehicle, err = s.repo.Create(ctx, dv)
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := newEvent(outbox.DRIVER_VEHICLE_ASSIGNED, dv.DriverID, dv.VehicleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewAssignmentEntry(dv.DriverID, dv.VehicleID, audit.CREATE, nil, driverVehicle)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[DRIVER-VEHICLE] Create - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -86,7 +174,22 @@ func (s *Service) Delete(ctx context.Context, driverID, vehicleID int64) error {
 		"driverID":  driverID,
 		"vehicleID": vehicleID,
 	})
-	err := s.repo.Delete(ctx, driverID, vehicleID)
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.repo.Delete(ctx, driverID, vehicleID); err != nil {
+			return nil, err
+		}
+
+		event, err := newEvent(outbox.DRIVER_VEHICLE_UNASSIGNED, driverID, vehicleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewAssignmentEntry(driverID, vehicleID, audit.DELETE, nil, nil)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[DRIVER-VEHICLE] Delete - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -96,3 +199,31 @@ func (s *Service) Delete(ctx context.Context, driverID, vehicleID int64) error {
 
 	return nil
 }
+
+// checkAssignable keeps from assignment the vehicles overdue on a critical
+// maintenance and, when insurance is required, the uninsured ones.
+func (s *Service) checkAssignable(ctx context.Context, vehicleID int64) error {
+	overdue, err := s.maintenance.HasOverdueCritical(ctx, vehicleID)
+	if err != nil {
+		return err
+	}
+
+	if overdue {
+		return fmt.Errorf("%w: [%d]", ErrOverdueMaintenance, vehicleID)
+	}
+
+	if !s.requireInsurance {
+		return nil
+	}
+
+	insured, err := s.insurance.HasValidPolicy(ctx, vehicleID, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !insured {
+		return fmt.Errorf("%w: [%d]", ErrUninsured, vehicleID)
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package drivervehicle_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 
@@ -10,7 +11,9 @@ import (
 	"github.com/LucasMateus-eng/operations-service/driver"
 	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
 	driver_vehicle_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/driver-vehicle"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 	"github.com/go-playground/assert/v2"
 	"go.uber.org/mock/gomock"
@@ -37,10 +40,22 @@ var (
 	}
 )
 
+// newEmitter accepts every event emitted by a test that does not check them.
+func newEmitter(ctrl *gomock.Controller) *outbox_mocks.MockEmitter {
+	events := outbox_mocks.NewMockEmitter(ctrl)
+	events.EXPECT().Emit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return events
+}
+
 func TestService_GetByID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo        *driver_vehicle_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		maintenance *driver_vehicle_mocks.MockMaintenanceReading
+		insurance   *driver_vehicle_mocks.MockInsuranceReading
+		logger      *logging.Logging
 	}
 
 	type args struct {
@@ -89,15 +104,19 @@ func TestService_GetByID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:        driver_vehicle_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      newEmitter(ctrl),
+				maintenance: driver_vehicle_mocks.NewMockMaintenanceReading(ctrl),
+				insurance:   driver_vehicle_mocks.NewMockInsuranceReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := drivervehicle.NewService(sm.repo, sm.logger)
+			s := drivervehicle.NewService(sm.repo, sm.auditor, sm.events, sm.maintenance, sm.insurance, false, sm.logger)
 
 			actualDriver, err := s.GetByID(test.args.ctx, test.args.driverID, test.args.vehicleID)
 
@@ -109,8 +128,12 @@ func TestService_GetByID(t *testing.T) {
 
 func TestService_GetDriverListByVehicleID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo        *driver_vehicle_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		maintenance *driver_vehicle_mocks.MockMaintenanceReading
+		insurance   *driver_vehicle_mocks.MockInsuranceReading
+		logger      *logging.Logging
 	}
 
 	type args struct {
@@ -165,15 +188,19 @@ func TestService_GetDriverListByVehicleID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:        driver_vehicle_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      newEmitter(ctrl),
+				maintenance: driver_vehicle_mocks.NewMockMaintenanceReading(ctrl),
+				insurance:   driver_vehicle_mocks.NewMockInsuranceReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := drivervehicle.NewService(sm.repo, sm.logger)
+			s := drivervehicle.NewService(sm.repo, sm.auditor, sm.events, sm.maintenance, sm.insurance, false, sm.logger)
 
 			actualDrivers, err := s.GetDriverListByVehicleID(test.args.ctx, test.args.specification)
 
@@ -185,8 +212,12 @@ func TestService_GetDriverListByVehicleID(t *testing.T) {
 
 func TestService_GetVehicleListByDriverID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo        *driver_vehicle_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		maintenance *driver_vehicle_mocks.MockMaintenanceReading
+		insurance   *driver_vehicle_mocks.MockInsuranceReading
+		logger      *logging.Logging
 	}
 
 	type args struct {
@@ -241,15 +272,19 @@ func TestService_GetVehicleListByDriverID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:        driver_vehicle_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      newEmitter(ctrl),
+				maintenance: driver_vehicle_mocks.NewMockMaintenanceReading(ctrl),
+				insurance:   driver_vehicle_mocks.NewMockInsuranceReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := drivervehicle.NewService(sm.repo, sm.logger)
+			s := drivervehicle.NewService(sm.repo, sm.auditor, sm.events, sm.maintenance, sm.insurance, false, sm.logger)
 
 			actualVehicles, err := s.GetVehicleListByDriverID(test.args.ctx, test.args.specification)
 
@@ -261,8 +296,12 @@ func TestService_GetVehicleListByDriverID(t *testing.T) {
 
 func TestService_Create(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo        *driver_vehicle_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		maintenance *driver_vehicle_mocks.MockMaintenanceReading
+		insurance   *driver_vehicle_mocks.MockInsuranceReading
+		logger      *logging.Logging
 	}
 
 	type args struct {
@@ -271,11 +310,12 @@ func TestService_Create(t *testing.T) {
 	}
 
 	tests := []struct {
-		name        string
-		args        args
-		prepareMock func(p args, m serviceMocks)
-		want        *drivervehicle.DriverVehicle
-		wantErr     bool
+		name             string
+		args             args
+		requireInsurance bool
+		prepareMock      func(p args, m serviceMocks)
+		want             *drivervehicle.DriverVehicle
+		wantErr          bool
 	}{
 		{
 			name: "Dado um DriverVehicle válido quando o método Create é chamado então a relação motorista/veículo é criada",
@@ -284,6 +324,7 @@ func TestService_Create(t *testing.T) {
 				dv:  &drivervehicle.DriverVehicle{DriverID: 1, VehicleID: 1},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.maintenance.EXPECT().HasOverdueCritical(p.ctx, p.dv.VehicleID).Return(false, nil)
 				m.repo.EXPECT().Create(p.ctx, p.dv).Return(expectedDriverVehicle, nil)
 			},
 			want:    expectedDriverVehicle,
@@ -296,11 +337,65 @@ func TestService_Create(t *testing.T) {
 				dv:  &drivervehicle.DriverVehicle{},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.maintenance.EXPECT().HasOverdueCritical(p.ctx, p.dv.VehicleID).Return(false, nil)
 				m.repo.EXPECT().Create(p.ctx, p.dv).Return(nil, errMocked)
 			},
 			want:    nil,
 			wantErr: true,
 		},
+		{
+			name: "Dado um veículo com manutenção crítica vencida quando o método Create é chamado então a relação não é criada",
+			args: args{
+				ctx: mockedContext,
+				dv:  &drivervehicle.DriverVehicle{DriverID: 1, VehicleID: 1},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.maintenance.EXPECT().HasOverdueCritical(p.ctx, p.dv.VehicleID).Return(true, nil)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro ao consultar as manutenções quando o método Create é chamado então um erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				dv:  &drivervehicle.DriverVehicle{DriverID: 1, VehicleID: 1},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.maintenance.EXPECT().HasOverdueCritical(p.ctx, p.dv.VehicleID).Return(false, errMocked)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Dado um veículo segurado com o seguro obrigatório quando o método Create é chamado então a relação é criada",
+			args: args{
+				ctx: mockedContext,
+				dv:  &drivervehicle.DriverVehicle{DriverID: 1, VehicleID: 1},
+			},
+			requireInsurance: true,
+			prepareMock: func(p args, m serviceMocks) {
+				m.maintenance.EXPECT().HasOverdueCritical(p.ctx, p.dv.VehicleID).Return(false, nil)
+				m.insurance.EXPECT().HasValidPolicy(p.ctx, p.dv.VehicleID, gomock.Any()).Return(true, nil)
+				m.repo.EXPECT().Create(p.ctx, p.dv).Return(expectedDriverVehicle, nil)
+			},
+			want:    expectedDriverVehicle,
+			wantErr: false,
+		},
+		{
+			name: "Dado um veículo sem seguro vigente com o seguro obrigatório quando o método Create é chamado então a relação não é criada",
+			args: args{
+				ctx: mockedContext,
+				dv:  &drivervehicle.DriverVehicle{DriverID: 1, VehicleID: 1},
+			},
+			requireInsurance: true,
+			prepareMock: func(p args, m serviceMocks) {
+				m.maintenance.EXPECT().HasOverdueCritical(p.ctx, p.dv.VehicleID).Return(false, nil)
+				m.insurance.EXPECT().HasValidPolicy(p.ctx, p.dv.VehicleID, gomock.Any()).Return(false, nil)
+			},
+			want:    nil,
+			wantErr: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -309,15 +404,19 @@ func TestService_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:        driver_vehicle_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      newEmitter(ctrl),
+				maintenance: driver_vehicle_mocks.NewMockMaintenanceReading(ctrl),
+				insurance:   driver_vehicle_mocks.NewMockInsuranceReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := drivervehicle.NewService(sm.repo, sm.logger)
+			s := drivervehicle.NewService(sm.repo, sm.auditor, sm.events, sm.maintenance, sm.insurance, test.requireInsurance, sm.logger)
 
 			actualDriverVehicle, err := s.Create(test.args.ctx, test.args.dv)
 
@@ -329,8 +428,12 @@ func TestService_Create(t *testing.T) {
 
 func TestService_Delete(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo        *driver_vehicle_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		maintenance *driver_vehicle_mocks.MockMaintenanceReading
+		insurance   *driver_vehicle_mocks.MockInsuranceReading
+		logger      *logging.Logging
 	}
 
 	type args struct {
@@ -353,6 +456,7 @@ func TestService_Delete(t *testing.T) {
 			},
 			prepareMock: func(p args, m serviceMocks) {
 				m.repo.EXPECT().Delete(p.ctx, p.driverID, p.vehicleID).Return(nil)
+				m.events.EXPECT().Emit(p.ctx, gomock.Any()).Return(nil)
 			},
 			wantErr: false,
 		},
@@ -368,6 +472,18 @@ func TestService_Delete(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Dado um vínculo inexistente quando o método Delete é chamado então nenhum evento é emitido",
+			args: args{
+				ctx:       mockedContext,
+				driverID:  1,
+				vehicleID: 2,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Delete(p.ctx, p.driverID, p.vehicleID).Return(sql.ErrNoRows)
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -376,15 +492,19 @@ func TestService_Delete(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:        driver_vehicle_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      outbox_mocks.NewMockEmitter(ctrl),
+				maintenance: driver_vehicle_mocks.NewMockMaintenanceReading(ctrl),
+				insurance:   driver_vehicle_mocks.NewMockInsuranceReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := drivervehicle.NewService(sm.repo, sm.logger)
+			s := drivervehicle.NewService(sm.repo, sm.auditor, sm.events, sm.maintenance, sm.insurance, false, sm.logger)
 
 			err := s.Delete(test.args.ctx, test.args.driverID, test.args.vehicleID)
 
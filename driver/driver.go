@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/user"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 )
 
@@ -32,6 +33,7 @@ type Driver struct {
 	Address          *address.Address
 	Contact          Contact
 	Vehicles         []vehicle.Vehicle
+	Version          int64
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	DeletedAt        time.Time
@@ -41,19 +43,30 @@ type DriverSpecification struct {
 	Page, PageSize int
 }
 
+// ImmutableFields cannot be changed by a patch once the driver is created.
+var ImmutableFields = []string{"id", "rg", "cpf", "driver_license"}
+
 type Reading interface {
 	GetByID(ctx context.Context, id int64) (*Driver, error)
 	GetByUserID(ctx context.Context, userId int64) (*Driver, error)
 	GetByIDWithEagerLoading(ctx context.Context, id int64) (*Driver, error)
 	GetByUserIDWithEagerLoading(ctx context.Context, userId int64) (*Driver, error)
+	ListByIDs(ctx context.Context, ids []int64) (*[]Driver, error)
+	GetByLegalInformation(ctx context.Context, info DriverLegalInformation) (*Driver, error)
 	List(ctx context.Context, specification *DriverSpecification) (*[]Driver, error)
 	ListWithEagerLoading(ctx context.Context, specification *DriverSpecification) (*[]Driver, error)
+	Iterate(ctx context.Context, specification *DriverSpecification, fn func(d *Driver) error) error
+	ListDeleted(ctx context.Context, specification *DriverSpecification) (*[]Driver, error)
 }
 
 type Writing interface {
 	Create(ctx context.Context, d *Driver) (int64, error)
+	CreateWithUser(ctx context.Context, d *Driver, u *user.User) (*Driver, error)
 	Update(ctx context.Context, d *Driver) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, d *Driver, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
+	Restore(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
 }
 
 type Repository interface {
@@ -66,9 +79,15 @@ type UseCase interface {
 	GetByUserID(ctx context.Context, userId int64) (*Driver, error)
 	GetByIDWithEagerLoading(ctx context.Context, id int64) (*Driver, error)
 	GetByUserIDWithEagerLoading(ctx context.Context, userId int64) (*Driver, error)
+	ListByIDs(ctx context.Context, ids []int64) (*[]Driver, error)
 	List(ctx context.Context, specification *DriverSpecification) (*[]Driver, error)
 	ListWithEagerLoading(ctx context.Context, specification *DriverSpecification) (*[]Driver, error)
+	Export(ctx context.Context, specification *DriverSpecification, fn func(d *Driver) error) error
+	ListDeleted(ctx context.Context, specification *DriverSpecification) (*[]Driver, error)
 	Create(ctx context.Context, d *Driver) (int64, error)
+	Import(ctx context.Context, rows []ImportRow) (*ImportReport, error)
 	Update(ctx context.Context, d *Driver) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, d *Driver, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
+	Restore(ctx context.Context, id int64) error
 }
@@ -0,0 +1,39 @@
+package driver
+
+import (
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// EventPayload is the body of the driver.created, driver.updated and
+// driver.deleted events.
+type EventPayload struct {
+	ID            int64  `json:"id"`
+	UserID        int64  `json:"user_id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	CPF           string `json:"cpf,omitempty"`
+	DriverLicense string `json:"driver_license,omitempty"`
+	CellPhone     string `json:"cell_phone,omitempty"`
+	Email         string `json:"email,omitempty"`
+}
+
+// newEventPayload describes the driver identified by id. d may be nil when
+// the driver could not be read, in which case only the ID is sent.
+func newEventPayload(id int64, d *Driver) EventPayload {
+	if d == nil {
+		return EventPayload{ID: id}
+	}
+
+	return EventPayload{
+		ID:            id,
+		UserID:        d.UserID,
+		Name:          d.Attributes.Name,
+		CPF:           d.LegalInformation.CPF,
+		DriverLicense: d.LegalInformation.DriverLicense,
+		CellPhone:     d.Contact.CellPhone,
+		Email:         d.Contact.Email,
+	}
+}
+
+func newEvent(eventType string, id int64, d *Driver) (*outbox.Event, error) {
+	return outbox.NewEvent(outbox.DRIVER, id, eventType, newEventPayload(id, d))
+}
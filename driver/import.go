@@ -0,0 +1,131 @@
+package driver
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/internal/validation"
+	"github.com/LucasMateus-eng/operations-service/user"
+)
+
+type ImportStatus string
+
+const (
+	IMPORT_CREATED    ImportStatus = "CREATED"
+	IMPORT_DUPLICATED ImportStatus = "DUPLICATED"
+	IMPORT_FAILED     ImportStatus = "FAILED"
+)
+
+// ImportRow is a single driver, together with its address, read from an
+// import file. Err carries any failure that happened while parsing the row.
+type ImportRow struct {
+	Line   int
+	Driver *Driver
+	Err    error
+}
+
+// ImportResult is the outcome of a row. Password is the generated initial
+// credential and is only available in this report, never persisted in plain.
+type ImportResult struct {
+	Line      int          `json:"line"`
+	Status    ImportStatus `json:"status"`
+	CPF       string       `json:"cpf,omitempty"`
+	DriverID  int64        `json:"driver_id,omitempty"`
+	UserID    int64        `json:"user_id,omitempty"`
+	AddressID int64        `json:"address_id,omitempty"`
+	Username  string       `json:"username,omitempty"`
+	Password  string       `json:"password,omitempty"`
+	Errors    []string     `json:"errors,omitempty"`
+}
+
+type ImportReport struct {
+	TotalRows  int            `json:"total_rows"`
+	Created    int            `json:"created"`
+	Duplicated int            `json:"duplicated"`
+	Failed     int            `json:"failed"`
+	Results    []ImportResult `json:"results"`
+}
+
+func (r *ImportReport) add(result ImportResult) {
+	switch result.Status {
+	case IMPORT_CREATED:
+		r.Created++
+	case IMPORT_DUPLICATED:
+		r.Duplicated++
+	case IMPORT_FAILED:
+		r.Failed++
+	}
+
+	r.Results = append(r.Results, result)
+}
+
+func (r *ImportReport) HasErrors() bool {
+	return r.Duplicated > 0 || r.Failed > 0
+}
+
+// importDocuments keeps the documents already seen in the file being
+// imported, so that a driver repeated in the same file is reported.
+type importDocuments struct {
+	rg, cpf, driverLicense map[string]int
+}
+
+func newImportDocuments(size int) *importDocuments {
+	return &importDocuments{
+		rg:            make(map[string]int, size),
+		cpf:           make(map[string]int, size),
+		driverLicense: make(map[string]int, size),
+	}
+}
+
+func (id *importDocuments) check(line int, info DriverLegalInformation) error {
+	documents := []struct {
+		name  string
+		value string
+		seen  map[string]int
+	}{
+		{"rg", info.RG, id.rg},
+		{"cpf", info.CPF, id.cpf},
+		{"driver_license", info.DriverLicense, id.driverLicense},
+	}
+
+	for _, document := range documents {
+		if firstLine, ok := document.seen[document.value]; ok {
+			return fmt.Errorf("the driver %s is duplicated in the file, first seen on line %d", document.name, firstLine)
+		}
+	}
+
+	for _, document := range documents {
+		document.seen[document.value] = line
+	}
+
+	return nil
+}
+
+// newDriverUser builds the DRIVER account of an imported driver, using the
+// e-mail as username and a generated password.
+func newDriverUser(d *Driver) (*user.User, string, error) {
+	password, err := user.GeneratePassword()
+	if err != nil {
+		return nil, "", err
+	}
+
+	hashedPassword, err := user.HashPassword(password)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &user.User{
+		Username:       strings.ToLower(strings.TrimSpace(d.Contact.Email)),
+		HashedPassword: hashedPassword,
+		Role:           user.DRIVER,
+	}, password, nil
+}
+
+func failedImportResult(line int, cpf string, errs ...error) ImportResult {
+	return ImportResult{
+		Line:   line,
+		Status: IMPORT_FAILED,
+		CPF:    cpf,
+		Errors: validation.Messages(errs...),
+	}
+}
@@ -0,0 +1,161 @@
+package driver
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/transaction"
+)
+
+// AssignmentReading is the part of the driver-vehicle repository needed to
+// tell who is unassigned when a driver is offboarded.
+type AssignmentReading interface {
+	ListVehicleIDsByDriverID(ctx context.Context, driverID int64) ([]int64, error)
+}
+
+// AssignmentWriting is the part of the driver-vehicle repository needed to
+// offboard a driver. It is declared here because the driver-vehicle package
+// already depends on this one.
+type AssignmentWriting interface {
+	EndByDriverID(ctx context.Context, driverID int64) error
+	PurgeByDriverID(ctx context.Context, driverID int64) error
+}
+
+// DocumentPurging is the part of the document use case needed to purge a
+// driver, whose documents cascade away with it. It is declared here because
+// the document package already depends on this one.
+type DocumentPurging interface {
+	DriverFiles(ctx context.Context, driverID int64) ([]string, error)
+	DiscardFiles(ctx context.Context, keys []string)
+}
+
+type OffboardingUseCase interface {
+	Offboard(ctx context.Context, id, version int64) error
+	Purge(ctx context.Context, id int64) error
+}
+
+// OffboardingService ends every vehicle assignment of a driver, each one
+// unassigned with an event and an audit entry, and deletes the driver as a
+// single unit of work. Purging a driver from the trash removes its
+// assignments for good as well, and the files of its documents once the
+// purge is committed.
+type OffboardingService struct {
+	transactor     transaction.Transactor
+	auditor        audit.Recorder
+	events         outbox.Emitter
+	assignments    AssignmentReading
+	assignmentRepo AssignmentWriting
+	documents      DocumentPurging
+	repo           Repository
+	logger         *logging.Logging
+}
+
+func NewOffboardingService(t transaction.Transactor, au audit.Recorder, em outbox.Emitter, arr AssignmentReading, ar AssignmentWriting, dp DocumentPurging, r Repository, l *logging.Logging) *OffboardingService {
+	return &OffboardingService{
+		transactor:     t,
+		auditor:        au,
+		events:         em,
+		assignments:    arr,
+		assignmentRepo: ar,
+		documents:      dp,
+		repo:           r,
+		logger:         l,
+	}
+}
+
+func (s *OffboardingService) Offboard(ctx context.Context, id, version int64) error {
+	s.logger.Debug("[DRIVER] Offboard - DEBUG: ", map[string]any{
+		"driverID": id,
+		"version":  version,
+	})
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		vehicleIDs, err := s.assignments.ListVehicleIDsByDriverID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.assignmentRepo.EndByDriverID(ctx, id); err != nil {
+			return err
+		}
+
+		if err := s.repo.Delete(ctx, id, version); err != nil {
+			return err
+		}
+
+		event, err := newEvent(outbox.DRIVER_DELETED, id, before)
+		if err != nil {
+			return err
+		}
+
+		events := []*outbox.Event{event}
+		entries := []*audit.Entry{audit.NewEntry(audit.DRIVER, id, audit.DELETE, before, nil)}
+		for _, vehicleID := range vehicleIDs {
+			event, err := outbox.NewUnassignedEvent(id, vehicleID)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, event)
+			entries = append(entries, audit.NewAssignmentEntry(id, vehicleID, audit.DELETE, nil, nil))
+		}
+
+		if err := s.events.Emit(ctx, events...); err != nil {
+			return err
+		}
+
+		return s.auditor.Record(ctx, entries...)
+	})
+	if err != nil {
+		s.logger.Error("[DRIVER] Offboard - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *OffboardingService) Purge(ctx context.Context, id int64) error {
+	s.logger.Debug("[DRIVER] Purge - DEBUG: ", map[string]any{
+		"driverID": id,
+	})
+
+	var files []string
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		files, err = s.documents.DriverFiles(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.Purge(ctx, id); err != nil {
+			return err
+		}
+
+		if err := s.auditor.Record(ctx, audit.NewEntry(audit.DRIVER, id, audit.PURGE, nil, nil)); err != nil {
+			return err
+		}
+
+		return s.assignmentRepo.PurgeByDriverID(ctx, id)
+	})
+	if err != nil {
+		s.logger.Error("[DRIVER] Purge - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return err
+	}
+
+	s.documents.DiscardFiles(ctx, files)
+
+	return nil
+}
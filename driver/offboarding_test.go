@@ -0,0 +1,287 @@
+package driver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	driver_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/driver"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
+	transaction_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/transaction"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/trash"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOffboardingService_Offboard(t *testing.T) {
+	type serviceMocks struct {
+		transactor     *transaction_mocks.MockTransactor
+		assignments    *driver_mocks.MockAssignmentReading
+		assignmentRepo *driver_mocks.MockAssignmentWriting
+		documents      *driver_mocks.MockDocumentPurging
+		repo           *driver_mocks.MockRepository
+		auditor        *audit_mocks.MockRecorder
+		events         *outbox_mocks.MockEmitter
+		logger         *logging.Logging
+	}
+
+	type args struct {
+		ctx     context.Context
+		id      int64
+		version int64
+	}
+
+	withinTransaction := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado um ID válido quando o método Offboard é chamado então os vínculos são encerrados e o motorista é removido",
+			args: args{
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&driver.Driver{ID: p.id}, nil)
+				m.assignments.EXPECT().ListVehicleIDsByDriverID(p.ctx, p.id).Return([]int64{2, 3}, nil)
+				m.assignmentRepo.EXPECT().EndByDriverID(p.ctx, p.id).Return(nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(nil)
+				m.events.EXPECT().Emit(p.ctx, gomock.Cond(func(x any) bool {
+					e := x.(*outbox.Event)
+					return e.Type == outbox.DRIVER_DELETED && e.AggregateID == "1"
+				}), gomock.Cond(func(x any) bool {
+					e := x.(*outbox.Event)
+					return e.Type == outbox.DRIVER_VEHICLE_UNASSIGNED && e.AggregateID == "1:2"
+				}), gomock.Cond(func(x any) bool {
+					e := x.(*outbox.Event)
+					return e.Type == outbox.DRIVER_VEHICLE_UNASSIGNED && e.AggregateID == "1:3"
+				})).Return(nil)
+				m.auditor.EXPECT().Record(p.ctx, gomock.Cond(func(x any) bool {
+					e := x.(*audit.Entry)
+					return e.EntityType == audit.DRIVER && e.EntityID == "1" && e.Operation == audit.DELETE
+				}), gomock.Cond(func(x any) bool {
+					e := x.(*audit.Entry)
+					return e.EntityType == audit.DRIVER_VEHICLE && e.EntityID == "1:2" && e.Operation == audit.DELETE
+				}), gomock.Cond(func(x any) bool {
+					e := x.(*audit.Entry)
+					return e.EntityType == audit.DRIVER_VEHICLE && e.EntityID == "1:3" && e.Operation == audit.DELETE
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado um erro ao listar os vínculos quando o método Offboard é chamado então nenhum vínculo é encerrado",
+			args: args{
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&driver.Driver{ID: p.id}, nil)
+				m.assignments.EXPECT().ListVehicleIDsByDriverID(p.ctx, p.id).Return(nil, errMocked)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro ao encerrar os vínculos quando o método Offboard é chamado então o motorista não é removido",
+			args: args{
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&driver.Driver{ID: p.id}, nil)
+				m.assignments.EXPECT().ListVehicleIDsByDriverID(p.ctx, p.id).Return([]int64{2, 3}, nil)
+				m.assignmentRepo.EXPECT().EndByDriverID(p.ctx, p.id).Return(errMocked)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro ao remover o motorista quando o método Offboard é chamado então o erro é retornado",
+			args: args{
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&driver.Driver{ID: p.id}, nil)
+				m.assignments.EXPECT().ListVehicleIDsByDriverID(p.ctx, p.id).Return([]int64{2, 3}, nil)
+				m.assignmentRepo.EXPECT().EndByDriverID(p.ctx, p.id).Return(nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				transactor:     transaction_mocks.NewMockTransactor(ctrl),
+				assignments:    driver_mocks.NewMockAssignmentReading(ctrl),
+				assignmentRepo: driver_mocks.NewMockAssignmentWriting(ctrl),
+				documents:      driver_mocks.NewMockDocumentPurging(ctrl),
+				repo:           driver_mocks.NewMockRepository(ctrl),
+				auditor:        audit_mocks.NewMockRecorder(ctrl),
+				events:         outbox_mocks.NewMockEmitter(ctrl),
+				logger:         logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := driver.NewOffboardingService(sm.transactor, sm.auditor, sm.events, sm.assignments, sm.assignmentRepo, sm.documents, sm.repo, sm.logger)
+
+			err := s.Offboard(test.args.ctx, test.args.id, test.args.version)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestOffboardingService_Purge(t *testing.T) {
+	type serviceMocks struct {
+		transactor     *transaction_mocks.MockTransactor
+		assignments    *driver_mocks.MockAssignmentReading
+		assignmentRepo *driver_mocks.MockAssignmentWriting
+		documents      *driver_mocks.MockDocumentPurging
+		repo           *driver_mocks.MockRepository
+		auditor        *audit_mocks.MockRecorder
+		events         *outbox_mocks.MockEmitter
+		logger         *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	withinTransaction := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	files := []string{"drivers/1/cnh.pdf"}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado um motorista na lixeira quando o método Purge é chamado então ele e seus vínculos são apagados definitivamente com os arquivos dos seus documentos",
+			args: args{
+				ctx: mockedContext,
+				id:  1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.documents.EXPECT().DriverFiles(p.ctx, p.id).Return(files, nil)
+				m.repo.EXPECT().Purge(p.ctx, p.id).Return(nil)
+				m.assignmentRepo.EXPECT().PurgeByDriverID(p.ctx, p.id).Return(nil)
+				m.documents.EXPECT().DiscardFiles(p.ctx, files)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um motorista fora da lixeira quando o método Purge é chamado então os vínculos são mantidos",
+			args: args{
+				ctx: mockedContext,
+				id:  1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.documents.EXPECT().DriverFiles(p.ctx, p.id).Return(files, nil)
+				m.repo.EXPECT().Purge(p.ctx, p.id).Return(trash.ErrNotInTrash)
+			},
+			wantErr: trash.ErrNotInTrash,
+		},
+		{
+			name: "Dado um motorista com histórico a manter quando o método Purge é chamado então ele não é apagado",
+			args: args{
+				ctx: mockedContext,
+				id:  1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.documents.EXPECT().DriverFiles(p.ctx, p.id).Return(files, nil)
+				m.repo.EXPECT().Purge(p.ctx, p.id).Return(trash.ErrPurgeConflict)
+			},
+			wantErr: trash.ErrPurgeConflict,
+		},
+		{
+			name: "Dado um erro ao apagar os vínculos quando o método Purge é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				id:  1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.documents.EXPECT().DriverFiles(p.ctx, p.id).Return(files, nil)
+				m.repo.EXPECT().Purge(p.ctx, p.id).Return(nil)
+				m.assignmentRepo.EXPECT().PurgeByDriverID(p.ctx, p.id).Return(errMocked)
+			},
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado um erro ao listar os arquivos dos documentos quando o método Purge é chamado então o motorista não é apagado",
+			args: args{
+				ctx: mockedContext,
+				id:  1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.documents.EXPECT().DriverFiles(p.ctx, p.id).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				transactor:     transaction_mocks.NewMockTransactor(ctrl),
+				assignments:    driver_mocks.NewMockAssignmentReading(ctrl),
+				assignmentRepo: driver_mocks.NewMockAssignmentWriting(ctrl),
+				documents:      driver_mocks.NewMockDocumentPurging(ctrl),
+				repo:           driver_mocks.NewMockRepository(ctrl),
+				auditor:        audit_mocks.NewPassThroughRecorder(ctrl),
+				events:         newEmitter(ctrl),
+				logger:         logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := driver.NewOffboardingService(sm.transactor, sm.auditor, sm.events, sm.assignments, sm.assignmentRepo, sm.documents, sm.repo, sm.logger)
+
+			err := s.Purge(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
@@ -0,0 +1,193 @@
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/transaction"
+	"github.com/LucasMateus-eng/operations-service/user"
+)
+
+var (
+	ErrInvalidOnboarding = errors.New("the given onboarding is invalid")
+	ErrMissingAddress    = errors.New("the driver address cannot be empty")
+	ErrShortPassword     = fmt.Errorf("the driver password must have at least %d characters", MINIMUM_PASSWORD_LENGTH)
+)
+
+const (
+	MINIMUM_PASSWORD_LENGTH = 8
+)
+
+// Onboarding gathers everything needed to bring a new driver in: the
+// credentials of the user that authenticates them and the driver itself,
+// address included. An empty username falls back to the driver email.
+type Onboarding struct {
+	Username string
+	Password string
+	Driver   *Driver
+}
+
+func (o *Onboarding) Validate() error {
+	var errs []error
+
+	if len(o.Password) < MINIMUM_PASSWORD_LENGTH {
+		errs = append(errs, ErrShortPassword)
+	}
+
+	if o.Driver.Address == nil {
+		errs = append(errs, ErrMissingAddress)
+	}
+
+	if err := o.Driver.Validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+type OnboardingUseCase interface {
+	Onboard(ctx context.Context, o *Onboarding) (*Driver, error)
+}
+
+// OnboardingService creates the user, the address and the driver as a single
+// unit of work: either the three rows are committed or none of them is.
+type OnboardingService struct {
+	transactor  transaction.Transactor
+	auditor     audit.Recorder
+	events      outbox.Emitter
+	userRepo    user.Writing
+	addressRepo address.Writing
+	repo        Repository
+	logger      *logging.Logging
+}
+
+func NewOnboardingService(t transaction.Transactor, au audit.Recorder, em outbox.Emitter, ur user.Writing, ar address.Writing, r Repository, l *logging.Logging) *OnboardingService {
+	return &OnboardingService{
+		transactor:  t,
+		auditor:     au,
+		events:      em,
+		userRepo:    ur,
+		addressRepo: ar,
+		repo:        r,
+		logger:      l,
+	}
+}
+
+func (s *OnboardingService) Onboard(ctx context.Context, o *Onboarding) (*Driver, error) {
+	s.logger.Debug("[DRIVER] Onboard - DEBUG: ", map[string]any{
+		"cpf": o.Driver.LegalInformation.CPF,
+	})
+
+	if err := o.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOnboarding, err)
+	}
+
+	u, err := newOnboardingUser(o)
+	if err != nil {
+		s.logger.Error("[DRIVER] Onboard - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return nil, err
+	}
+
+	var driverID int64
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		existingDriver, err := s.repo.GetByLegalInformation(ctx, o.Driver.LegalInformation)
+		if err != nil {
+			return err
+		}
+
+		if existingDriver != nil {
+			return ErrDuplicatedDriver
+		}
+
+		userID, err := s.userRepo.Create(ctx, u)
+		if err != nil {
+			return err
+		}
+
+		a := *o.Driver.Address
+		a.UserID = userID
+
+		a.ID, err = s.addressRepo.Create(ctx, &a)
+		if err != nil {
+			return err
+		}
+
+		d := *o.Driver
+		d.UserID = userID
+		d.Address = &a
+
+		driverID, err = s.repo.Create(ctx, &d)
+		if err != nil {
+			return err
+		}
+
+		d.ID = driverID
+
+		event, err := newEvent(outbox.DRIVER_CREATED, driverID, &d)
+		if err != nil {
+			return err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return err
+		}
+
+		return s.auditor.Record(ctx, onboardingEntries(&d, u)...)
+	})
+	if err != nil {
+		s.logger.Error("[DRIVER] Onboard - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return nil, err
+	}
+
+	onboardedDriver, err := s.repo.GetByIDWithEagerLoading(ctx, driverID)
+	if err != nil {
+		s.logger.Error("[DRIVER] Onboard - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return nil, err
+	}
+
+	return onboardedDriver, nil
+}
+
+// onboardingEntries records the creation of a driver together with its user
+// and address.
+func onboardingEntries(d *Driver, u *user.User) []*audit.Entry {
+	entries := []*audit.Entry{audit.NewEntry(audit.USER, d.UserID, audit.CREATE, nil, u)}
+	if d.Address != nil {
+		entries = append(entries, audit.NewEntry(audit.ADDRESS, d.Address.ID, audit.CREATE, nil, d.Address))
+	}
+
+	return append(entries, audit.NewEntry(audit.DRIVER, d.ID, audit.CREATE, nil, d))
+}
+
+func newOnboardingUser(o *Onboarding) (*user.User, error) {
+	hashedPassword, err := user.HashPassword(o.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	username := strings.TrimSpace(o.Username)
+	if len(username) == 0 {
+		username = o.Driver.Contact.Email
+	}
+
+	return &user.User{
+		Username:       strings.ToLower(username),
+		HashedPassword: hashedPassword,
+		Role:           user.DRIVER,
+	}, nil
+}
@@ -0,0 +1,158 @@
+package driver_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	address_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/address"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	driver_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/driver"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
+	transaction_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/transaction"
+	user_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/user"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+func TestOnboardingService_Onboard(t *testing.T) {
+	type serviceMocks struct {
+		transactor  *transaction_mocks.MockTransactor
+		userRepo    *user_mocks.MockWriting
+		addressRepo *address_mocks.MockWriting
+		repo        *driver_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx        context.Context
+		onboarding *driver.Onboarding
+	}
+
+	withinTransaction := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+
+	driverWithoutAddress := validDriver
+	driverWithoutAddress.Address = nil
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        *driver.Driver
+		wantErr     error
+	}{
+		{
+			name: "Dado um cadastro válido quando o método Onboard é chamado então usuário, endereço e motorista são criados na mesma transação",
+			args: args{
+				ctx:        mockedContext,
+				onboarding: &driver.Onboarding{Password: "s3nh4-segura", Driver: &validDriver},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByLegalInformation(p.ctx, validDriver.LegalInformation).Return(nil, nil)
+				m.userRepo.EXPECT().Create(p.ctx, gomock.Any()).DoAndReturn(func(_ context.Context, u *user.User) (int64, error) {
+					if u.Username != "joao@example.com" || u.Role != user.DRIVER || len(u.HashedPassword) == 0 {
+						return 0, errMocked
+					}
+					return 2, nil
+				})
+				m.addressRepo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(3), nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(1), nil)
+				m.repo.EXPECT().GetByIDWithEagerLoading(p.ctx, int64(1)).Return(expectedDriverWithEagerLoading, nil)
+			},
+			want:    expectedDriverWithEagerLoading,
+			wantErr: nil,
+		},
+		{
+			name: "Dado um cadastro sem endereço quando o método Onboard é chamado então nenhuma transação é aberta",
+			args: args{
+				ctx:        mockedContext,
+				onboarding: &driver.Onboarding{Password: "s3nh4-segura", Driver: &driverWithoutAddress},
+			},
+			want:    nil,
+			wantErr: driver.ErrInvalidOnboarding,
+		},
+		{
+			name: "Dado um motorista já cadastrado quando o método Onboard é chamado então um erro de duplicidade é retornado",
+			args: args{
+				ctx:        mockedContext,
+				onboarding: &driver.Onboarding{Password: "s3nh4-segura", Driver: &validDriver},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByLegalInformation(p.ctx, validDriver.LegalInformation).Return(expectedDriver, nil)
+			},
+			want:    nil,
+			wantErr: driver.ErrDuplicatedDriver,
+		},
+		{
+			name: "Dado um motorista excluído com o mesmo CPF quando o método Onboard é chamado então o cadastro não é barrado pela lixeira",
+			args: args{
+				ctx:        mockedContext,
+				onboarding: &driver.Onboarding{Password: "s3nh4-segura", Driver: &validDriver},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByLegalInformation(p.ctx, validDriver.LegalInformation).Return(nil, nil)
+				m.userRepo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(2), nil)
+				m.addressRepo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(3), nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(4), nil)
+				m.repo.EXPECT().GetByIDWithEagerLoading(p.ctx, int64(4)).Return(expectedDriverWithEagerLoading, nil)
+			},
+			want:    expectedDriverWithEagerLoading,
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma falha ao criar o motorista quando o método Onboard é chamado então o erro da transação é retornado",
+			args: args{
+				ctx:        mockedContext,
+				onboarding: &driver.Onboarding{Password: "s3nh4-segura", Driver: &validDriver},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByLegalInformation(p.ctx, validDriver.LegalInformation).Return(nil, nil)
+				m.userRepo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(2), nil)
+				m.addressRepo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(3), nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(0), errMocked)
+			},
+			want:    nil,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				transactor:  transaction_mocks.NewMockTransactor(ctrl),
+				userRepo:    user_mocks.NewMockWriting(ctrl),
+				addressRepo: address_mocks.NewMockWriting(ctrl),
+				repo:        driver_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      newEmitter(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := driver.NewOnboardingService(sm.transactor, sm.auditor, sm.events, sm.userRepo, sm.addressRepo, sm.repo, sm.logger)
+
+			actualDriver, err := s.Onboard(test.args.ctx, test.args.onboarding)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			assert.Equal(tt, test.want, actualDriver)
+		})
+	}
+}
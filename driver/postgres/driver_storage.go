@@ -5,9 +5,13 @@ import (
 	"database/sql"
 	"errors"
 
+	address_mapping "github.com/LucasMateus-eng/operations-service/address/postgres/mapping"
 	"github.com/LucasMateus-eng/operations-service/driver"
 	"github.com/LucasMateus-eng/operations-service/driver/postgres/dto"
 	"github.com/LucasMateus-eng/operations-service/driver/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/user"
+	user_mapping "github.com/LucasMateus-eng/operations-service/user/postgres/mapping"
 	"github.com/uptrace/bun"
 )
 
@@ -21,10 +25,14 @@ func New(db *bun.DB) *driverPostgresRepo {
 	}
 }
 
+func (dr *driverPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, dr.db)
+}
+
 func (dr *driverPostgresRepo) GetByID(ctx context.Context, id int64) (*driver.Driver, error) {
 	var driverDTO dto.DriverDTO
 
-	err := dr.db.NewSelect().Model(&driverDTO).Where("id = ?", id).Scan(ctx)
+	err := dr.conn(ctx).NewSelect().Model(&driverDTO).Where("id = ?", id).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -40,7 +48,7 @@ func (dr *driverPostgresRepo) GetByID(ctx context.Context, id int64) (*driver.Dr
 func (dr *driverPostgresRepo) GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error) {
 	var driverDTO dto.DriverDTO
 
-	err := dr.db.NewSelect().Model(&driverDTO).Where("user_id = ?", userId).Scan(ctx)
+	err := dr.conn(ctx).NewSelect().Model(&driverDTO).Where("user_id = ?", userId).Scan(ctx)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, nil
@@ -59,7 +67,7 @@ func (dr *driverPostgresRepo) GetByUserID(ctx context.Context, userId int64) (*d
 func (dr *driverPostgresRepo) GetByIDWithEagerLoading(ctx context.Context, id int64) (*driver.Driver, error) {
 	var driverDTO dto.DriverDTO
 
-	err := dr.db.NewSelect().Model(&driverDTO).
+	err := dr.conn(ctx).NewSelect().Model(&driverDTO).
 		Relation("Vehicles").
 		Relation("User").
 		Relation("User.Address").
@@ -83,7 +91,7 @@ func (dr *driverPostgresRepo) GetByIDWithEagerLoading(ctx context.Context, id in
 func (dr *driverPostgresRepo) GetByUserIDWithEagerLoading(ctx context.Context, userId int64) (*driver.Driver, error) {
 	var driverDTO dto.DriverDTO
 
-	err := dr.db.NewSelect().Model(&driverDTO).
+	err := dr.conn(ctx).NewSelect().Model(&driverDTO).
 		Relation("Vehicles").
 		Relation("User").
 		Relation("User.Address").
@@ -104,10 +112,63 @@ func (dr *driverPostgresRepo) GetByUserIDWithEagerLoading(ctx context.Context, u
 	return mappedValue, nil
 }
 
+func (dr *driverPostgresRepo) GetByLegalInformation(ctx context.Context, info driver.DriverLegalInformation) (*driver.Driver, error) {
+	var driverDTO dto.DriverDTO
+
+	err := whereLegalInformation(dr.conn(ctx).NewSelect().Model(&driverDTO), info).Limit(1).Scan(ctx)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	mappedValue, err := mapping.MapDTOToDriver(&driverDTO)
+	if err != nil {
+		return nil, err
+	}
+
+	return mappedValue, nil
+}
+
+// whereLegalInformation matches the drivers sharing any document with info.
+// The conditions are grouped so that the soft-delete filter bun adds applies
+// to all of them, and drivers in the trash never count as duplicates.
+func whereLegalInformation(query *bun.SelectQuery, info driver.DriverLegalInformation) *bun.SelectQuery {
+	return query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.WhereOr("rg = ?", info.RG).
+			WhereOr("cpf = ?", info.CPF).
+			WhereOr("driver_license = ?", info.DriverLicense)
+	})
+}
+
+// ListByIDs returns the drivers with the given ids in a single query. Ids
+// without a driver are left out of the result.
+func (dr *driverPostgresRepo) ListByIDs(ctx context.Context, ids []int64) (*[]driver.Driver, error) {
+	var driverDTOs []dto.DriverDTO
+
+	err := dr.conn(ctx).NewSelect().Model(&driverDTOs).Where("id IN (?)", bun.In(ids)).Order("id ASC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var drivers []driver.Driver
+	for _, dto := range driverDTOs {
+		mappedValue, err := mapping.MapDTOToDriver(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		drivers = append(drivers, *mappedValue)
+	}
+
+	return &drivers, nil
+}
+
 func (dr *driverPostgresRepo) List(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	var driverDTOs []dto.DriverDTO
 
-	query := dr.db.NewSelect().Model(&driverDTOs).Order("id ASC")
+	query := dr.conn(ctx).NewSelect().Model(&driverDTOs).Order("id ASC")
 
 	if specification.Page > 0 && specification.PageSize > 0 {
 		offset := (specification.Page - 1) * specification.PageSize
@@ -135,7 +196,7 @@ func (dr *driverPostgresRepo) List(ctx context.Context, specification *driver.Dr
 func (dr *driverPostgresRepo) ListWithEagerLoading(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	var driverDTOs []dto.DriverDTO
 
-	query := dr.db.NewSelect().
+	query := dr.conn(ctx).NewSelect().
 		Model(&driverDTOs).
 		Relation("Vehicles").
 		Relation("User").
@@ -165,12 +226,93 @@ func (dr *driverPostgresRepo) ListWithEagerLoading(ctx context.Context, specific
 	return &drivers, nil
 }
 
+// Iterate walks through the drivers, joined with their addresses not in the
+// trash, one row at a time, so that exports do not need to hold every driver
+// in memory.
+func (dr *driverPostgresRepo) Iterate(ctx context.Context, specification *driver.DriverSpecification, fn func(d *driver.Driver) error) error {
+	query := iterateQuery(dr.conn(ctx).NewSelect(), specification)
+
+	rows, err := query.Rows(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var driverAddressDTO dto.DriverAddressDTO
+		if err := dr.db.ScanRow(ctx, rows, &driverAddressDTO); err != nil {
+			return err
+		}
+
+		mappedValue, err := mapping.MapDriverAddressDTOToDriver(&driverAddressDTO)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(mappedValue); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// iterateQuery selects the page of drivers of the specification, each
+// joined with its address unless the address is in the trash.
+func iterateQuery(query *bun.SelectQuery, specification *driver.DriverSpecification) *bun.SelectQuery {
+	query = query.
+		Model((*dto.DriverAddressDTO)(nil)).
+		ColumnExpr("?TableAlias.*").
+		ColumnExpr("a.id AS address_id, a.locality AS address_locality, a.number AS address_number").
+		ColumnExpr("a.complement AS address_complement, a.neighborhood AS address_neighborhood").
+		ColumnExpr("a.city AS address_city, a.state AS address_state, a.cep AS address_cep, a.country AS address_country").
+		ColumnExpr("a.created_at AS address_created_at, a.updated_at AS address_updated_at").
+		Join("LEFT JOIN adresses AS a ON a.user_id = ?TableAlias.user_id AND a.deleted_at = '0001-01-01 00:00:00+00'").
+		OrderExpr("?TableAlias.id ASC")
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		offset := (specification.Page - 1) * specification.PageSize
+		query = query.Offset(offset).Limit(specification.PageSize)
+	}
+
+	return query
+}
+
+// ListDeleted returns the drivers in the trash, most recently deleted first.
+func (dr *driverPostgresRepo) ListDeleted(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
+	var driverDTOs []dto.DriverDTO
+
+	query := dr.conn(ctx).NewSelect().Model(&driverDTOs).WhereDeleted().Order("deleted_at DESC", "id ASC")
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		offset := (specification.Page - 1) * specification.PageSize
+		query = query.Offset(offset).Limit(specification.PageSize)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var drivers []driver.Driver
+	for _, dto := range driverDTOs {
+		mappedValue, err := mapping.MapDTOToDriver(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		drivers = append(drivers, *mappedValue)
+	}
+
+	return &drivers, nil
+}
+
 func (dr *driverPostgresRepo) Create(ctx context.Context, d *driver.Driver) (int64, error) {
 	var driverID int64
 
 	driverDTO := mapping.MapDriverToDTO(d)
 
-	query := dr.db.NewInsert().Model(driverDTO).On("CONFLICT (id) DO UPDATE").Returning("id")
+	query := dr.conn(ctx).NewInsert().Model(driverDTO).Returning("id")
 
 	err := query.Scan(ctx, &driverID)
 	if err != nil {
@@ -180,19 +322,114 @@ func (dr *driverPostgresRepo) Create(ctx context.Context, d *driver.Driver) (int
 	return driverID, nil
 }
 
+func (dr *driverPostgresRepo) CreateWithUser(ctx context.Context, d *driver.Driver, u *user.User) (*driver.Driver, error) {
+	createdDriver := *d
+
+	err := db_postgres.WithinTransaction(ctx, dr.db, func(ctx context.Context) error {
+		userDTO := user_mapping.MapUserToDTO(u)
+
+		err := dr.conn(ctx).NewInsert().Model(userDTO).Returning("id").Scan(ctx, &userDTO.ID)
+		if err != nil {
+			return err
+		}
+
+		createdDriver.UserID = userDTO.ID
+
+		if d.Address != nil {
+			createdAddress := *d.Address
+			createdAddress.UserID = userDTO.ID
+
+			addressDTO := address_mapping.MapAddressToDTO(&createdAddress)
+
+			err = dr.conn(ctx).NewInsert().Model(addressDTO).Returning("id").Scan(ctx, &createdAddress.ID)
+			if err != nil {
+				return err
+			}
+
+			createdDriver.Address = &createdAddress
+		}
+
+		driverDTO := mapping.MapDriverToDTO(&createdDriver)
+
+		return dr.conn(ctx).NewInsert().Model(driverDTO).Returning("id").Scan(ctx, &createdDriver.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &createdDriver, nil
+}
+
 func (dr *driverPostgresRepo) Update(ctx context.Context, d *driver.Driver) error {
 	driverDTO := mapping.MapDriverToDTO(d)
 
-	_, err := dr.db.NewUpdate().Model(driverDTO).
+	res, err := dr.conn(ctx).NewUpdate().Model(driverDTO).
 		OmitZero().
 		ExcludeColumn("rg", "cpf", "driver_license", "deleted_at").
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
 		Where("id = ?", driverDTO.ID).
+		Where("version = ?", d.Version).
+		Returning("version").
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, dr.conn(ctx), (*dto.DriverDTO)(nil), driverDTO.ID, res); err != nil {
+		return err
+	}
+
+	d.Version = driverDTO.Version
+
+	return nil
+}
+
+func (dr *driverPostgresRepo) Patch(ctx context.Context, d *driver.Driver, fields []string) error {
+	columns, err := db_postgres.PatchColumns(fields, "name", "date_of_birth", "cell_phone", "email")
+	if err != nil {
+		return err
+	}
+
+	driverDTO := mapping.MapDriverToDTO(d)
+
+	res, err := dr.conn(ctx).NewUpdate().Model(driverDTO).
+		Column(columns...).
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
+		Where("id = ?", driverDTO.ID).
+		Where("version = ?", d.Version).
+		Returning("version").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, dr.conn(ctx), (*dto.DriverDTO)(nil), driverDTO.ID, res); err != nil {
+		return err
+	}
+
+	d.Version = driverDTO.Version
+
+	return nil
+}
+
+func (dr *driverPostgresRepo) Delete(ctx context.Context, id, version int64) error {
+	res, err := dr.conn(ctx).NewDelete().Model((*dto.DriverDTO)(nil)).
+		Where("id = ?", id).
+		Where("version = ?", version).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db_postgres.CheckVersion(ctx, dr.conn(ctx), (*dto.DriverDTO)(nil), id, res)
+}
 
-	return err
+func (dr *driverPostgresRepo) Restore(ctx context.Context, id int64) error {
+	return db_postgres.Restore(ctx, dr.conn(ctx), (*dto.DriverDTO)(nil), id)
 }
 
-func (dr *driverPostgresRepo) Delete(ctx context.Context, id int64) error {
-	_, err := dr.db.NewDelete().Model((*dto.DriverDTO)(nil)).Where("id = ?", id).Exec(ctx)
-	return err
+func (dr *driverPostgresRepo) Purge(ctx context.Context, id int64) error {
+	return db_postgres.Purge(ctx, dr.conn(ctx), (*dto.DriverDTO)(nil), id)
 }
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/driver"
+	driver_vehicle_dto "github.com/LucasMateus-eng/operations-service/driver-vehicle/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/driver/postgres/dto"
+	"github.com/go-playground/assert/v2"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// newTestDB builds the queries without reaching a database, as sql.OpenDB
+// only connects on the first query.
+func newTestDB() *bun.DB {
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	db.RegisterModel((*driver_vehicle_dto.DriverVehicleDTO)(nil))
+
+	return db
+}
+
+func TestWhereLegalInformation(t *testing.T) {
+	info := driver.DriverLegalInformation{RG: "123456789", CPF: "12345678909", DriverLicense: "01234567890"}
+
+	t.Run("Dado um motorista excluído com o mesmo RG ou CPF quando a duplicidade é buscada então o filtro da lixeira vale para todos os documentos", func(tt *testing.T) {
+		query := whereLegalInformation(newTestDB().NewSelect().Model((*dto.DriverDTO)(nil)), info).String()
+
+		where := query[strings.Index(query, " WHERE "):]
+		assert.Equal(tt, ` WHERE ((rg = '123456789') OR (cpf = '12345678909') OR (driver_license = '01234567890')) AND "driver_dto"."deleted_at" = '0001-01-01 00:00:00+00:00'`, where)
+	})
+}
+
+func TestIterateQuery(t *testing.T) {
+	t.Run("Dado um endereço excluído quando os motoristas são percorridos então ele não é juntado ao motorista", func(tt *testing.T) {
+		query := iterateQuery(newTestDB().NewSelect(), &driver.DriverSpecification{}).String()
+
+		assert.Equal(tt, true, strings.Contains(query, ` LEFT JOIN adresses AS a ON a.user_id = "driver_dto".user_id AND a.deleted_at = '0001-01-01 00:00:00+00' `))
+		assert.Equal(tt, true, strings.HasSuffix(query, ` ORDER BY "driver_dto".id ASC`))
+	})
+}
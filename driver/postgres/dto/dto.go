@@ -22,7 +22,27 @@ type DriverDTO struct {
 	UserID        int64                    `bun:"user_id,notnull,unique"`
 	User          user_dto.UserDTO         `bun:"rel:belongs-to,join:user_id=id"`
 	Vehicles      []vehicle_dto.VehicleDTO `bun:"m2m:drivers_vehicles,join:Driver=Vehicle"`
+	Version       int64                    `bun:"version,nullzero,notnull,default:1"`
 	CreatedAt     time.Time                `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt     time.Time                `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
-	DeletedAt     time.Time                `bun:"deleted_at,soft_delete,nullzero,notnull,default:'0001-01-01 00:00:00+00'"`
+	DeletedAt     time.Time                `bun:"deleted_at,soft_delete,notnull,default:'0001-01-01 00:00:00+00'"`
+}
+
+// DriverAddressDTO is a flat projection of a driver joined with the address
+// of its user. It lets rows be streamed with bun.DB.ScanRow, which does not
+// resolve relations.
+type DriverAddressDTO struct {
+	DriverDTO `bun:",extend"`
+
+	AddressID           int64     `bun:"address_id,scanonly"`
+	AddressLocality     string    `bun:"address_locality,scanonly"`
+	AddressNumber       string    `bun:"address_number,scanonly"`
+	AddressComplement   string    `bun:"address_complement,scanonly"`
+	AddressNeighborhood string    `bun:"address_neighborhood,scanonly"`
+	AddressCity         string    `bun:"address_city,scanonly"`
+	AddressState        string    `bun:"address_state,scanonly"`
+	AddressCEP          string    `bun:"address_cep,scanonly"`
+	AddressCountry      string    `bun:"address_country,scanonly"`
+	AddressCreatedAt    time.Time `bun:"address_created_at,scanonly"`
+	AddressUpdatedAt    time.Time `bun:"address_updated_at,scanonly"`
 }
@@ -2,6 +2,8 @@ package mapping
 
 import (
 	"github.com/LucasMateus-eng/operations-service/address"
+	address_dto "github.com/LucasMateus-eng/operations-service/address/postgres/dto"
+	address_mapping "github.com/LucasMateus-eng/operations-service/address/postgres/mapping"
 	"github.com/LucasMateus-eng/operations-service/driver"
 	driver_dto "github.com/LucasMateus-eng/operations-service/driver/postgres/dto"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
@@ -26,6 +28,7 @@ func MapDriverToDTO(driver *driver.Driver) *driver_dto.DriverDTO {
 		Email:         driver.Contact.Email,
 		UserID:        driver.UserID,
 		Vehicles:      vehicleDTOs,
+		Version:       driver.Version,
 		CreatedAt:     driver.CreatedAt,
 		UpdatedAt:     driver.UpdatedAt,
 		DeletedAt:     driver.DeletedAt,
@@ -38,9 +41,13 @@ func MapDTOToDriver(driverDTO *driver_dto.DriverDTO) (*driver.Driver, error) {
 		return nil, err
 	}
 
-	state, err := address.GetBrazilianState(driverDTO.User.AddressDTO.State)
-	if err != nil {
-		return nil, err
+	// The address is only present when the user relation is eager loaded.
+	var driverAddress *address.Address
+	if driverDTO.User.AddressDTO != nil {
+		driverAddress, err = address_mapping.MapDTOToAddress(driverDTO.User.AddressDTO)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	return &driver.Driver{
@@ -55,32 +62,42 @@ func MapDTOToDriver(driverDTO *driver_dto.DriverDTO) (*driver.Driver, error) {
 			CPF:           driverDTO.CPF,
 			DriverLicense: driverDTO.DriverLicense,
 		},
-		Address: &address.Address{
-			ID:           driverDTO.User.AddressDTO.ID,
-			UserID:       driverDTO.User.AddressDTO.UserID,
-			Locality:     driverDTO.User.AddressDTO.Locality,
-			Number:       driverDTO.User.AddressDTO.Number,
-			Complement:   driverDTO.User.AddressDTO.Complement,
-			Neighborhood: driverDTO.User.AddressDTO.Neighborhood,
-			City:         driverDTO.User.AddressDTO.City,
-			State:        state,
-			CEP:          driverDTO.User.AddressDTO.CEP,
-			Country:      driverDTO.User.AddressDTO.Country,
-			CreatedAt:    driverDTO.User.AddressDTO.CreatedAt,
-			UpdatedAt:    driverDTO.User.AddressDTO.UpdatedAt,
-			DeletedAt:    driverDTO.User.AddressDTO.DeletedAt,
-		},
+		Address: driverAddress,
 		Contact: driver.Contact{
 			CellPhone: driverDTO.CellPhone,
 			Email:     driverDTO.Email,
 		},
 		Vehicles:  vehicles,
+		Version:   driverDTO.Version,
 		CreatedAt: driverDTO.CreatedAt,
 		UpdatedAt: driverDTO.UpdatedAt,
 		DeletedAt: driverDTO.DeletedAt,
 	}, nil
 }
 
+func MapDriverAddressDTOToDriver(driverAddressDTO *driver_dto.DriverAddressDTO) (*driver.Driver, error) {
+	driverDTO := driverAddressDTO.DriverDTO
+
+	if driverAddressDTO.AddressID != 0 {
+		driverDTO.User.AddressDTO = &address_dto.AddressDTO{
+			ID:           driverAddressDTO.AddressID,
+			Locality:     driverAddressDTO.AddressLocality,
+			Number:       driverAddressDTO.AddressNumber,
+			Complement:   driverAddressDTO.AddressComplement,
+			Neighborhood: driverAddressDTO.AddressNeighborhood,
+			City:         driverAddressDTO.AddressCity,
+			State:        driverAddressDTO.AddressState,
+			CEP:          driverAddressDTO.AddressCEP,
+			Country:      driverAddressDTO.AddressCountry,
+			UserID:       driverAddressDTO.UserID,
+			CreatedAt:    driverAddressDTO.AddressCreatedAt,
+			UpdatedAt:    driverAddressDTO.AddressUpdatedAt,
+		}
+	}
+
+	return MapDTOToDriver(&driverDTO)
+}
+
 func mapVehicles(vehicleDTOs []vehicle_dto.VehicleDTO) ([]vehicle.Vehicle, error) {
 	vehicles := make([]vehicle.Vehicle, len(vehicleDTOs))
 	for i, vehicleDTO := range vehicleDTOs {
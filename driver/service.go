@@ -2,19 +2,31 @@ package driver
 
 import (
 	"context"
+	"errors"
 
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+var (
+	ErrDuplicatedDriver = errors.New("a driver with the same rg, cpf or driver license already exists")
 )
 
 type Service struct {
-	repo   Repository
-	logger *logging.Logging
+	repo    Repository
+	auditor audit.Recorder
+	events  outbox.Emitter
+	logger  *logging.Logging
 }
 
-func NewService(r Repository, l *logging.Logging) *Service {
+func NewService(r Repository, au audit.Recorder, em outbox.Emitter, l *logging.Logging) *Service {
 	return &Service{
-		repo:   r,
-		logger: l,
+		repo:    r,
+		auditor: au,
+		events:  em,
+		logger:  l,
 	}
 }
 
@@ -78,6 +90,21 @@ func (s *Service) GetByUserIDWithEagerLoading(ctx context.Context, userId int64)
 	return driver, nil
 }
 
+func (s *Service) ListByIDs(ctx context.Context, ids []int64) (*[]Driver, error) {
+	s.logger.Debug("[DRIVER] ListByIDs - DEBUG: ", map[string]any{
+		"ids": ids,
+	})
+	drivers, err := s.repo.ListByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("[DRIVER] ListByIDs - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return drivers, nil
+}
+
 func (s *Service) List(ctx context.Context, specification *DriverSpecification) (*[]Driver, error) {
 	s.logger.Debug("[DRIVER] List - DEBUG: ", map[string]any{
 		"specification": specification,
@@ -108,11 +135,44 @@ func (s *Service) ListWithEagerLoading(ctx context.Context, specification *Drive
 	return drivers, nil
 }
 
+func (s *Service) Export(ctx context.Context, specification *DriverSpecification, fn func(d *Driver) error) error {
+	s.logger.Debug("[DRIVER] Export - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	err := s.repo.Iterate(ctx, specification, fn)
+	if err != nil {
+		s.logger.Error("[DRIVER] Export - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
 func (s *Service) Create(ctx context.Context, d *Driver) (int64, error) {
 	s.logger.Debug("[DRIVER] Create - DEBUG: ", map[string]any{
 		"driver": d,
 	})
-	driverID, err := s.repo.Create(ctx, d)
+	var driverID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		driverID, err = s.repo.Create(ctx, d)
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := newEvent(outbox.DRIVER_CREATED, driverID, d)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.DRIVER, driverID, audit.CREATE, nil, d)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[DRIVER] Create - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -123,11 +183,137 @@ func (s *Service) Create(ctx context.Context, d *Driver) (int64, error) {
 	return driverID, nil
 }
 
+func (s *Service) Import(ctx context.Context, rows []ImportRow) (*ImportReport, error) {
+	s.logger.Debug("[DRIVER] Import - DEBUG: ", map[string]any{
+		"rows": len(rows),
+	})
+
+	report := &ImportReport{
+		TotalRows: len(rows),
+		Results:   make([]ImportResult, 0, len(rows)),
+	}
+	documents := newImportDocuments(len(rows))
+
+	for _, row := range rows {
+		if row.Err != nil {
+			report.add(failedImportResult(row.Line, "", row.Err))
+			continue
+		}
+
+		cpf := row.Driver.LegalInformation.CPF
+
+		if err := row.Driver.Validate(); err != nil {
+			report.add(failedImportResult(row.Line, cpf, err))
+			continue
+		}
+
+		if err := documents.check(row.Line, row.Driver.LegalInformation); err != nil {
+			report.add(ImportResult{Line: row.Line, Status: IMPORT_DUPLICATED, CPF: cpf, Errors: []string{err.Error()}})
+			continue
+		}
+
+		existingDriver, err := s.repo.GetByLegalInformation(ctx, row.Driver.LegalInformation)
+		if err != nil {
+			report.add(failedImportResult(row.Line, cpf, err))
+			continue
+		}
+
+		if existingDriver != nil {
+			report.add(ImportResult{
+				Line:     row.Line,
+				Status:   IMPORT_DUPLICATED,
+				CPF:      cpf,
+				DriverID: existingDriver.ID,
+				Errors:   []string{ErrDuplicatedDriver.Error()},
+			})
+			continue
+		}
+
+		u, password, err := newDriverUser(row.Driver)
+		if err != nil {
+			s.logger.Error("[DRIVER] Import - ERROR: ", map[string]any{
+				"err": err.Error(),
+			})
+			return nil, err
+		}
+
+		var createdDriver *Driver
+		err = s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+			var err error
+			createdDriver, err = s.repo.CreateWithUser(ctx, row.Driver, u)
+			if err != nil {
+				return nil, err
+			}
+
+			event, err := newEvent(outbox.DRIVER_CREATED, createdDriver.ID, createdDriver)
+			if err != nil {
+				return nil, err
+			}
+
+			if err := s.events.Emit(ctx, event); err != nil {
+				return nil, err
+			}
+
+			return onboardingEntries(createdDriver, u), nil
+		})
+		if err != nil {
+			s.logger.Error("[DRIVER] Import - ERROR: ", map[string]any{
+				"line": row.Line,
+				"err":  err.Error(),
+			})
+			report.add(failedImportResult(row.Line, cpf, err))
+			continue
+		}
+
+		result := ImportResult{
+			Line:     row.Line,
+			Status:   IMPORT_CREATED,
+			CPF:      cpf,
+			DriverID: createdDriver.ID,
+			UserID:   createdDriver.UserID,
+			Username: u.Username,
+			Password: password,
+		}
+		if createdDriver.Address != nil {
+			result.AddressID = createdDriver.Address.ID
+		}
+
+		report.add(result)
+	}
+
+	return report, nil
+}
+
 func (s *Service) Update(ctx context.Context, d *Driver) error {
 	s.logger.Debug("[DRIVER] Update - DEBUG: ", map[string]any{
 		"driver": d,
 	})
-	err := s.repo.Update(ctx, d)
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, d.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Update(ctx, d); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetByID(ctx, d.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := newEvent(outbox.DRIVER_UPDATED, d.ID, after)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.DRIVER, d.ID, audit.UPDATE, before, after)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[DRIVER] Update - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -139,11 +325,80 @@ func (s *Service) Update(ctx context.Context, d *Driver) error {
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context, id int64) error {
+// Patch writes only the given fields of the driver, which must carry the
+// version it was read with.
+func (s *Service) Patch(ctx context.Context, d *Driver, fields []string) error {
+	s.logger.Debug("[DRIVER] Patch - DEBUG: ", map[string]any{
+		"driver": d,
+		"fields": fields,
+	})
+	if err := mergepatch.CheckImmutable(fields, ImmutableFields...); err != nil {
+		return err
+	}
+
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, d.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Patch(ctx, d, fields); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetByID(ctx, d.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := newEvent(outbox.DRIVER_UPDATED, d.ID, after)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.DRIVER, d.ID, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[DRIVER] Patch - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, id, version int64) error {
 	s.logger.Debug("[DRIVER] Delete - DEBUG: ", map[string]any{
 		"driverID": id,
+		"version":  version,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Delete(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		event, err := newEvent(outbox.DRIVER_DELETED, id, before)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.DRIVER, id, audit.DELETE, before, nil)}, nil
 	})
-	err := s.repo.Delete(ctx, id)
 	if err != nil {
 		s.logger.Error("[DRIVER] Delete - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -154,3 +409,39 @@ func (s *Service) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (s *Service) ListDeleted(ctx context.Context, specification *DriverSpecification) (*[]Driver, error) {
+	s.logger.Debug("[DRIVER] ListDeleted - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	drivers, err := s.repo.ListDeleted(ctx, specification)
+	if err != nil {
+		s.logger.Error("[DRIVER] ListDeleted - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return drivers, nil
+}
+
+func (s *Service) Restore(ctx context.Context, id int64) error {
+	s.logger.Debug("[DRIVER] Restore - DEBUG: ", map[string]any{
+		"driverID": id,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.repo.Restore(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.DRIVER, id, audit.RESTORE, nil, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[DRIVER] Restore - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/LucasMateus-eng/operations-service/address"
 	"github.com/LucasMateus-eng/operations-service/config"
 	"github.com/LucasMateus-eng/operations-service/driver"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
 	driver_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/driver"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
+	"github.com/LucasMateus-eng/operations-service/user"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 	"github.com/go-playground/assert/v2"
 	"go.uber.org/mock/gomock"
@@ -39,12 +43,47 @@ var (
 	expectedDriversWithEagerLoading = &[]driver.Driver{
 		*expectedDriverWithEagerLoading,
 	}
+	validAddress = address.Address{
+		Locality:     "Avenida Paulista",
+		Number:       "1000",
+		Neighborhood: "Bela Vista",
+		City:         "São Paulo",
+		State:        address.SP,
+		CEP:          "01310-100",
+		Country:      "Brasil",
+	}
+	validDriver = driver.Driver{
+		Attributes: driver.DriverAttributes{
+			Name:        "João da Silva",
+			DateOfBirth: time.Date(1990, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		LegalInformation: driver.DriverLegalInformation{
+			RG:            "123456789",
+			CPF:           "52998224725",
+			DriverLicense: "12345678900",
+		},
+		Contact: driver.Contact{
+			CellPhone: "11999999999",
+			Email:     "Joao@Example.com",
+		},
+		Address: &validAddress,
+	}
 )
 
+// newEmitter accepts every event emitted by a test that does not check them.
+func newEmitter(ctrl *gomock.Controller) *outbox_mocks.MockEmitter {
+	events := outbox_mocks.NewMockEmitter(ctrl)
+	events.EXPECT().Emit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return events
+}
+
 func TestService_GetByID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -91,15 +130,17 @@ func TestService_GetByID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualDriver, err := s.GetByID(test.args.ctx, test.args.id)
 
@@ -111,8 +152,10 @@ func TestService_GetByID(t *testing.T) {
 
 func TestService_GetByUserID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -159,15 +202,17 @@ func TestService_GetByUserID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualDriver, err := s.GetByUserID(test.args.ctx, test.args.userId)
 
@@ -179,8 +224,10 @@ func TestService_GetByUserID(t *testing.T) {
 
 func TestService_GetByIDWithEagerLoading(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -227,15 +274,17 @@ func TestService_GetByIDWithEagerLoading(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualDriver, err := s.GetByIDWithEagerLoading(test.args.ctx, test.args.id)
 
@@ -247,8 +296,10 @@ func TestService_GetByIDWithEagerLoading(t *testing.T) {
 
 func TestService_GetByUserIDWithEagerLoading(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -295,15 +346,17 @@ func TestService_GetByUserIDWithEagerLoading(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualDriver, err := s.GetByUserIDWithEagerLoading(test.args.ctx, test.args.userId)
 
@@ -315,8 +368,10 @@ func TestService_GetByUserIDWithEagerLoading(t *testing.T) {
 
 func TestService_List(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -369,15 +424,17 @@ func TestService_List(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualDrivers, err := s.List(test.args.ctx, test.args.specification)
 
@@ -389,8 +446,10 @@ func TestService_List(t *testing.T) {
 
 func TestService_ListWithEagerLoading(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -443,15 +502,17 @@ func TestService_ListWithEagerLoading(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualDrivers, err := s.ListWithEagerLoading(test.args.ctx, test.args.specification)
 
@@ -461,10 +522,96 @@ func TestService_ListWithEagerLoading(t *testing.T) {
 	}
 }
 
+func TestService_Export(t *testing.T) {
+	type serviceMocks struct {
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx           context.Context
+		specification *driver.DriverSpecification
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        []driver.Driver
+		wantErr     bool
+	}{
+		{
+			name: "Dado uma especificação válida quando o método Export é chamado então cada motorista é repassado ao callback",
+			args: args{
+				ctx:           mockedContext,
+				specification: &driver.DriverSpecification{},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Iterate(p.ctx, p.specification, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *driver.DriverSpecification, fn func(d *driver.Driver) error) error {
+						for i := range *expectedDrivers {
+							if err := fn(&(*expectedDrivers)[i]); err != nil {
+								return err
+							}
+						}
+						return nil
+					})
+			},
+			want:    *expectedDrivers,
+			wantErr: false,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Export é chamado então o erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				specification: &driver.DriverSpecification{},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Iterate(p.ctx, p.specification, gomock.Any()).Return(errMocked)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
+
+			var actualDrivers []driver.Driver
+			err := s.Export(test.args.ctx, test.args.specification, func(d *driver.Driver) error {
+				actualDrivers = append(actualDrivers, *d)
+				return nil
+			})
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, actualDrivers)
+		})
+	}
+}
+
 func TestService_Create(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -515,15 +662,17 @@ func TestService_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualDriverID, err := s.Create(test.args.ctx, test.args.d)
 
@@ -533,10 +682,140 @@ func TestService_Create(t *testing.T) {
 	}
 }
 
+func TestService_Import(t *testing.T) {
+	type serviceMocks struct {
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx  context.Context
+		rows []driver.ImportRow
+	}
+
+	anotherValidDriver := validDriver
+	anotherValidDriver.LegalInformation = driver.DriverLegalInformation{
+		RG:            "987654321",
+		CPF:           "11144477735",
+		DriverLicense: "98765432100",
+	}
+
+	invalidDriver := validDriver
+	invalidDriver.LegalInformation.CPF = "11111111111"
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        []driver.ImportResult
+		wantErr     bool
+	}{
+		{
+			name: "Dado uma linha válida quando o método Import é chamado então o usuário, o endereço e o motorista são criados",
+			args: args{
+				ctx:  mockedContext,
+				rows: []driver.ImportRow{{Line: 2, Driver: &validDriver}},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByLegalInformation(p.ctx, validDriver.LegalInformation).Return(nil, nil)
+				m.repo.EXPECT().CreateWithUser(p.ctx, &validDriver, gomock.Any()).DoAndReturn(
+					func(_ context.Context, d *driver.Driver, u *user.User) (*driver.Driver, error) {
+						assert.Equal(t, user.DRIVER, u.Role)
+						createdDriver := *d
+						createdAddress := *d.Address
+						createdAddress.ID = 3
+						createdDriver.ID, createdDriver.UserID, createdDriver.Address = 1, 2, &createdAddress
+						return &createdDriver, nil
+					},
+				)
+			},
+			want: []driver.ImportResult{
+				{Line: 2, Status: driver.IMPORT_CREATED, CPF: "52998224725", DriverID: 1, UserID: 2, AddressID: 3, Username: "joao@example.com"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado motoristas duplicados no arquivo ou na base quando o método Import é chamado então as linhas são marcadas como duplicadas",
+			args: args{
+				ctx: mockedContext,
+				rows: []driver.ImportRow{
+					{Line: 2, Driver: &anotherValidDriver},
+					{Line: 3, Driver: &anotherValidDriver},
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByLegalInformation(p.ctx, anotherValidDriver.LegalInformation).Return(expectedDriver, nil)
+			},
+			want: []driver.ImportResult{
+				{Line: 2, Status: driver.IMPORT_DUPLICATED, CPF: "11144477735", DriverID: 1, Errors: []string{driver.ErrDuplicatedDriver.Error()}},
+				{Line: 3, Status: driver.IMPORT_DUPLICATED, CPF: "11144477735", Errors: []string{"the driver rg is duplicated in the file, first seen on line 2"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado linhas inválidas ou uma falha na persistência quando o método Import é chamado então as linhas falham sem interromper a importação",
+			args: args{
+				ctx: mockedContext,
+				rows: []driver.ImportRow{
+					{Line: 2, Err: errMocked},
+					{Line: 3, Driver: &invalidDriver},
+					{Line: 4, Driver: &anotherValidDriver},
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByLegalInformation(p.ctx, anotherValidDriver.LegalInformation).Return(nil, nil)
+				m.repo.EXPECT().CreateWithUser(p.ctx, &anotherValidDriver, gomock.Any()).Return(nil, errMocked)
+			},
+			want: []driver.ImportResult{
+				{Line: 2, Status: driver.IMPORT_FAILED, Errors: []string{errMocked.Error()}},
+				{Line: 3, Status: driver.IMPORT_FAILED, CPF: "11111111111", Errors: []string{driver.ErrInvalidCPF.Error() + ": [11111111111]"}},
+				{Line: 4, Status: driver.IMPORT_FAILED, CPF: "11144477735", Errors: []string{errMocked.Error()}},
+			},
+			wantErr: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
+
+			actualReport, err := s.Import(test.args.ctx, test.args.rows)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, len(test.want), len(actualReport.Results))
+			for i, result := range actualReport.Results {
+				if result.Status == driver.IMPORT_CREATED {
+					assert.Equal(tt, user.GENERATED_PASSWORD_LENGTH, len(result.Password))
+					result.Password = ""
+				}
+				assert.Equal(tt, test.want[i], result)
+			}
+		})
+	}
+}
+
 func TestService_Update(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -557,6 +836,7 @@ func TestService_Update(t *testing.T) {
 				d:   &driver.Driver{ID: 1, Attributes: driver.DriverAttributes{Name: "Novo nome"}},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.d.ID).Return(p.d, nil).Times(2)
 				m.repo.EXPECT().Update(p.ctx, p.d).Return(nil)
 			},
 			wantErr: false,
@@ -568,6 +848,7 @@ func TestService_Update(t *testing.T) {
 				d:   &driver.Driver{},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.d.ID).Return(p.d, nil)
 				m.repo.EXPECT().Update(p.ctx, p.d).Return(errMocked)
 			},
 			wantErr: true,
@@ -580,15 +861,17 @@ func TestService_Update(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			err := s.Update(test.args.ctx, test.args.d)
 
@@ -597,15 +880,100 @@ func TestService_Update(t *testing.T) {
 	}
 }
 
+func TestService_Patch(t *testing.T) {
+	type serviceMocks struct {
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		d      *driver.Driver
+		fields []string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado campos mutáveis quando o método Patch é chamado então apenas esses campos são gravados",
+			args: args{
+				ctx:    mockedContext,
+				d:      &driver.Driver{ID: 1, Version: 1},
+				fields: []string{"email"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.d.ID).Return(p.d, nil).Times(2)
+				m.repo.EXPECT().Patch(p.ctx, p.d, p.fields).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado um campo imutável quando o método Patch é chamado então um erro é retornado sem gravar nada",
+			args: args{
+				ctx:    mockedContext,
+				d:      &driver.Driver{ID: 1, Version: 1},
+				fields: []string{"cpf"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Patch é chamado então o erro é retornado",
+			args: args{
+				ctx:    mockedContext,
+				d:      &driver.Driver{ID: 1, Version: 1},
+				fields: []string{"email"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.d.ID).Return(p.d, nil)
+				m.repo.EXPECT().Patch(p.ctx, p.d, p.fields).Return(errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
+
+			err := s.Patch(test.args.ctx, test.args.d, test.args.fields)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
 func TestService_Delete(t *testing.T) {
 	type serviceMocks struct {
-		repo   *driver_mocks.MockRepository
-		logger *logging.Logging
+		repo    *driver_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
-		ctx context.Context
-		id  int64
+		ctx     context.Context
+		id      int64
+		version int64
 	}
 
 	tests := []struct {
@@ -617,22 +985,26 @@ func TestService_Delete(t *testing.T) {
 		{
 			name: "Dado um ID válido quando o método Delete é chamado então o motorista é removido",
 			args: args{
-				ctx: mockedContext,
-				id:  1,
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(nil)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&driver.Driver{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "Dado um ID inválido quando o método Delete é chamado então um erro é retornado",
 			args: args{
-				ctx: mockedContext,
-				id:  0,
+				ctx:     mockedContext,
+				id:      0,
+				version: 1,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(errMocked)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&driver.Driver{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(errMocked)
 			},
 			wantErr: true,
 		},
@@ -644,17 +1016,19 @@ func TestService_Delete(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   driver_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    driver_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := driver.NewService(sm.repo, sm.logger)
+			s := driver.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
-			err := s.Delete(test.args.ctx, test.args.id)
+			err := s.Delete(test.args.ctx, test.args.id, test.args.version)
 
 			assert.Equal(tt, test.wantErr, err != nil)
 		})
@@ -0,0 +1,113 @@
+package driver
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	CPF_LENGTH            = 11
+	DRIVER_LICENSE_LENGTH = 11
+	MINIMUM_DRIVER_AGE    = 18
+)
+
+var (
+	ErrEmptyName            = errors.New("the driver name cannot be empty")
+	ErrInvalidDateOfBirth   = errors.New("the driver must be at least 18 years old")
+	ErrEmptyRG              = errors.New("the driver rg cannot be empty")
+	ErrInvalidCPF           = errors.New("the driver cpf must have 11 digits and valid check digits")
+	ErrInvalidDriverLicense = errors.New("the driver license (CNH) must have 11 digits")
+	ErrEmptyCellPhone       = errors.New("the driver cell phone cannot be empty")
+	ErrInvalidEmail         = errors.New("the driver email is not a valid address")
+)
+
+// NormalizeDocument removes every non digit character, so that CPF and CNH
+// can be informed either formatted (123.456.789-09) or not.
+func NormalizeDocument(document string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, document)
+}
+
+func ValidateCPF(cpf string) error {
+	normalized := NormalizeDocument(cpf)
+	if len(normalized) != CPF_LENGTH || strings.Count(normalized, normalized[:1]) == CPF_LENGTH {
+		return fmt.Errorf("%w: [%s]", ErrInvalidCPF, cpf)
+	}
+
+	for _, size := range []int{9, 10} {
+		sum := 0
+		for i := 0; i < size; i++ {
+			sum += int(normalized[i]-'0') * (size + 1 - i)
+		}
+
+		checkDigit := (sum * 10) % 11
+		if checkDigit == 10 {
+			checkDigit = 0
+		}
+
+		if checkDigit != int(normalized[size]-'0') {
+			return fmt.Errorf("%w: [%s]", ErrInvalidCPF, cpf)
+		}
+	}
+
+	return nil
+}
+
+func ValidateDriverLicense(driverLicense string) error {
+	normalized := NormalizeDocument(driverLicense)
+	if len(normalized) != DRIVER_LICENSE_LENGTH {
+		return fmt.Errorf("%w: [%s]", ErrInvalidDriverLicense, driverLicense)
+	}
+
+	return nil
+}
+
+// Validate returns every rule broken by the driver joined in a single error.
+// The address, when present, is validated as well.
+func (d *Driver) Validate() error {
+	var errs []error
+
+	if len(strings.TrimSpace(d.Attributes.Name)) == 0 {
+		errs = append(errs, ErrEmptyName)
+	}
+
+	if d.Attributes.DateOfBirth.IsZero() || d.Attributes.DateOfBirth.AddDate(MINIMUM_DRIVER_AGE, 0, 0).After(time.Now()) {
+		errs = append(errs, ErrInvalidDateOfBirth)
+	}
+
+	if len(strings.TrimSpace(d.LegalInformation.RG)) == 0 {
+		errs = append(errs, ErrEmptyRG)
+	}
+
+	if err := ValidateCPF(d.LegalInformation.CPF); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := ValidateDriverLicense(d.LegalInformation.DriverLicense); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(strings.TrimSpace(d.Contact.CellPhone)) == 0 {
+		errs = append(errs, ErrEmptyCellPhone)
+	}
+
+	if _, err := mail.ParseAddress(d.Contact.Email); err != nil {
+		errs = append(errs, fmt.Errorf("%w: [%s]", ErrInvalidEmail, d.Contact.Email))
+	}
+
+	if d.Address != nil {
+		if err := d.Address.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,62 @@
+package driver_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestValidateCPF(t *testing.T) {
+	tests := []struct {
+		name    string
+		cpf     string
+		wantErr bool
+	}{
+		{
+			name:    "Dado um CPF formatado e válido quando a validação é chamada então o CPF é aceito",
+			cpf:     "529.982.247-25",
+			wantErr: false,
+		},
+		{
+			name:    "Dado um CPF com dígitos verificadores inválidos quando a validação é chamada então um erro é retornado",
+			cpf:     "52998224726",
+			wantErr: true,
+		},
+		{
+			name:    "Dado um CPF com todos os dígitos iguais quando a validação é chamada então um erro é retornado",
+			cpf:     "00000000000",
+			wantErr: true,
+		},
+		{
+			name:    "Dado um CPF incompleto quando a validação é chamada então um erro é retornado",
+			cpf:     "5299822472",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := driver.ValidateCPF(test.cpf)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestDriver_Validate(t *testing.T) {
+	d := validDriver
+	d.Contact.Email = "not an email"
+	d.Address = &address.Address{State: address.SP, CEP: "123"}
+
+	err := d.Validate()
+
+	assert.Equal(t, true, errors.Is(err, driver.ErrInvalidEmail))
+	assert.Equal(t, true, errors.Is(err, address.ErrInvalidCEP))
+	assert.Equal(t, true, errors.Is(err, address.ErrEmptyLocality))
+	assert.Equal(t, false, errors.Is(err, driver.ErrInvalidCPF))
+	assert.Equal(t, false, errors.Is(err, address.ErrUndefinedState))
+	assert.Equal(t, nil, validDriver.Validate())
+}
@@ -0,0 +1,27 @@
+package fine
+
+import (
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// PointsEventPayload is the body of the driver.points_near_suspension
+// event, raised by the fine that brought the driver near the threshold.
+type PointsEventPayload struct {
+	DriverID    int64 `json:"driver_id"`
+	FineID      int64 `json:"fine_id"`
+	Points      int   `json:"points"`
+	Gravissimas int   `json:"gravissimas"`
+	Threshold   int   `json:"threshold"`
+	Suspended   bool  `json:"suspended"`
+}
+
+func newPointsEvent(fineID int64, s *Standing) (*outbox.Event, error) {
+	return outbox.NewEvent(outbox.DRIVER, s.DriverID, outbox.DRIVER_POINTS_NEAR_SUSPENSION, PointsEventPayload{
+		DriverID:    s.DriverID,
+		FineID:      fineID,
+		Points:      s.Points,
+		Gravissimas: s.Gravissimas,
+		Threshold:   s.Threshold(),
+		Suspended:   s.Suspended(),
+	})
+}
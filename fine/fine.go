@@ -0,0 +1,143 @@
+package fine
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+// Points of each infraction severity, as set by the CTB: leve, média, grave
+// and gravíssima.
+const (
+	LEVE_POINTS       = 3
+	MEDIA_POINTS      = 4
+	GRAVE_POINTS      = 5
+	GRAVISSIMA_POINTS = 7
+)
+
+// POINTS_PERIOD_MONTHS is how far back the infractions of a driver count
+// towards the suspension of the CNH.
+const POINTS_PERIOD_MONTHS = 12
+
+// DEFAULT_ALERT_MARGIN is how many points below the suspension threshold a
+// driver starts raising alerts.
+const DEFAULT_ALERT_MARGIN = 5
+
+var (
+	ErrUnknownPlate     = errors.New("no vehicle has the plate of the fine")
+	ErrAlreadyPaid      = errors.New("the fine is already paid")
+	ErrAlreadyIndicated = errors.New("the driver of the fine is already indicated")
+	ErrMissingDriver    = errors.New("the fine is not attributed to any driver, so the driver must be given")
+)
+
+// Fine is a traffic infraction notified for the plate of a vehicle. The
+// driver is the one assigned to the vehicle when it was committed, if there
+// was exactly one, and otherwise must be indicated. Amount is in cents.
+type Fine struct {
+	ID              int64
+	VehicleID       int64
+	Plate           string
+	DriverID        int64
+	CommittedAt     time.Time
+	Location        string
+	Article         string
+	Points          int
+	Amount          int64
+	PaymentDueAt    time.Time
+	IndicationDueAt time.Time
+	PaidAt          time.Time
+	IndicatedAt     time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// Standing is the points a driver accumulated over the last
+// POINTS_PERIOD_MONTHS. Alert is set by the use case when the points are
+// within its margin of the threshold.
+type Standing struct {
+	DriverID    int64
+	Points      int
+	Gravissimas int
+	Alert       bool
+}
+
+// Threshold is the points at which the CNH is suspended, which is lower
+// the more gravíssima infractions were committed (CTB, art. 261).
+func (s *Standing) Threshold() int {
+	switch {
+	case s.Gravissimas >= 2:
+		return 20
+	case s.Gravissimas == 1:
+		return 30
+	}
+
+	return 40
+}
+
+func (s *Standing) WithinMargin(margin int) bool {
+	return s.Points >= s.Threshold()-margin
+}
+
+func (s *Standing) Suspended() bool {
+	return s.Points >= s.Threshold()
+}
+
+// PointsSince is the start of the period whose infractions count towards
+// the standing of a driver at now.
+func PointsSince(now time.Time) time.Time {
+	return now.AddDate(0, -POINTS_PERIOD_MONTHS, 0)
+}
+
+// FineSpecification filters the fines. DueBefore keeps the fines that are
+// still to be paid or indicated with a deadline before it.
+type FineSpecification struct {
+	VehicleID, DriverID int64
+	Unpaid              bool
+	DueBefore           time.Time
+	Page, PageSize      int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Fine, error)
+	List(ctx context.Context, specification *FineSpecification) (*[]Fine, error)
+	// Standing sums the points of the driver committed since the given time.
+	Standing(ctx context.Context, driverID int64, since time.Time) (*Standing, error)
+	// ListStandings sums the points of every driver committed since the given
+	// time, leaving out the drivers with fewer than minimumPoints.
+	ListStandings(ctx context.Context, since time.Time, minimumPoints int) (*[]Standing, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, f *Fine) (int64, error)
+	Update(ctx context.Context, f *Fine) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// VehicleReading is the part of the vehicle repository needed to find the
+// vehicle of a fine.
+type VehicleReading interface {
+	GetByPlate(ctx context.Context, plate string) (*vehicle.Vehicle, error)
+}
+
+// AssignmentReading is the part of the driver-vehicle use case needed to
+// attribute a fine to a driver.
+type AssignmentReading interface {
+	ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error)
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Fine, error)
+	List(ctx context.Context, specification *FineSpecification) (*[]Fine, error)
+	Create(ctx context.Context, f *Fine) (int64, error)
+	Pay(ctx context.Context, id int64, paidAt time.Time) (*Fine, error)
+	Indicate(ctx context.Context, id, driverID int64) (*Fine, error)
+	Standing(ctx context.Context, driverID int64) (*Standing, error)
+	ListAlerts(ctx context.Context) (*[]Standing, error)
+}
@@ -0,0 +1,45 @@
+package fine_test
+
+import (
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/fine"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestStanding_Threshold(t *testing.T) {
+	tests := []struct {
+		name          string
+		standing      fine.Standing
+		wantThreshold int
+		wantAlert     bool
+		wantSuspended bool
+	}{
+		{
+			name:          "Dado um motorista sem gravíssimas longe do limite quando a pontuação é avaliada então não há alerta",
+			standing:      fine.Standing{Points: 20},
+			wantThreshold: 40,
+		},
+		{
+			name:          "Dado um motorista com uma gravíssima perto do limite quando a pontuação é avaliada então há alerta",
+			standing:      fine.Standing{Points: 26, Gravissimas: 1},
+			wantThreshold: 30,
+			wantAlert:     true,
+		},
+		{
+			name:          "Dado um motorista com duas gravíssimas no limite quando a pontuação é avaliada então a CNH está suspensa",
+			standing:      fine.Standing{Points: 21, Gravissimas: 3},
+			wantThreshold: 20,
+			wantAlert:     true,
+			wantSuspended: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.wantThreshold, test.standing.Threshold())
+			assert.Equal(tt, test.wantAlert, test.standing.WithinMargin(fine.DEFAULT_ALERT_MARGIN))
+			assert.Equal(tt, test.wantSuspended, test.standing.Suspended())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type FineDTO struct {
+	bun.BaseModel `bun:"table:fines"`
+
+	ID              int64     `bun:"id,pk,autoincrement"`
+	VehicleID       int64     `bun:"vehicle_id,notnull"`
+	Plate           string    `bun:"plate,notnull"`
+	DriverID        int64     `bun:"driver_id,nullzero"`
+	CommittedAt     time.Time `bun:"committed_at,notnull"`
+	Location        string    `bun:"location,nullzero"`
+	Article         string    `bun:"article,notnull"`
+	Points          int       `bun:"points,notnull"`
+	Amount          int64     `bun:"amount,notnull"`
+	PaymentDueAt    time.Time `bun:"payment_due_at,nullzero"`
+	IndicationDueAt time.Time `bun:"indication_due_at,nullzero"`
+	PaidAt          time.Time `bun:"paid_at,nullzero"`
+	IndicatedAt     time.Time `bun:"indicated_at,nullzero"`
+	CreatedAt       time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt       time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// StandingDTO is a row of the points query, the points of a driver summed
+// over a period.
+type StandingDTO struct {
+	DriverID    int64 `bun:"driver_id"`
+	Points      int   `bun:"points"`
+	Gravissimas int   `bun:"gravissimas"`
+}
@@ -0,0 +1,173 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/fine"
+	"github.com/LucasMateus-eng/operations-service/fine/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/fine/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/uptrace/bun"
+)
+
+type finePostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *finePostgresRepo {
+	return &finePostgresRepo{
+		db: db,
+	}
+}
+
+func (fr *finePostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, fr.db)
+}
+
+func (fr *finePostgresRepo) GetByID(ctx context.Context, id int64) (*fine.Fine, error) {
+	fineDTO := new(dto.FineDTO)
+
+	err := fr.conn(ctx).NewSelect().Model(fineDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToFine(fineDTO), nil
+}
+
+func (fr *finePostgresRepo) List(ctx context.Context, specification *fine.FineSpecification) (*[]fine.Fine, error) {
+	var fineDTOs []dto.FineDTO
+
+	query := fr.conn(ctx).NewSelect().Model(&fineDTOs).Order("committed_at DESC", "id DESC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if specification.DriverID != 0 {
+		query = query.Where("driver_id = ?", specification.DriverID)
+	}
+
+	if specification.Unpaid {
+		query = query.Where("paid_at IS NULL")
+	}
+
+	if !specification.DueBefore.IsZero() {
+		query = query.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("paid_at IS NULL AND payment_due_at < ?", specification.DueBefore).
+				WhereOr("indicated_at IS NULL AND indication_due_at < ?", specification.DueBefore)
+		})
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	fines := make([]fine.Fine, 0, len(fineDTOs))
+	for _, dto := range fineDTOs {
+		fines = append(fines, *mapping.MapDTOToFine(&dto))
+	}
+
+	return &fines, nil
+}
+
+func (fr *finePostgresRepo) Standing(ctx context.Context, driverID int64, since time.Time) (*fine.Standing, error) {
+	standingDTO := new(dto.StandingDTO)
+
+	err := fr.points(ctx, since).Where("driver_id = ?", driverID).Scan(ctx, standingDTO)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &fine.Standing{DriverID: driverID}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToStanding(standingDTO), nil
+}
+
+func (fr *finePostgresRepo) ListStandings(ctx context.Context, since time.Time, minimumPoints int) (*[]fine.Standing, error) {
+	var standingDTOs []dto.StandingDTO
+
+	err := fr.points(ctx, since).
+		Where("driver_id IS NOT NULL").
+		Having("sum(points) >= ?", minimumPoints).
+		OrderExpr("points DESC, driver_id ASC").
+		Scan(ctx, &standingDTOs)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]fine.Standing, 0, len(standingDTOs))
+	for _, dto := range standingDTOs {
+		standings = append(standings, *mapping.MapDTOToStanding(&dto))
+	}
+
+	return &standings, nil
+}
+
+// points sums the points of the fines committed since the given time by
+// driver.
+func (fr *finePostgresRepo) points(ctx context.Context, since time.Time) *bun.SelectQuery {
+	return fr.conn(ctx).NewSelect().
+		TableExpr("fines").
+		ColumnExpr("driver_id").
+		ColumnExpr("sum(points) AS points").
+		ColumnExpr("count(*) FILTER (WHERE points = ?) AS gravissimas", fine.GRAVISSIMA_POINTS).
+		Where("committed_at >= ?", since).
+		Group("driver_id")
+}
+
+func (fr *finePostgresRepo) Create(ctx context.Context, f *fine.Fine) (int64, error) {
+	fineDTO := mapping.MapFineToDTO(f)
+
+	_, err := fr.conn(ctx).NewInsert().Model(fineDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return fineDTO.ID, nil
+}
+
+// Update saves what may change once the fine is registered, its driver and
+// whether it was paid and indicated.
+func (fr *finePostgresRepo) Update(ctx context.Context, f *fine.Fine) error {
+	fineDTO := mapping.MapFineToDTO(f)
+
+	res, err := fr.conn(ctx).NewUpdate().
+		Model(fineDTO).
+		Column("driver_id", "paid_at", "indicated_at", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
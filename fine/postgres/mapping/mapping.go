@@ -0,0 +1,54 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/fine"
+	"github.com/LucasMateus-eng/operations-service/fine/postgres/dto"
+)
+
+func MapFineToDTO(f *fine.Fine) *dto.FineDTO {
+	return &dto.FineDTO{
+		ID:              f.ID,
+		VehicleID:       f.VehicleID,
+		Plate:           f.Plate,
+		DriverID:        f.DriverID,
+		CommittedAt:     f.CommittedAt,
+		Location:        f.Location,
+		Article:         f.Article,
+		Points:          f.Points,
+		Amount:          f.Amount,
+		PaymentDueAt:    f.PaymentDueAt,
+		IndicationDueAt: f.IndicationDueAt,
+		PaidAt:          f.PaidAt,
+		IndicatedAt:     f.IndicatedAt,
+		CreatedAt:       f.CreatedAt,
+		UpdatedAt:       f.UpdatedAt,
+	}
+}
+
+func MapDTOToFine(fineDTO *dto.FineDTO) *fine.Fine {
+	return &fine.Fine{
+		ID:              fineDTO.ID,
+		VehicleID:       fineDTO.VehicleID,
+		Plate:           fineDTO.Plate,
+		DriverID:        fineDTO.DriverID,
+		CommittedAt:     fineDTO.CommittedAt,
+		Location:        fineDTO.Location,
+		Article:         fineDTO.Article,
+		Points:          fineDTO.Points,
+		Amount:          fineDTO.Amount,
+		PaymentDueAt:    fineDTO.PaymentDueAt,
+		IndicationDueAt: fineDTO.IndicationDueAt,
+		PaidAt:          fineDTO.PaidAt,
+		IndicatedAt:     fineDTO.IndicatedAt,
+		CreatedAt:       fineDTO.CreatedAt,
+		UpdatedAt:       fineDTO.UpdatedAt,
+	}
+}
+
+func MapDTOToStanding(standingDTO *dto.StandingDTO) *fine.Standing {
+	return &fine.Standing{
+		DriverID:    standingDTO.DriverID,
+		Points:      standingDTO.Points,
+		Gravissimas: standingDTO.Gravissimas,
+	}
+}
@@ -0,0 +1,62 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/fine"
+	fine_dto "github.com/LucasMateus-eng/operations-service/fine/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapFineToDTO(t *testing.T) {
+	f := &fine.Fine{
+		ID:              1,
+		VehicleID:       2,
+		Plate:           "ABC1D23",
+		DriverID:        3,
+		CommittedAt:     mockedTime,
+		Location:        "Av. Paulista, 1000",
+		Article:         "218-I",
+		Points:          fine.MEDIA_POINTS,
+		Amount:          13016,
+		PaymentDueAt:    mockedTime,
+		IndicationDueAt: mockedTime,
+		PaidAt:          mockedTime,
+		IndicatedAt:     mockedTime,
+		CreatedAt:       mockedTime,
+		UpdatedAt:       mockedTime,
+	}
+
+	expectedDTO := &fine_dto.FineDTO{
+		ID:              1,
+		VehicleID:       2,
+		Plate:           "ABC1D23",
+		DriverID:        3,
+		CommittedAt:     mockedTime,
+		Location:        "Av. Paulista, 1000",
+		Article:         "218-I",
+		Points:          4,
+		Amount:          13016,
+		PaymentDueAt:    mockedTime,
+		IndicationDueAt: mockedTime,
+		PaidAt:          mockedTime,
+		IndicatedAt:     mockedTime,
+		CreatedAt:       mockedTime,
+		UpdatedAt:       mockedTime,
+	}
+
+	actualDTO := MapFineToDTO(f)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, f, MapDTOToFine(actualDTO))
+}
+
+func TestMapDTOToStanding(t *testing.T) {
+	standingDTO := &fine_dto.StandingDTO{DriverID: 1, Points: 26, Gravissimas: 1}
+
+	assert.Equal(t, &fine.Standing{DriverID: 1, Points: 26, Gravissimas: 1}, MapDTOToStanding(standingDTO))
+}
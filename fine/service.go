@@ -0,0 +1,307 @@
+package fine
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+type Service struct {
+	repo        Repository
+	auditor     audit.Recorder
+	events      outbox.Emitter
+	vehicles    VehicleReading
+	assignments AssignmentReading
+	alertMargin int
+	logger      *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, em outbox.Emitter, vr VehicleReading, ar AssignmentReading, alertMargin int, l *logging.Logging) *Service {
+	if alertMargin <= 0 {
+		alertMargin = DEFAULT_ALERT_MARGIN
+	}
+
+	return &Service{
+		repo:        r,
+		auditor:     au,
+		events:      em,
+		vehicles:    vr,
+		assignments: ar,
+		alertMargin: alertMargin,
+		logger:      l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Fine, error) {
+	s.logger.Debug("[FINE] GetByID - DEBUG: ", map[string]any{
+		"fineID": id,
+	})
+	fine, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[FINE] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return fine, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *FineSpecification) (*[]Fine, error) {
+	s.logger.Debug("[FINE] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	fines, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[FINE] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return fines, nil
+}
+
+// Create registers the fine against the vehicle with its plate and
+// attributes it to the driver assigned to the vehicle when it was
+// committed. When no driver or more than one was assigned, the fine is left
+// unattributed until the driver is indicated.
+func (s *Service) Create(ctx context.Context, f *Fine) (int64, error) {
+	s.logger.Debug("[FINE] Create - DEBUG: ", map[string]any{
+		"fine": f,
+	})
+	if err := f.Validate(); err != nil {
+		return 0, err
+	}
+
+	f.Plate = vehicle.NormalizePlate(f.Plate)
+
+	var fineID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		v, err := s.vehicles.GetByPlate(ctx, f.Plate)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fmt.Errorf("%w: [%s]", ErrUnknownPlate, f.Plate)
+		}
+		if err != nil {
+			return nil, err
+		}
+		f.VehicleID = v.ID
+
+		assignments, err := s.assignments.ListByVehicleIDAt(ctx, v.ID, f.CommittedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		f.DriverID = 0
+		if len(*assignments) == 1 {
+			f.DriverID = (*assignments)[0].DriverID
+		}
+
+		fineID, err = s.repo.Create(ctx, f)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.alert(ctx, fineID, f.DriverID); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.FINE, fineID, audit.CREATE, nil, f)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[FINE] Create - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	// The owner of the vehicle answers for an unattributed fine unless the
+	// driver is indicated before the deadline.
+	if f.DriverID == 0 {
+		s.logger.Warn("[FINE] Create - WARN: ", map[string]any{
+			"fineID":          fineID,
+			"vehicleID":       f.VehicleID,
+			"indicationDueAt": f.IndicationDueAt,
+		})
+	}
+
+	return fineID, nil
+}
+
+// Pay records the payment of the fine, at paidAt or now when it is zero.
+func (s *Service) Pay(ctx context.Context, id int64, paidAt time.Time) (*Fine, error) {
+	s.logger.Debug("[FINE] Pay - DEBUG: ", map[string]any{
+		"fineID": id,
+		"paidAt": paidAt,
+	})
+	if paidAt.IsZero() {
+		paidAt = time.Now()
+	}
+
+	var after *Fine
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !before.PaidAt.IsZero() {
+			return nil, fmt.Errorf("%w: [%d]", ErrAlreadyPaid, id)
+		}
+
+		after = new(Fine)
+		*after = *before
+		after.PaidAt = paidAt
+
+		if err := s.repo.Update(ctx, after); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.FINE, id, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[FINE] Pay - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// Indicate records that the driver of the fine was indicated to the traffic
+// authority. driverID replaces the attributed driver when given, and may
+// only be left out when the fine is attributed already. The points move to
+// the indicated driver.
+func (s *Service) Indicate(ctx context.Context, id, driverID int64) (*Fine, error) {
+	s.logger.Debug("[FINE] Indicate - DEBUG: ", map[string]any{
+		"fineID":   id,
+		"driverID": driverID,
+	})
+
+	var after *Fine
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if !before.IndicatedAt.IsZero() {
+			return nil, fmt.Errorf("%w: [%d]", ErrAlreadyIndicated, id)
+		}
+
+		if driverID == 0 {
+			driverID = before.DriverID
+		}
+
+		if driverID == 0 {
+			return nil, fmt.Errorf("%w: [%d]", ErrMissingDriver, id)
+		}
+
+		after = new(Fine)
+		*after = *before
+		after.DriverID = driverID
+		after.IndicatedAt = time.Now()
+
+		if err := s.repo.Update(ctx, after); err != nil {
+			return nil, err
+		}
+
+		if driverID != before.DriverID {
+			if err := s.alert(ctx, id, driverID); err != nil {
+				return nil, err
+			}
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.FINE, id, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[FINE] Indicate - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return after, nil
+}
+
+func (s *Service) Standing(ctx context.Context, driverID int64) (*Standing, error) {
+	s.logger.Debug("[FINE] Standing - DEBUG: ", map[string]any{
+		"driverID": driverID,
+	})
+	standing, err := s.repo.Standing(ctx, driverID, PointsSince(time.Now()))
+	if err != nil {
+		s.logger.Error("[FINE] Standing - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	standing.Alert = standing.WithinMargin(s.alertMargin)
+
+	return standing, nil
+}
+
+// ListAlerts lists the drivers whose points are within the alert margin of
+// the suspension threshold, suspended ones included.
+func (s *Service) ListAlerts(ctx context.Context) (*[]Standing, error) {
+	s.logger.Debug("[FINE] ListAlerts - DEBUG: ", nil)
+
+	// No threshold is lower than the one of a driver with two gravíssimas.
+	lowest := (&Standing{Gravissimas: 2}).Threshold() - s.alertMargin
+
+	standings, err := s.repo.ListStandings(ctx, PointsSince(time.Now()), lowest)
+	if err != nil {
+		s.logger.Error("[FINE] ListAlerts - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	alerts := make([]Standing, 0, len(*standings))
+	for _, standing := range *standings {
+		if standing.WithinMargin(s.alertMargin) {
+			standing.Alert = true
+			alerts = append(alerts, standing)
+		}
+	}
+
+	return &alerts, nil
+}
+
+// alert emits an event when the points of the driver, with the fine
+// already counted, are within the alert margin of the threshold.
+func (s *Service) alert(ctx context.Context, fineID, driverID int64) error {
+	if driverID == 0 {
+		return nil
+	}
+
+	standing, err := s.repo.Standing(ctx, driverID, PointsSince(time.Now()))
+	if err != nil {
+		return err
+	}
+
+	if !standing.WithinMargin(s.alertMargin) {
+		return nil
+	}
+
+	s.logger.Warn("[FINE] alert - WARN: ", map[string]any{
+		"driverID":  driverID,
+		"points":    standing.Points,
+		"threshold": standing.Threshold(),
+	})
+
+	event, err := newPointsEvent(fineID, standing)
+	if err != nil {
+		return err
+	}
+
+	return s.events.Emit(ctx, event)
+}
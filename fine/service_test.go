@@ -0,0 +1,450 @@
+package fine_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/fine"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	fine_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/fine"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	committedAt   = time.Now().Add(-24 * time.Hour)
+)
+
+func TestService_Create(t *testing.T) {
+	type serviceMocks struct {
+		repo        *fine_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		vehicles    *fine_mocks.MockVehicleReading
+		assignments *fine_mocks.MockAssignmentReading
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		f   *fine.Fine
+	}
+
+	vehicleFound := &vehicle.Vehicle{ID: 1}
+
+	tests := []struct {
+		name         string
+		args         args
+		prepareMock  func(p args, m serviceMocks)
+		want         int64
+		wantDriverID int64
+		wantErr      error
+	}{
+		{
+			name: "Dado um único motorista no momento da infração quando o método Create é chamado então a multa é atribuída a ele",
+			args: args{
+				ctx: mockedContext,
+				f:   &fine.Fine{Plate: " abc1d23 ", CommittedAt: committedAt, Article: "218-I", Points: fine.GRAVISSIMA_POINTS, Amount: 29347},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByPlate(p.ctx, "ABC1D23").Return(vehicleFound, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, vehicleFound.ID, p.f.CommittedAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}}, nil)
+				m.repo.EXPECT().Create(p.ctx, p.f).Return(int64(5), nil)
+				m.repo.EXPECT().Standing(p.ctx, int64(7), gomock.Any()).Return(&fine.Standing{DriverID: 7, Points: 14, Gravissimas: 2}, nil)
+			},
+			want:         5,
+			wantDriverID: 7,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado um motorista perto da suspensão quando o método Create é chamado então o alerta é emitido",
+			args: args{
+				ctx: mockedContext,
+				f:   &fine.Fine{Plate: " abc1d23 ", CommittedAt: committedAt, Article: "218-I", Points: fine.GRAVISSIMA_POINTS, Amount: 29347},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByPlate(p.ctx, "ABC1D23").Return(vehicleFound, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, vehicleFound.ID, p.f.CommittedAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}}, nil)
+				m.repo.EXPECT().Create(p.ctx, p.f).Return(int64(5), nil)
+				m.repo.EXPECT().Standing(p.ctx, int64(7), gomock.Any()).Return(&fine.Standing{DriverID: 7, Points: 16, Gravissimas: 2}, nil)
+				m.events.EXPECT().Emit(p.ctx, gomock.Cond(func(x any) bool {
+					event, ok := x.(*outbox.Event)
+					return ok && event.Type == outbox.DRIVER_POINTS_NEAR_SUSPENSION && event.AggregateID == "7"
+				})).Return(nil)
+			},
+			want:         5,
+			wantDriverID: 7,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado vários motoristas no momento da infração quando o método Create é chamado então a multa fica sem motorista",
+			args: args{
+				ctx: mockedContext,
+				f:   &fine.Fine{Plate: " abc1d23 ", CommittedAt: committedAt, Article: "218-I", Points: fine.GRAVISSIMA_POINTS, Amount: 29347},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByPlate(p.ctx, "ABC1D23").Return(vehicleFound, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, vehicleFound.ID, p.f.CommittedAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}, {DriverID: 8, VehicleID: 1}}, nil)
+				m.repo.EXPECT().Create(p.ctx, p.f).Return(int64(5), nil)
+			},
+			want:         5,
+			wantDriverID: 0,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado uma placa desconhecida quando o método Create é chamado então a multa não é criada",
+			args: args{
+				ctx: mockedContext,
+				f:   &fine.Fine{Plate: " abc1d23 ", CommittedAt: committedAt, Article: "218-I", Points: fine.GRAVISSIMA_POINTS, Amount: 29347},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByPlate(p.ctx, "ABC1D23").Return(nil, sql.ErrNoRows)
+			},
+			want:         0,
+			wantDriverID: 0,
+			wantErr:      fine.ErrUnknownPlate,
+		},
+		{
+			name: "Dado uma multa inválida quando o método Create é chamado então a multa não é criada",
+			args: args{
+				ctx: mockedContext,
+				f:   &fine.Fine{Plate: "ABC1D23", CommittedAt: committedAt, Article: "218-I", Points: 6},
+			},
+			want:         0,
+			wantDriverID: 0,
+			wantErr:      fine.ErrInvalidFine,
+		},
+		{
+			name: "Dado um erro do repositório quando o método Create é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				f:   &fine.Fine{Plate: " abc1d23 ", CommittedAt: committedAt, Article: "218-I", Points: fine.GRAVISSIMA_POINTS, Amount: 29347},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByPlate(p.ctx, "ABC1D23").Return(vehicleFound, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, vehicleFound.ID, p.f.CommittedAt).Return(nil, errMocked)
+			},
+			want:         0,
+			wantDriverID: 0,
+			wantErr:      errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        fine_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      outbox_mocks.NewMockEmitter(ctrl),
+				vehicles:    fine_mocks.NewMockVehicleReading(ctrl),
+				assignments: fine_mocks.NewMockAssignmentReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := fine.NewService(sm.repo, sm.auditor, sm.events, sm.vehicles, sm.assignments, 0, sm.logger)
+
+			actualID, err := s.Create(test.args.ctx, test.args.f)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, test.wantDriverID, test.args.f.DriverID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_Pay(t *testing.T) {
+	type serviceMocks struct {
+		repo        *fine_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		vehicles    *fine_mocks.MockVehicleReading
+		assignments *fine_mocks.MockAssignmentReading
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		id     int64
+		paidAt time.Time
+	}
+
+	paidAt := time.Now()
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado uma multa em aberto quando o método Pay é chamado então o pagamento é registrado",
+			args: args{
+				ctx:    mockedContext,
+				id:     5,
+				paidAt: paidAt,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&fine.Fine{ID: 5}, nil)
+				m.repo.EXPECT().Update(p.ctx, &fine.Fine{ID: 5, PaidAt: p.paidAt}).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma multa paga quando o método Pay é chamado então um erro é retornado",
+			args: args{
+				ctx:    mockedContext,
+				id:     5,
+				paidAt: paidAt,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&fine.Fine{ID: 5, PaidAt: p.paidAt}, nil)
+			},
+			wantErr: fine.ErrAlreadyPaid,
+		},
+		{
+			name: "Dado uma multa inexistente quando o método Pay é chamado então o erro é retornado",
+			args: args{
+				ctx:    mockedContext,
+				id:     5,
+				paidAt: paidAt,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        fine_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      outbox_mocks.NewMockEmitter(ctrl),
+				vehicles:    fine_mocks.NewMockVehicleReading(ctrl),
+				assignments: fine_mocks.NewMockAssignmentReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := fine.NewService(sm.repo, sm.auditor, sm.events, sm.vehicles, sm.assignments, 0, sm.logger)
+
+			_, err := s.Pay(test.args.ctx, test.args.id, test.args.paidAt)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_Indicate(t *testing.T) {
+	type serviceMocks struct {
+		repo        *fine_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		vehicles    *fine_mocks.MockVehicleReading
+		assignments *fine_mocks.MockAssignmentReading
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx      context.Context
+		id       int64
+		driverID int64
+	}
+
+	tests := []struct {
+		name         string
+		args         args
+		prepareMock  func(p args, m serviceMocks)
+		wantDriverID int64
+		wantErr      error
+	}{
+		{
+			name: "Dado uma multa atribuída quando o método Indicate é chamado sem motorista então o motorista atribuído é indicado",
+			args: args{
+				ctx:      mockedContext,
+				id:       5,
+				driverID: 0,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&fine.Fine{ID: 5, DriverID: 7}, nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any()).Return(nil)
+			},
+			wantDriverID: 7,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado outro motorista quando o método Indicate é chamado então os pontos passam a ele",
+			args: args{
+				ctx:      mockedContext,
+				id:       5,
+				driverID: 8,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&fine.Fine{ID: 5, DriverID: 7}, nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any()).Return(nil)
+				m.repo.EXPECT().Standing(p.ctx, p.driverID, gomock.Any()).Return(&fine.Standing{DriverID: 8, Points: 7}, nil)
+			},
+			wantDriverID: 8,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado uma multa sem motorista quando o método Indicate é chamado sem motorista então um erro é retornado",
+			args: args{
+				ctx:      mockedContext,
+				id:       5,
+				driverID: 0,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&fine.Fine{ID: 5}, nil)
+			},
+			wantErr: fine.ErrMissingDriver,
+		},
+		{
+			name: "Dado uma multa já indicada quando o método Indicate é chamado então um erro é retornado",
+			args: args{
+				ctx:      mockedContext,
+				id:       5,
+				driverID: 8,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&fine.Fine{ID: 5, DriverID: 7, IndicatedAt: committedAt}, nil)
+			},
+			wantErr: fine.ErrAlreadyIndicated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        fine_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      outbox_mocks.NewMockEmitter(ctrl),
+				vehicles:    fine_mocks.NewMockVehicleReading(ctrl),
+				assignments: fine_mocks.NewMockAssignmentReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := fine.NewService(sm.repo, sm.auditor, sm.events, sm.vehicles, sm.assignments, 0, sm.logger)
+
+			actual, err := s.Indicate(test.args.ctx, test.args.id, test.args.driverID)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, test.wantDriverID, actual.DriverID)
+				assert.Equal(tt, false, actual.IndicatedAt.IsZero())
+			}
+		})
+	}
+}
+
+func TestService_ListAlerts(t *testing.T) {
+	type serviceMocks struct {
+		repo        *fine_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		events      *outbox_mocks.MockEmitter
+		vehicles    *fine_mocks.MockVehicleReading
+		assignments *fine_mocks.MockAssignmentReading
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        *[]fine.Standing
+		wantErr     error
+	}{
+		{
+			name: "Dado motoristas com pontos quando o método ListAlerts é chamado então só os perto da suspensão são retornados",
+			args: args{
+				ctx: mockedContext,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListStandings(p.ctx, gomock.Any(), 15).Return(&[]fine.Standing{
+					{DriverID: 1, Points: 36},
+					{DriverID: 2, Points: 16, Gravissimas: 2},
+					{DriverID: 3, Points: 16},
+				}, nil)
+			},
+			want: &[]fine.Standing{
+				{DriverID: 1, Points: 36, Alert: true},
+				{DriverID: 2, Points: 16, Gravissimas: 2, Alert: true},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um erro do repositório quando o método ListAlerts é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListStandings(p.ctx, gomock.Any(), 15).Return(nil, errMocked)
+			},
+			want:    nil,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        fine_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				events:      outbox_mocks.NewMockEmitter(ctrl),
+				vehicles:    fine_mocks.NewMockVehicleReading(ctrl),
+				assignments: fine_mocks.NewMockAssignmentReading(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := fine.NewService(sm.repo, sm.auditor, sm.events, sm.vehicles, sm.assignments, 0, sm.logger)
+
+			alerts, err := s.ListAlerts(test.args.ctx)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			assert.Equal(tt, test.want, alerts)
+		})
+	}
+}
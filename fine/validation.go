@@ -0,0 +1,58 @@
+package fine
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidFine = errors.New("the given fine is invalid")
+
+	ErrMissingPlate         = errors.New("the fine must have the plate of the vehicle")
+	ErrInvalidCommittedAt   = errors.New("the infraction date cannot be empty or in the future")
+	ErrMissingArticle       = errors.New("the fine must have the article of the infraction")
+	ErrInvalidPoints        = errors.New("the points of the fine must be 3, 4, 5 or 7")
+	ErrNegativeAmount       = errors.New("the fine amount cannot be negative")
+	ErrDeadlineBeforeCommit = errors.New("the deadlines of the fine cannot be before the infraction")
+)
+
+var points = []int{LEVE_POINTS, MEDIA_POINTS, GRAVE_POINTS, GRAVISSIMA_POINTS}
+
+// Validate returns every rule broken by the fine joined in a single error.
+func (f *Fine) Validate() error {
+	var errs []error
+
+	if strings.TrimSpace(f.Plate) == "" {
+		errs = append(errs, ErrMissingPlate)
+	}
+
+	if f.CommittedAt.IsZero() || f.CommittedAt.After(time.Now()) {
+		errs = append(errs, ErrInvalidCommittedAt)
+	}
+
+	if strings.TrimSpace(f.Article) == "" {
+		errs = append(errs, ErrMissingArticle)
+	}
+
+	if !slices.Contains(points, f.Points) {
+		errs = append(errs, ErrInvalidPoints)
+	}
+
+	if f.Amount < 0 {
+		errs = append(errs, ErrNegativeAmount)
+	}
+
+	if (!f.PaymentDueAt.IsZero() && f.PaymentDueAt.Before(f.CommittedAt)) ||
+		(!f.IndicationDueAt.IsZero() && f.IndicationDueAt.Before(f.CommittedAt)) {
+		errs = append(errs, ErrDeadlineBeforeCommit)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidFine, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package fine_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/fine"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestFine_Validate(t *testing.T) {
+	committedAt := time.Now().Add(-24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		fine     fine.Fine
+		wantErrs []error
+	}{
+		{
+			name: "Dado uma multa válida quando a validação é chamada então nenhum erro é retornado",
+			fine: fine.Fine{Plate: "ABC1D23", CommittedAt: committedAt, Article: "218-I", Points: fine.MEDIA_POINTS, Amount: 13016, IndicationDueAt: committedAt.AddDate(0, 0, 30)},
+		},
+		{
+			name:     "Dado um prazo anterior à infração quando a validação é chamada então um erro é retornado",
+			fine:     fine.Fine{Plate: "ABC1D23", CommittedAt: committedAt, Article: "218-I", Points: fine.MEDIA_POINTS, PaymentDueAt: committedAt.Add(-time.Hour)},
+			wantErrs: []error{fine.ErrInvalidFine, fine.ErrDeadlineBeforeCommit},
+		},
+		{
+			name: "Dado uma multa vazia no futuro quando a validação é chamada então todas as regras quebradas são retornadas",
+			fine: fine.Fine{CommittedAt: time.Now().Add(time.Hour), Points: 6, Amount: -1},
+			wantErrs: []error{
+				fine.ErrInvalidFine, fine.ErrMissingPlate, fine.ErrInvalidCommittedAt, fine.ErrMissingArticle, fine.ErrInvalidPoints, fine.ErrNegativeAmount,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.fine.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+package fuel
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sort"
+	"time"
+
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+)
+
+type FuelType string
+
+const (
+	GASOLINE FuelType = "GASOLINE"
+	ETHANOL  FuelType = "ETHANOL"
+	DIESEL   FuelType = "DIESEL"
+	CNG      FuelType = "CNG"
+)
+
+// DEFAULT_OUTLIER_TOLERANCE is how far, in percent, the consumption of a
+// refuel may be from the median of its vehicle before it is flagged.
+const DEFAULT_OUTLIER_TOLERANCE = 30
+
+// MINIMUM_SEGMENTS is how many refuels a vehicle needs in the period for its
+// median to be meaningful enough to flag outliers.
+const MINIMUM_SEGMENTS = 3
+
+var (
+	fuelTypes = []FuelType{GASOLINE, ETHANOL, DIESEL, CNG}
+
+	ErrInvalidFuelType          = errors.New("the fuel type must be one of GASOLINE, ETHANOL, DIESEL or CNG")
+	ErrNoAssignedDriver         = errors.New("the vehicle is not assigned to any driver")
+	ErrAmbiguousDriver          = errors.New("the vehicle is assigned to more than one driver, so the driver must be given")
+	ErrDriverNotAssigned        = errors.New("the driver is not assigned to the vehicle")
+	ErrMissingEfficiencySubject = errors.New("the fuel efficiency is computed for either a vehicle or a driver")
+)
+
+func GetFuelType(name string) (FuelType, error) {
+	fuelType := FuelType(name)
+	if !slices.Contains(fuelTypes, fuelType) {
+		return "", ErrInvalidFuelType
+	}
+
+	return fuelType, nil
+}
+
+// Refuel is a refuelling of a vehicle by the driver assigned to it. Price is
+// the total paid, in cents. The odometer is recorded as a reading of the
+// vehicle as well.
+type Refuel struct {
+	ID        int64
+	VehicleID int64
+	DriverID  int64
+	FueledAt  time.Time
+	Litres    float64
+	FuelType  FuelType
+	Price     int64
+	Station   string
+	Odometer  int64
+	CreatedAt time.Time
+}
+
+// Segment is the distance driven on the fuel of a refuel, from the previous
+// refuel of the vehicle to this one, assuming the tank is filled up every
+// time.
+type Segment struct {
+	RefuelID  int64
+	VehicleID int64
+	DriverID  int64
+	FueledAt  time.Time
+	Distance  int64
+	Litres    float64
+	Outlier   bool
+}
+
+func (s *Segment) KmPerLitre() float64 {
+	if s.Litres <= 0 {
+		return 0
+	}
+
+	return float64(s.Distance) / s.Litres
+}
+
+// Efficiency sums up the segments of a vehicle or a driver over a period.
+type Efficiency struct {
+	Distance int64
+	Litres   float64
+	Segments []Segment
+}
+
+func (e *Efficiency) KmPerLitre() float64 {
+	if e.Litres <= 0 {
+		return 0
+	}
+
+	return float64(e.Distance) / e.Litres
+}
+
+// FlagOutliers flags the segments whose consumption is further than
+// tolerance percent from the median of their vehicle. Vehicles with fewer
+// than MINIMUM_SEGMENTS segments are left alone.
+func FlagOutliers(segments []Segment, tolerance int) {
+	byVehicle := make(map[int64][]float64)
+	for _, s := range segments {
+		byVehicle[s.VehicleID] = append(byVehicle[s.VehicleID], s.KmPerLitre())
+	}
+
+	medians := make(map[int64]float64, len(byVehicle))
+	for vehicleID, values := range byVehicle {
+		if len(values) < MINIMUM_SEGMENTS {
+			continue
+		}
+
+		sort.Float64s(values)
+		medians[vehicleID] = values[len(values)/2]
+		if len(values)%2 == 0 {
+			medians[vehicleID] = (values[len(values)/2-1] + values[len(values)/2]) / 2
+		}
+	}
+
+	margin := float64(tolerance) / 100
+	for i := range segments {
+		median, ok := medians[segments[i].VehicleID]
+		if !ok {
+			continue
+		}
+
+		kmPerLitre := segments[i].KmPerLitre()
+		segments[i].Outlier = kmPerLitre < median*(1-margin) || kmPerLitre > median*(1+margin)
+	}
+}
+
+type RefuelSpecification struct {
+	VehicleID, DriverID int64
+	From, To            time.Time
+	Page, PageSize      int
+}
+
+// EfficiencySpecification computes the efficiency of a vehicle or of a
+// driver over the refuels between From and To, either of which may be zero
+// to leave the period open.
+type EfficiencySpecification struct {
+	VehicleID, DriverID int64
+	From, To            time.Time
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Refuel, error)
+	List(ctx context.Context, specification *RefuelSpecification) (*[]Refuel, error)
+	// ListSegments returns the segments of the refuels in the period, of the
+	// vehicle or, for a driver, of every vehicle the driver refuelled in the
+	// period, so that their medians can be computed.
+	ListSegments(ctx context.Context, specification *EfficiencySpecification) (*[]Segment, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, r *Refuel) (int64, error)
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// AssignmentReading is the part of the driver-vehicle use case needed to
+// find the driver of a refuel.
+type AssignmentReading interface {
+	ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error)
+}
+
+// OdometerWriting is the part of the odometer use case needed to record the
+// odometer of a refuel.
+type OdometerWriting interface {
+	Create(ctx context.Context, r *odometer.Record) (int64, error)
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Refuel, error)
+	List(ctx context.Context, specification *RefuelSpecification) (*[]Refuel, error)
+	Create(ctx context.Context, r *Refuel) (int64, error)
+	Efficiency(ctx context.Context, specification *EfficiencySpecification) (*Efficiency, error)
+}
@@ -0,0 +1,65 @@
+package fuel_test
+
+import (
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestFlagOutliers(t *testing.T) {
+	tests := []struct {
+		name     string
+		segments []fuel.Segment
+		want     []bool
+	}{
+		{
+			name: "Dado um consumo muito acima da mediana do veículo quando os desvios são sinalizados então o trecho é sinalizado",
+			segments: []fuel.Segment{
+				{VehicleID: 1, Distance: 500, Litres: 50},
+				{VehicleID: 1, Distance: 520, Litres: 50},
+				{VehicleID: 1, Distance: 200, Litres: 50},
+				{VehicleID: 1, Distance: 480, Litres: 50},
+			},
+			want: []bool{false, false, true, false},
+		},
+		{
+			name: "Dado veículos com medianas diferentes quando os desvios são sinalizados então cada trecho é comparado ao seu veículo",
+			segments: []fuel.Segment{
+				{VehicleID: 1, Distance: 500, Litres: 50},
+				{VehicleID: 1, Distance: 500, Litres: 50},
+				{VehicleID: 1, Distance: 500, Litres: 50},
+				{VehicleID: 2, Distance: 250, Litres: 50},
+				{VehicleID: 2, Distance: 250, Litres: 50},
+				{VehicleID: 2, Distance: 250, Litres: 50},
+			},
+			want: []bool{false, false, false, false, false, false},
+		},
+		{
+			name: "Dado menos trechos que o mínimo quando os desvios são sinalizados então nenhum trecho é sinalizado",
+			segments: []fuel.Segment{
+				{VehicleID: 1, Distance: 500, Litres: 50},
+				{VehicleID: 1, Distance: 100, Litres: 50},
+			},
+			want: []bool{false, false},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			fuel.FlagOutliers(test.segments, fuel.DEFAULT_OUTLIER_TOLERANCE)
+
+			actual := make([]bool, 0, len(test.segments))
+			for _, s := range test.segments {
+				actual = append(actual, s.Outlier)
+			}
+
+			assert.Equal(tt, test.want, actual)
+		})
+	}
+}
+
+func TestEfficiency_KmPerLitre(t *testing.T) {
+	assert.Equal(t, 12.5, (&fuel.Efficiency{Distance: 500, Litres: 40}).KmPerLitre())
+	assert.Equal(t, float64(0), (&fuel.Efficiency{}).KmPerLitre())
+}
@@ -0,0 +1,33 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type RefuelDTO struct {
+	bun.BaseModel `bun:"table:refuels"`
+
+	ID        int64     `bun:"id,pk,autoincrement"`
+	VehicleID int64     `bun:"vehicle_id,notnull"`
+	DriverID  int64     `bun:"driver_id,notnull"`
+	FueledAt  time.Time `bun:"fueled_at,notnull"`
+	Litres    float64   `bun:"litres,notnull"`
+	FuelType  string    `bun:"fuel_type,notnull"`
+	Price     int64     `bun:"price,notnull"`
+	Station   string    `bun:"station,nullzero"`
+	Odometer  int64     `bun:"odometer,notnull"`
+	CreatedAt time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// SegmentDTO is a row of the segments query, a refuel along with the
+// distance driven since the previous refuel of its vehicle.
+type SegmentDTO struct {
+	RefuelID  int64     `bun:"refuel_id"`
+	VehicleID int64     `bun:"vehicle_id"`
+	DriverID  int64     `bun:"driver_id"`
+	FueledAt  time.Time `bun:"fueled_at"`
+	Distance  int64     `bun:"distance"`
+	Litres    float64   `bun:"litres"`
+}
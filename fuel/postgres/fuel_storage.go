@@ -0,0 +1,140 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	"github.com/LucasMateus-eng/operations-service/fuel/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/fuel/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/uptrace/bun"
+)
+
+type fuelPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *fuelPostgresRepo {
+	return &fuelPostgresRepo{
+		db: db,
+	}
+}
+
+func (fr *fuelPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, fr.db)
+}
+
+func (fr *fuelPostgresRepo) GetByID(ctx context.Context, id int64) (*fuel.Refuel, error) {
+	refuelDTO := new(dto.RefuelDTO)
+
+	err := fr.conn(ctx).NewSelect().Model(refuelDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToRefuel(refuelDTO), nil
+}
+
+func (fr *fuelPostgresRepo) List(ctx context.Context, specification *fuel.RefuelSpecification) (*[]fuel.Refuel, error) {
+	var refuelDTOs []dto.RefuelDTO
+
+	query := fr.conn(ctx).NewSelect().Model(&refuelDTOs).Order("fueled_at DESC", "id DESC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if specification.DriverID != 0 {
+		query = query.Where("driver_id = ?", specification.DriverID)
+	}
+
+	query = during(query, "fueled_at", specification.From, specification.To)
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		query = query.Offset((specification.Page - 1) * specification.PageSize).Limit(specification.PageSize)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refuels := make([]fuel.Refuel, 0, len(refuelDTOs))
+	for _, dto := range refuelDTOs {
+		refuels = append(refuels, *mapping.MapDTOToRefuel(&dto))
+	}
+
+	return &refuels, nil
+}
+
+// ListSegments measures the distance of every refuel from the previous one
+// of its vehicle over the whole history, so that the first refuel of the
+// period has a segment as well.
+func (fr *fuelPostgresRepo) ListSegments(ctx context.Context, specification *fuel.EfficiencySpecification) (*[]fuel.Segment, error) {
+	var segmentDTOs []dto.SegmentDTO
+
+	segments := fr.conn(ctx).NewSelect().
+		TableExpr("refuels AS f").
+		ColumnExpr("f.id AS refuel_id, f.vehicle_id, f.driver_id, f.fueled_at, f.litres").
+		ColumnExpr("f.odometer - lag(f.odometer) OVER (PARTITION BY f.vehicle_id ORDER BY f.fueled_at, f.id) AS distance")
+
+	query := fr.conn(ctx).NewSelect().
+		With("segments", segments).
+		TableExpr("segments AS s").
+		ColumnExpr("s.*").
+		Where("s.distance IS NOT NULL").
+		OrderExpr("s.fueled_at ASC, s.refuel_id ASC")
+
+	query = during(query, "s.fueled_at", specification.From, specification.To)
+
+	if specification.VehicleID != 0 {
+		query = query.Where("s.vehicle_id = ?", specification.VehicleID)
+	}
+
+	if specification.DriverID != 0 {
+		vehicles := during(fr.conn(ctx).NewSelect().
+			TableExpr("refuels").
+			ColumnExpr("DISTINCT vehicle_id").
+			Where("driver_id = ?", specification.DriverID), "fueled_at", specification.From, specification.To)
+
+		query = query.Where("s.vehicle_id IN (?)", vehicles)
+	}
+
+	err := query.Scan(ctx, &segmentDTOs)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]fuel.Segment, 0, len(segmentDTOs))
+	for _, dto := range segmentDTOs {
+		result = append(result, *mapping.MapDTOToSegment(&dto))
+	}
+
+	return &result, nil
+}
+
+func (fr *fuelPostgresRepo) Create(ctx context.Context, r *fuel.Refuel) (int64, error) {
+	refuelDTO := mapping.MapRefuelToDTO(r)
+
+	_, err := fr.conn(ctx).NewInsert().Model(refuelDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return refuelDTO.ID, nil
+}
+
+// during restricts the query to the period [from, to), leaving a zero end
+// open.
+func during(query *bun.SelectQuery, column string, from, to time.Time) *bun.SelectQuery {
+	if !from.IsZero() {
+		query = query.Where("? >= ?", bun.Safe(column), from)
+	}
+
+	if !to.IsZero() {
+		query = query.Where("? < ?", bun.Safe(column), to)
+	}
+
+	return query
+}
@@ -0,0 +1,47 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	"github.com/LucasMateus-eng/operations-service/fuel/postgres/dto"
+)
+
+func MapRefuelToDTO(refuel *fuel.Refuel) *dto.RefuelDTO {
+	return &dto.RefuelDTO{
+		ID:        refuel.ID,
+		VehicleID: refuel.VehicleID,
+		DriverID:  refuel.DriverID,
+		FueledAt:  refuel.FueledAt,
+		Litres:    refuel.Litres,
+		FuelType:  string(refuel.FuelType),
+		Price:     refuel.Price,
+		Station:   refuel.Station,
+		Odometer:  refuel.Odometer,
+		CreatedAt: refuel.CreatedAt,
+	}
+}
+
+func MapDTOToRefuel(refuelDTO *dto.RefuelDTO) *fuel.Refuel {
+	return &fuel.Refuel{
+		ID:        refuelDTO.ID,
+		VehicleID: refuelDTO.VehicleID,
+		DriverID:  refuelDTO.DriverID,
+		FueledAt:  refuelDTO.FueledAt,
+		Litres:    refuelDTO.Litres,
+		FuelType:  fuel.FuelType(refuelDTO.FuelType),
+		Price:     refuelDTO.Price,
+		Station:   refuelDTO.Station,
+		Odometer:  refuelDTO.Odometer,
+		CreatedAt: refuelDTO.CreatedAt,
+	}
+}
+
+func MapDTOToSegment(segmentDTO *dto.SegmentDTO) *fuel.Segment {
+	return &fuel.Segment{
+		RefuelID:  segmentDTO.RefuelID,
+		VehicleID: segmentDTO.VehicleID,
+		DriverID:  segmentDTO.DriverID,
+		FueledAt:  segmentDTO.FueledAt,
+		Distance:  segmentDTO.Distance,
+		Litres:    segmentDTO.Litres,
+	}
+}
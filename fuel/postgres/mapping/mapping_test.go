@@ -0,0 +1,54 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	fuel_dto "github.com/LucasMateus-eng/operations-service/fuel/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapRefuelToDTO(t *testing.T) {
+	refuel := &fuel.Refuel{
+		ID:        1,
+		VehicleID: 2,
+		DriverID:  3,
+		FueledAt:  mockedTime,
+		Litres:    42.3,
+		FuelType:  fuel.GASOLINE,
+		Price:     25380,
+		Station:   "Posto Central",
+		Odometer:  10000,
+		CreatedAt: mockedTime,
+	}
+
+	expectedDTO := &fuel_dto.RefuelDTO{
+		ID:        1,
+		VehicleID: 2,
+		DriverID:  3,
+		FueledAt:  mockedTime,
+		Litres:    42.3,
+		FuelType:  "GASOLINE",
+		Price:     25380,
+		Station:   "Posto Central",
+		Odometer:  10000,
+		CreatedAt: mockedTime,
+	}
+
+	actualDTO := MapRefuelToDTO(refuel)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, refuel, MapDTOToRefuel(actualDTO))
+}
+
+func TestMapDTOToSegment(t *testing.T) {
+	segmentDTO := &fuel_dto.SegmentDTO{RefuelID: 1, VehicleID: 2, DriverID: 3, FueledAt: mockedTime, Distance: 480, Litres: 40}
+
+	expected := &fuel.Segment{RefuelID: 1, VehicleID: 2, DriverID: 3, FueledAt: mockedTime, Distance: 480, Litres: 40}
+
+	assert.Equal(t, expected, MapDTOToSegment(segmentDTO))
+}
@@ -0,0 +1,170 @@
+package fuel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+)
+
+type Service struct {
+	repo             Repository
+	auditor          audit.Recorder
+	assignments      AssignmentReading
+	odometer         OdometerWriting
+	outlierTolerance int
+	logger           *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, ar AssignmentReading, ow OdometerWriting, outlierTolerance int, l *logging.Logging) *Service {
+	if outlierTolerance <= 0 {
+		outlierTolerance = DEFAULT_OUTLIER_TOLERANCE
+	}
+
+	return &Service{
+		repo:             r,
+		auditor:          au,
+		assignments:      ar,
+		odometer:         ow,
+		outlierTolerance: outlierTolerance,
+		logger:           l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Refuel, error) {
+	s.logger.Debug("[FUEL] GetByID - DEBUG: ", map[string]any{
+		"refuelID": id,
+	})
+	refuel, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[FUEL] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return refuel, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *RefuelSpecification) (*[]Refuel, error) {
+	s.logger.Debug("[FUEL] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	refuels, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[FUEL] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return refuels, nil
+}
+
+// Create records the refuel and its odometer in the same transaction. The
+// driver is the one assigned to the vehicle when it was fueled; it only
+// needs to be given when the vehicle was assigned to more than one.
+func (s *Service) Create(ctx context.Context, r *Refuel) (int64, error) {
+	s.logger.Debug("[FUEL] Create - DEBUG: ", map[string]any{
+		"refuel": r,
+	})
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	var refuelID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		driverID, err := s.driver(ctx, r.VehicleID, r.DriverID, r.FueledAt)
+		if err != nil {
+			return nil, err
+		}
+		r.DriverID = driverID
+
+		_, err = s.odometer.Create(ctx, &odometer.Record{
+			VehicleID: r.VehicleID,
+			ReadAt:    r.FueledAt,
+			Km:        r.Odometer,
+			Source:    odometer.FUEL,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		refuelID, err = s.repo.Create(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.REFUEL, refuelID, audit.CREATE, nil, r)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[FUEL] Create - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return refuelID, nil
+}
+
+func (s *Service) driver(ctx context.Context, vehicleID, driverID int64, at time.Time) (int64, error) {
+	assignments, err := s.assignments.ListByVehicleIDAt(ctx, vehicleID, at)
+	if err != nil {
+		return 0, err
+	}
+
+	switch {
+	case driverID != 0:
+		for _, a := range *assignments {
+			if a.DriverID == driverID {
+				return driverID, nil
+			}
+		}
+
+		return 0, fmt.Errorf("%w: [%d]", ErrDriverNotAssigned, driverID)
+	case len(*assignments) == 0:
+		return 0, fmt.Errorf("%w: [%d]", ErrNoAssignedDriver, vehicleID)
+	case len(*assignments) > 1:
+		return 0, fmt.Errorf("%w: [%d]", ErrAmbiguousDriver, vehicleID)
+	}
+
+	return (*assignments)[0].DriverID, nil
+}
+
+// Efficiency computes the km/l of a vehicle or of a driver over a period,
+// flagging the refuels whose consumption is far from the usual one of their
+// vehicle, which may indicate fuel being diverted.
+func (s *Service) Efficiency(ctx context.Context, specification *EfficiencySpecification) (*Efficiency, error) {
+	s.logger.Debug("[FUEL] Efficiency - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	if (specification.VehicleID == 0) == (specification.DriverID == 0) {
+		return nil, ErrMissingEfficiencySubject
+	}
+
+	segments, err := s.repo.ListSegments(ctx, specification)
+	if err != nil {
+		s.logger.Error("[FUEL] Efficiency - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	FlagOutliers(*segments, s.outlierTolerance)
+
+	efficiency := &Efficiency{Segments: make([]Segment, 0, len(*segments))}
+	for _, segment := range *segments {
+		if specification.DriverID != 0 && segment.DriverID != specification.DriverID {
+			continue
+		}
+
+		efficiency.Distance += segment.Distance
+		efficiency.Litres += segment.Litres
+		efficiency.Segments = append(efficiency.Segments, segment)
+	}
+
+	return efficiency, nil
+}
@@ -0,0 +1,305 @@
+package fuel_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	fuel_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/fuel"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	fueledAt      = time.Now().Add(-time.Hour)
+)
+
+func TestService_Create(t *testing.T) {
+	type serviceMocks struct {
+		repo        *fuel_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		assignments *fuel_mocks.MockAssignmentReading
+		odometer    *fuel_mocks.MockOdometerWriting
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		refuel *fuel.Refuel
+	}
+
+	odometerRecord := &odometer.Record{VehicleID: 1, ReadAt: fueledAt, Km: 10500, Source: odometer.FUEL}
+
+	tests := []struct {
+		name         string
+		args         args
+		prepareMock  func(p args, m serviceMocks)
+		want         int64
+		wantDriverID int64
+		wantErr      error
+	}{
+		{
+			name: "Dado um veículo com um único motorista quando o método Create é chamado então o abastecimento é atribuído a ele",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, Litres: 40, FuelType: fuel.DIESEL, Price: 24000, Odometer: 10500},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.refuel.VehicleID, p.refuel.FueledAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}}, nil)
+				m.odometer.EXPECT().Create(p.ctx, odometerRecord).Return(int64(3), nil)
+				m.repo.EXPECT().Create(p.ctx, p.refuel).Return(int64(5), nil)
+			},
+			want:         5,
+			wantDriverID: 7,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado um motorista informado entre os do veículo quando o método Create é chamado então o abastecimento é criado",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, DriverID: 8, FueledAt: fueledAt, Litres: 40, FuelType: fuel.DIESEL, Price: 24000, Odometer: 10500},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.refuel.VehicleID, p.refuel.FueledAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}, {DriverID: 8, VehicleID: 1}}, nil)
+				m.odometer.EXPECT().Create(p.ctx, odometerRecord).Return(int64(3), nil)
+				m.repo.EXPECT().Create(p.ctx, p.refuel).Return(int64(5), nil)
+			},
+			want:         5,
+			wantDriverID: 8,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado um veículo com vários motoristas quando o método Create é chamado sem motorista então o abastecimento não é criado",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, Litres: 40, FuelType: fuel.DIESEL, Price: 24000, Odometer: 10500},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.refuel.VehicleID, p.refuel.FueledAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}, {DriverID: 8, VehicleID: 1}}, nil)
+			},
+			want:         0,
+			wantDriverID: 0,
+			wantErr:      fuel.ErrAmbiguousDriver,
+		},
+		{
+			name: "Dado um veículo sem motorista quando o método Create é chamado então o abastecimento não é criado",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, Litres: 40, FuelType: fuel.DIESEL, Price: 24000, Odometer: 10500},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.refuel.VehicleID, p.refuel.FueledAt).Return(&[]drivervehicle.DriverVehicle{}, nil)
+			},
+			want:         0,
+			wantDriverID: 0,
+			wantErr:      fuel.ErrNoAssignedDriver,
+		},
+		{
+			name: "Dado um motorista que não dirige o veículo quando o método Create é chamado então o abastecimento não é criado",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, DriverID: 9, FueledAt: fueledAt, Litres: 40, FuelType: fuel.DIESEL, Price: 24000, Odometer: 10500},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.refuel.VehicleID, p.refuel.FueledAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}}, nil)
+			},
+			want:         0,
+			wantDriverID: 9,
+			wantErr:      fuel.ErrDriverNotAssigned,
+		},
+		{
+			name: "Dado um hodômetro menor que a leitura anterior quando o método Create é chamado então o abastecimento não é criado",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, Litres: 40, FuelType: fuel.DIESEL, Price: 24000, Odometer: 10500},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.refuel.VehicleID, p.refuel.FueledAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 7, VehicleID: 1}}, nil)
+				m.odometer.EXPECT().Create(p.ctx, odometerRecord).Return(int64(0), odometer.ErrDecreasingReading)
+			},
+			want:         0,
+			wantDriverID: 7,
+			wantErr:      odometer.ErrDecreasingReading,
+		},
+		{
+			name: "Dado um abastecimento inválido quando o método Create é chamado então o abastecimento não é criado",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, FuelType: fuel.DIESEL},
+			},
+			want:         0,
+			wantDriverID: 0,
+			wantErr:      fuel.ErrInvalidRefuel,
+		},
+		{
+			name: "Dado um erro do repositório quando o método Create é chamado então o erro é retornado",
+			args: args{
+				ctx:    mockedContext,
+				refuel: &fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, Litres: 40, FuelType: fuel.DIESEL, Price: 24000, Odometer: 10500},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.refuel.VehicleID, p.refuel.FueledAt).Return(nil, errMocked)
+			},
+			want:         0,
+			wantDriverID: 0,
+			wantErr:      errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        fuel_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				assignments: fuel_mocks.NewMockAssignmentReading(ctrl),
+				odometer:    fuel_mocks.NewMockOdometerWriting(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := fuel.NewService(sm.repo, sm.auditor, sm.assignments, sm.odometer, 0, sm.logger)
+
+			actualID, err := s.Create(test.args.ctx, test.args.refuel)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, test.wantDriverID, test.args.refuel.DriverID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_Efficiency(t *testing.T) {
+	type serviceMocks struct {
+		repo        *fuel_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		assignments *fuel_mocks.MockAssignmentReading
+		odometer    *fuel_mocks.MockOdometerWriting
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx           context.Context
+		specification *fuel.EfficiencySpecification
+	}
+
+	segments := []fuel.Segment{
+		{RefuelID: 1, VehicleID: 1, DriverID: 7, Distance: 500, Litres: 50},
+		{RefuelID: 2, VehicleID: 1, DriverID: 8, Distance: 520, Litres: 50},
+		{RefuelID: 3, VehicleID: 1, DriverID: 7, Distance: 200, Litres: 50},
+		{RefuelID: 4, VehicleID: 1, DriverID: 8, Distance: 480, Litres: 50},
+	}
+
+	tests := []struct {
+		name         string
+		args         args
+		prepareMock  func(p args, m serviceMocks)
+		wantDistance int64
+		wantLitres   float64
+		wantOutliers []int64
+		wantErr      error
+	}{
+		{
+			name: "Dado um veículo quando o método Efficiency é chamado então todos os trechos são somados",
+			args: args{
+				ctx:           mockedContext,
+				specification: &fuel.EfficiencySpecification{VehicleID: 1},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				actual := append([]fuel.Segment{}, segments...)
+				m.repo.EXPECT().ListSegments(p.ctx, p.specification).Return(&actual, nil)
+			},
+			wantDistance: 1700,
+			wantLitres:   200,
+			wantOutliers: []int64{3},
+			wantErr:      nil,
+		},
+		{
+			name: "Dado um motorista quando o método Efficiency é chamado então só os seus trechos são somados, comparados à mediana do veículo",
+			args: args{
+				ctx:           mockedContext,
+				specification: &fuel.EfficiencySpecification{DriverID: 7},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				actual := append([]fuel.Segment{}, segments...)
+				m.repo.EXPECT().ListSegments(p.ctx, p.specification).Return(&actual, nil)
+			},
+			wantDistance: 700,
+			wantLitres:   100,
+			wantOutliers: []int64{3},
+			wantErr:      nil,
+		},
+		{
+			name: "Dado um veículo e um motorista quando o método Efficiency é chamado então um erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				specification: &fuel.EfficiencySpecification{VehicleID: 1, DriverID: 7},
+			},
+			wantErr: fuel.ErrMissingEfficiencySubject,
+		},
+		{
+			name: "Dado um erro do repositório quando o método Efficiency é chamado então o erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				specification: &fuel.EfficiencySpecification{VehicleID: 1},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListSegments(p.ctx, p.specification).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        fuel_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				assignments: fuel_mocks.NewMockAssignmentReading(ctrl),
+				odometer:    fuel_mocks.NewMockOdometerWriting(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := fuel.NewService(sm.repo, sm.auditor, sm.assignments, sm.odometer, 0, sm.logger)
+
+			efficiency, err := s.Efficiency(test.args.ctx, test.args.specification)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err != nil {
+				return
+			}
+
+			var outliers []int64
+			for _, s := range efficiency.Segments {
+				if s.Outlier {
+					outliers = append(outliers, s.RefuelID)
+				}
+			}
+
+			assert.Equal(tt, test.wantDistance, efficiency.Distance)
+			assert.Equal(tt, test.wantLitres, efficiency.Litres)
+			assert.Equal(tt, test.wantOutliers, outliers)
+		})
+	}
+}
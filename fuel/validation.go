@@ -0,0 +1,52 @@
+package fuel
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrInvalidRefuel = errors.New("the given refuel is invalid")
+
+	ErrMissingVehicle    = errors.New("the refuel must belong to a vehicle")
+	ErrInvalidFueledAt   = errors.New("the refuel date cannot be empty or in the future")
+	ErrNonPositiveLitres = errors.New("the refuelled litres must be positive")
+	ErrNegativePrice     = errors.New("the refuel price cannot be negative")
+	ErrNegativeOdometer  = errors.New("the refuel odometer cannot be negative")
+)
+
+// Validate returns every rule broken by the refuel joined in a single error.
+func (r *Refuel) Validate() error {
+	var errs []error
+
+	if r.VehicleID <= 0 {
+		errs = append(errs, ErrMissingVehicle)
+	}
+
+	if r.FueledAt.IsZero() || r.FueledAt.After(time.Now()) {
+		errs = append(errs, ErrInvalidFueledAt)
+	}
+
+	if r.Litres <= 0 {
+		errs = append(errs, ErrNonPositiveLitres)
+	}
+
+	if _, err := GetFuelType(string(r.FuelType)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if r.Price < 0 {
+		errs = append(errs, ErrNegativePrice)
+	}
+
+	if r.Odometer < 0 {
+		errs = append(errs, ErrNegativeOdometer)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidRefuel, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package fuel_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestRefuel_Validate(t *testing.T) {
+	fueledAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		refuel   fuel.Refuel
+		wantErrs []error
+	}{
+		{
+			name:   "Dado um abastecimento válido quando a validação é chamada então nenhum erro é retornado",
+			refuel: fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, Litres: 40.5, FuelType: fuel.ETHANOL, Price: 19845, Odometer: 10000},
+		},
+		{
+			name:     "Dado um combustível desconhecido quando a validação é chamada então um erro é retornado",
+			refuel:   fuel.Refuel{VehicleID: 1, FueledAt: fueledAt, Litres: 40.5, FuelType: "HYDROGEN", Odometer: 10000},
+			wantErrs: []error{fuel.ErrInvalidRefuel, fuel.ErrInvalidFuelType},
+		},
+		{
+			name:   "Dado um abastecimento vazio no futuro quando a validação é chamada então todas as regras quebradas são retornadas",
+			refuel: fuel.Refuel{FueledAt: time.Now().Add(time.Hour), FuelType: fuel.DIESEL, Price: -1, Odometer: -1},
+			wantErrs: []error{
+				fuel.ErrInvalidRefuel, fuel.ErrMissingVehicle, fuel.ErrInvalidFueledAt, fuel.ErrNonPositiveLitres, fuel.ErrNegativePrice, fuel.ErrNegativeOdometer,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.refuel.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package incident
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+type Type string
+
+const (
+	ACCIDENT  Type = "ACCIDENT"
+	BREAKDOWN Type = "BREAKDOWN"
+	THEFT     Type = "THEFT"
+	ROBBERY   Type = "ROBBERY"
+)
+
+var (
+	types = []Type{ACCIDENT, BREAKDOWN, THEFT, ROBBERY}
+
+	ErrInvalidType = errors.New("the incident type must be one of ACCIDENT, BREAKDOWN, THEFT or ROBBERY")
+)
+
+func GetType(name string) (Type, error) {
+	t := Type(name)
+	if !slices.Contains(types, t) {
+		return "", ErrInvalidType
+	}
+
+	return t, nil
+}
+
+// Stolen tells the incidents that take the vehicle away from the fleet,
+// furto and roubo.
+func (t Type) Stolen() bool {
+	return t == THEFT || t == ROBBERY
+}
+
+// ThirdParty is someone else involved in the incident, such as the driver
+// of the other vehicle of an accident. Document is their CPF or CNPJ.
+type ThirdParty struct {
+	Name     string
+	Document string
+	Phone    string
+	Plate    string
+	Insurer  string
+}
+
+// CostEstimate is a part of the cost of the incident, such as a repair or
+// the towing, in cents.
+type CostEstimate struct {
+	Description string
+	Amount      int64
+}
+
+// Incident is an accident, breakdown, theft or robbery of a vehicle. The
+// driver is the one assigned to the vehicle when it occurred, if there was
+// exactly one. PoliceReport is the number of the boletim de ocorrência.
+type Incident struct {
+	ID            int64
+	Type          Type
+	VehicleID     int64
+	DriverID      int64
+	OccurredAt    time.Time
+	Location      string
+	Description   string
+	PoliceReport  string
+	ThirdParties  []ThirdParty
+	CostEstimates []CostEstimate
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// EstimatedCost sums the cost estimates of the incident, in cents.
+func (i *Incident) EstimatedCost() int64 {
+	var total int64
+	for _, e := range i.CostEstimates {
+		total += e.Amount
+	}
+
+	return total
+}
+
+// IncidentSpecification filters the incidents. From and To bound when they
+// occurred, either of which may be zero to leave the period open.
+type IncidentSpecification struct {
+	VehicleID, DriverID int64
+	Type                Type
+	From, To            time.Time
+	Page, PageSize      int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Incident, error)
+	List(ctx context.Context, specification *IncidentSpecification) (*[]Incident, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, i *Incident) (int64, error)
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// VehicleWriting is the part of the vehicle use case needed to find the
+// vehicle of an incident and to mark it as stolen.
+type VehicleWriting interface {
+	GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error)
+	Patch(ctx context.Context, v *vehicle.Vehicle, fields []string) error
+}
+
+// AssignmentReading is the part of the driver-vehicle use case needed to
+// attribute an incident to a driver and to know which assignments a theft
+// ends.
+type AssignmentReading interface {
+	ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]drivervehicle.DriverVehicle, error)
+	ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error)
+}
+
+// AssignmentWriting is the part of the driver-vehicle repository needed to
+// end the assignments of a stolen vehicle.
+type AssignmentWriting interface {
+	EndByVehicleID(ctx context.Context, vehicleID int64) error
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Incident, error)
+	List(ctx context.Context, specification *IncidentSpecification) (*[]Incident, error)
+	Report(ctx context.Context, i *Incident) (int64, error)
+}
@@ -0,0 +1,37 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ThirdPartyDTO struct {
+	Name     string `json:"name"`
+	Document string `json:"document,omitempty"`
+	Phone    string `json:"phone,omitempty"`
+	Plate    string `json:"plate,omitempty"`
+	Insurer  string `json:"insurer,omitempty"`
+}
+
+type CostEstimateDTO struct {
+	Description string `json:"description"`
+	Amount      int64  `json:"amount"`
+}
+
+type IncidentDTO struct {
+	bun.BaseModel `bun:"table:incidents"`
+
+	ID            int64             `bun:"id,pk,autoincrement"`
+	Type          string            `bun:"type,notnull"`
+	VehicleID     int64             `bun:"vehicle_id,notnull"`
+	DriverID      int64             `bun:"driver_id,nullzero"`
+	OccurredAt    time.Time         `bun:"occurred_at,notnull"`
+	Location      string            `bun:"location,notnull"`
+	Description   string            `bun:"description,notnull"`
+	PoliceReport  string            `bun:"police_report,nullzero"`
+	ThirdParties  []ThirdPartyDTO   `bun:"third_parties,type:jsonb,notnull"`
+	CostEstimates []CostEstimateDTO `bun:"cost_estimates,type:jsonb,notnull"`
+	CreatedAt     time.Time         `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt     time.Time         `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
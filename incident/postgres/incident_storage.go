@@ -0,0 +1,94 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/incident"
+	"github.com/LucasMateus-eng/operations-service/incident/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/incident/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/uptrace/bun"
+)
+
+type incidentPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *incidentPostgresRepo {
+	return &incidentPostgresRepo{
+		db: db,
+	}
+}
+
+func (ir *incidentPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, ir.db)
+}
+
+func (ir *incidentPostgresRepo) GetByID(ctx context.Context, id int64) (*incident.Incident, error) {
+	incidentDTO := new(dto.IncidentDTO)
+
+	err := ir.conn(ctx).NewSelect().Model(incidentDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToIncident(incidentDTO), nil
+}
+
+// List returns the incidents, the latest to occur first.
+func (ir *incidentPostgresRepo) List(ctx context.Context, specification *incident.IncidentSpecification) (*[]incident.Incident, error) {
+	var incidentDTOs []dto.IncidentDTO
+
+	query := ir.conn(ctx).NewSelect().Model(&incidentDTOs).Order("occurred_at DESC", "id DESC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if specification.DriverID != 0 {
+		query = query.Where("driver_id = ?", specification.DriverID)
+	}
+
+	if len(specification.Type) > 0 {
+		query = query.Where("type = ?", specification.Type)
+	}
+
+	if !specification.From.IsZero() {
+		query = query.Where("occurred_at >= ?", specification.From)
+	}
+
+	if !specification.To.IsZero() {
+		query = query.Where("occurred_at < ?", specification.To)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	incidents := make([]incident.Incident, 0, len(incidentDTOs))
+	for _, dto := range incidentDTOs {
+		incidents = append(incidents, *mapping.MapDTOToIncident(&dto))
+	}
+
+	return &incidents, nil
+}
+
+func (ir *incidentPostgresRepo) Create(ctx context.Context, i *incident.Incident) (int64, error) {
+	incidentDTO := mapping.MapIncidentToDTO(i)
+
+	_, err := ir.conn(ctx).NewInsert().Model(incidentDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return incidentDTO.ID, nil
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
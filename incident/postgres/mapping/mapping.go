@@ -0,0 +1,78 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/incident"
+	"github.com/LucasMateus-eng/operations-service/incident/postgres/dto"
+)
+
+func MapIncidentToDTO(i *incident.Incident) *dto.IncidentDTO {
+	thirdParties := make([]dto.ThirdPartyDTO, 0, len(i.ThirdParties))
+	for _, p := range i.ThirdParties {
+		thirdParties = append(thirdParties, dto.ThirdPartyDTO{
+			Name:     p.Name,
+			Document: p.Document,
+			Phone:    p.Phone,
+			Plate:    p.Plate,
+			Insurer:  p.Insurer,
+		})
+	}
+
+	costEstimates := make([]dto.CostEstimateDTO, 0, len(i.CostEstimates))
+	for _, e := range i.CostEstimates {
+		costEstimates = append(costEstimates, dto.CostEstimateDTO{
+			Description: e.Description,
+			Amount:      e.Amount,
+		})
+	}
+
+	return &dto.IncidentDTO{
+		ID:            i.ID,
+		Type:          string(i.Type),
+		VehicleID:     i.VehicleID,
+		DriverID:      i.DriverID,
+		OccurredAt:    i.OccurredAt,
+		Location:      i.Location,
+		Description:   i.Description,
+		PoliceReport:  i.PoliceReport,
+		ThirdParties:  thirdParties,
+		CostEstimates: costEstimates,
+		CreatedAt:     i.CreatedAt,
+		UpdatedAt:     i.UpdatedAt,
+	}
+}
+
+func MapDTOToIncident(incidentDTO *dto.IncidentDTO) *incident.Incident {
+	thirdParties := make([]incident.ThirdParty, 0, len(incidentDTO.ThirdParties))
+	for _, p := range incidentDTO.ThirdParties {
+		thirdParties = append(thirdParties, incident.ThirdParty{
+			Name:     p.Name,
+			Document: p.Document,
+			Phone:    p.Phone,
+			Plate:    p.Plate,
+			Insurer:  p.Insurer,
+		})
+	}
+
+	costEstimates := make([]incident.CostEstimate, 0, len(incidentDTO.CostEstimates))
+	for _, e := range incidentDTO.CostEstimates {
+		costEstimates = append(costEstimates, incident.CostEstimate{
+			Description: e.Description,
+			Amount:      e.Amount,
+		})
+	}
+
+	return &incident.Incident{
+		ID:            incidentDTO.ID,
+		Type:          incident.Type(incidentDTO.Type),
+		VehicleID:     incidentDTO.VehicleID,
+		DriverID:      incidentDTO.DriverID,
+		OccurredAt:    incidentDTO.OccurredAt,
+		Location:      incidentDTO.Location,
+		Description:   incidentDTO.Description,
+		PoliceReport:  incidentDTO.PoliceReport,
+		ThirdParties:  thirdParties,
+		CostEstimates: costEstimates,
+		CreatedAt:     incidentDTO.CreatedAt,
+		UpdatedAt:     incidentDTO.UpdatedAt,
+	}
+}
@@ -0,0 +1,50 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/incident"
+	incident_dto "github.com/LucasMateus-eng/operations-service/incident/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapIncidentToDTO(t *testing.T) {
+	i := &incident.Incident{
+		ID:            1,
+		Type:          incident.ACCIDENT,
+		VehicleID:     2,
+		DriverID:      3,
+		OccurredAt:    mockedTime,
+		Location:      "Av. Paulista, 1000",
+		Description:   "Colisão traseira no semáforo",
+		PoliceReport:  "2026-000123",
+		ThirdParties:  []incident.ThirdParty{{Name: "Maria Souza", Document: "12345678909", Plate: "ABC1D23", Insurer: "Porto"}},
+		CostEstimates: []incident.CostEstimate{{Description: "Para-choque", Amount: 150000}},
+		CreatedAt:     mockedTime,
+		UpdatedAt:     mockedTime,
+	}
+
+	expectedDTO := &incident_dto.IncidentDTO{
+		ID:            1,
+		Type:          "ACCIDENT",
+		VehicleID:     2,
+		DriverID:      3,
+		OccurredAt:    mockedTime,
+		Location:      "Av. Paulista, 1000",
+		Description:   "Colisão traseira no semáforo",
+		PoliceReport:  "2026-000123",
+		ThirdParties:  []incident_dto.ThirdPartyDTO{{Name: "Maria Souza", Document: "12345678909", Plate: "ABC1D23", Insurer: "Porto"}},
+		CostEstimates: []incident_dto.CostEstimateDTO{{Description: "Para-choque", Amount: 150000}},
+		CreatedAt:     mockedTime,
+		UpdatedAt:     mockedTime,
+	}
+
+	actualDTO := MapIncidentToDTO(i)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, i, MapDTOToIncident(actualDTO))
+}
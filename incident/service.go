@@ -0,0 +1,161 @@
+package incident
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+type Service struct {
+	repo              Repository
+	auditor           audit.Recorder
+	events            outbox.Emitter
+	vehicles          VehicleWriting
+	assignments       AssignmentReading
+	assignmentWriting AssignmentWriting
+	logger            *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, em outbox.Emitter, vw VehicleWriting, ar AssignmentReading, aw AssignmentWriting, l *logging.Logging) *Service {
+	return &Service{
+		repo:              r,
+		auditor:           au,
+		events:            em,
+		vehicles:          vw,
+		assignments:       ar,
+		assignmentWriting: aw,
+		logger:            l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Incident, error) {
+	s.logger.Debug("[INCIDENT] GetByID - DEBUG: ", map[string]any{
+		"incidentID": id,
+	})
+	incident, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[INCIDENT] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return incident, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *IncidentSpecification) (*[]Incident, error) {
+	s.logger.Debug("[INCIDENT] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	incidents, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[INCIDENT] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return incidents, nil
+}
+
+// Report records the incident, attributing it to the driver assigned to the
+// vehicle when it occurred. A theft or a robbery also marks the vehicle as
+// stolen and ends its assignments.
+func (s *Service) Report(ctx context.Context, i *Incident) (int64, error) {
+	s.logger.Debug("[INCIDENT] Report - DEBUG: ", map[string]any{
+		"incident": i,
+	})
+	if err := i.Validate(); err != nil {
+		return 0, err
+	}
+
+	var incidentID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		v, err := s.vehicles.GetByID(ctx, i.VehicleID)
+		if err != nil {
+			return nil, err
+		}
+
+		assignments, err := s.assignments.ListByVehicleIDAt(ctx, i.VehicleID, i.OccurredAt)
+		if err != nil {
+			return nil, err
+		}
+
+		i.DriverID = 0
+		if len(*assignments) == 1 {
+			i.DriverID = (*assignments)[0].DriverID
+		}
+
+		incidentID, err = s.repo.Create(ctx, i)
+		if err != nil {
+			return nil, err
+		}
+
+		entries := []*audit.Entry{audit.NewEntry(audit.INCIDENT, incidentID, audit.CREATE, nil, i)}
+		if i.Type.Stolen() {
+			unassigned, err := s.markStolen(ctx, v)
+			if err != nil {
+				return nil, err
+			}
+			entries = append(entries, unassigned...)
+		}
+
+		return entries, nil
+	})
+	if err != nil {
+		s.logger.Error("[INCIDENT] Report - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return incidentID, nil
+}
+
+// markStolen takes the vehicle out of the fleet: it becomes STOLEN, unless
+// it already is, and no driver is assigned to it anymore. Every assignment
+// it ends is unassigned as by the driver-vehicle use case, with an event and
+// an audit entry, which it returns.
+func (s *Service) markStolen(ctx context.Context, v *vehicle.Vehicle) ([]*audit.Entry, error) {
+	if v.LegalInformation.Licensing.Status != vehicle.STOLEN {
+		status, err := v.LegalInformation.Licensing.Status.Change(vehicle.STOLEN)
+		if err != nil {
+			return nil, err
+		}
+
+		v.LegalInformation.Licensing.Status = *status
+		if err := s.vehicles.Patch(ctx, v, []string{"licensing_status"}); err != nil {
+			return nil, err
+		}
+	}
+
+	assignments, err := s.assignments.ListByVehicleIDs(ctx, []int64{v.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.assignmentWriting.EndByVehicleID(ctx, v.ID); err != nil {
+		return nil, err
+	}
+
+	entries := make([]*audit.Entry, 0, len(*assignments))
+	events := make([]*outbox.Event, 0, len(*assignments))
+	for _, dv := range *assignments {
+		event, err := outbox.NewUnassignedEvent(dv.DriverID, dv.VehicleID)
+		if err != nil {
+			return nil, err
+		}
+
+		events = append(events, event)
+		entries = append(entries, audit.NewAssignmentEntry(dv.DriverID, dv.VehicleID, audit.DELETE, nil, nil))
+	}
+
+	if err := s.events.Emit(ctx, events...); err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
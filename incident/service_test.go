@@ -0,0 +1,190 @@
+package incident_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/incident"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	incident_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/incident"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	occurredAt    = time.Date(2026, 3, 10, 14, 30, 0, 0, time.UTC)
+)
+
+func TestService_Report(t *testing.T) {
+	type serviceMocks struct {
+		repo              *incident_mocks.MockRepository
+		auditor           *audit_mocks.MockRecorder
+		events            *outbox_mocks.MockEmitter
+		vehicles          *incident_mocks.MockVehicleWriting
+		assignments       *incident_mocks.MockAssignmentReading
+		assignmentWriting *incident_mocks.MockAssignmentWriting
+		logger            *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		i   *incident.Incident
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        int64
+		wantDriver  int64
+		wantErr     error
+	}{
+		{
+			name: "Dado um acidente com um único motorista vinculado quando o método Report é chamado então o incidente é atribuído a ele",
+			args: args{
+				ctx: mockedContext,
+				i:   &incident.Incident{Type: incident.ACCIDENT, VehicleID: 2, OccurredAt: occurredAt, Location: "Rua Augusta, 500 - São Paulo/SP", Description: "Ocorrência registrada pelo motorista"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByID(p.ctx, p.i.VehicleID).Return(&vehicle.Vehicle{ID: 2, LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.REGULAR}}}, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.i.VehicleID, p.i.OccurredAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 1, VehicleID: 2}}, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(7), nil)
+			},
+			want:       7,
+			wantDriver: 1,
+			wantErr:    nil,
+		},
+		{
+			name: "Dado uma pane em veículo com mais de um motorista vinculado quando o método Report é chamado então o incidente fica sem motorista",
+			args: args{
+				ctx: mockedContext,
+				i:   &incident.Incident{Type: incident.BREAKDOWN, VehicleID: 2, OccurredAt: occurredAt, Location: "Rua Augusta, 500 - São Paulo/SP", Description: "Ocorrência registrada pelo motorista"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByID(p.ctx, p.i.VehicleID).Return(&vehicle.Vehicle{ID: 2, LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.REGULAR}}}, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.i.VehicleID, p.i.OccurredAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 1}, {DriverID: 3}}, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(7), nil)
+			},
+			want:       7,
+			wantDriver: 0,
+			wantErr:    nil,
+		},
+		{
+			name: "Dado um furto quando o método Report é chamado então o veículo passa a roubado e cada vínculo encerrado gera um evento de desvinculação",
+			args: args{
+				ctx: mockedContext,
+				i:   &incident.Incident{Type: incident.THEFT, VehicleID: 2, OccurredAt: occurredAt, Location: "Rua Augusta, 500 - São Paulo/SP", Description: "Ocorrência registrada pelo motorista"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByID(p.ctx, p.i.VehicleID).Return(&vehicle.Vehicle{ID: 2, LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.REGULAR}}}, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.i.VehicleID, p.i.OccurredAt).Return(&[]drivervehicle.DriverVehicle{{DriverID: 1}}, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(7), nil)
+				m.vehicles.EXPECT().Patch(p.ctx, &vehicle.Vehicle{ID: 2, LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.STOLEN}}}, []string{"licensing_status"}).Return(nil)
+				m.assignments.EXPECT().ListByVehicleIDs(p.ctx, []int64{2}).Return(&[]drivervehicle.DriverVehicle{{DriverID: 1, VehicleID: 2}, {DriverID: 3, VehicleID: 2}}, nil)
+				m.assignmentWriting.EXPECT().EndByVehicleID(p.ctx, int64(2)).Return(nil)
+				m.events.EXPECT().Emit(p.ctx, gomock.Cond(func(x any) bool {
+					e := x.(*outbox.Event)
+					return e.Type == outbox.DRIVER_VEHICLE_UNASSIGNED && e.AggregateID == "1:2"
+				}), gomock.Cond(func(x any) bool {
+					e := x.(*outbox.Event)
+					return e.Type == outbox.DRIVER_VEHICLE_UNASSIGNED && e.AggregateID == "3:2"
+				})).Return(nil)
+			},
+			want:       7,
+			wantDriver: 1,
+			wantErr:    nil,
+		},
+		{
+			name: "Dado um roubo de veículo já marcado como roubado quando o método Report é chamado então apenas os vínculos são encerrados",
+			args: args{
+				ctx: mockedContext,
+				i:   &incident.Incident{Type: incident.ROBBERY, VehicleID: 2, OccurredAt: occurredAt, Location: "Rua Augusta, 500 - São Paulo/SP", Description: "Ocorrência registrada pelo motorista"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByID(p.ctx, p.i.VehicleID).Return(&vehicle.Vehicle{ID: 2, LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.STOLEN}}}, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.i.VehicleID, p.i.OccurredAt).Return(&[]drivervehicle.DriverVehicle{}, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(7), nil)
+				m.assignments.EXPECT().ListByVehicleIDs(p.ctx, []int64{2}).Return(&[]drivervehicle.DriverVehicle{}, nil)
+				m.assignmentWriting.EXPECT().EndByVehicleID(p.ctx, int64(2)).Return(nil)
+				m.events.EXPECT().Emit(p.ctx).Return(nil)
+			},
+			want:       7,
+			wantDriver: 0,
+			wantErr:    nil,
+		},
+		{
+			name: "Dado um erro ao marcar o veículo como roubado quando o método Report é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				i:   &incident.Incident{Type: incident.THEFT, VehicleID: 2, OccurredAt: occurredAt, Location: "Rua Augusta, 500 - São Paulo/SP", Description: "Ocorrência registrada pelo motorista"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByID(p.ctx, p.i.VehicleID).Return(&vehicle.Vehicle{ID: 2, LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.REGULAR}}}, nil)
+				m.assignments.EXPECT().ListByVehicleIDAt(p.ctx, p.i.VehicleID, p.i.OccurredAt).Return(&[]drivervehicle.DriverVehicle{}, nil)
+				m.repo.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(7), nil)
+				m.vehicles.EXPECT().Patch(p.ctx, gomock.Any(), []string{"licensing_status"}).Return(errMocked)
+			},
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado um veículo inexistente quando o método Report é chamado então o incidente não é registrado",
+			args: args{
+				ctx: mockedContext,
+				i:   &incident.Incident{Type: incident.ACCIDENT, VehicleID: 2, OccurredAt: occurredAt, Location: "Rua Augusta, 500 - São Paulo/SP", Description: "Ocorrência registrada pelo motorista"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.vehicles.EXPECT().GetByID(p.ctx, p.i.VehicleID).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado um incidente inválido quando o método Report é chamado então o incidente não é registrado",
+			args: args{
+				ctx: mockedContext,
+				i:   &incident.Incident{Type: incident.THEFT},
+			},
+			wantErr: incident.ErrInvalidIncident,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:              incident_mocks.NewMockRepository(ctrl),
+				auditor:           audit_mocks.NewPassThroughRecorder(ctrl),
+				events:            outbox_mocks.NewMockEmitter(ctrl),
+				vehicles:          incident_mocks.NewMockVehicleWriting(ctrl),
+				assignments:       incident_mocks.NewMockAssignmentReading(ctrl),
+				assignmentWriting: incident_mocks.NewMockAssignmentWriting(ctrl),
+				logger:            logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := incident.NewService(sm.repo, sm.auditor, sm.events, sm.vehicles, sm.assignments, sm.assignmentWriting, sm.logger)
+
+			actualID, err := s.Report(test.args.ctx, test.args.i)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, test.wantDriver, test.args.i.DriverID)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package incident
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidIncident = errors.New("the given incident is invalid")
+
+	ErrMissingVehicle      = errors.New("the incident must have a vehicle")
+	ErrInvalidOccurredAt   = errors.New("the incident date cannot be empty or in the future")
+	ErrEmptyLocation       = errors.New("the incident location cannot be empty")
+	ErrEmptyDescription    = errors.New("the incident description cannot be empty")
+	ErrInvalidThirdParty   = errors.New("every third party must have a name")
+	ErrInvalidCostEstimate = errors.New("every cost estimate must have a description and an amount that is not negative")
+)
+
+// Validate returns every rule broken by the incident joined in a single
+// error.
+func (i *Incident) Validate() error {
+	var errs []error
+
+	if _, err := GetType(string(i.Type)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if i.VehicleID <= 0 {
+		errs = append(errs, ErrMissingVehicle)
+	}
+
+	if i.OccurredAt.IsZero() || i.OccurredAt.After(time.Now()) {
+		errs = append(errs, ErrInvalidOccurredAt)
+	}
+
+	if strings.TrimSpace(i.Location) == "" {
+		errs = append(errs, ErrEmptyLocation)
+	}
+
+	if strings.TrimSpace(i.Description) == "" {
+		errs = append(errs, ErrEmptyDescription)
+	}
+
+	for _, p := range i.ThirdParties {
+		if strings.TrimSpace(p.Name) == "" {
+			errs = append(errs, ErrInvalidThirdParty)
+			break
+		}
+	}
+
+	for _, e := range i.CostEstimates {
+		if strings.TrimSpace(e.Description) == "" || e.Amount < 0 {
+			errs = append(errs, ErrInvalidCostEstimate)
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidIncident, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package incident_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/incident"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestIncident_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		incident incident.Incident
+		wantErrs []error
+	}{
+		{
+			name: "Dado um acidente válido quando a validação é chamada então nenhum erro é retornado",
+			incident: incident.Incident{
+				Type:          incident.ACCIDENT,
+				VehicleID:     2,
+				OccurredAt:    time.Now().Add(-time.Hour),
+				Location:      "Av. Paulista, 1000 - São Paulo/SP",
+				Description:   "Colisão traseira no semáforo",
+				PoliceReport:  "2026-000123",
+				ThirdParties:  []incident.ThirdParty{{Name: "Maria Souza", Plate: "ABC1D23"}},
+				CostEstimates: []incident.CostEstimate{{Description: "Para-choque", Amount: 150000}},
+			},
+		},
+		{
+			name: "Dado um incidente no futuro quando a validação é chamada então um erro é retornado",
+			incident: incident.Incident{
+				Type:        incident.BREAKDOWN,
+				VehicleID:   2,
+				OccurredAt:  time.Now().Add(time.Hour),
+				Location:    "BR-116, km 200",
+				Description: "Pane elétrica",
+			},
+			wantErrs: []error{incident.ErrInvalidIncident, incident.ErrInvalidOccurredAt},
+		},
+		{
+			name: "Dado um incidente vazio com terceiro e orçamento inválidos quando a validação é chamada então todas as regras quebradas são retornadas",
+			incident: incident.Incident{
+				Location:      " ",
+				ThirdParties:  []incident.ThirdParty{{Plate: "ABC1D23"}},
+				CostEstimates: []incident.CostEstimate{{Description: "Guincho", Amount: -1}},
+			},
+			wantErrs: []error{
+				incident.ErrInvalidIncident, incident.ErrInvalidType, incident.ErrMissingVehicle, incident.ErrInvalidOccurredAt,
+				incident.ErrEmptyLocation, incident.ErrEmptyDescription, incident.ErrInvalidThirdParty, incident.ErrInvalidCostEstimate,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.incident.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
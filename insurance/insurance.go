@@ -0,0 +1,197 @@
+package insurance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/incident"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+type Coverage string
+
+// Coverages of an auto insurance policy: compreensiva, only the damages to
+// third parties, or only incêndio e roubo.
+const (
+	COMPREHENSIVE  Coverage = "COMPREHENSIVE"
+	THIRD_PARTY    Coverage = "THIRD_PARTY"
+	FIRE_AND_THEFT Coverage = "FIRE_AND_THEFT"
+)
+
+type ClaimStatus string
+
+const (
+	OPEN         ClaimStatus = "OPEN"
+	UNDER_REVIEW ClaimStatus = "UNDER_REVIEW"
+	APPROVED     ClaimStatus = "APPROVED"
+	DENIED       ClaimStatus = "DENIED"
+	PAID         ClaimStatus = "PAID"
+)
+
+// DEFAULT_EXPIRY_ALERT_DAYS is how many days before its end a policy that
+// was not renewed starts raising alerts.
+const DEFAULT_EXPIRY_ALERT_DAYS = 30
+
+var (
+	coverages = []Coverage{COMPREHENSIVE, THIRD_PARTY, FIRE_AND_THEFT}
+
+	// claimTransitions are the statuses each status of a claim may move to.
+	// DENIED and PAID close the claim.
+	claimTransitions = map[ClaimStatus][]ClaimStatus{
+		OPEN:         {UNDER_REVIEW, DENIED},
+		UNDER_REVIEW: {APPROVED, DENIED},
+		APPROVED:     {PAID},
+	}
+
+	ErrInvalidCoverage        = errors.New("the policy coverage must be one of COMPREHENSIVE, THIRD_PARTY or FIRE_AND_THEFT")
+	ErrInvalidClaimStatus     = errors.New("the claim status must be one of OPEN, UNDER_REVIEW, APPROVED, DENIED or PAID")
+	ErrInvalidClaimTransition = errors.New("the claim cannot move to the given status")
+	ErrPolicyExists           = errors.New("there is already a policy with the number at the insurer")
+	ErrClaimExists            = errors.New("the incident is already claimed on the policy")
+	ErrNotCovered             = errors.New("the policy does not cover the vehicle of the incident when it occurred")
+)
+
+func GetCoverage(name string) (Coverage, error) {
+	c := Coverage(name)
+	if !slices.Contains(coverages, c) {
+		return "", ErrInvalidCoverage
+	}
+
+	return c, nil
+}
+
+func GetClaimStatus(name string) (ClaimStatus, error) {
+	s := ClaimStatus(name)
+	if s != OPEN && s != UNDER_REVIEW && s != APPROVED && s != DENIED && s != PAID {
+		return "", ErrInvalidClaimStatus
+	}
+
+	return s, nil
+}
+
+// Change moves the claim to the new status, as long as claimTransitions
+// allows it.
+func (s ClaimStatus) Change(new ClaimStatus) (ClaimStatus, error) {
+	if !slices.Contains(claimTransitions[s], new) {
+		return "", fmt.Errorf("%w: from [%s] to [%s]", ErrInvalidClaimTransition, s, new)
+	}
+
+	return new, nil
+}
+
+func (s ClaimStatus) Closed() bool {
+	return s == DENIED || s == PAID
+}
+
+// Policy is the insurance of a vehicle, valid from StartsAt until EndsAt,
+// exclusive. Premium and Deductible, the franquia, are in cents. Alert is
+// set by the use case when the policy is about to end and was not renewed.
+type Policy struct {
+	ID         int64
+	VehicleID  int64
+	Insurer    string
+	Number     string
+	Coverage   Coverage
+	StartsAt   time.Time
+	EndsAt     time.Time
+	Premium    int64
+	Deductible int64
+	Alert      bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (p *Policy) ValidAt(at time.Time) bool {
+	return !at.Before(p.StartsAt) && at.Before(p.EndsAt)
+}
+
+// ExpiresWithin tells whether the policy is still valid at now and ends in
+// the given days.
+func (p *Policy) ExpiresWithin(now time.Time, days int) bool {
+	return p.ValidAt(now) && p.EndsAt.Before(now.AddDate(0, 0, days))
+}
+
+// Claim is a sinistro reported to the insurer for an incident of the
+// insured vehicle. Number is the one given by the insurer, once known.
+// Amount is what is claimed, in cents.
+type Claim struct {
+	ID         int64
+	PolicyID   int64
+	IncidentID int64
+	VehicleID  int64
+	Number     string
+	Status     ClaimStatus
+	Amount     int64
+	Notes      string
+	ClosedAt   time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+// PolicySpecification filters the policies. ValidAt keeps the policies in
+// force at the given time.
+type PolicySpecification struct {
+	VehicleID      int64
+	Insurer        string
+	Coverage       Coverage
+	ValidAt        time.Time
+	Page, PageSize int
+}
+
+type ClaimSpecification struct {
+	PolicyID, IncidentID, VehicleID int64
+	Status                          ClaimStatus
+	Page, PageSize                  int
+}
+
+type Reading interface {
+	GetPolicy(ctx context.Context, id int64) (*Policy, error)
+	ListPolicies(ctx context.Context, specification *PolicySpecification) (*[]Policy, error)
+	// ListExpiring returns the policies valid at now that end before until,
+	// leaving out those of vehicles with a policy that ends later.
+	ListExpiring(ctx context.Context, now, until time.Time) (*[]Policy, error)
+	// HasValidPolicy tells whether any policy of the vehicle is in force at
+	// the given time.
+	HasValidPolicy(ctx context.Context, vehicleID int64, at time.Time) (bool, error)
+	GetClaim(ctx context.Context, id int64) (*Claim, error)
+	ListClaims(ctx context.Context, specification *ClaimSpecification) (*[]Claim, error)
+}
+
+type Writing interface {
+	CreatePolicy(ctx context.Context, p *Policy) (int64, error)
+	UpdatePolicy(ctx context.Context, p *Policy) error
+	CreateClaim(ctx context.Context, c *Claim) (int64, error)
+	UpdateClaim(ctx context.Context, c *Claim) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// VehicleReading is the part of the vehicle use case needed to insure a
+// vehicle.
+type VehicleReading interface {
+	GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error)
+}
+
+// IncidentReading is the part of the incident use case needed to claim an
+// incident.
+type IncidentReading interface {
+	GetByID(ctx context.Context, id int64) (*incident.Incident, error)
+}
+
+type UseCase interface {
+	GetPolicy(ctx context.Context, id int64) (*Policy, error)
+	ListPolicies(ctx context.Context, specification *PolicySpecification) (*[]Policy, error)
+	ListAlerts(ctx context.Context) (*[]Policy, error)
+	CreatePolicy(ctx context.Context, p *Policy) (int64, error)
+	UpdatePolicy(ctx context.Context, p *Policy) error
+	GetClaim(ctx context.Context, id int64) (*Claim, error)
+	ListClaims(ctx context.Context, specification *ClaimSpecification) (*[]Claim, error)
+	OpenClaim(ctx context.Context, c *Claim) (int64, error)
+	ChangeClaimStatus(ctx context.Context, id int64, status ClaimStatus, number string) (*Claim, error)
+}
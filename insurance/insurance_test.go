@@ -0,0 +1,91 @@
+package insurance_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestClaimStatus_Change(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  insurance.ClaimStatus
+		new     insurance.ClaimStatus
+		wantErr error
+	}{
+		{
+			name:   "Dado um sinistro aberto quando ele passa a análise então a mudança é aceita",
+			status: insurance.OPEN,
+			new:    insurance.UNDER_REVIEW,
+		},
+		{
+			name:   "Dado um sinistro aprovado quando ele é pago então a mudança é aceita",
+			status: insurance.APPROVED,
+			new:    insurance.PAID,
+		},
+		{
+			name:    "Dado um sinistro aberto quando ele é pago sem aprovação então a mudança é recusada",
+			status:  insurance.OPEN,
+			new:     insurance.PAID,
+			wantErr: insurance.ErrInvalidClaimTransition,
+		},
+		{
+			name:    "Dado um sinistro negado quando ele volta a análise então a mudança é recusada",
+			status:  insurance.DENIED,
+			new:     insurance.UNDER_REVIEW,
+			wantErr: insurance.ErrInvalidClaimTransition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			actual, err := test.status.Change(test.new)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, test.new, actual)
+			}
+		})
+	}
+}
+
+func TestPolicy_ExpiresWithin(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		policy    insurance.Policy
+		wantValid bool
+		wantAlert bool
+	}{
+		{
+			name:      "Dado uma apólice que termina em dez dias quando a vigência é avaliada então há alerta",
+			policy:    insurance.Policy{StartsAt: now.AddDate(-1, 0, 0), EndsAt: now.AddDate(0, 0, 10)},
+			wantValid: true,
+			wantAlert: true,
+		},
+		{
+			name:      "Dado uma apólice que termina em seis meses quando a vigência é avaliada então não há alerta",
+			policy:    insurance.Policy{StartsAt: now.AddDate(0, -6, 0), EndsAt: now.AddDate(0, 6, 0)},
+			wantValid: true,
+		},
+		{
+			name:   "Dado uma apólice que terminou agora quando a vigência é avaliada então ela não vale mais",
+			policy: insurance.Policy{StartsAt: now.AddDate(-1, 0, 0), EndsAt: now},
+		},
+		{
+			name:   "Dado uma apólice que ainda não começou quando a vigência é avaliada então ela não vale",
+			policy: insurance.Policy{StartsAt: now.AddDate(0, 0, 1), EndsAt: now.AddDate(1, 0, 0)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.wantValid, test.policy.ValidAt(now))
+			assert.Equal(tt, test.wantAlert, test.policy.ExpiresWithin(now, insurance.DEFAULT_EXPIRY_ALERT_DAYS))
+		})
+	}
+}
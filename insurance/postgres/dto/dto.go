@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type PolicyDTO struct {
+	bun.BaseModel `bun:"table:insurance_policies"`
+
+	ID         int64     `bun:"id,pk,autoincrement"`
+	VehicleID  int64     `bun:"vehicle_id,notnull"`
+	Insurer    string    `bun:"insurer,notnull"`
+	Number     string    `bun:"number,notnull"`
+	Coverage   string    `bun:"coverage,notnull"`
+	StartsAt   time.Time `bun:"starts_at,notnull"`
+	EndsAt     time.Time `bun:"ends_at,notnull"`
+	Premium    int64     `bun:"premium,notnull"`
+	Deductible int64     `bun:"deductible,notnull"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt  time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type ClaimDTO struct {
+	bun.BaseModel `bun:"table:insurance_claims"`
+
+	ID         int64     `bun:"id,pk,autoincrement"`
+	PolicyID   int64     `bun:"policy_id,notnull"`
+	IncidentID int64     `bun:"incident_id,notnull"`
+	VehicleID  int64     `bun:"vehicle_id,notnull"`
+	Number     string    `bun:"number,nullzero"`
+	Status     string    `bun:"status,notnull"`
+	Amount     int64     `bun:"amount,notnull"`
+	Notes      string    `bun:"notes,nullzero"`
+	ClosedAt   time.Time `bun:"closed_at,nullzero"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt  time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
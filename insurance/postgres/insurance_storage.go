@@ -0,0 +1,240 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	"github.com/LucasMateus-eng/operations-service/insurance/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/insurance/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/uptrace/bun"
+)
+
+type insurancePostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *insurancePostgresRepo {
+	return &insurancePostgresRepo{
+		db: db,
+	}
+}
+
+func (ir *insurancePostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, ir.db)
+}
+
+func (ir *insurancePostgresRepo) GetPolicy(ctx context.Context, id int64) (*insurance.Policy, error) {
+	policyDTO := new(dto.PolicyDTO)
+
+	err := ir.conn(ctx).NewSelect().Model(policyDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToPolicy(policyDTO), nil
+}
+
+// ListPolicies returns the policies, the latest to end first.
+func (ir *insurancePostgresRepo) ListPolicies(ctx context.Context, specification *insurance.PolicySpecification) (*[]insurance.Policy, error) {
+	var policyDTOs []dto.PolicyDTO
+
+	query := ir.conn(ctx).NewSelect().Model(&policyDTOs).Order("ends_at DESC", "id DESC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if len(specification.Insurer) > 0 {
+		query = query.Where("insurer = ?", specification.Insurer)
+	}
+
+	if len(specification.Coverage) > 0 {
+		query = query.Where("coverage = ?", specification.Coverage)
+	}
+
+	if !specification.ValidAt.IsZero() {
+		query = query.Where("starts_at <= ? AND ends_at > ?", specification.ValidAt, specification.ValidAt)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPolicies(policyDTOs), nil
+}
+
+func (ir *insurancePostgresRepo) ListExpiring(ctx context.Context, now, until time.Time) (*[]insurance.Policy, error) {
+	var policyDTOs []dto.PolicyDTO
+
+	err := ir.conn(ctx).NewSelect().
+		Model(&policyDTOs).
+		Where("?TableAlias.starts_at <= ? AND ?TableAlias.ends_at > ?", now, now).
+		Where("?TableAlias.ends_at < ?", until).
+		Where(`NOT EXISTS (
+			SELECT 1 FROM insurance_policies AS renewal
+			WHERE renewal.vehicle_id = ?TableAlias.vehicle_id AND renewal.ends_at > ?TableAlias.ends_at
+		)`).
+		Order("ends_at ASC", "id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapPolicies(policyDTOs), nil
+}
+
+func (ir *insurancePostgresRepo) HasValidPolicy(ctx context.Context, vehicleID int64, at time.Time) (bool, error) {
+	return ir.conn(ctx).NewSelect().
+		Model((*dto.PolicyDTO)(nil)).
+		Where("vehicle_id = ?", vehicleID).
+		Where("starts_at <= ? AND ends_at > ?", at, at).
+		Exists(ctx)
+}
+
+func (ir *insurancePostgresRepo) CreatePolicy(ctx context.Context, p *insurance.Policy) (int64, error) {
+	policyDTO := mapping.MapPolicyToDTO(p)
+
+	_, err := ir.conn(ctx).NewInsert().Model(policyDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return 0, insurance.ErrPolicyExists
+		}
+
+		return 0, err
+	}
+
+	return policyDTO.ID, nil
+}
+
+func (ir *insurancePostgresRepo) UpdatePolicy(ctx context.Context, p *insurance.Policy) error {
+	policyDTO := mapping.MapPolicyToDTO(p)
+
+	res, err := ir.conn(ctx).NewUpdate().
+		Model(policyDTO).
+		Column("insurer", "number", "coverage", "starts_at", "ends_at", "premium", "deductible", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return insurance.ErrPolicyExists
+		}
+
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (ir *insurancePostgresRepo) GetClaim(ctx context.Context, id int64) (*insurance.Claim, error) {
+	claimDTO := new(dto.ClaimDTO)
+
+	err := ir.conn(ctx).NewSelect().Model(claimDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToClaim(claimDTO), nil
+}
+
+// ListClaims returns the claims, the latest opened first.
+func (ir *insurancePostgresRepo) ListClaims(ctx context.Context, specification *insurance.ClaimSpecification) (*[]insurance.Claim, error) {
+	var claimDTOs []dto.ClaimDTO
+
+	query := ir.conn(ctx).NewSelect().Model(&claimDTOs).Order("created_at DESC", "id DESC")
+
+	if specification.PolicyID != 0 {
+		query = query.Where("policy_id = ?", specification.PolicyID)
+	}
+
+	if specification.IncidentID != 0 {
+		query = query.Where("incident_id = ?", specification.IncidentID)
+	}
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if len(specification.Status) > 0 {
+		query = query.Where("status = ?", specification.Status)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := make([]insurance.Claim, 0, len(claimDTOs))
+	for _, dto := range claimDTOs {
+		claims = append(claims, *mapping.MapDTOToClaim(&dto))
+	}
+
+	return &claims, nil
+}
+
+func (ir *insurancePostgresRepo) CreateClaim(ctx context.Context, c *insurance.Claim) (int64, error) {
+	claimDTO := mapping.MapClaimToDTO(c)
+
+	_, err := ir.conn(ctx).NewInsert().Model(claimDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return 0, insurance.ErrClaimExists
+		}
+
+		return 0, err
+	}
+
+	return claimDTO.ID, nil
+}
+
+// UpdateClaim saves what changes along the processing of the claim, its
+// status and the number given by the insurer.
+func (ir *insurancePostgresRepo) UpdateClaim(ctx context.Context, c *insurance.Claim) error {
+	claimDTO := mapping.MapClaimToDTO(c)
+
+	res, err := ir.conn(ctx).NewUpdate().
+		Model(claimDTO).
+		Column("number", "status", "closed_at", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func mapPolicies(policyDTOs []dto.PolicyDTO) *[]insurance.Policy {
+	policies := make([]insurance.Policy, 0, len(policyDTOs))
+	for _, dto := range policyDTOs {
+		policies = append(policies, *mapping.MapDTOToPolicy(&dto))
+	}
+
+	return &policies
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	"github.com/LucasMateus-eng/operations-service/insurance/postgres/dto"
+)
+
+func MapPolicyToDTO(p *insurance.Policy) *dto.PolicyDTO {
+	return &dto.PolicyDTO{
+		ID:         p.ID,
+		VehicleID:  p.VehicleID,
+		Insurer:    p.Insurer,
+		Number:     p.Number,
+		Coverage:   string(p.Coverage),
+		StartsAt:   p.StartsAt,
+		EndsAt:     p.EndsAt,
+		Premium:    p.Premium,
+		Deductible: p.Deductible,
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+	}
+}
+
+func MapDTOToPolicy(policyDTO *dto.PolicyDTO) *insurance.Policy {
+	return &insurance.Policy{
+		ID:         policyDTO.ID,
+		VehicleID:  policyDTO.VehicleID,
+		Insurer:    policyDTO.Insurer,
+		Number:     policyDTO.Number,
+		Coverage:   insurance.Coverage(policyDTO.Coverage),
+		StartsAt:   policyDTO.StartsAt,
+		EndsAt:     policyDTO.EndsAt,
+		Premium:    policyDTO.Premium,
+		Deductible: policyDTO.Deductible,
+		CreatedAt:  policyDTO.CreatedAt,
+		UpdatedAt:  policyDTO.UpdatedAt,
+	}
+}
+
+func MapClaimToDTO(c *insurance.Claim) *dto.ClaimDTO {
+	return &dto.ClaimDTO{
+		ID:         c.ID,
+		PolicyID:   c.PolicyID,
+		IncidentID: c.IncidentID,
+		VehicleID:  c.VehicleID,
+		Number:     c.Number,
+		Status:     string(c.Status),
+		Amount:     c.Amount,
+		Notes:      c.Notes,
+		ClosedAt:   c.ClosedAt,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+func MapDTOToClaim(claimDTO *dto.ClaimDTO) *insurance.Claim {
+	return &insurance.Claim{
+		ID:         claimDTO.ID,
+		PolicyID:   claimDTO.PolicyID,
+		IncidentID: claimDTO.IncidentID,
+		VehicleID:  claimDTO.VehicleID,
+		Number:     claimDTO.Number,
+		Status:     insurance.ClaimStatus(claimDTO.Status),
+		Amount:     claimDTO.Amount,
+		Notes:      claimDTO.Notes,
+		ClosedAt:   claimDTO.ClosedAt,
+		CreatedAt:  claimDTO.CreatedAt,
+		UpdatedAt:  claimDTO.UpdatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	insurance_dto "github.com/LucasMateus-eng/operations-service/insurance/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapPolicyToDTO(t *testing.T) {
+	p := &insurance.Policy{
+		ID:         1,
+		VehicleID:  2,
+		Insurer:    "Porto Seguro",
+		Number:     "0531.2026.000123",
+		Coverage:   insurance.COMPREHENSIVE,
+		StartsAt:   mockedTime,
+		EndsAt:     mockedTime.AddDate(1, 0, 0),
+		Premium:    420000,
+		Deductible: 350000,
+		CreatedAt:  mockedTime,
+		UpdatedAt:  mockedTime,
+	}
+
+	expectedDTO := &insurance_dto.PolicyDTO{
+		ID:         1,
+		VehicleID:  2,
+		Insurer:    "Porto Seguro",
+		Number:     "0531.2026.000123",
+		Coverage:   "COMPREHENSIVE",
+		StartsAt:   mockedTime,
+		EndsAt:     mockedTime.AddDate(1, 0, 0),
+		Premium:    420000,
+		Deductible: 350000,
+		CreatedAt:  mockedTime,
+		UpdatedAt:  mockedTime,
+	}
+
+	actualDTO := MapPolicyToDTO(p)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, p, MapDTOToPolicy(actualDTO))
+}
+
+func TestMapClaimToDTO(t *testing.T) {
+	c := &insurance.Claim{
+		ID:         1,
+		PolicyID:   2,
+		IncidentID: 3,
+		VehicleID:  4,
+		Number:     "SIN-2026-77",
+		Status:     insurance.PAID,
+		Amount:     150000,
+		Notes:      "Reparo em oficina referenciada",
+		ClosedAt:   mockedTime,
+		CreatedAt:  mockedTime,
+		UpdatedAt:  mockedTime,
+	}
+
+	expectedDTO := &insurance_dto.ClaimDTO{
+		ID:         1,
+		PolicyID:   2,
+		IncidentID: 3,
+		VehicleID:  4,
+		Number:     "SIN-2026-77",
+		Status:     "PAID",
+		Amount:     150000,
+		Notes:      "Reparo em oficina referenciada",
+		ClosedAt:   mockedTime,
+		CreatedAt:  mockedTime,
+		UpdatedAt:  mockedTime,
+	}
+
+	actualDTO := MapClaimToDTO(c)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, c, MapDTOToClaim(actualDTO))
+}
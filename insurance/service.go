@@ -0,0 +1,292 @@
+package insurance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+type Service struct {
+	repo            Repository
+	auditor         audit.Recorder
+	vehicles        VehicleReading
+	incidents       IncidentReading
+	expiryAlertDays int
+	logger          *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, vr VehicleReading, ir IncidentReading, expiryAlertDays int, l *logging.Logging) *Service {
+	if expiryAlertDays <= 0 {
+		expiryAlertDays = DEFAULT_EXPIRY_ALERT_DAYS
+	}
+
+	return &Service{
+		repo:            r,
+		auditor:         au,
+		vehicles:        vr,
+		incidents:       ir,
+		expiryAlertDays: expiryAlertDays,
+		logger:          l,
+	}
+}
+
+func (s *Service) GetPolicy(ctx context.Context, id int64) (*Policy, error) {
+	s.logger.Debug("[INSURANCE] GetPolicy - DEBUG: ", map[string]any{
+		"policyID": id,
+	})
+	policy, err := s.repo.GetPolicy(ctx, id)
+	if err != nil {
+		s.logger.Error("[INSURANCE] GetPolicy - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	policy.Alert = policy.ExpiresWithin(time.Now(), s.expiryAlertDays)
+
+	return policy, nil
+}
+
+func (s *Service) ListPolicies(ctx context.Context, specification *PolicySpecification) (*[]Policy, error) {
+	s.logger.Debug("[INSURANCE] ListPolicies - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	policies, err := s.repo.ListPolicies(ctx, specification)
+	if err != nil {
+		s.logger.Error("[INSURANCE] ListPolicies - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	now := time.Now()
+	for i := range *policies {
+		(*policies)[i].Alert = (*policies)[i].ExpiresWithin(now, s.expiryAlertDays)
+	}
+
+	return policies, nil
+}
+
+// ListAlerts lists the policies ending within the alert days whose vehicle
+// has no later policy, the first to end first.
+func (s *Service) ListAlerts(ctx context.Context) (*[]Policy, error) {
+	s.logger.Debug("[INSURANCE] ListAlerts - DEBUG: ", nil)
+	now := time.Now()
+
+	policies, err := s.repo.ListExpiring(ctx, now, now.AddDate(0, 0, s.expiryAlertDays))
+	if err != nil {
+		s.logger.Error("[INSURANCE] ListAlerts - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	for i := range *policies {
+		(*policies)[i].Alert = true
+	}
+
+	return policies, nil
+}
+
+func (s *Service) CreatePolicy(ctx context.Context, p *Policy) (int64, error) {
+	s.logger.Debug("[INSURANCE] CreatePolicy - DEBUG: ", map[string]any{
+		"policy": p,
+	})
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+
+	var policyID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if _, err := s.vehicles.GetByID(ctx, p.VehicleID); err != nil {
+			return nil, err
+		}
+
+		var err error
+		policyID, err = s.repo.CreatePolicy(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.INSURANCE_POLICY, policyID, audit.CREATE, nil, p)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[INSURANCE] CreatePolicy - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return policyID, nil
+}
+
+// UpdatePolicy replaces the terms of the policy, such as on an endorsement.
+// The vehicle of a policy never changes.
+func (s *Service) UpdatePolicy(ctx context.Context, p *Policy) error {
+	s.logger.Debug("[INSURANCE] UpdatePolicy - DEBUG: ", map[string]any{
+		"policy": p,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetPolicy(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		p.VehicleID = before.VehicleID
+		if err := p.Validate(); err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.UpdatePolicy(ctx, p); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetPolicy(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.INSURANCE_POLICY, p.ID, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[INSURANCE] UpdatePolicy - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetClaim(ctx context.Context, id int64) (*Claim, error) {
+	s.logger.Debug("[INSURANCE] GetClaim - DEBUG: ", map[string]any{
+		"claimID": id,
+	})
+	claim, err := s.repo.GetClaim(ctx, id)
+	if err != nil {
+		s.logger.Error("[INSURANCE] GetClaim - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return claim, nil
+}
+
+func (s *Service) ListClaims(ctx context.Context, specification *ClaimSpecification) (*[]Claim, error) {
+	s.logger.Debug("[INSURANCE] ListClaims - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	claims, err := s.repo.ListClaims(ctx, specification)
+	if err != nil {
+		s.logger.Error("[INSURANCE] ListClaims - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// OpenClaim claims the incident on the policy, which must have insured its
+// vehicle when it occurred. The amount defaults to the estimated cost of
+// the incident.
+func (s *Service) OpenClaim(ctx context.Context, c *Claim) (int64, error) {
+	s.logger.Debug("[INSURANCE] OpenClaim - DEBUG: ", map[string]any{
+		"claim": c,
+	})
+	if err := c.Validate(); err != nil {
+		return 0, err
+	}
+
+	var claimID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		policy, err := s.repo.GetPolicy(ctx, c.PolicyID)
+		if err != nil {
+			return nil, err
+		}
+
+		i, err := s.incidents.GetByID(ctx, c.IncidentID)
+		if err != nil {
+			return nil, err
+		}
+
+		if i.VehicleID != policy.VehicleID || !policy.ValidAt(i.OccurredAt) {
+			return nil, fmt.Errorf("%w: policy [%d], incident [%d]", ErrNotCovered, policy.ID, i.ID)
+		}
+
+		c.VehicleID = policy.VehicleID
+		c.Status = OPEN
+		if c.Amount == 0 {
+			c.Amount = i.EstimatedCost()
+		}
+
+		claimID, err = s.repo.CreateClaim(ctx, c)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.INSURANCE_CLAIM, claimID, audit.CREATE, nil, c)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[INSURANCE] OpenClaim - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return claimID, nil
+}
+
+// ChangeClaimStatus moves the claim along its processing by the insurer,
+// keeping the claim number when none is given. The history of the statuses
+// is kept by the audit log.
+func (s *Service) ChangeClaimStatus(ctx context.Context, id int64, status ClaimStatus, number string) (*Claim, error) {
+	s.logger.Debug("[INSURANCE] ChangeClaimStatus - DEBUG: ", map[string]any{
+		"claimID": id,
+		"status":  status,
+	})
+	var after *Claim
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetClaim(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		changed := *before
+		changed.Status, err = before.Status.Change(status)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(number) > 0 {
+			changed.Number = number
+		}
+
+		if changed.Status.Closed() {
+			changed.ClosedAt = time.Now()
+		}
+
+		if err := s.repo.UpdateClaim(ctx, &changed); err != nil {
+			return nil, err
+		}
+
+		after, err = s.repo.GetClaim(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.INSURANCE_CLAIM, id, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[INSURANCE] ChangeClaimStatus - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return after, nil
+}
@@ -0,0 +1,338 @@
+package insurance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/incident"
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	insurance_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/insurance"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	policy        = &insurance.Policy{
+		ID:        1,
+		VehicleID: 2,
+		Insurer:   "Porto Seguro",
+		Number:    "0531.2026.000123",
+		Coverage:  insurance.COMPREHENSIVE,
+		StartsAt:  time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC),
+		EndsAt:    time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC),
+	}
+)
+
+func TestService_OpenClaim(t *testing.T) {
+	type serviceMocks struct {
+		repo      *insurance_mocks.MockRepository
+		auditor   *audit_mocks.MockRecorder
+		vehicles  *insurance_mocks.MockVehicleReading
+		incidents *insurance_mocks.MockIncidentReading
+		logger    *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		c   *insurance.Claim
+	}
+
+	covered := time.Date(2026, 5, 10, 14, 30, 0, 0, time.UTC)
+	costEstimates := []incident.CostEstimate{{Description: "Para-choque", Amount: 150000}, {Description: "Guincho", Amount: 30000}}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        int64
+		wantAmount  int64
+		wantErr     error
+	}{
+		{
+			name: "Dado um incidente coberto sem valor informado quando o método OpenClaim é chamado então o sinistro é aberto pelo custo estimado",
+			args: args{
+				ctx: mockedContext,
+				c:   &insurance.Claim{PolicyID: 1, IncidentID: 3},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetPolicy(p.ctx, p.c.PolicyID).Return(policy, nil)
+				m.incidents.EXPECT().GetByID(p.ctx, p.c.IncidentID).Return(&incident.Incident{ID: 3, Type: incident.ACCIDENT, VehicleID: 2, OccurredAt: covered, CostEstimates: costEstimates}, nil)
+				m.repo.EXPECT().CreateClaim(p.ctx, &insurance.Claim{PolicyID: 1, IncidentID: 3, VehicleID: 2, Status: insurance.OPEN, Amount: 180000}).Return(int64(5), nil)
+			},
+			want:       5,
+			wantAmount: 180000,
+			wantErr:    nil,
+		},
+		{
+			name: "Dado um valor informado quando o método OpenClaim é chamado então o sinistro é aberto pelo valor informado",
+			args: args{
+				ctx: mockedContext,
+				c:   &insurance.Claim{PolicyID: 1, IncidentID: 3, Amount: 100000},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetPolicy(p.ctx, p.c.PolicyID).Return(policy, nil)
+				m.incidents.EXPECT().GetByID(p.ctx, p.c.IncidentID).Return(&incident.Incident{ID: 3, Type: incident.ACCIDENT, VehicleID: 2, OccurredAt: covered, CostEstimates: costEstimates}, nil)
+				m.repo.EXPECT().CreateClaim(p.ctx, p.c).Return(int64(5), nil)
+			},
+			want:       5,
+			wantAmount: 100000,
+			wantErr:    nil,
+		},
+		{
+			name: "Dado um incidente antes da vigência da apólice quando o método OpenClaim é chamado então o sinistro não é aberto",
+			args: args{
+				ctx: mockedContext,
+				c:   &insurance.Claim{PolicyID: 1, IncidentID: 3},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetPolicy(p.ctx, p.c.PolicyID).Return(policy, nil)
+				m.incidents.EXPECT().GetByID(p.ctx, p.c.IncidentID).Return(&incident.Incident{ID: 3, Type: incident.ACCIDENT, VehicleID: 2, OccurredAt: policy.StartsAt.Add(-time.Hour), CostEstimates: costEstimates}, nil)
+			},
+			wantErr: insurance.ErrNotCovered,
+		},
+		{
+			name: "Dado um incidente de outro veículo quando o método OpenClaim é chamado então o sinistro não é aberto",
+			args: args{
+				ctx: mockedContext,
+				c:   &insurance.Claim{PolicyID: 1, IncidentID: 3},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetPolicy(p.ctx, p.c.PolicyID).Return(policy, nil)
+				m.incidents.EXPECT().GetByID(p.ctx, p.c.IncidentID).Return(&incident.Incident{ID: 3, Type: incident.ACCIDENT, VehicleID: 9, OccurredAt: covered, CostEstimates: costEstimates}, nil)
+			},
+			wantErr: insurance.ErrNotCovered,
+		},
+		{
+			name: "Dado um erro ao buscar o incidente quando o método OpenClaim é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				c:   &insurance.Claim{PolicyID: 1, IncidentID: 3},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetPolicy(p.ctx, p.c.PolicyID).Return(policy, nil)
+				m.incidents.EXPECT().GetByID(p.ctx, p.c.IncidentID).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado um sinistro inválido quando o método OpenClaim é chamado então o sinistro não é aberto",
+			args: args{
+				ctx: mockedContext,
+				c:   &insurance.Claim{Amount: -1},
+			},
+			wantErr: insurance.ErrInvalidClaim,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:      insurance_mocks.NewMockRepository(ctrl),
+				auditor:   audit_mocks.NewPassThroughRecorder(ctrl),
+				vehicles:  insurance_mocks.NewMockVehicleReading(ctrl),
+				incidents: insurance_mocks.NewMockIncidentReading(ctrl),
+				logger:    logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := insurance.NewService(sm.repo, sm.auditor, sm.vehicles, sm.incidents, 0, sm.logger)
+
+			actualID, err := s.OpenClaim(test.args.ctx, test.args.c)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, test.wantAmount, test.args.c.Amount)
+			}
+		})
+	}
+}
+
+func TestService_ChangeClaimStatus(t *testing.T) {
+	type serviceMocks struct {
+		repo      *insurance_mocks.MockRepository
+		auditor   *audit_mocks.MockRecorder
+		vehicles  *insurance_mocks.MockVehicleReading
+		incidents *insurance_mocks.MockIncidentReading
+		logger    *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		id     int64
+		status insurance.ClaimStatus
+		number string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado um sinistro em análise quando ele é aprovado então o status e o número da seguradora são salvos",
+			args: args{
+				ctx:    mockedContext,
+				id:     5,
+				status: insurance.APPROVED,
+				number: "SIN-2026-77",
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetClaim(p.ctx, p.id).Return(&insurance.Claim{ID: 5, Status: insurance.UNDER_REVIEW}, nil)
+				m.repo.EXPECT().UpdateClaim(p.ctx, &insurance.Claim{ID: 5, Status: insurance.APPROVED, Number: "SIN-2026-77"}).Return(nil)
+				m.repo.EXPECT().GetClaim(p.ctx, p.id).Return(&insurance.Claim{ID: 5, Status: insurance.APPROVED, Number: "SIN-2026-77"}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um sinistro aprovado quando ele é pago então o sinistro é encerrado mantendo o número",
+			args: args{
+				ctx:    mockedContext,
+				id:     5,
+				status: insurance.PAID,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetClaim(p.ctx, p.id).Return(&insurance.Claim{ID: 5, Status: insurance.APPROVED, Number: "SIN-2026-77"}, nil)
+				m.repo.EXPECT().UpdateClaim(p.ctx, gomock.Cond(func(x any) bool {
+					c, ok := x.(*insurance.Claim)
+					return ok && c.Status == insurance.PAID && c.Number == "SIN-2026-77" && !c.ClosedAt.IsZero()
+				})).Return(nil)
+				m.repo.EXPECT().GetClaim(p.ctx, p.id).Return(&insurance.Claim{ID: 5, Status: insurance.PAID}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um sinistro aberto quando ele é pago sem aprovação então o status não muda",
+			args: args{
+				ctx:    mockedContext,
+				id:     5,
+				status: insurance.PAID,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetClaim(p.ctx, p.id).Return(&insurance.Claim{ID: 5, Status: insurance.OPEN}, nil)
+			},
+			wantErr: insurance.ErrInvalidClaimTransition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:      insurance_mocks.NewMockRepository(ctrl),
+				auditor:   audit_mocks.NewPassThroughRecorder(ctrl),
+				vehicles:  insurance_mocks.NewMockVehicleReading(ctrl),
+				incidents: insurance_mocks.NewMockIncidentReading(ctrl),
+				logger:    logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := insurance.NewService(sm.repo, sm.auditor, sm.vehicles, sm.incidents, 0, sm.logger)
+
+			actual, err := s.ChangeClaimStatus(test.args.ctx, test.args.id, test.args.status, test.args.number)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, test.args.status, actual.Status)
+			}
+		})
+	}
+}
+
+func TestService_UpdatePolicy(t *testing.T) {
+	type serviceMocks struct {
+		repo      *insurance_mocks.MockRepository
+		auditor   *audit_mocks.MockRecorder
+		vehicles  *insurance_mocks.MockVehicleReading
+		incidents *insurance_mocks.MockIncidentReading
+		logger    *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		p   *insurance.Policy
+	}
+
+	changed := *policy
+	changed.VehicleID = 9
+	changed.Deductible = 500000
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado uma apólice alterada quando o método UpdatePolicy é chamado então o veículo segurado é mantido",
+			args: args{
+				ctx: mockedContext,
+				p:   &changed,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetPolicy(p.ctx, p.p.ID).Return(policy, nil)
+				m.repo.EXPECT().UpdatePolicy(p.ctx, gomock.Cond(func(x any) bool {
+					updated, ok := x.(*insurance.Policy)
+					return ok && updated.VehicleID == policy.VehicleID && updated.Deductible == 500000
+				})).Return(nil)
+				m.repo.EXPECT().GetPolicy(p.ctx, p.p.ID).Return(p.p, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma apólice inexistente quando o método UpdatePolicy é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				p:   &changed,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetPolicy(p.ctx, p.p.ID).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:      insurance_mocks.NewMockRepository(ctrl),
+				auditor:   audit_mocks.NewPassThroughRecorder(ctrl),
+				vehicles:  insurance_mocks.NewMockVehicleReading(ctrl),
+				incidents: insurance_mocks.NewMockIncidentReading(ctrl),
+				logger:    logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := insurance.NewService(sm.repo, sm.auditor, sm.vehicles, sm.incidents, 0, sm.logger)
+
+			err := s.UpdatePolicy(test.args.ctx, test.args.p)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package insurance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	ErrInvalidPolicy = errors.New("the given policy is invalid")
+
+	ErrMissingVehicle     = errors.New("the policy must have a vehicle")
+	ErrEmptyInsurer       = errors.New("the policy insurer cannot be empty")
+	ErrEmptyNumber        = errors.New("the policy number cannot be empty")
+	ErrInvalidValidity    = errors.New("the policy must start and end, and end after it starts")
+	ErrNegativePremium    = errors.New("the policy premium cannot be negative")
+	ErrNegativeDeductible = errors.New("the policy deductible cannot be negative")
+
+	ErrInvalidClaim = errors.New("the given claim is invalid")
+
+	ErrMissingPolicy       = errors.New("the claim must have a policy")
+	ErrMissingIncident     = errors.New("the claim must have an incident")
+	ErrNegativeClaimAmount = errors.New("the claim amount cannot be negative")
+)
+
+// Validate returns every rule broken by the policy joined in a single error.
+func (p *Policy) Validate() error {
+	var errs []error
+
+	if p.VehicleID <= 0 {
+		errs = append(errs, ErrMissingVehicle)
+	}
+
+	if strings.TrimSpace(p.Insurer) == "" {
+		errs = append(errs, ErrEmptyInsurer)
+	}
+
+	if strings.TrimSpace(p.Number) == "" {
+		errs = append(errs, ErrEmptyNumber)
+	}
+
+	if _, err := GetCoverage(string(p.Coverage)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if p.StartsAt.IsZero() || p.EndsAt.IsZero() || !p.EndsAt.After(p.StartsAt) {
+		errs = append(errs, ErrInvalidValidity)
+	}
+
+	if p.Premium < 0 {
+		errs = append(errs, ErrNegativePremium)
+	}
+
+	if p.Deductible < 0 {
+		errs = append(errs, ErrNegativeDeductible)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidPolicy, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Validate returns every rule broken by the claim joined in a single error.
+func (c *Claim) Validate() error {
+	var errs []error
+
+	if c.PolicyID <= 0 {
+		errs = append(errs, ErrMissingPolicy)
+	}
+
+	if c.IncidentID <= 0 {
+		errs = append(errs, ErrMissingIncident)
+	}
+
+	if c.Amount < 0 {
+		errs = append(errs, ErrNegativeClaimAmount)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidClaim, errors.Join(errs...))
+	}
+
+	return nil
+}
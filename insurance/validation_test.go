@@ -0,0 +1,94 @@
+package insurance_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestPolicy_Validate(t *testing.T) {
+	startsAt := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		policy   insurance.Policy
+		wantErrs []error
+	}{
+		{
+			name: "Dado uma apólice válida quando a validação é chamada então nenhum erro é retornado",
+			policy: insurance.Policy{
+				VehicleID:  2,
+				Insurer:    "Porto Seguro",
+				Number:     "0531.2026.000123",
+				Coverage:   insurance.COMPREHENSIVE,
+				StartsAt:   startsAt,
+				EndsAt:     startsAt.AddDate(1, 0, 0),
+				Premium:    420000,
+				Deductible: 350000,
+			},
+		},
+		{
+			name: "Dado uma apólice que termina antes de começar quando a validação é chamada então um erro é retornado",
+			policy: insurance.Policy{
+				VehicleID: 2,
+				Insurer:   "Porto Seguro",
+				Number:    "0531.2026.000123",
+				Coverage:  insurance.THIRD_PARTY,
+				StartsAt:  startsAt,
+				EndsAt:    startsAt.AddDate(0, 0, -1),
+			},
+			wantErrs: []error{insurance.ErrInvalidPolicy, insurance.ErrInvalidValidity},
+		},
+		{
+			name:   "Dado uma apólice vazia com valores negativos quando a validação é chamada então todas as regras quebradas são retornadas",
+			policy: insurance.Policy{Insurer: " ", Premium: -1, Deductible: -1},
+			wantErrs: []error{
+				insurance.ErrInvalidPolicy, insurance.ErrMissingVehicle, insurance.ErrEmptyInsurer, insurance.ErrEmptyNumber,
+				insurance.ErrInvalidCoverage, insurance.ErrInvalidValidity, insurance.ErrNegativePremium, insurance.ErrNegativeDeductible,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.policy.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
+
+func TestClaim_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		claim    insurance.Claim
+		wantErrs []error
+	}{
+		{
+			name:  "Dado um sinistro válido quando a validação é chamada então nenhum erro é retornado",
+			claim: insurance.Claim{PolicyID: 1, IncidentID: 2, Amount: 150000},
+		},
+		{
+			name:     "Dado um sinistro vazio com valor negativo quando a validação é chamada então todas as regras quebradas são retornadas",
+			claim:    insurance.Claim{Amount: -1},
+			wantErrs: []error{insurance.ErrInvalidClaim, insurance.ErrMissingPolicy, insurance.ErrMissingIncident, insurance.ErrNegativeClaimAmount},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.claim.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
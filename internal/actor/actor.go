@@ -0,0 +1,24 @@
+package actor
+
+import "context"
+
+// Actor identifies who is performing a request. It is kept free of any
+// domain package so that every layer can read it from the context.
+type Actor struct {
+	UserID   int64
+	Username string
+	Role     string
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying the actor.
+func WithActor(ctx context.Context, a *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, a)
+}
+
+// FromContext returns the actor carried by ctx, if any.
+func FromContext(ctx context.Context) (*Actor, bool) {
+	a, ok := ctx.Value(actorKey{}).(*Actor)
+	return a, ok && a != nil
+}
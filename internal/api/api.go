@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"errors"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -9,57 +11,102 @@ import (
 	"time"
 
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"google.golang.org/grpc"
 )
 
 const TIMEOUT = 30 * time.Second
 
-type ServerOption func(server *http.Server)
+type servers struct {
+	http     *http.Server
+	grpc     *grpc.Server
+	grpcPort string
+}
+
+type ServerOption func(s *servers)
 
-// Start a new http server with graceful shutdown and default parameters
+// Start a new http server, and a gRPC server when one is given, with
+// graceful shutdown and default parameters
 func Start(port string, logger *logging.Logging, handler http.Handler, options ...ServerOption) error {
 
-	srv := &http.Server{
-		ReadTimeout:  TIMEOUT,
-		WriteTimeout: TIMEOUT,
-		Addr:         ":" + port,
-		Handler:      handler,
+	s := &servers{
+		http: &http.Server{
+			ReadTimeout:  TIMEOUT,
+			WriteTimeout: TIMEOUT,
+			Addr:         ":" + port,
+			Handler:      handler,
+		},
 	}
 
 	for _, o := range options {
-		o(srv)
+		o(s)
 	}
 
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
-	go func() {
-		<-ctx.Done()
-		logger.Info("stopping server", nil)
-		err := srv.Shutdown(context.Background())
+	errs := make(chan error, 2)
+
+	if s.grpc != nil {
+		listener, err := net.Listen("tcp", ":"+s.grpcPort)
 		if err != nil {
-			panic(err)
+			return err
+		}
+
+		go func() {
+			logger.Info("grpc server started successfully", map[string]any{
+				"port": s.grpcPort,
+			})
+			errs <- s.grpc.Serve(listener)
+		}()
+	}
+
+	go func() {
+		logger.Info("server started successfully", map[string]any{
+			"port": port,
+		})
+		if err := s.http.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			errs <- err
 		}
 	}()
 
-	logger.Info("server started successfully", map[string]any{
-		"port": port,
-	})
-	if err := srv.ListenAndServe(); err != http.ErrServerClosed {
+	select {
+	case <-ctx.Done():
+	case err := <-errs:
+		cancel()
+		s.stop()
 		return err
 	}
-	return nil
+
+	logger.Info("stopping server", nil)
+	return s.stop()
+}
+
+func (s *servers) stop() error {
+	if s.grpc != nil {
+		s.grpc.GracefulStop()
+	}
+
+	return s.http.Shutdown(context.Background())
 }
 
 // WithReadTimeout configure http.Server parameter ReadTimeout
 func WithReadTimeout(t time.Duration) ServerOption {
-	return func(srv *http.Server) {
-		srv.ReadTimeout = t
+	return func(s *servers) {
+		s.http.ReadTimeout = t
 	}
 }
 
 // WithWriteTimeout configure http.Server parameter WriteTimeout
 func WithWriteTimeout(t time.Duration) ServerOption {
-	return func(srv *http.Server) {
-		srv.WriteTimeout = t
+	return func(s *servers) {
+		s.http.WriteTimeout = t
+	}
+}
+
+// WithGRPCServer serves srv on port alongside the http server
+func WithGRPCServer(port string, srv *grpc.Server) ServerOption {
+	return func(s *servers) {
+		s.grpcPort = port
+		s.grpc = srv
 	}
 }
@@ -0,0 +1,140 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strconv"
+	"time"
+)
+
+type Operation string
+
+const (
+	CREATE  Operation = "CREATE"
+	UPDATE  Operation = "UPDATE"
+	DELETE  Operation = "DELETE"
+	RESTORE Operation = "RESTORE"
+	PURGE   Operation = "PURGE"
+)
+
+// Entity types recorded in the audit log.
+const (
+	USER               = "user"
+	DRIVER             = "driver"
+	VEHICLE            = "vehicle"
+	ADDRESS            = "address"
+	DRIVER_VEHICLE     = "driver-vehicle"
+	MAINTENANCE_PLAN   = "maintenance-plan"
+	MAINTENANCE_ORDER  = "maintenance-order"
+	MAINTENANCE_TICKET = "maintenance-ticket"
+	ODOMETER_RECORD    = "odometer-record"
+	REFUEL             = "refuel"
+	FINE               = "fine"
+	TRIP               = "trip"
+	SHIFT              = "shift"
+	CHECKLIST          = "checklist"
+	CHECKLIST_TEMPLATE = "checklist-template"
+	INCIDENT           = "incident"
+	INSURANCE_POLICY   = "insurance-policy"
+	INSURANCE_CLAIM    = "insurance-claim"
+	DOCUMENT           = "document"
+)
+
+var (
+	entityTypes = []string{USER, DRIVER, VEHICLE, ADDRESS, DRIVER_VEHICLE, MAINTENANCE_PLAN, MAINTENANCE_ORDER, MAINTENANCE_TICKET, ODOMETER_RECORD, REFUEL, FINE, TRIP, SHIFT, CHECKLIST, CHECKLIST_TEMPLATE, INCIDENT, INSURANCE_POLICY, INSURANCE_CLAIM, DOCUMENT}
+
+	ErrUnknownEntityType = errors.New("the entity must be one of user, driver, vehicle, address, driver-vehicle, maintenance-plan, maintenance-order, maintenance-ticket, odometer-record, refuel, fine, trip, shift, checklist, checklist-template, incident, insurance-policy, insurance-claim or document")
+	ErrEmptyEntityID     = errors.New("the entity id cannot be empty")
+)
+
+// Change is the value of a single field before and after an operation. A
+// field that did not exist on one side is nil there.
+type Change struct {
+	Field  string
+	Before any
+	After  any
+}
+
+// Entry is an append-only record of one operation on one entity.
+type Entry struct {
+	ID            int64
+	EntityType    string
+	EntityID      string
+	Operation     Operation
+	ActorID       int64
+	ActorUsername string
+	RequestID     string
+	Changes       []Change
+	CreatedAt     time.Time
+}
+
+// NewEntry describes an operation on the entity identified by entityType and
+// id, with the field-level changes between before and after. Either side can
+// be nil, as on a create or a delete.
+func NewEntry(entityType string, id int64, operation Operation, before, after any) *Entry {
+	return &Entry{
+		EntityType: entityType,
+		EntityID:   strconv.FormatInt(id, 10),
+		Operation:  operation,
+		Changes:    Diff(before, after),
+	}
+}
+
+// NewAssignmentEntry describes an operation on the assignment of a vehicle to
+// a driver, identified as "driverID:vehicleID".
+func NewAssignmentEntry(driverID, vehicleID int64, operation Operation, before, after any) *Entry {
+	return &Entry{
+		EntityType: DRIVER_VEHICLE,
+		EntityID:   strconv.FormatInt(driverID, 10) + ":" + strconv.FormatInt(vehicleID, 10),
+		Operation:  operation,
+		Changes:    Diff(before, after),
+	}
+}
+
+type EntrySpecification struct {
+	EntityType     string
+	EntityID       string
+	Page, PageSize int
+}
+
+func (s *EntrySpecification) Validate() error {
+	if !slices.Contains(entityTypes, s.EntityType) {
+		return ErrUnknownEntityType
+	}
+
+	if len(s.EntityID) == 0 {
+		return ErrEmptyEntityID
+	}
+
+	return nil
+}
+
+type Reading interface {
+	List(ctx context.Context, specification *EntrySpecification) (*[]Entry, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, entries []*Entry) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// Recorder appends entries to the audit log. The services of every aggregate
+// depend on it to keep the history of their writes.
+type Recorder interface {
+	// Track runs write in a transaction and appends the entries it returns
+	// in the same transaction, so a change is never stored without its
+	// history.
+	Track(ctx context.Context, write func(ctx context.Context) ([]*Entry, error)) error
+	// Record appends entries using the transaction carried by ctx, if any.
+	Record(ctx context.Context, entries ...*Entry) error
+}
+
+type UseCase interface {
+	Recorder
+	List(ctx context.Context, specification *EntrySpecification) (*[]Entry, error)
+}
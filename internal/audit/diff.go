@@ -0,0 +1,145 @@
+package audit
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const REDACTED = "[REDACTED]"
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+
+	// ignoredFields are bookkeeping fields that change on every write.
+	ignoredFields = []string{"ID", "Version", "CreatedAt", "UpdatedAt", "DeletedAt"}
+
+	// redactedFields are recorded as changed without their values.
+	redactedFields = []string{"hashed_password"}
+)
+
+// Diff compares two values of the same struct type field by field. Nested
+// structs are flattened into dotted snake_case names, e.g.
+// "legal_information.licensing.status". Pointers, slices and maps hold
+// related aggregates, which keep a history of their own, so they are skipped.
+func Diff(before, after any) []Change {
+	b := flatten(before)
+	a := flatten(after)
+
+	fields := make([]string, 0, len(b)+len(a))
+	for field := range b {
+		fields = append(fields, field)
+	}
+	for field := range a {
+		if _, ok := b[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+	slices.Sort(fields)
+
+	changes := make([]Change, 0, len(fields))
+	for _, field := range fields {
+		beforeValue, inBefore := b[field]
+		afterValue, inAfter := a[field]
+
+		if inBefore && inAfter && equal(beforeValue, afterValue) {
+			continue
+		}
+
+		change := Change{Field: field, Before: beforeValue, After: afterValue}
+		if slices.Contains(redactedFields, field) {
+			change.Before, change.After = redact(inBefore), redact(inAfter)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func flatten(value any) map[string]any {
+	fields := make(map[string]any)
+	if value == nil {
+		return fields
+	}
+
+	v := reflect.ValueOf(value)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return fields
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() == reflect.Struct {
+		walk(v, "", fields)
+	}
+
+	return fields
+}
+
+func walk(v reflect.Value, prefix string, fields map[string]any) {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() || (len(prefix) == 0 && slices.Contains(ignoredFields, field.Name)) {
+			continue
+		}
+
+		name := snakeCase(field.Name)
+		if len(prefix) > 0 {
+			name = prefix + "." + name
+		}
+
+		fv := v.Field(i)
+		switch {
+		case field.Type == timeType:
+			fields[name] = fv.Interface()
+		case fv.Kind() == reflect.Struct:
+			walk(fv, name, fields)
+		case fv.Kind() == reflect.Pointer, fv.Kind() == reflect.Slice, fv.Kind() == reflect.Map, fv.Kind() == reflect.Interface:
+			continue
+		default:
+			fields[name] = fv.Interface()
+		}
+	}
+}
+
+func equal(a, b any) bool {
+	if at, ok := a.(time.Time); ok {
+		bt, ok := b.(time.Time)
+		return ok && at.Equal(bt)
+	}
+
+	return reflect.DeepEqual(a, b)
+}
+
+func redact(present bool) any {
+	if !present {
+		return nil
+	}
+
+	return REDACTED
+}
+
+// snakeCase turns a Go field name into snake_case, keeping acronyms
+// together: "CPF" becomes "cpf" and "DriverLicense" becomes "driver_license".
+func snakeCase(name string) string {
+	runes := []rune(name)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if unicode.IsUpper(r) {
+			startsWord := i > 0 && (unicode.IsLower(runes[i-1]) || (i+1 < len(runes) && unicode.IsLower(runes[i+1])))
+			if startsWord {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
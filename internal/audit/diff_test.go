@@ -0,0 +1,107 @@
+package audit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/go-playground/assert/v2"
+)
+
+type legalInformation struct {
+	CPF           string
+	DriverLicense string
+}
+
+type subject struct {
+	ID               int64
+	Name             string
+	HashedPassword   string
+	LegalInformation legalInformation
+	DateOfBirth      time.Time
+	Vehicles         []string
+	Version          int64
+	UpdatedAt        time.Time
+}
+
+func TestDiff(t *testing.T) {
+	dateOfBirth := time.Date(1990, 1, 2, 0, 0, 0, 0, time.UTC)
+	before := &subject{
+		ID:               1,
+		Name:             "Fulano",
+		HashedPassword:   "hash",
+		LegalInformation: legalInformation{CPF: "11111111111", DriverLicense: "A"},
+		DateOfBirth:      dateOfBirth,
+		Version:          1,
+	}
+
+	tests := []struct {
+		name   string
+		before any
+		after  any
+		want   []audit.Change
+	}{
+		{
+			name:   "Dado valores iguais quando o método Diff é chamado então nenhuma alteração é retornada",
+			before: before,
+			after: &subject{
+				ID:               1,
+				Name:             "Fulano",
+				HashedPassword:   "hash",
+				LegalInformation: legalInformation{CPF: "11111111111", DriverLicense: "A"},
+				DateOfBirth:      dateOfBirth.In(time.FixedZone("BRT", -3*60*60)),
+				Vehicles:         []string{"ABC1D23"},
+				Version:          2,
+				UpdatedAt:        time.Now(),
+			},
+			want: []audit.Change{},
+		},
+		{
+			name:   "Dado campos alterados quando o método Diff é chamado então apenas eles são retornados em snake_case",
+			before: before,
+			after: &subject{
+				ID:               1,
+				Name:             "Ciclano",
+				HashedPassword:   "hash",
+				LegalInformation: legalInformation{CPF: "11111111111", DriverLicense: "B"},
+				DateOfBirth:      dateOfBirth,
+			},
+			want: []audit.Change{
+				{Field: "legal_information.driver_license", Before: "A", After: "B"},
+				{Field: "name", Before: "Fulano", After: "Ciclano"},
+			},
+		},
+		{
+			name:   "Dado uma senha alterada quando o método Diff é chamado então o valor é omitido",
+			before: before,
+			after: &subject{
+				ID:               1,
+				Name:             "Fulano",
+				HashedPassword:   "outro hash",
+				LegalInformation: legalInformation{CPF: "11111111111", DriverLicense: "A"},
+				DateOfBirth:      dateOfBirth,
+			},
+			want: []audit.Change{
+				{Field: "hashed_password", Before: audit.REDACTED, After: audit.REDACTED},
+			},
+		},
+		{
+			name:   "Dado um valor anterior nulo quando o método Diff é chamado então todos os campos são retornados como criados",
+			before: nil,
+			after:  &subject{Name: "Fulano", LegalInformation: legalInformation{CPF: "1"}},
+			want: []audit.Change{
+				{Field: "date_of_birth", After: time.Time{}},
+				{Field: "hashed_password", After: audit.REDACTED},
+				{Field: "legal_information.cpf", After: "1"},
+				{Field: "legal_information.driver_license", After: ""},
+				{Field: "name", After: "Fulano"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, audit.Diff(test.before, test.after))
+		})
+	}
+}
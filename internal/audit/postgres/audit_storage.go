@@ -0,0 +1,72 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/audit/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/audit/postgres/mapping"
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/uptrace/bun"
+)
+
+type auditPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *auditPostgresRepo {
+	return &auditPostgresRepo{
+		db: db,
+	}
+}
+
+func (ar *auditPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, ar.db)
+}
+
+// List returns the history of an entity, oldest entry first.
+func (ar *auditPostgresRepo) List(ctx context.Context, specification *audit.EntrySpecification) (*[]audit.Entry, error) {
+	var entryDTOs []dto.EntryDTO
+
+	query := ar.conn(ctx).NewSelect().
+		Model(&entryDTOs).
+		Where("entity_type = ?", specification.EntityType).
+		Where("entity_id = ?", specification.EntityID).
+		Order("created_at ASC", "id ASC")
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		offset := (specification.Page - 1) * specification.PageSize
+		query = query.Offset(offset).Limit(specification.PageSize)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]audit.Entry, 0, len(entryDTOs))
+	for _, dto := range entryDTOs {
+		entries = append(entries, *mapping.MapDTOToEntry(&dto))
+	}
+
+	return &entries, nil
+}
+
+func (ar *auditPostgresRepo) Create(ctx context.Context, entries []*audit.Entry) error {
+	entryDTOs := make([]*dto.EntryDTO, len(entries))
+	for i, entry := range entries {
+		entryDTOs[i] = mapping.MapEntryToDTO(entry)
+	}
+
+	_, err := ar.conn(ctx).NewInsert().Model(&entryDTOs).Returning("id, created_at").Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, entryDTO := range entryDTOs {
+		entries[i].ID = entryDTO.ID
+		entries[i].CreatedAt = entryDTO.CreatedAt
+	}
+
+	return nil
+}
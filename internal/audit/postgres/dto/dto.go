@@ -0,0 +1,27 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ChangeDTO struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+type EntryDTO struct {
+	bun.BaseModel `bun:"table:audit_entries"`
+
+	ID            int64       `bun:"id,pk,autoincrement"`
+	EntityType    string      `bun:"entity_type,notnull"`
+	EntityID      string      `bun:"entity_id,notnull"`
+	Operation     string      `bun:"operation,notnull"`
+	ActorID       int64       `bun:"actor_id,nullzero"`
+	ActorUsername string      `bun:"actor_username,nullzero"`
+	RequestID     string      `bun:"request_id,nullzero"`
+	Changes       []ChangeDTO `bun:"changes,type:jsonb,notnull"`
+	CreatedAt     time.Time   `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,52 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/audit/postgres/dto"
+)
+
+func MapEntryToDTO(entry *audit.Entry) *dto.EntryDTO {
+	changes := make([]dto.ChangeDTO, len(entry.Changes))
+	for i, change := range entry.Changes {
+		changes[i] = dto.ChangeDTO{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+
+	return &dto.EntryDTO{
+		ID:            entry.ID,
+		EntityType:    entry.EntityType,
+		EntityID:      entry.EntityID,
+		Operation:     string(entry.Operation),
+		ActorID:       entry.ActorID,
+		ActorUsername: entry.ActorUsername,
+		RequestID:     entry.RequestID,
+		Changes:       changes,
+		CreatedAt:     entry.CreatedAt,
+	}
+}
+
+func MapDTOToEntry(entryDTO *dto.EntryDTO) *audit.Entry {
+	changes := make([]audit.Change, len(entryDTO.Changes))
+	for i, change := range entryDTO.Changes {
+		changes[i] = audit.Change{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		}
+	}
+
+	return &audit.Entry{
+		ID:            entryDTO.ID,
+		EntityType:    entryDTO.EntityType,
+		EntityID:      entryDTO.EntityID,
+		Operation:     audit.Operation(entryDTO.Operation),
+		ActorID:       entryDTO.ActorID,
+		ActorUsername: entryDTO.ActorUsername,
+		RequestID:     entryDTO.RequestID,
+		Changes:       changes,
+		CreatedAt:     entryDTO.CreatedAt,
+	}
+}
@@ -0,0 +1,82 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/requestid"
+	"github.com/LucasMateus-eng/operations-service/internal/transaction"
+)
+
+type Service struct {
+	transactor transaction.Transactor
+	repo       Repository
+	logger     *logging.Logging
+}
+
+func NewService(t transaction.Transactor, r Repository, l *logging.Logging) *Service {
+	return &Service{
+		transactor: t,
+		repo:       r,
+		logger:     l,
+	}
+}
+
+func (s *Service) Track(ctx context.Context, write func(ctx context.Context) ([]*Entry, error)) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		entries, err := write(ctx)
+		if err != nil {
+			return err
+		}
+
+		return s.Record(ctx, entries...)
+	})
+}
+
+// Record stamps the entries with the actor and the request ID carried by ctx
+// before appending them.
+func (s *Service) Record(ctx context.Context, entries ...*Entry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	a, _ := actor.FromContext(ctx)
+	requestID := requestid.FromContext(ctx)
+	for _, entry := range entries {
+		if a != nil {
+			entry.ActorID = a.UserID
+			entry.ActorUsername = a.Username
+		}
+		entry.RequestID = requestID
+	}
+
+	err := s.repo.Create(ctx, entries)
+	if err != nil {
+		s.logger.Error("[AUDIT] Record - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) List(ctx context.Context, specification *EntrySpecification) (*[]Entry, error) {
+	s.logger.Debug("[AUDIT] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	if err := specification.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[AUDIT] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return entries, nil
+}
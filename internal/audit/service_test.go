@@ -0,0 +1,210 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	transaction_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/transaction"
+	"github.com/LucasMateus-eng/operations-service/internal/requestid"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+)
+
+func withinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestService_Track(t *testing.T) {
+	type serviceMocks struct {
+		transactor *transaction_mocks.MockTransactor
+		repo       *audit_mocks.MockRepository
+		logger     *logging.Logging
+	}
+
+	type args struct {
+		ctx   context.Context
+		write func(ctx context.Context) ([]*audit.Entry, error)
+	}
+
+	entry := audit.NewEntry(audit.VEHICLE, 1, audit.DELETE, nil, nil)
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado uma escrita bem-sucedida quando o método Track é chamado então as entradas são gravadas na mesma transação",
+			args: args{
+				ctx: mockedContext,
+				write: func(ctx context.Context) ([]*audit.Entry, error) {
+					return []*audit.Entry{entry}, nil
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().Create(p.ctx, []*audit.Entry{entry}).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado uma escrita com erro quando o método Track é chamado então nenhuma entrada é gravada",
+			args: args{
+				ctx: mockedContext,
+				write: func(ctx context.Context) ([]*audit.Entry, error) {
+					return nil, errMocked
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro ao gravar as entradas quando o método Track é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				write: func(ctx context.Context) ([]*audit.Entry, error) {
+					return []*audit.Entry{entry}, nil
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().Create(p.ctx, []*audit.Entry{entry}).Return(errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				transactor: transaction_mocks.NewMockTransactor(ctrl),
+				repo:       audit_mocks.NewMockRepository(ctrl),
+				logger:     logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := audit.NewService(sm.transactor, sm.repo, sm.logger)
+
+			err := s.Track(test.args.ctx, test.args.write)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestService_Record(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repo := audit_mocks.NewMockRepository(ctrl)
+	s := audit.NewService(transaction_mocks.NewMockTransactor(ctrl), repo, logging.InitializerLogging(&config.Config{}))
+
+	ctx := actor.WithActor(mockedContext, &actor.Actor{UserID: 7, Username: "admin", Role: "ADMINISTRATOR"})
+	ctx = requestid.WithRequestID(ctx, "request-id")
+
+	entry := audit.NewEntry(audit.USER, 1, audit.CREATE, nil, nil)
+	repo.EXPECT().Create(ctx, []*audit.Entry{entry}).Return(nil)
+
+	err := s.Record(ctx, entry)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(7), entry.ActorID)
+	assert.Equal(t, "admin", entry.ActorUsername)
+	assert.Equal(t, "request-id", entry.RequestID)
+}
+
+func TestService_List(t *testing.T) {
+	type serviceMocks struct {
+		repo   *audit_mocks.MockRepository
+		logger *logging.Logging
+	}
+
+	type args struct {
+		ctx           context.Context
+		specification *audit.EntrySpecification
+	}
+
+	expectedEntries := &[]audit.Entry{{ID: 1, EntityType: audit.VEHICLE, EntityID: "1", Operation: audit.CREATE}}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        *[]audit.Entry
+		wantErr     error
+	}{
+		{
+			name: "Dado uma especificação válida quando o método List é chamado então as entradas são retornadas",
+			args: args{
+				ctx:           mockedContext,
+				specification: &audit.EntrySpecification{EntityType: audit.VEHICLE, EntityID: "1", Page: 1, PageSize: 10},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().List(p.ctx, p.specification).Return(expectedEntries, nil)
+			},
+			want:    expectedEntries,
+			wantErr: nil,
+		},
+		{
+			name: "Dado um tipo de entidade desconhecido quando o método List é chamado então um erro é retornado sem consultar o repositório",
+			args: args{
+				ctx:           mockedContext,
+				specification: &audit.EntrySpecification{EntityType: "fleet", EntityID: "1", Page: 1, PageSize: 10},
+			},
+			want:    nil,
+			wantErr: audit.ErrUnknownEntityType,
+		},
+		{
+			name: "Dado um ID vazio quando o método List é chamado então um erro é retornado sem consultar o repositório",
+			args: args{
+				ctx:           mockedContext,
+				specification: &audit.EntrySpecification{EntityType: audit.VEHICLE, Page: 1, PageSize: 10},
+			},
+			want:    nil,
+			wantErr: audit.ErrEmptyEntityID,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:   audit_mocks.NewMockRepository(ctrl),
+				logger: logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := audit.NewService(nil, sm.repo, sm.logger)
+
+			actualEntries, err := s.List(test.args.ctx, test.args.specification)
+
+			assert.Equal(tt, test.wantErr, err)
+			assert.Equal(tt, test.want, actualEntries)
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package auth
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/user"
+)
+
+var (
+	ErrUnauthenticated    = errors.New("the request must be authenticated with a username and password")
+	ErrInvalidCredentials = errors.New("the username or password is invalid")
+	ErrForbidden          = errors.New("the authenticated user is not allowed to perform this action")
+)
+
+// NewActor describes an authenticated user as the actor of a request.
+func NewActor(u *user.User) *actor.Actor {
+	return &actor.Actor{
+		UserID:   u.ID,
+		Username: u.Username,
+		Role:     u.Role.String(),
+	}
+}
+
+// RequireRole checks that the authenticated user in ctx has one of roles.
+func RequireRole(ctx context.Context, roles ...user.Role) error {
+	a, ok := actor.FromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	for _, role := range roles {
+		if a.Role == role.String() {
+			return nil
+		}
+	}
+
+	return ErrForbidden
+}
+
+// Authenticator checks usernames and passwords against the stored users.
+type Authenticator struct {
+	users  user.Reading
+	logger *logging.Logging
+}
+
+func NewAuthenticator(users user.Reading, l *logging.Logging) *Authenticator {
+	return &Authenticator{
+		users:  users,
+		logger: l,
+	}
+}
+
+func (a *Authenticator) Authenticate(ctx context.Context, username, password string) (*user.User, error) {
+	a.logger.Debug("[AUTH] Authenticate - DEBUG: ", map[string]any{
+		"username": username,
+	})
+	u, err := a.users.GetByUsername(ctx, username)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		a.logger.Error("[AUTH] Authenticate - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	if u == nil || !user.CheckPassword(u.HashedPassword, password) {
+		return nil, ErrInvalidCredentials
+	}
+
+	return u, nil
+}
@@ -0,0 +1,129 @@
+package auth_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/auth"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	user_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/user"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	hashedPassword, err := user.HashPassword("s3cr3t-pass")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	administrator := &user.User{ID: 1, Username: "admin", HashedPassword: hashedPassword, Role: user.ADMINISTRATOR}
+
+	type args struct {
+		username, password string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m *user_mocks.MockReading)
+		want        *user.User
+		wantErr     error
+	}{
+		{
+			name: "Dado credenciais válidas quando o método Authenticate é chamado então o usuário é retornado",
+			args: args{username: "admin", password: "s3cr3t-pass"},
+			prepareMock: func(p args, m *user_mocks.MockReading) {
+				m.EXPECT().GetByUsername(mockedContext, p.username).Return(administrator, nil)
+			},
+			want:    administrator,
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma senha incorreta quando o método Authenticate é chamado então as credenciais são recusadas",
+			args: args{username: "admin", password: "wrong-pass"},
+			prepareMock: func(p args, m *user_mocks.MockReading) {
+				m.EXPECT().GetByUsername(mockedContext, p.username).Return(administrator, nil)
+			},
+			want:    nil,
+			wantErr: auth.ErrInvalidCredentials,
+		},
+		{
+			name: "Dado um usuário inexistente quando o método Authenticate é chamado então as credenciais são recusadas",
+			args: args{username: "ghost", password: "s3cr3t-pass"},
+			prepareMock: func(p args, m *user_mocks.MockReading) {
+				m.EXPECT().GetByUsername(mockedContext, p.username).Return(nil, sql.ErrNoRows)
+			},
+			want:    nil,
+			wantErr: auth.ErrInvalidCredentials,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Authenticate é chamado então o erro é retornado",
+			args: args{username: "admin", password: "s3cr3t-pass"},
+			prepareMock: func(p args, m *user_mocks.MockReading) {
+				m.EXPECT().GetByUsername(mockedContext, p.username).Return(nil, errMocked)
+			},
+			want:    nil,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			users := user_mocks.NewMockReading(ctrl)
+			test.prepareMock(test.args, users)
+
+			a := auth.NewAuthenticator(users, logging.InitializerLogging(&config.Config{}))
+
+			got, err := a.Authenticate(mockedContext, test.args.username, test.args.password)
+
+			assert.Equal(tt, test.want, got)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestRequireRole(t *testing.T) {
+	tests := []struct {
+		name    string
+		ctx     context.Context
+		wantErr error
+	}{
+		{
+			name:    "Dado um administrador autenticado quando RequireRole é chamado então o acesso é permitido",
+			ctx:     actor.WithActor(mockedContext, auth.NewActor(&user.User{ID: 1, Role: user.ADMINISTRATOR})),
+			wantErr: nil,
+		},
+		{
+			name:    "Dado um motorista autenticado quando RequireRole é chamado então o acesso é negado",
+			ctx:     actor.WithActor(mockedContext, auth.NewActor(&user.User{ID: 2, Role: user.DRIVER})),
+			wantErr: auth.ErrForbidden,
+		},
+		{
+			name:    "Dado um contexto sem usuário quando RequireRole é chamado então a autenticação é exigida",
+			ctx:     mockedContext,
+			wantErr: auth.ErrUnauthenticated,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := auth.RequireRole(test.ctx, user.ADMINISTRATOR)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
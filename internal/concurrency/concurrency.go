@@ -0,0 +1,10 @@
+package concurrency
+
+import "errors"
+
+var (
+	// ErrVersionConflict is returned by repositories when a write carries a
+	// version that is no longer the current one, meaning someone else changed
+	// the row since it was read.
+	ErrVersionConflict = errors.New("the resource was changed by another request, fetch it again before retrying")
+)
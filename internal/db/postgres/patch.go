@@ -0,0 +1,22 @@
+package postgres
+
+import (
+	"fmt"
+	"slices"
+)
+
+// PatchColumns checks that every patched field is a column that can be
+// written and returns them together with the bookkeeping columns that every
+// versioned write also touches.
+func PatchColumns(fields []string, patchable ...string) ([]string, error) {
+	columns := make([]string, 0, len(fields)+2)
+	for _, field := range fields {
+		if !slices.Contains(patchable, field) {
+			return nil, fmt.Errorf("the column [%s] cannot be patched", field)
+		}
+
+		columns = append(columns, field)
+	}
+
+	return append(columns, "version", "updated_at"), nil
+}
@@ -0,0 +1,47 @@
+package postgres
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+)
+
+type txKey struct{}
+
+type transactor struct {
+	db *bun.DB
+}
+
+func NewTransactor(db *bun.DB) *transactor {
+	return &transactor{
+		db: db,
+	}
+}
+
+func (t *transactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return WithinTransaction(ctx, t.db, fn)
+}
+
+// WithinTransaction runs fn in the transaction carried by the context or, when
+// there is none, in a new one that is committed once fn succeeds. Repositories
+// use it for writes that must be atomic on their own, so that they still join
+// the unit of work of a service when called from one.
+func WithinTransaction(ctx context.Context, db *bun.DB, fn func(ctx context.Context) error) error {
+	if _, ok := ctx.Value(txKey{}).(bun.Tx); ok {
+		return fn(ctx)
+	}
+
+	return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+		return fn(context.WithValue(ctx, txKey{}, tx))
+	})
+}
+
+// Conn returns the transaction carried by the context, when there is one,
+// or the database itself. Repositories must build every query from it.
+func Conn(ctx context.Context, db *bun.DB) bun.IDB {
+	if tx, ok := ctx.Value(txKey{}).(bun.Tx); ok {
+		return tx
+	}
+
+	return db
+}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/LucasMateus-eng/operations-service/internal/trash"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+const (
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+// Restore brings a soft deleted row back. Its version is bumped, so ETags
+// handed out before the deletion no longer match.
+func Restore(ctx context.Context, db bun.IDB, model any, id int64) error {
+	res, err := db.NewUpdate().
+		Model(model).
+		WhereDeleted().
+		Where("id = ?", id).
+		Set("deleted_at = DEFAULT").
+		Set("updated_at = current_timestamp").
+		Set("version = version + 1").
+		Exec(ctx)
+	if err != nil {
+		if IsUniqueViolation(err) {
+			return trash.ErrRestoreConflict
+		}
+
+		return err
+	}
+
+	return checkTrashed(res)
+}
+
+// Purge removes a soft deleted row for good. Rows that were not deleted
+// first are left untouched, as are those still referred to by records that
+// must be kept.
+func Purge(ctx context.Context, db bun.IDB, model any, id int64) error {
+	res, err := db.NewDelete().
+		Model(model).
+		WhereDeleted().
+		Where("id = ?", id).
+		ForceDelete().
+		Exec(ctx)
+	if err != nil {
+		if isForeignKeyViolation(err) {
+			return trash.ErrPurgeConflict
+		}
+
+		return err
+	}
+
+	return checkTrashed(res)
+}
+
+func IsUniqueViolation(err error) bool {
+	var pgErr pgdriver.Error
+	return errors.As(err, &pgErr) && pgErr.Field('C') == uniqueViolation
+}
+
+func isForeignKeyViolation(err error) bool {
+	var pgErr pgdriver.Error
+	return errors.As(err, &pgErr) && pgErr.Field('C') == foreignKeyViolation
+}
+
+func checkTrashed(res sql.Result) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return trash.ErrNotInTrash
+	}
+
+	return nil
+}
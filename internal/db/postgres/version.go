@@ -0,0 +1,34 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
+	"github.com/uptrace/bun"
+)
+
+// CheckVersion tells why a versioned write of the row with the id matched no
+// row: sql.ErrNoRows when the row is gone, concurrency.ErrVersionConflict
+// when its version has moved on since it was read.
+func CheckVersion(ctx context.Context, db bun.IDB, model any, id int64, res sql.Result) error {
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected > 0 {
+		return nil
+	}
+
+	exists, err := db.NewSelect().Model(model).Where("id = ?", id).Exists(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return sql.ErrNoRows
+	}
+
+	return concurrency.ErrVersionConflict
+}
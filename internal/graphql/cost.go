@@ -0,0 +1,220 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	graphql_go "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// NESTED_LIST_SIZE is the number of items expected from the lists that are
+// not paginated, such as the vehicles of a driver.
+const NESTED_LIST_SIZE = 10
+
+var (
+	ErrQueryTooCostly = errors.New("the query is too costly")
+	ErrQueryTooDeep   = errors.New("the query is too deep")
+)
+
+// analysis estimates, before anything is fetched, how many objects an
+// operation may return and how deep it nests them. Every object costs one
+// and the cost of a list is multiplied by the page size it asks for, so
+// that a wide page of drivers each with its vehicles costs what it loads.
+type analysis struct {
+	schema    *graphql_go.Schema
+	fragments map[string]*ast.FragmentDefinition
+	variables map[string]any
+}
+
+func newAnalysis(schema *graphql_go.Schema, document *ast.Document, variables map[string]any) *analysis {
+	a := &analysis{
+		schema:    schema,
+		fragments: map[string]*ast.FragmentDefinition{},
+		variables: variables,
+	}
+
+	for _, definition := range document.Definitions {
+		if fragment, ok := definition.(*ast.FragmentDefinition); ok {
+			a.fragments[fragment.Name.Value] = fragment
+		}
+	}
+
+	return a
+}
+
+// operation returns the operation that will be executed, or nil when the
+// executor is going to reject the request anyway.
+func operation(document *ast.Document, operationName string) *ast.OperationDefinition {
+	var found *ast.OperationDefinition
+	for _, definition := range document.Definitions {
+		op, ok := definition.(*ast.OperationDefinition)
+		if !ok {
+			continue
+		}
+
+		if operationName == "" {
+			if found != nil {
+				return nil
+			}
+			found = op
+			continue
+		}
+
+		if op.Name != nil && op.Name.Value == operationName {
+			return op
+		}
+	}
+
+	return found
+}
+
+// check returns ErrQueryTooCostly or ErrQueryTooDeep when the operation
+// goes over the limits.
+func (a *analysis) check(op *ast.OperationDefinition, maximumCost, maximumDepth int) error {
+	if op.Operation != ast.OperationTypeQuery {
+		return nil
+	}
+
+	cost, depth := a.selectionSet(a.schema.QueryType(), op.SelectionSet, 1)
+	if depth > maximumDepth {
+		return fmt.Errorf("%w: depth %d is over the maximum of %d", ErrQueryTooDeep, depth, maximumDepth)
+	}
+	if cost > maximumCost {
+		return fmt.Errorf("%w: cost %d is over the maximum of %d", ErrQueryTooCostly, cost, maximumCost)
+	}
+
+	return nil
+}
+
+// selectionSet returns the cost of the selections made on parent and the
+// depth of the deepest of them, where depth is the depth of set itself.
+func (a *analysis) selectionSet(parent *graphql_go.Object, set *ast.SelectionSet, depth int) (cost, deepest int) {
+	if parent == nil || set == nil {
+		return 0, depth - 1
+	}
+
+	deepest = depth
+	for _, selection := range set.Selections {
+		var selectionCost, selectionDepth int
+
+		switch selection := selection.(type) {
+		case *ast.Field:
+			selectionCost, selectionDepth = a.field(parent, selection, depth)
+		case *ast.InlineFragment:
+			selectionCost, selectionDepth = a.selectionSet(a.typeCondition(parent, selection.TypeCondition), selection.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			fragment, ok := a.fragments[selection.Name.Value]
+			if !ok {
+				continue
+			}
+			selectionCost, selectionDepth = a.selectionSet(a.typeCondition(parent, fragment.TypeCondition), fragment.SelectionSet, depth)
+		}
+
+		cost += selectionCost
+		deepest = max(deepest, selectionDepth)
+	}
+
+	return cost, deepest
+}
+
+func (a *analysis) field(parent *graphql_go.Object, field *ast.Field, depth int) (cost, deepest int) {
+	// Introspection fields describe the schema and cost nothing to resolve.
+	if strings.HasPrefix(field.Name.Value, "__") {
+		return 0, depth
+	}
+
+	definition, ok := parent.Fields()[field.Name.Value]
+	if !ok {
+		return 0, depth
+	}
+
+	fieldType, list := unwrap(definition.Type)
+	object, ok := fieldType.(*graphql_go.Object)
+	if !ok {
+		return 0, depth
+	}
+
+	cost, deepest = a.selectionSet(object, field.SelectionSet, depth+1)
+	cost++
+
+	if list {
+		cost *= a.listSize(definition, field)
+	}
+
+	return cost, deepest
+}
+
+// listSize is the page size asked for a paginated list, or the default one
+// of the field, and NESTED_LIST_SIZE for the other lists. The page size is
+// kept between 1 and MAXIMUM_PAGE_SIZE, so that an invalid one, which fails
+// only when its own field is resolved, cannot lower the cost of its siblings.
+func (a *analysis) listSize(definition *graphql_go.FieldDefinition, field *ast.Field) int {
+	paginated := false
+	for _, argument := range definition.Args {
+		if argument.Name() == "pageSize" {
+			paginated = true
+		}
+	}
+
+	if !paginated {
+		return NESTED_LIST_SIZE
+	}
+
+	for _, argument := range field.Arguments {
+		if argument.Name.Value != "pageSize" {
+			continue
+		}
+
+		switch value := argument.Value.(type) {
+		case *ast.IntValue:
+			if size, err := strconv.Atoi(value.Value); err == nil {
+				return min(max(size, 1), MAXIMUM_PAGE_SIZE)
+			}
+		case *ast.Variable:
+			if size, ok := toInt(a.variables[value.Name.Value]); ok {
+				return min(max(size, 1), MAXIMUM_PAGE_SIZE)
+			}
+		}
+	}
+
+	return DEFAULT_PAGE_SIZE
+}
+
+func (a *analysis) typeCondition(parent *graphql_go.Object, condition *ast.Named) *graphql_go.Object {
+	if condition == nil {
+		return parent
+	}
+
+	object, _ := a.schema.Type(condition.Name.Value).(*graphql_go.Object)
+	return object
+}
+
+// unwrap removes the non null and list wrappers of t, telling whether a
+// list was among them.
+func unwrap(t graphql_go.Type) (named graphql_go.Type, list bool) {
+	for {
+		switch wrapper := t.(type) {
+		case *graphql_go.NonNull:
+			t = wrapper.OfType
+		case *graphql_go.List:
+			t = wrapper.OfType
+			list = true
+		default:
+			return t, list
+		}
+	}
+}
+
+// toInt reads the numbers of decoded JSON variables.
+func toInt(value any) (int, bool) {
+	switch value := value.(type) {
+	case int:
+		return value, true
+	case float64:
+		return int(value), true
+	}
+	return 0, false
+}
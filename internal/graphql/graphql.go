@@ -0,0 +1,121 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	graphql_go "github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+const (
+	DEFAULT_MAXIMUM_COST  = 1000
+	DEFAULT_MAXIMUM_DEPTH = 6
+)
+
+type Request struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// Server answers GraphQL queries over the drivers, vehicles, users,
+// addresses and assignments. It only reads, through the same use cases as
+// the REST API, so the authorization of the request applies unchanged.
+type Server struct {
+	userService          user.UseCase
+	addressService       address.UseCase
+	driverService        driver.UseCase
+	vehicleService       vehicle.UseCase
+	driverVehicleService drivervehicle.UseCase
+	schema               graphql_go.Schema
+	maximumCost          int
+	maximumDepth         int
+	logger               *logging.Logging
+}
+
+// NewServer builds the schema once. A maximum cost or depth that is not
+// positive falls back to its default.
+func NewServer(
+	userService user.UseCase,
+	addressService address.UseCase,
+	driverService driver.UseCase,
+	vehicleService vehicle.UseCase,
+	driverVehicleService drivervehicle.UseCase,
+	maximumCost, maximumDepth int,
+	l *logging.Logging,
+) (*Server, error) {
+	if maximumCost <= 0 {
+		maximumCost = DEFAULT_MAXIMUM_COST
+	}
+	if maximumDepth <= 0 {
+		maximumDepth = DEFAULT_MAXIMUM_DEPTH
+	}
+
+	s := &Server{
+		userService:          userService,
+		addressService:       addressService,
+		driverService:        driverService,
+		vehicleService:       vehicleService,
+		driverVehicleService: driverVehicleService,
+		maximumCost:          maximumCost,
+		maximumDepth:         maximumDepth,
+		logger:               l,
+	}
+
+	schema, err := s.newSchema()
+	if err != nil {
+		return nil, err
+	}
+	s.schema = schema
+
+	return s, nil
+}
+
+// Do parses and validates the query, rejects it when it is over the cost
+// or depth limits and only then executes it.
+func (s *Server) Do(ctx context.Context, req Request) *graphql_go.Result {
+	s.logger.Debug("[GRAPHQL] Do - DEBUG: ", map[string]any{
+		"operationName": req.OperationName,
+	})
+
+	document, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{
+			Body: []byte(req.Query),
+			Name: "GraphQL request",
+		}),
+	})
+	if err != nil {
+		return &graphql_go.Result{Errors: gqlerrors.FormatErrors(err)}
+	}
+
+	validation := graphql_go.ValidateDocument(&s.schema, document, nil)
+	if !validation.IsValid {
+		return &graphql_go.Result{Errors: validation.Errors}
+	}
+
+	if op := operation(document, req.OperationName); op != nil {
+		err := newAnalysis(&s.schema, document, req.Variables).check(op, s.maximumCost, s.maximumDepth)
+		if err != nil {
+			s.logger.Error("[GRAPHQL] Do - ERROR: ", map[string]any{
+				"err": err.Error(),
+			})
+			return &graphql_go.Result{Errors: gqlerrors.FormatErrors(err)}
+		}
+	}
+
+	return graphql_go.Execute(graphql_go.ExecuteParams{
+		Schema:        s.schema,
+		AST:           document,
+		OperationName: req.OperationName,
+		Args:          req.Variables,
+		Context:       withLoaders(ctx, s.newLoaders()),
+	})
+}
@@ -0,0 +1,180 @@
+package graphql_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/internal/graphql"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	address_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/address"
+	driver_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/driver"
+	driver_vehicle_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/driver-vehicle"
+	user_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/user"
+	vehicle_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/vehicle"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+type services struct {
+	user          *user_mocks.MockUseCase
+	address       *address_mocks.MockUseCase
+	driver        *driver_mocks.MockUseCase
+	vehicle       *vehicle_mocks.MockUseCase
+	driverVehicle *driver_vehicle_mocks.MockUseCase
+}
+
+func TestServer_Do(t *testing.T) {
+	drivers := &[]driver.Driver{
+		{ID: 1, UserID: 10, Attributes: driver.DriverAttributes{Name: "Ana"}},
+		{ID: 2, UserID: 20, Attributes: driver.DriverAttributes{Name: "Bruno"}},
+	}
+
+	tests := []struct {
+		name     string
+		request  graphql.Request
+		prepare  func(s services)
+		wantData string
+		wantErr  string
+	}{
+		{
+			name: "Dado motoristas com usuários e veículos quando o método Do é chamado então cada relação é carregada em um único lote",
+			request: graphql.Request{
+				Query: `{ drivers(pageSize: 2) { name user { username role } vehicles { plate } } }`,
+			},
+			prepare: func(s services) {
+				s.driver.EXPECT().List(gomock.Any(), &driver.DriverSpecification{Page: 1, PageSize: 2}).Return(drivers, nil)
+				s.user.EXPECT().ListByIDs(gomock.Any(), gomock.InAnyOrder([]int64{10, 20})).Return(&[]user.User{
+					{ID: 10, Username: "ana", Role: user.DRIVER},
+					{ID: 20, Username: "bruno", Role: user.DRIVER},
+				}, nil)
+				s.driverVehicle.EXPECT().ListByDriverIDs(gomock.Any(), gomock.InAnyOrder([]int64{1, 2})).Return(&[]drivervehicle.DriverVehicle{
+					{DriverID: 1, VehicleID: 100},
+					{DriverID: 2, VehicleID: 100},
+					{DriverID: 2, VehicleID: 200},
+				}, nil)
+				s.vehicle.EXPECT().ListByIDs(gomock.Any(), gomock.InAnyOrder([]int64{100, 200})).Return(&[]vehicle.Vehicle{
+					{ID: 100, LegalInformation: vehicle.VehicleLegalInformation{Plate: "ABC1D23"}},
+					{ID: 200, LegalInformation: vehicle.VehicleLegalInformation{Plate: "XYZ9876"}},
+				}, nil)
+			},
+			wantData: `{"drivers":[` +
+				`{"name":"Ana","user":{"role":"DRIVER","username":"ana"},"vehicles":[{"plate":"ABC1D23"}]},` +
+				`{"name":"Bruno","user":{"role":"DRIVER","username":"bruno"},"vehicles":[{"plate":"ABC1D23"},{"plate":"XYZ9876"}]}` +
+				`]}`,
+		},
+		{
+			name: "Dado um endereço ausente quando o método Do é chamado então o campo é nulo",
+			request: graphql.Request{
+				Query:     `query Driver($id: ID!) { driver(id: $id) { name address { city state } } }`,
+				Variables: map[string]any{"id": "1"},
+			},
+			prepare: func(s services) {
+				s.driver.EXPECT().GetByID(gomock.Any(), int64(1)).Return(&(*drivers)[0], nil)
+				s.address.EXPECT().ListByUserIDs(gomock.Any(), []int64{10}).Return(&[]address.Address{}, nil)
+			},
+			wantData: `{"driver":{"address":null,"name":"Ana"}}`,
+		},
+		{
+			name: "Dado um motorista inexistente quando o método Do é chamado então o resultado é nulo",
+			request: graphql.Request{
+				Query: `{ driver(id: 3) { name } }`,
+			},
+			prepare: func(s services) {
+				s.driver.EXPECT().GetByID(gomock.Any(), int64(3)).Return(nil, sql.ErrNoRows)
+			},
+			wantData: `{"driver":null}`,
+		},
+		{
+			name: "Dado uma consulta acima do custo máximo quando o método Do é chamado então nada é carregado",
+			request: graphql.Request{
+				Query: `{ drivers(pageSize: 100) { vehicles { drivers { name } } } }`,
+			},
+			prepare: func(s services) {},
+			wantErr: "the query is too costly: cost 11100 is over the maximum of 1000",
+		},
+		{
+			name: "Dado um apelido com tamanho de página negativo quando o método Do é chamado então ele não reduz o custo dos vizinhos",
+			request: graphql.Request{
+				Query: `{ a: drivers(pageSize: -1000000) { name } b: drivers(pageSize: 100) { vehicles { drivers { name } } } }`,
+			},
+			prepare: func(s services) {},
+			wantErr: "the query is too costly: cost 11101 is over the maximum of 1000",
+		},
+		{
+			name: "Dado uma consulta acima da profundidade máxima quando o método Do é chamado então nada é carregado",
+			request: graphql.Request{
+				Query: `{ vehicle(id: 1) { drivers { vehicles { drivers { vehicles { drivers { name } } } } } } }`,
+			},
+			prepare: func(s services) {},
+			wantErr: "the query is too deep: depth 7 is over the maximum of 6",
+		},
+		{
+			name: "Dado um custo vindo de fragmentos e variáveis quando o método Do é chamado então ele também é contado",
+			request: graphql.Request{
+				Query:     `query Drivers($size: Int) { drivers(pageSize: $size) { ...Fleet } } fragment Fleet on Driver { vehicles { drivers { name } } }`,
+				Variables: map[string]any{"size": float64(50)},
+			},
+			prepare: func(s services) {},
+			wantErr: "the query is too costly: cost 5550 is over the maximum of 1000",
+		},
+		{
+			name: "Dado um tamanho de página acima do máximo quando o método Do é chamado então um erro é retornado",
+			request: graphql.Request{
+				Query: `{ vehicles(pageSize: 500) { plate } }`,
+			},
+			prepare:  func(s services) {},
+			wantData: "null",
+			wantErr:  "the page size must be between 1 and 100",
+		},
+		{
+			name: "Dado um campo inexistente quando o método Do é chamado então a consulta é rejeitada",
+			request: graphql.Request{
+				Query: `{ drivers { hashedPassword } }`,
+			},
+			prepare: func(s services) {},
+			wantErr: `Cannot query field "hashedPassword" on type "Driver".`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			s := services{
+				user:          user_mocks.NewMockUseCase(ctrl),
+				address:       address_mocks.NewMockUseCase(ctrl),
+				driver:        driver_mocks.NewMockUseCase(ctrl),
+				vehicle:       vehicle_mocks.NewMockUseCase(ctrl),
+				driverVehicle: driver_vehicle_mocks.NewMockUseCase(ctrl),
+			}
+			test.prepare(s)
+
+			server, err := graphql.NewServer(s.user, s.address, s.driver, s.vehicle, s.driverVehicle, 0, 0, logging.InitializerLogging(&config.Config{}))
+			assert.Equal(tt, nil, err)
+
+			result := server.Do(context.Background(), test.request)
+
+			messages := make([]string, 0, len(result.Errors))
+			for _, e := range result.Errors {
+				messages = append(messages, e.Message)
+			}
+			assert.Equal(tt, test.wantErr, strings.Join(messages, "; "))
+
+			if len(test.wantData) > 0 {
+				data, err := json.Marshal(result.Data)
+				assert.Equal(tt, nil, err)
+				assert.Equal(tt, test.wantData, string(data))
+			}
+		})
+	}
+}
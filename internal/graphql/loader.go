@@ -0,0 +1,74 @@
+package graphql
+
+import (
+	"context"
+	"sync"
+)
+
+type batchFunc[V any] func(ctx context.Context, keys []int64) (map[int64]V, error)
+
+// loader collects the keys asked by the resolvers of one level of a query
+// and fetches them with a single call when the first of them is needed,
+// the way DataLoader does. The executor resolves the thunks returned by load
+// breadth first, so every key of a level is queued before any is fetched.
+type loader[V any] struct {
+	mu     sync.Mutex
+	fetch  batchFunc[V]
+	queue  []int64
+	queued map[int64]bool
+	values map[int64]V
+	errs   map[int64]error
+}
+
+func newLoader[V any](fetch batchFunc[V]) *loader[V] {
+	return &loader[V]{
+		fetch:  fetch,
+		queued: map[int64]bool{},
+		values: map[int64]V{},
+		errs:   map[int64]error{},
+	}
+}
+
+// load queues key for the next batch and returns the thunk that resolves
+// it. Keys already fetched are served from the cache of the request.
+func (l *loader[V]) load(ctx context.Context, key int64) func() (any, error) {
+	l.mu.Lock()
+	_, fetched := l.values[key]
+	_, failed := l.errs[key]
+	if !fetched && !failed && !l.queued[key] {
+		l.queue = append(l.queue, key)
+		l.queued[key] = true
+	}
+	l.mu.Unlock()
+
+	return func() (any, error) {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		if l.queued[key] {
+			l.dispatch(ctx)
+		}
+
+		if err, ok := l.errs[key]; ok {
+			return nil, err
+		}
+
+		return l.values[key], nil
+	}
+}
+
+func (l *loader[V]) dispatch(ctx context.Context) {
+	keys := l.queue
+	l.queue = nil
+	l.queued = map[int64]bool{}
+
+	values, err := l.fetch(ctx, keys)
+	for _, key := range keys {
+		if err != nil {
+			l.errs[key] = err
+			continue
+		}
+
+		l.values[key] = values[key]
+	}
+}
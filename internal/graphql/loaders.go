@@ -0,0 +1,208 @@
+package graphql
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+type loadersKey struct{}
+
+// loaders are created for every request, so that nothing fetched for one
+// caller is ever served to another.
+type loaders struct {
+	users                *loader[*user.User]
+	addressesByUser      *loader[*address.Address]
+	drivers              *loader[*driver.Driver]
+	vehicles             *loader[*vehicle.Vehicle]
+	assignmentsByDriver  *loader[[]*drivervehicle.DriverVehicle]
+	assignmentsByVehicle *loader[[]*drivervehicle.DriverVehicle]
+	vehiclesByDriver     *loader[[]*vehicle.Vehicle]
+	driversByVehicle     *loader[[]*driver.Driver]
+}
+
+func (s *Server) newLoaders() *loaders {
+	return &loaders{
+		users:                newLoader(s.fetchUsers),
+		addressesByUser:      newLoader(s.fetchAddressesByUser),
+		drivers:              newLoader(s.fetchDrivers),
+		vehicles:             newLoader(s.fetchVehicles),
+		assignmentsByDriver:  newLoader(s.fetchAssignmentsByDriver),
+		assignmentsByVehicle: newLoader(s.fetchAssignmentsByVehicle),
+		vehiclesByDriver:     newLoader(s.fetchVehiclesByDriver),
+		driversByVehicle:     newLoader(s.fetchDriversByVehicle),
+	}
+}
+
+func withLoaders(ctx context.Context, l *loaders) context.Context {
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+func (s *Server) fetchUsers(ctx context.Context, ids []int64) (map[int64]*user.User, error) {
+	users, err := s.userService.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*user.User, len(*users))
+	for i := range *users {
+		byID[(*users)[i].ID] = &(*users)[i]
+	}
+
+	return byID, nil
+}
+
+func (s *Server) fetchAddressesByUser(ctx context.Context, userIDs []int64) (map[int64]*address.Address, error) {
+	addresses, err := s.addressService.ListByUserIDs(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byUserID := make(map[int64]*address.Address, len(*addresses))
+	for i := range *addresses {
+		byUserID[(*addresses)[i].UserID] = &(*addresses)[i]
+	}
+
+	return byUserID, nil
+}
+
+func (s *Server) fetchDrivers(ctx context.Context, ids []int64) (map[int64]*driver.Driver, error) {
+	if len(ids) == 0 {
+		return map[int64]*driver.Driver{}, nil
+	}
+
+	drivers, err := s.driverService.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*driver.Driver, len(*drivers))
+	for i := range *drivers {
+		byID[(*drivers)[i].ID] = &(*drivers)[i]
+	}
+
+	return byID, nil
+}
+
+func (s *Server) fetchVehicles(ctx context.Context, ids []int64) (map[int64]*vehicle.Vehicle, error) {
+	if len(ids) == 0 {
+		return map[int64]*vehicle.Vehicle{}, nil
+	}
+
+	vehicles, err := s.vehicleService.ListByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[int64]*vehicle.Vehicle, len(*vehicles))
+	for i := range *vehicles {
+		byID[(*vehicles)[i].ID] = &(*vehicles)[i]
+	}
+
+	return byID, nil
+}
+
+func (s *Server) fetchAssignmentsByDriver(ctx context.Context, driverIDs []int64) (map[int64][]*drivervehicle.DriverVehicle, error) {
+	assignments, err := s.driverVehicleService.ListByDriverIDs(ctx, driverIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byDriverID := map[int64][]*drivervehicle.DriverVehicle{}
+	for i := range *assignments {
+		dv := &(*assignments)[i]
+		byDriverID[dv.DriverID] = append(byDriverID[dv.DriverID], dv)
+	}
+
+	return byDriverID, nil
+}
+
+func (s *Server) fetchAssignmentsByVehicle(ctx context.Context, vehicleIDs []int64) (map[int64][]*drivervehicle.DriverVehicle, error) {
+	assignments, err := s.driverVehicleService.ListByVehicleIDs(ctx, vehicleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byVehicleID := map[int64][]*drivervehicle.DriverVehicle{}
+	for i := range *assignments {
+		dv := &(*assignments)[i]
+		byVehicleID[dv.VehicleID] = append(byVehicleID[dv.VehicleID], dv)
+	}
+
+	return byVehicleID, nil
+}
+
+// fetchVehiclesByDriver needs two queries, the assignments of the drivers
+// and then their vehicles, but no more however many drivers are asked.
+func (s *Server) fetchVehiclesByDriver(ctx context.Context, driverIDs []int64) (map[int64][]*vehicle.Vehicle, error) {
+	assignments, err := s.fetchAssignmentsByDriver(ctx, driverIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicles, err := s.fetchVehicles(ctx, assignedIDs(assignments, func(dv *drivervehicle.DriverVehicle) int64 {
+		return dv.VehicleID
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	byDriverID := make(map[int64][]*vehicle.Vehicle, len(assignments))
+	for driverID, dvs := range assignments {
+		for _, dv := range dvs {
+			if v, ok := vehicles[dv.VehicleID]; ok {
+				byDriverID[driverID] = append(byDriverID[driverID], v)
+			}
+		}
+	}
+
+	return byDriverID, nil
+}
+
+func (s *Server) fetchDriversByVehicle(ctx context.Context, vehicleIDs []int64) (map[int64][]*driver.Driver, error) {
+	assignments, err := s.fetchAssignmentsByVehicle(ctx, vehicleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	drivers, err := s.fetchDrivers(ctx, assignedIDs(assignments, func(dv *drivervehicle.DriverVehicle) int64 {
+		return dv.DriverID
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	byVehicleID := make(map[int64][]*driver.Driver, len(assignments))
+	for vehicleID, dvs := range assignments {
+		for _, dv := range dvs {
+			if d, ok := drivers[dv.DriverID]; ok {
+				byVehicleID[vehicleID] = append(byVehicleID[vehicleID], d)
+			}
+		}
+	}
+
+	return byVehicleID, nil
+}
+
+func assignedIDs(assignments map[int64][]*drivervehicle.DriverVehicle, id func(dv *drivervehicle.DriverVehicle) int64) []int64 {
+	seen := map[int64]bool{}
+	ids := []int64{}
+	for _, dvs := range assignments {
+		for _, dv := range dvs {
+			if !seen[id(dv)] {
+				seen[id(dv)] = true
+				ids = append(ids, id(dv))
+			}
+		}
+	}
+
+	return ids
+}
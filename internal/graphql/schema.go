@@ -0,0 +1,355 @@
+package graphql
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	graphql_go "github.com/graphql-go/graphql"
+)
+
+const (
+	DEFAULT_PAGE_SIZE = 20
+	MAXIMUM_PAGE_SIZE = 100
+)
+
+var (
+	ErrInvalidID       = errors.New("the id must be a positive integer")
+	ErrInvalidPage     = errors.New("the page must be greater than zero")
+	ErrInvalidPageSize = fmt.Errorf("the page size must be between 1 and %d", MAXIMUM_PAGE_SIZE)
+)
+
+// resolve adapts a function of the domain value behind an object to a field
+// resolver, as the domain structs do not match the flat GraphQL types.
+func resolve[T any](fn func(source T) any) graphql_go.FieldResolveFn {
+	return func(p graphql_go.ResolveParams) (any, error) {
+		return fn(p.Source.(T)), nil
+	}
+}
+
+// timestamp leaves the zero time out of the response.
+func timestamp(t time.Time) any {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
+
+func idArgument(args map[string]any) (int64, error) {
+	id, err := strconv.ParseInt(fmt.Sprint(args["id"]), 10, 64)
+	if err != nil || id < 1 {
+		return 0, ErrInvalidID
+	}
+	return id, nil
+}
+
+func pageArguments(args map[string]any) (page, pageSize int, err error) {
+	page, pageSize = args["page"].(int), args["pageSize"].(int)
+	if page < 1 {
+		return 0, 0, ErrInvalidPage
+	}
+	if pageSize < 1 || pageSize > MAXIMUM_PAGE_SIZE {
+		return 0, 0, ErrInvalidPageSize
+	}
+	return page, pageSize, nil
+}
+
+func pageArgumentsConfig() graphql_go.FieldConfigArgument {
+	return graphql_go.FieldConfigArgument{
+		"page": &graphql_go.ArgumentConfig{
+			Type:         graphql_go.Int,
+			DefaultValue: 1,
+		},
+		"pageSize": &graphql_go.ArgumentConfig{
+			Type:         graphql_go.Int,
+			DefaultValue: DEFAULT_PAGE_SIZE,
+		},
+	}
+}
+
+// found turns the sql.ErrNoRows of a lookup by id into a null result.
+func found[T any](value *T, err error) (any, error) {
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+func pointers[T any](values *[]T) []*T {
+	if values == nil {
+		return []*T{}
+	}
+
+	result := make([]*T, 0, len(*values))
+	for i := range *values {
+		result = append(result, &(*values)[i])
+	}
+	return result
+}
+
+func (s *Server) newSchema() (graphql_go.Schema, error) {
+	roleEnum := graphql_go.NewEnum(graphql_go.EnumConfig{
+		Name: "Role",
+		Values: graphql_go.EnumValueConfigMap{
+			"ADMINISTRATOR": &graphql_go.EnumValueConfig{Value: user.ADMINISTRATOR},
+			"EMPLOYEE":      &graphql_go.EnumValueConfig{Value: user.EMPLOYEE},
+			"DRIVER":        &graphql_go.EnumValueConfig{Value: user.DRIVER},
+		},
+	})
+
+	licensingStatusEnum := graphql_go.NewEnum(graphql_go.EnumConfig{
+		Name: "LicensingStatus",
+		Values: graphql_go.EnumValueConfigMap{
+			"REGULAR": &graphql_go.EnumValueConfig{Value: vehicle.REGULAR},
+			"LATE":    &graphql_go.EnumValueConfig{Value: vehicle.LATE},
+			"BLOCKED": &graphql_go.EnumValueConfig{Value: vehicle.BLOCKED},
+			"SEIZED":  &graphql_go.EnumValueConfig{Value: vehicle.SEIZED},
+			"STOLEN":  &graphql_go.EnumValueConfig{Value: vehicle.STOLEN},
+		},
+	})
+
+	addressType := graphql_go.NewObject(graphql_go.ObjectConfig{
+		Name: "Address",
+		Fields: graphql_go.Fields{
+			"id":           &graphql_go.Field{Type: graphql_go.NewNonNull(graphql_go.ID), Resolve: resolve(func(a *address.Address) any { return a.ID })},
+			"locality":     &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.Locality })},
+			"number":       &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.Number })},
+			"complement":   &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.Complement })},
+			"neighborhood": &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.Neighborhood })},
+			"city":         &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.City })},
+			"state":        &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.State.String() })},
+			"cep":          &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.CEP })},
+			"country":      &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(a *address.Address) any { return a.Country })},
+			"version":      &graphql_go.Field{Type: graphql_go.Int, Resolve: resolve(func(a *address.Address) any { return a.Version })},
+			"createdAt":    &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(a *address.Address) any { return timestamp(a.CreatedAt) })},
+			"updatedAt":    &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(a *address.Address) any { return timestamp(a.UpdatedAt) })},
+		},
+	})
+
+	userType := graphql_go.NewObject(graphql_go.ObjectConfig{
+		Name: "User",
+		Fields: graphql_go.Fields{
+			"id":        &graphql_go.Field{Type: graphql_go.NewNonNull(graphql_go.ID), Resolve: resolve(func(u *user.User) any { return u.ID })},
+			"username":  &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(u *user.User) any { return u.Username })},
+			"role":      &graphql_go.Field{Type: roleEnum, Resolve: resolve(func(u *user.User) any { return u.Role })},
+			"version":   &graphql_go.Field{Type: graphql_go.Int, Resolve: resolve(func(u *user.User) any { return u.Version })},
+			"createdAt": &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(u *user.User) any { return timestamp(u.CreatedAt) })},
+			"updatedAt": &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(u *user.User) any { return timestamp(u.UpdatedAt) })},
+			"address": &graphql_go.Field{
+				Type: addressType,
+				Resolve: func(p graphql_go.ResolveParams) (any, error) {
+					return loadersFrom(p.Context).addressesByUser.load(p.Context, p.Source.(*user.User).ID), nil
+				},
+			},
+		},
+	})
+
+	var driverType, vehicleType *graphql_go.Object
+
+	assignmentType := graphql_go.NewObject(graphql_go.ObjectConfig{
+		Name: "Assignment",
+		Fields: graphql_go.FieldsThunk(func() graphql_go.Fields {
+			return graphql_go.Fields{
+				"assignedAt": &graphql_go.Field{
+					Type:    graphql_go.DateTime,
+					Resolve: resolve(func(dv *drivervehicle.DriverVehicle) any { return timestamp(dv.CreatedAt) }),
+				},
+				"driver": &graphql_go.Field{
+					Type: driverType,
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).drivers.load(p.Context, p.Source.(*drivervehicle.DriverVehicle).DriverID), nil
+					},
+				},
+				"vehicle": &graphql_go.Field{
+					Type: vehicleType,
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).vehicles.load(p.Context, p.Source.(*drivervehicle.DriverVehicle).VehicleID), nil
+					},
+				},
+			}
+		}),
+	})
+
+	driverType = graphql_go.NewObject(graphql_go.ObjectConfig{
+		Name: "Driver",
+		Fields: graphql_go.FieldsThunk(func() graphql_go.Fields {
+			return graphql_go.Fields{
+				"id":            &graphql_go.Field{Type: graphql_go.NewNonNull(graphql_go.ID), Resolve: resolve(func(d *driver.Driver) any { return d.ID })},
+				"name":          &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(d *driver.Driver) any { return d.Attributes.Name })},
+				"dateOfBirth":   &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(d *driver.Driver) any { return timestamp(d.Attributes.DateOfBirth) })},
+				"rg":            &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(d *driver.Driver) any { return d.LegalInformation.RG })},
+				"cpf":           &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(d *driver.Driver) any { return d.LegalInformation.CPF })},
+				"driverLicense": &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(d *driver.Driver) any { return d.LegalInformation.DriverLicense })},
+				"cellPhone":     &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(d *driver.Driver) any { return d.Contact.CellPhone })},
+				"email":         &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(d *driver.Driver) any { return d.Contact.Email })},
+				"version":       &graphql_go.Field{Type: graphql_go.Int, Resolve: resolve(func(d *driver.Driver) any { return d.Version })},
+				"createdAt":     &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(d *driver.Driver) any { return timestamp(d.CreatedAt) })},
+				"updatedAt":     &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(d *driver.Driver) any { return timestamp(d.UpdatedAt) })},
+				"user": &graphql_go.Field{
+					Type: userType,
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).users.load(p.Context, p.Source.(*driver.Driver).UserID), nil
+					},
+				},
+				"address": &graphql_go.Field{
+					Type: addressType,
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).addressesByUser.load(p.Context, p.Source.(*driver.Driver).UserID), nil
+					},
+				},
+				"vehicles": &graphql_go.Field{
+					Type: graphql_go.NewNonNull(graphql_go.NewList(graphql_go.NewNonNull(vehicleType))),
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).vehiclesByDriver.load(p.Context, p.Source.(*driver.Driver).ID), nil
+					},
+				},
+				"assignments": &graphql_go.Field{
+					Type: graphql_go.NewNonNull(graphql_go.NewList(graphql_go.NewNonNull(assignmentType))),
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).assignmentsByDriver.load(p.Context, p.Source.(*driver.Driver).ID), nil
+					},
+				},
+			}
+		}),
+	})
+
+	vehicleType = graphql_go.NewObject(graphql_go.ObjectConfig{
+		Name: "Vehicle",
+		Fields: graphql_go.FieldsThunk(func() graphql_go.Fields {
+			return graphql_go.Fields{
+				"id":                  &graphql_go.Field{Type: graphql_go.NewNonNull(graphql_go.ID), Resolve: resolve(func(v *vehicle.Vehicle) any { return v.ID })},
+				"brand":               &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(v *vehicle.Vehicle) any { return v.Attributes.Brand })},
+				"model":               &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(v *vehicle.Vehicle) any { return v.Attributes.Model })},
+				"yearOfManufacture":   &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(v *vehicle.Vehicle) any { return timestamp(v.Attributes.YearOfManufacture) })},
+				"category":            &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(v *vehicle.Vehicle) any { return string(v.Attributes.Category) })},
+				"plate":               &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(v *vehicle.Vehicle) any { return v.LegalInformation.Plate })},
+				"renavam":             &graphql_go.Field{Type: graphql_go.String, Resolve: resolve(func(v *vehicle.Vehicle) any { return v.LegalInformation.Renavam })},
+				"licensingExpiryDate": &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(v *vehicle.Vehicle) any { return timestamp(v.LegalInformation.Licensing.ExpiryDate) })},
+				"licensingStatus":     &graphql_go.Field{Type: licensingStatusEnum, Resolve: resolve(func(v *vehicle.Vehicle) any { return v.LegalInformation.Licensing.Status })},
+				"odometer":            &graphql_go.Field{Type: graphql_go.Int, Resolve: resolve(func(v *vehicle.Vehicle) any { return v.Odometer })},
+				"version":             &graphql_go.Field{Type: graphql_go.Int, Resolve: resolve(func(v *vehicle.Vehicle) any { return v.Version })},
+				"createdAt":           &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(v *vehicle.Vehicle) any { return timestamp(v.CreatedAt) })},
+				"updatedAt":           &graphql_go.Field{Type: graphql_go.DateTime, Resolve: resolve(func(v *vehicle.Vehicle) any { return timestamp(v.UpdatedAt) })},
+				"drivers": &graphql_go.Field{
+					Type: graphql_go.NewNonNull(graphql_go.NewList(graphql_go.NewNonNull(driverType))),
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).driversByVehicle.load(p.Context, p.Source.(*vehicle.Vehicle).ID), nil
+					},
+				},
+				"assignments": &graphql_go.Field{
+					Type: graphql_go.NewNonNull(graphql_go.NewList(graphql_go.NewNonNull(assignmentType))),
+					Resolve: func(p graphql_go.ResolveParams) (any, error) {
+						return loadersFrom(p.Context).assignmentsByVehicle.load(p.Context, p.Source.(*vehicle.Vehicle).ID), nil
+					},
+				},
+			}
+		}),
+	})
+
+	vehiclesArguments := pageArgumentsConfig()
+	vehiclesArguments["brand"] = &graphql_go.ArgumentConfig{Type: graphql_go.String}
+	vehiclesArguments["model"] = &graphql_go.ArgumentConfig{Type: graphql_go.String}
+	vehiclesArguments["licensingStatus"] = &graphql_go.ArgumentConfig{Type: licensingStatusEnum}
+
+	queryType := graphql_go.NewObject(graphql_go.ObjectConfig{
+		Name: "Query",
+		Fields: graphql_go.Fields{
+			"user": &graphql_go.Field{
+				Type: userType,
+				Args: graphql_go.FieldConfigArgument{
+					"id": &graphql_go.ArgumentConfig{Type: graphql_go.NewNonNull(graphql_go.ID)},
+				},
+				Resolve: func(p graphql_go.ResolveParams) (any, error) {
+					id, err := idArgument(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					return found(s.userService.GetByID(p.Context, id))
+				},
+			},
+			"driver": &graphql_go.Field{
+				Type: driverType,
+				Args: graphql_go.FieldConfigArgument{
+					"id": &graphql_go.ArgumentConfig{Type: graphql_go.NewNonNull(graphql_go.ID)},
+				},
+				Resolve: func(p graphql_go.ResolveParams) (any, error) {
+					id, err := idArgument(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					return found(s.driverService.GetByID(p.Context, id))
+				},
+			},
+			"drivers": &graphql_go.Field{
+				Type: graphql_go.NewNonNull(graphql_go.NewList(graphql_go.NewNonNull(driverType))),
+				Args: pageArgumentsConfig(),
+				Resolve: func(p graphql_go.ResolveParams) (any, error) {
+					page, pageSize, err := pageArguments(p.Args)
+					if err != nil {
+						return nil, err
+					}
+
+					drivers, err := s.driverService.List(p.Context, &driver.DriverSpecification{
+						Page:     page,
+						PageSize: pageSize,
+					})
+					if err != nil {
+						return nil, err
+					}
+					return pointers(drivers), nil
+				},
+			},
+			"vehicle": &graphql_go.Field{
+				Type: vehicleType,
+				Args: graphql_go.FieldConfigArgument{
+					"id": &graphql_go.ArgumentConfig{Type: graphql_go.NewNonNull(graphql_go.ID)},
+				},
+				Resolve: func(p graphql_go.ResolveParams) (any, error) {
+					id, err := idArgument(p.Args)
+					if err != nil {
+						return nil, err
+					}
+					return found(s.vehicleService.GetByID(p.Context, id))
+				},
+			},
+			"vehicles": &graphql_go.Field{
+				Type: graphql_go.NewNonNull(graphql_go.NewList(graphql_go.NewNonNull(vehicleType))),
+				Args: vehiclesArguments,
+				Resolve: func(p graphql_go.ResolveParams) (any, error) {
+					page, pageSize, err := pageArguments(p.Args)
+					if err != nil {
+						return nil, err
+					}
+
+					specification := &vehicle.VehicleSpectification{
+						Page:     page,
+						PageSize: pageSize,
+					}
+					specification.Attributes.Brand, _ = p.Args["brand"].(string)
+					specification.Attributes.Model, _ = p.Args["model"].(string)
+					specification.Licensing.Status, _ = p.Args["licensingStatus"].(vehicle.LicensingStatus)
+
+					vehicles, err := s.vehicleService.List(p.Context, specification)
+					if err != nil {
+						return nil, err
+					}
+					return pointers(vehicles), nil
+				},
+			},
+		},
+	})
+
+	return graphql_go.NewSchema(graphql_go.SchemaConfig{
+		Query: queryType,
+	})
+}
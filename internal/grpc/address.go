@@ -0,0 +1,126 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	grpc_mapping "github.com/LucasMateus-eng/operations-service/internal/grpc/mapping"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type addressServer struct {
+	operationsv1.UnimplementedAddressServiceServer
+	service address.UseCase
+}
+
+func newAddressServer(service address.UseCase) *addressServer {
+	return &addressServer{
+		service: service,
+	}
+}
+
+func (as *addressServer) GetAddress(ctx context.Context, req *operationsv1.GetAddressRequest) (*operationsv1.Address, error) {
+	a, err := as.service.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapAddressToProto(*a), nil
+}
+
+func (as *addressServer) GetAddressByUserID(ctx context.Context, req *operationsv1.GetAddressByUserIDRequest) (*operationsv1.Address, error) {
+	a, err := as.service.GetByUserID(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapAddressToProto(*a), nil
+}
+
+func (as *addressServer) CreateAddress(ctx context.Context, req *operationsv1.CreateAddressRequest) (*operationsv1.CreateAddressResponse, error) {
+	a, err := validAddress(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	a.UserID = req.GetUserId()
+
+	addressID, err := as.service.Create(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.CreateAddressResponse{Id: addressID}, nil
+}
+
+func (as *addressServer) UpdateAddress(ctx context.Context, req *operationsv1.UpdateAddressRequest) (*operationsv1.UpdateAddressResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	a, err := validAddress(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+	a.ID = req.GetId()
+	a.Version = req.GetVersion()
+
+	if err := as.service.Update(ctx, a); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateAddressResponse{Version: a.Version}, nil
+}
+
+func (as *addressServer) PatchAddress(ctx context.Context, req *operationsv1.PatchAddressRequest) (*operationsv1.UpdateAddressResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	fields, err := maskedFields(req.GetUpdateMask(), (*operationsv1.AddressInput)(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := grpc_mapping.MapProtoToAddress(req.GetAddress())
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+	a.ID = req.GetId()
+	a.Version = req.GetVersion()
+
+	if err := as.service.Patch(ctx, a, fields); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateAddressResponse{Version: a.Version}, nil
+}
+
+func (as *addressServer) DeleteAddress(ctx context.Context, req *operationsv1.DeleteAddressRequest) (*emptypb.Empty, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	if err := as.service.Delete(ctx, req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validAddress(input *operationsv1.AddressInput) (*address.Address, error) {
+	if input == nil {
+		return nil, ErrEmptyMessage
+	}
+
+	a, err := grpc_mapping.MapProtoToAddress(input)
+	if err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	if err := a.Validate(); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	return a, nil
+}
@@ -0,0 +1,186 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/driver"
+	grpc_mapping "github.com/LucasMateus-eng/operations-service/internal/grpc/mapping"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type driverServer struct {
+	operationsv1.UnimplementedDriverServiceServer
+	service            driver.UseCase
+	offboardingService driver.OffboardingUseCase
+}
+
+func newDriverServer(service driver.UseCase, offboardingService driver.OffboardingUseCase) *driverServer {
+	return &driverServer{
+		service:            service,
+		offboardingService: offboardingService,
+	}
+}
+
+func (ds *driverServer) GetDriver(ctx context.Context, req *operationsv1.GetDriverRequest) (*operationsv1.Driver, error) {
+	getDriver := ds.service.GetByID
+	if req.GetEagerLoading() {
+		getDriver = ds.service.GetByIDWithEagerLoading
+	}
+
+	d, err := getDriver(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapDriverToProto(*d), nil
+}
+
+func (ds *driverServer) GetDriverByUserID(ctx context.Context, req *operationsv1.GetDriverByUserIDRequest) (*operationsv1.Driver, error) {
+	getDriver := ds.service.GetByUserID
+	if req.GetEagerLoading() {
+		getDriver = ds.service.GetByUserIDWithEagerLoading
+	}
+
+	d, err := getDriver(ctx, req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapDriverToProto(*d), nil
+}
+
+func (ds *driverServer) ListDrivers(ctx context.Context, req *operationsv1.ListDriversRequest) (*operationsv1.ListDriversResponse, error) {
+	listDrivers := ds.service.List
+	if req.GetEagerLoading() {
+		listDrivers = ds.service.ListWithEagerLoading
+	}
+
+	drivers, err := listDrivers(ctx, &driver.DriverSpecification{
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.ListDriversResponse{Drivers: grpc_mapping.MapDriverListToProto(*drivers)}, nil
+}
+
+func (ds *driverServer) ExportDrivers(req *operationsv1.ExportDriversRequest, stream operationsv1.DriverService_ExportDriversServer) error {
+	specification := &driver.DriverSpecification{
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	}
+
+	return ds.service.Export(stream.Context(), specification, func(d *driver.Driver) error {
+		return stream.Send(grpc_mapping.MapDriverToProto(*d))
+	})
+}
+
+func (ds *driverServer) CreateDriver(ctx context.Context, req *operationsv1.CreateDriverRequest) (*operationsv1.CreateDriverResponse, error) {
+	d, err := validDriver(req.GetDriver())
+	if err != nil {
+		return nil, err
+	}
+
+	driverID, err := ds.service.Create(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.CreateDriverResponse{Id: driverID}, nil
+}
+
+func (ds *driverServer) UpdateDriver(ctx context.Context, req *operationsv1.UpdateDriverRequest) (*operationsv1.UpdateDriverResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	d, err := validDriver(req.GetDriver())
+	if err != nil {
+		return nil, err
+	}
+	d.ID = req.GetId()
+	d.Version = req.GetVersion()
+
+	if err := ds.service.Update(ctx, d); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateDriverResponse{Version: d.Version}, nil
+}
+
+func (ds *driverServer) PatchDriver(ctx context.Context, req *operationsv1.PatchDriverRequest) (*operationsv1.UpdateDriverResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	fields, err := maskedFields(req.GetUpdateMask(), (*operationsv1.DriverInput)(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	d := grpc_mapping.MapProtoToDriver(req.GetDriver())
+	d.ID = req.GetId()
+	d.Version = req.GetVersion()
+
+	if err := ds.service.Patch(ctx, d, fields); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateDriverResponse{Version: d.Version}, nil
+}
+
+func (ds *driverServer) DeleteDriver(ctx context.Context, req *operationsv1.DeleteDriverRequest) (*emptypb.Empty, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	if err := ds.offboardingService.Offboard(ctx, req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (ds *driverServer) ListDeletedDrivers(ctx context.Context, req *operationsv1.ListDeletedDriversRequest) (*operationsv1.ListDriversResponse, error) {
+	drivers, err := ds.service.ListDeleted(ctx, &driver.DriverSpecification{
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.ListDriversResponse{Drivers: grpc_mapping.MapDriverListToProto(*drivers)}, nil
+}
+
+func (ds *driverServer) RestoreDriver(ctx context.Context, req *operationsv1.RestoreDriverRequest) (*operationsv1.Driver, error) {
+	if err := ds.service.Restore(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return ds.GetDriver(ctx, &operationsv1.GetDriverRequest{Id: req.GetId()})
+}
+
+func (ds *driverServer) PurgeDriver(ctx context.Context, req *operationsv1.PurgeDriverRequest) (*emptypb.Empty, error) {
+	if err := ds.offboardingService.Purge(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validDriver(input *operationsv1.DriverInput) (*driver.Driver, error) {
+	if input == nil {
+		return nil, ErrEmptyMessage
+	}
+
+	d := grpc_mapping.MapProtoToDriver(input)
+	if err := d.Validate(); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	grpc_mapping "github.com/LucasMateus-eng/operations-service/internal/grpc/mapping"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type driverVehicleServer struct {
+	operationsv1.UnimplementedDriverVehicleServiceServer
+	service drivervehicle.UseCase
+}
+
+func newDriverVehicleServer(service drivervehicle.UseCase) *driverVehicleServer {
+	return &driverVehicleServer{
+		service: service,
+	}
+}
+
+func (dvs *driverVehicleServer) GetDriverVehicle(ctx context.Context, req *operationsv1.GetDriverVehicleRequest) (*operationsv1.DriverVehicle, error) {
+	dv, err := dvs.service.GetByID(ctx, req.GetDriverId(), req.GetVehicleId())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapDriverVehicleToProto(*dv), nil
+}
+
+func (dvs *driverVehicleServer) ListVehiclesByDriver(ctx context.Context, req *operationsv1.ListVehiclesByDriverRequest) (*operationsv1.ListVehiclesResponse, error) {
+	vehicles, err := dvs.service.GetVehicleListByDriverID(ctx, &drivervehicle.DriverVehicleSpecification{
+		DriverID: req.GetDriverId(),
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.ListVehiclesResponse{Vehicles: grpc_mapping.MapVehicleListToProto(*vehicles)}, nil
+}
+
+func (dvs *driverVehicleServer) ListDriversByVehicle(ctx context.Context, req *operationsv1.ListDriversByVehicleRequest) (*operationsv1.ListDriversResponse, error) {
+	drivers, err := dvs.service.GetDriverListByVehicleID(ctx, &drivervehicle.DriverVehicleSpecification{
+		VehicleID: req.GetVehicleId(),
+		Page:      int(req.GetPage()),
+		PageSize:  int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.ListDriversResponse{Drivers: grpc_mapping.MapDriverListToProto(*drivers)}, nil
+}
+
+func (dvs *driverVehicleServer) AssignVehicle(ctx context.Context, req *operationsv1.AssignVehicleRequest) (*operationsv1.DriverVehicle, error) {
+	dv, err := dvs.service.Create(ctx, &drivervehicle.DriverVehicle{
+		DriverID:  req.GetDriverId(),
+		VehicleID: req.GetVehicleId(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapDriverVehicleToProto(*dv), nil
+}
+
+func (dvs *driverVehicleServer) UnassignVehicle(ctx context.Context, req *operationsv1.UnassignVehicleRequest) (*emptypb.Empty, error) {
+	if err := dvs.service.Delete(ctx, req.GetDriverId(), req.GetVehicleId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/internal/auth"
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
+	"github.com/LucasMateus-eng/operations-service/internal/trash"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrMissingVersion = errors.New("the version of the resource read before the change is required")
+	ErrEmptyMessage   = errors.New("the resource to be written is required")
+)
+
+// errorCode translates the errors of the use cases into the gRPC status
+// codes matching the HTTP statuses of the REST API.
+func errorCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, context.Canceled):
+		return codes.Canceled
+	case errors.Is(err, context.DeadlineExceeded):
+		return codes.DeadlineExceeded
+	case errors.Is(err, concurrency.ErrVersionConflict):
+		return codes.Aborted
+	case errors.Is(err, mergepatch.ErrImmutableField),
+		errors.Is(err, mergepatch.ErrInvalidPatch),
+		errors.Is(err, ErrMissingVersion),
+		errors.Is(err, ErrEmptyMessage):
+		return codes.InvalidArgument
+	case errors.Is(err, sql.ErrNoRows),
+		errors.Is(err, trash.ErrNotInTrash):
+		return codes.NotFound
+	case errors.Is(err, trash.ErrRestoreConflict),
+		errors.Is(err, driver.ErrDuplicatedDriver),
+		errors.Is(err, drivervehicle.ErrAlreadyAssigned):
+		return codes.AlreadyExists
+	case errors.Is(err, drivervehicle.ErrOverdueMaintenance),
+		errors.Is(err, drivervehicle.ErrUninsured),
+		errors.Is(err, trash.ErrPurgeConflict):
+		return codes.FailedPrecondition
+	case errors.Is(err, auth.ErrUnauthenticated),
+		errors.Is(err, auth.ErrInvalidCredentials):
+		return codes.Unauthenticated
+	case errors.Is(err, auth.ErrForbidden):
+		return codes.PermissionDenied
+	}
+
+	return codes.Internal
+}
+
+// toStatus keeps the errors that already carry a status, such as those of
+// invalidArgument, and translates the others with errorCode.
+func toStatus(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return status.Error(errorCode(err), err.Error())
+}
+
+func invalidArgument(err error) error {
+	return status.Error(codes.InvalidArgument, err.Error())
+}
@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/internal/auth"
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
+	"github.com/LucasMateus-eng/operations-service/internal/trash"
+	"github.com/go-playground/assert/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want codes.Code
+	}{
+		{
+			name: "Dado nenhum erro quando a função toStatus é chamada então o código é OK",
+			err:  nil,
+			want: codes.OK,
+		},
+		{
+			name: "Dado um conflito de versão quando a função toStatus é chamada então o código é Aborted",
+			err:  fmt.Errorf("update: %w", concurrency.ErrVersionConflict),
+			want: codes.Aborted,
+		},
+		{
+			name: "Dado um registro inexistente quando a função toStatus é chamada então o código é NotFound",
+			err:  fmt.Errorf("get: %w", sql.ErrNoRows),
+			want: codes.NotFound,
+		},
+		{
+			name: "Dado um registro fora da lixeira quando a função toStatus é chamada então o código é NotFound",
+			err:  trash.ErrNotInTrash,
+			want: codes.NotFound,
+		},
+		{
+			name: "Dado credenciais inválidas quando a função toStatus é chamada então o código é Unauthenticated",
+			err:  auth.ErrInvalidCredentials,
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "Dado um papel insuficiente quando a função toStatus é chamada então o código é PermissionDenied",
+			err:  auth.ErrForbidden,
+			want: codes.PermissionDenied,
+		},
+		{
+			name: "Dado uma versão ausente quando a função toStatus é chamada então o código é InvalidArgument",
+			err:  ErrMissingVersion,
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "Dado um erro que já carrega um status quando a função toStatus é chamada então o status é mantido",
+			err:  invalidArgument(errors.New("bad state")),
+			want: codes.InvalidArgument,
+		},
+		{
+			name: "Dado um erro desconhecido quando a função toStatus é chamada então o código é Internal",
+			err:  errors.New("connection refused"),
+			want: codes.Internal,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			got := toStatus(test.err)
+			assert.Equal(tt, test.want, status.Code(got))
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package grpc
+
+import (
+	"context"
+	"encoding/base64"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/auth"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/requestid"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"github.com/LucasMateus-eng/operations-service/user"
+	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	AUTHORIZATION_METADATA = "authorization"
+	BASIC_SCHEME           = "Basic "
+)
+
+// administratorMethods are only served to administrators, as the routes
+// guarded by requireRole(user.ADMINISTRATOR) in the REST API.
+var administratorMethods = []string{
+	operationsv1.UserService_PurgeUser_FullMethodName,
+	operationsv1.DriverService_PurgeDriver_FullMethodName,
+	operationsv1.VehicleService_PurgeVehicle_FullMethodName,
+}
+
+// contextStream replaces the context of a server stream.
+type contextStream struct {
+	google_grpc.ServerStream
+	ctx context.Context
+}
+
+func (cs *contextStream) Context() context.Context {
+	return cs.ctx
+}
+
+// interceptor prepares the context of every call the way the gin
+// middlewares prepare a request: it tags the call with a request ID,
+// identifies the caller from its Basic credentials, checks the role needed
+// by the method, logs the outcome and translates the returned error into a
+// gRPC status.
+type interceptor struct {
+	authenticator *auth.Authenticator
+	logger        *logging.Logging
+}
+
+func newInterceptor(authenticator *auth.Authenticator, logger *logging.Logging) *interceptor {
+	return &interceptor{
+		authenticator: authenticator,
+		logger:        logger,
+	}
+}
+
+func (i *interceptor) unary() google_grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *google_grpc.UnaryServerInfo, handler google_grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		ctx, err := i.prepare(ctx, info.FullMethod)
+		if err != nil {
+			return nil, i.finish(ctx, info.FullMethod, start, err)
+		}
+
+		res, err := handler(ctx, req)
+		return res, i.finish(ctx, info.FullMethod, start, err)
+	}
+}
+
+func (i *interceptor) stream() google_grpc.StreamServerInterceptor {
+	return func(srv any, ss google_grpc.ServerStream, info *google_grpc.StreamServerInfo, handler google_grpc.StreamHandler) error {
+		start := time.Now()
+
+		ctx, err := i.prepare(ss.Context(), info.FullMethod)
+		if err != nil {
+			return i.finish(ctx, info.FullMethod, start, err)
+		}
+
+		err = handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+		return i.finish(ctx, info.FullMethod, start, err)
+	}
+}
+
+func (i *interceptor) prepare(ctx context.Context, method string) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	id := first(md.Get(requestid.HEADER))
+	if !requestid.IsUsable(id) {
+		id = requestid.New()
+	}
+
+	ctx = requestid.WithRequestID(ctx, id)
+	google_grpc.SetHeader(ctx, metadata.Pairs(requestid.HEADER, id))
+
+	if authorization := first(md.Get(AUTHORIZATION_METADATA)); len(authorization) > 0 {
+		username, password, ok := parseBasic(authorization)
+		if !ok {
+			return ctx, auth.ErrInvalidCredentials
+		}
+
+		u, err := i.authenticator.Authenticate(ctx, username, password)
+		if err != nil {
+			return ctx, err
+		}
+
+		ctx = actor.WithActor(ctx, auth.NewActor(u))
+	}
+
+	if slices.Contains(administratorMethods, method) {
+		if err := auth.RequireRole(ctx, user.ADMINISTRATOR); err != nil {
+			return ctx, err
+		}
+	}
+
+	return ctx, nil
+}
+
+func (i *interceptor) finish(ctx context.Context, method string, start time.Time, err error) error {
+	err = toStatus(err)
+
+	fields := map[string]any{
+		"method":    method,
+		"code":      status.Code(err).String(),
+		"duration":  time.Since(start).String(),
+		"requestID": requestid.FromContext(ctx),
+	}
+	if err != nil {
+		fields["err"] = err.Error()
+	}
+
+	i.logger.Info("gRPC call", fields)
+
+	return err
+}
+
+// parseBasic reads the credentials of a Basic authorization, as
+// http.Request.BasicAuth does.
+func parseBasic(authorization string) (username, password string, ok bool) {
+	if len(authorization) < len(BASIC_SCHEME) || !strings.EqualFold(authorization[:len(BASIC_SCHEME)], BASIC_SCHEME) {
+		return "", "", false
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(authorization[len(BASIC_SCHEME):])
+	if err != nil {
+		return "", "", false
+	}
+
+	return strings.Cut(string(decoded), ":")
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
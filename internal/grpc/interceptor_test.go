@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+)
+
+func TestParseBasic(t *testing.T) {
+	tests := []struct {
+		name          string
+		authorization string
+		wantUsername  string
+		wantPassword  string
+		wantOk        bool
+	}{
+		{
+			name:          "Dado credenciais Basic válidas quando a função parseBasic é chamada então usuário e senha são retornados",
+			authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte("admin:s3cr3t:x")),
+			wantUsername:  "admin",
+			wantPassword:  "s3cr3t:x",
+			wantOk:        true,
+		},
+		{
+			name:          "Dado um esquema em minúsculas quando a função parseBasic é chamada então as credenciais são aceitas",
+			authorization: "basic " + base64.StdEncoding.EncodeToString([]byte("admin:s3cr3t")),
+			wantUsername:  "admin",
+			wantPassword:  "s3cr3t",
+			wantOk:        true,
+		},
+		{
+			name:          "Dado um esquema Bearer quando a função parseBasic é chamada então as credenciais são rejeitadas",
+			authorization: "Bearer token",
+			wantOk:        false,
+		},
+		{
+			name:          "Dado credenciais sem base64 válido quando a função parseBasic é chamada então elas são rejeitadas",
+			authorization: "Basic !!!",
+			wantOk:        false,
+		},
+		{
+			name:          "Dado credenciais sem separador quando a função parseBasic é chamada então elas são rejeitadas",
+			authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte("admin")),
+			wantUsername:  "admin",
+			wantOk:        false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			username, password, ok := parseBasic(test.authorization)
+			assert.Equal(tt, test.wantUsername, username)
+			assert.Equal(tt, test.wantPassword, password)
+			assert.Equal(tt, test.wantOk, ok)
+		})
+	}
+}
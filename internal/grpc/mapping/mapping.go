@@ -0,0 +1,227 @@
+package mapping
+
+import (
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func MapAddressToProto(address address.Address) *operationsv1.Address {
+	return &operationsv1.Address{
+		Id:           address.ID,
+		UserId:       address.UserID,
+		Locality:     address.Locality,
+		Number:       address.Number,
+		Complement:   address.Complement,
+		Neighborhood: address.Neighborhood,
+		City:         address.City,
+		State:        stateToProto(address.State),
+		Cep:          address.CEP,
+		Country:      address.Country,
+		Version:      address.Version,
+		CreatedAt:    timestampToProto(address.CreatedAt),
+		UpdatedAt:    timestampToProto(address.UpdatedAt),
+		DeletedAt:    timestampToProto(address.DeletedAt),
+	}
+}
+
+// MapProtoToAddress fails when the state is not the name of a
+// brazilian state. An empty state is left undefined.
+func MapProtoToAddress(input *operationsv1.AddressInput) (*address.Address, error) {
+	var state address.BrazilianState
+	if len(input.GetState()) > 0 {
+		var err error
+		state, err = address.GetBrazilianState(input.GetState())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &address.Address{
+		Locality:     input.GetLocality(),
+		Number:       input.GetNumber(),
+		Complement:   input.GetComplement(),
+		Neighborhood: input.GetNeighborhood(),
+		City:         input.GetCity(),
+		State:        state,
+		CEP:          input.GetCep(),
+		Country:      input.GetCountry(),
+	}, nil
+}
+
+func MapDriverToProto(driver driver.Driver) *operationsv1.Driver {
+	var driverAddress *operationsv1.Address
+	if driver.Address != nil {
+		driverAddress = MapAddressToProto(*driver.Address)
+	}
+
+	return &operationsv1.Driver{
+		Id:            driver.ID,
+		UserId:        driver.UserID,
+		Name:          driver.Attributes.Name,
+		DateOfBirth:   timestampToProto(driver.Attributes.DateOfBirth),
+		Rg:            driver.LegalInformation.RG,
+		Cpf:           driver.LegalInformation.CPF,
+		DriverLicense: driver.LegalInformation.DriverLicense,
+		CellPhone:     driver.Contact.CellPhone,
+		Email:         driver.Contact.Email,
+		Address:       driverAddress,
+		Vehicles:      MapVehicleListToProto(driver.Vehicles),
+		Version:       driver.Version,
+		CreatedAt:     timestampToProto(driver.CreatedAt),
+		UpdatedAt:     timestampToProto(driver.UpdatedAt),
+		DeletedAt:     timestampToProto(driver.DeletedAt),
+	}
+}
+
+func MapDriverListToProto(drivers []driver.Driver) []*operationsv1.Driver {
+	driverProtos := make([]*operationsv1.Driver, 0, len(drivers))
+	for _, d := range drivers {
+		driverProtos = append(driverProtos, MapDriverToProto(d))
+	}
+	return driverProtos
+}
+
+func MapProtoToDriver(input *operationsv1.DriverInput) *driver.Driver {
+	return &driver.Driver{
+		Attributes: driver.DriverAttributes{
+			Name:        input.GetName(),
+			DateOfBirth: timestampFromProto(input.GetDateOfBirth()),
+		},
+		LegalInformation: driver.DriverLegalInformation{
+			RG:            input.GetRg(),
+			CPF:           input.GetCpf(),
+			DriverLicense: input.GetDriverLicense(),
+		},
+		Contact: driver.Contact{
+			CellPhone: input.GetCellPhone(),
+			Email:     input.GetEmail(),
+		},
+	}
+}
+
+func MapDriverVehicleToProto(driverVehicle drivervehicle.DriverVehicle) *operationsv1.DriverVehicle {
+	return &operationsv1.DriverVehicle{
+		DriverId:  driverVehicle.DriverID,
+		VehicleId: driverVehicle.VehicleID,
+		CreatedAt: timestampToProto(driverVehicle.CreatedAt),
+		UpdatedAt: timestampToProto(driverVehicle.UpdatedAt),
+		DeletedAt: timestampToProto(driverVehicle.DeletedAt),
+	}
+}
+
+func MapUserToProto(user user.User) *operationsv1.User {
+	return &operationsv1.User{
+		Id:        user.ID,
+		Username:  user.Username,
+		Role:      operationsv1.Role(user.Role),
+		Version:   user.Version,
+		CreatedAt: timestampToProto(user.CreatedAt),
+		UpdatedAt: timestampToProto(user.UpdatedAt),
+		DeletedAt: timestampToProto(user.DeletedAt),
+	}
+}
+
+func MapUserListToProto(users []user.User) []*operationsv1.User {
+	userProtos := make([]*operationsv1.User, 0, len(users))
+	for _, u := range users {
+		userProtos = append(userProtos, MapUserToProto(u))
+	}
+	return userProtos
+}
+
+func MapProtoToUser(input *operationsv1.UserInput) *user.User {
+	return &user.User{
+		Username:       input.GetUsername(),
+		HashedPassword: input.GetHashedPassword(),
+		Role:           user.Role(input.GetRole()),
+	}
+}
+
+func MapVehicleToProto(vehicle vehicle.Vehicle) *operationsv1.Vehicle {
+	return &operationsv1.Vehicle{
+		Id:                  vehicle.ID,
+		Brand:               vehicle.Attributes.Brand,
+		Model:               vehicle.Attributes.Model,
+		YearOfManufacture:   timestampToProto(vehicle.Attributes.YearOfManufacture),
+		Plate:               vehicle.LegalInformation.Plate,
+		Renavam:             vehicle.LegalInformation.Renavam,
+		LicensingExpiryDate: timestampToProto(vehicle.LegalInformation.Licensing.ExpiryDate),
+		LicensingStatus:     operationsv1.LicensingStatus(vehicle.LegalInformation.Licensing.Status),
+		Version:             vehicle.Version,
+		CreatedAt:           timestampToProto(vehicle.CreatedAt),
+		UpdatedAt:           timestampToProto(vehicle.UpdatedAt),
+		DeletedAt:           timestampToProto(vehicle.DeletedAt),
+	}
+}
+
+func MapVehicleListToProto(vehicles []vehicle.Vehicle) []*operationsv1.Vehicle {
+	vehicleProtos := make([]*operationsv1.Vehicle, 0, len(vehicles))
+	for _, v := range vehicles {
+		vehicleProtos = append(vehicleProtos, MapVehicleToProto(v))
+	}
+	return vehicleProtos
+}
+
+func MapProtoToVehicle(input *operationsv1.VehicleInput) *vehicle.Vehicle {
+	return &vehicle.Vehicle{
+		Attributes: vehicle.VehicleAttributes{
+			Brand:             input.GetBrand(),
+			Model:             input.GetModel(),
+			YearOfManufacture: timestampFromProto(input.GetYearOfManufacture()),
+		},
+		LegalInformation: vehicle.VehicleLegalInformation{
+			Plate:   input.GetPlate(),
+			Renavam: input.GetRenavam(),
+			Licensing: vehicle.Licensing{
+				ExpiryDate: timestampFromProto(input.GetLicensingExpiryDate()),
+				Status:     vehicle.LicensingStatus(input.GetLicensingStatus()),
+			},
+		},
+	}
+}
+
+func MapProtoToVehicleSpecification(request *operationsv1.ListVehiclesRequest) *vehicle.VehicleSpectification {
+	return &vehicle.VehicleSpectification{
+		Attributes: vehicle.VehicleAttributes{
+			Brand:             request.GetBrand(),
+			Model:             request.GetModel(),
+			YearOfManufacture: timestampFromProto(request.GetYearOfManufacture()),
+		},
+		Licensing: vehicle.Licensing{
+			ExpiryDate: timestampFromProto(request.GetLicensingExpiryDate()),
+			Status:     vehicle.LicensingStatus(request.GetLicensingStatus()),
+		},
+		Page:     int(request.GetPage()),
+		PageSize: int(request.GetPageSize()),
+	}
+}
+
+func stateToProto(state address.BrazilianState) string {
+	if state == address.UNDEFINED {
+		return ""
+	}
+	return state.String()
+}
+
+// timestampToProto leaves zero times, such as the deletion time of an
+// active record, unset.
+func timestampToProto(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func timestampFromProto(t *timestamppb.Timestamp) time.Time {
+	if t == nil {
+		return time.Time{}
+	}
+	return t.AsTime()
+}
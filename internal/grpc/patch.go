@@ -0,0 +1,38 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	ErrEmptyUpdateMask = errors.New("the update mask must name at least one field")
+)
+
+// maskedFields checks that every path of mask is a top level field of the
+// input message m and returns them as the field names expected by the Patch
+// of the use cases, which are the same in the REST API and in the protos.
+func maskedFields(mask *fieldmaskpb.FieldMask, m proto.Message) ([]string, error) {
+	paths := mask.GetPaths()
+	if len(paths) == 0 {
+		return nil, invalidArgument(ErrEmptyUpdateMask)
+	}
+
+	nested := slices.ContainsFunc(paths, func(path string) bool {
+		return strings.Contains(path, ".")
+	})
+	if nested || !mask.IsValid(m) {
+		return nil, invalidArgument(fmt.Errorf("%w: the update mask %v names unknown fields", mergepatch.ErrInvalidPatch, paths))
+	}
+
+	fields := slices.Clone(paths)
+	slices.Sort(fields)
+
+	return slices.Compact(fields), nil
+}
@@ -0,0 +1,53 @@
+package grpc
+
+import (
+	"testing"
+
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"github.com/go-playground/assert/v2"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestMaskedFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		mask     *fieldmaskpb.FieldMask
+		want     []string
+		wantCode codes.Code
+	}{
+		{
+			name:     "Dado uma máscara com campos repetidos quando a função maskedFields é chamada então os campos são retornados ordenados e sem repetição",
+			mask:     &fieldmaskpb.FieldMask{Paths: []string{"model", "brand", "model"}},
+			want:     []string{"brand", "model"},
+			wantCode: codes.OK,
+		},
+		{
+			name:     "Dado uma máscara vazia quando a função maskedFields é chamada então um erro InvalidArgument é retornado",
+			mask:     nil,
+			want:     nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Dado um campo desconhecido quando a função maskedFields é chamada então um erro InvalidArgument é retornado",
+			mask:     &fieldmaskpb.FieldMask{Paths: []string{"color"}},
+			want:     nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name:     "Dado um campo aninhado quando a função maskedFields é chamada então um erro InvalidArgument é retornado",
+			mask:     &fieldmaskpb.FieldMask{Paths: []string{"year_of_manufacture.seconds"}},
+			want:     nil,
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			got, err := maskedFields(test.mask, (*operationsv1.VehicleInput)(nil))
+			assert.Equal(tt, test.want, got)
+			assert.Equal(tt, test.wantCode, status.Code(err))
+		})
+	}
+}
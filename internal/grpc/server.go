@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"log"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	postgres_address "github.com/LucasMateus-eng/operations-service/address/postgres"
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/document"
+	postgres_document "github.com/LucasMateus-eng/operations-service/document/postgres"
+	"github.com/LucasMateus-eng/operations-service/document/storage"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	postgres_driver_vehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle/postgres"
+	postgres_driver "github.com/LucasMateus-eng/operations-service/driver/postgres"
+	postgres_insurance "github.com/LucasMateus-eng/operations-service/insurance/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	postgres_audit "github.com/LucasMateus-eng/operations-service/internal/audit/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/auth"
+	"github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	postgres_outbox "github.com/LucasMateus-eng/operations-service/internal/outbox/postgres"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	postgres_maintenance "github.com/LucasMateus-eng/operations-service/maintenance/postgres"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"github.com/LucasMateus-eng/operations-service/user"
+	postgres_user "github.com/LucasMateus-eng/operations-service/user/postgres"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	postgres_vehicle "github.com/LucasMateus-eng/operations-service/vehicle/postgres"
+	"github.com/uptrace/bun"
+	google_grpc "google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// NewServer builds the gRPC counterpart of gin.Handlers: the same services
+// over the same repositories, plus the standard health and reflection
+// services.
+func NewServer(config *config.Config, db *bun.DB, logger *logging.Logging) *google_grpc.Server {
+	transactor := postgres.NewTransactor(db)
+	auditRepo := postgres_audit.New(db)
+	auditService := audit.NewService(transactor, auditRepo, logger)
+	outboxService := outbox.NewService(postgres_outbox.New(db), logger)
+	userRepo := postgres_user.New(db)
+	userService := user.NewService(userRepo, auditService, logger)
+	driverRepo := postgres_driver.New(db)
+	driverService := driver.NewService(driverRepo, auditService, outboxService, logger)
+	addressRepo := postgres_address.New(db)
+	addressService := address.NewService(addressRepo, auditService, logger)
+	vehicleRepo := postgres_vehicle.New(db)
+	vehicleService := vehicle.NewService(vehicleRepo, auditService, outboxService, logger)
+	driverVehicleRepo := postgres_driver_vehicle.New(db)
+	maintenanceService := maintenance.NewService(postgres_maintenance.New(db), auditService, logger)
+	driverVehicleService := drivervehicle.NewService(driverVehicleRepo, auditService, outboxService, maintenanceService, postgres_insurance.New(db), config.InsuranceRequiredForAssignment, logger)
+	documentStorage, err := storage.New(config, logger)
+	if err != nil {
+		log.Fatalf("error when initializing the document storage: %s", err.Error())
+	}
+	documentService := document.NewService(postgres_document.New(db), auditService, documentStorage, driverService, vehicleService, config.DocumentMaximumSize, config.DocumentURLTTL, logger)
+	offboardingService := driver.NewOffboardingService(transactor, auditService, outboxService, driverVehicleRepo, driverVehicleRepo, documentService, driverRepo, logger)
+	decommissioningService := vehicle.NewDecommissioningService(transactor, auditService, outboxService, driverVehicleRepo, driverVehicleRepo, documentService, vehicleRepo, logger)
+	authenticator := auth.NewAuthenticator(userRepo, logger)
+	i := newInterceptor(authenticator, logger)
+
+	srv := google_grpc.NewServer(
+		google_grpc.ChainUnaryInterceptor(i.unary()),
+		google_grpc.ChainStreamInterceptor(i.stream()),
+	)
+
+	operationsv1.RegisterUserServiceServer(srv, newUserServer(userService))
+	operationsv1.RegisterAddressServiceServer(srv, newAddressServer(addressService))
+	operationsv1.RegisterDriverServiceServer(srv, newDriverServer(driverService, offboardingService))
+	operationsv1.RegisterVehicleServiceServer(srv, newVehicleServer(vehicleService, decommissioningService))
+	operationsv1.RegisterDriverVehicleServiceServer(srv, newDriverVehicleServer(driverVehicleService))
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(srv, healthServer)
+
+	reflection.Register(srv)
+
+	return srv
+}
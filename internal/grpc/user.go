@@ -0,0 +1,133 @@
+package grpc
+
+import (
+	"context"
+
+	grpc_mapping "github.com/LucasMateus-eng/operations-service/internal/grpc/mapping"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type userServer struct {
+	operationsv1.UnimplementedUserServiceServer
+	service user.UseCase
+}
+
+func newUserServer(service user.UseCase) *userServer {
+	return &userServer{
+		service: service,
+	}
+}
+
+func (us *userServer) GetUser(ctx context.Context, req *operationsv1.GetUserRequest) (*operationsv1.User, error) {
+	u, err := us.service.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapUserToProto(*u), nil
+}
+
+func (us *userServer) GetUserByUsername(ctx context.Context, req *operationsv1.GetUserByUsernameRequest) (*operationsv1.User, error) {
+	u, err := us.service.GetByUsername(ctx, req.GetUsername())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapUserToProto(*u), nil
+}
+
+func (us *userServer) CreateUser(ctx context.Context, req *operationsv1.CreateUserRequest) (*operationsv1.CreateUserResponse, error) {
+	if req.GetUser() == nil {
+		return nil, ErrEmptyMessage
+	}
+
+	userID, err := us.service.Create(ctx, grpc_mapping.MapProtoToUser(req.GetUser()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.CreateUserResponse{Id: userID}, nil
+}
+
+func (us *userServer) UpdateUser(ctx context.Context, req *operationsv1.UpdateUserRequest) (*operationsv1.UpdateUserResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	if req.GetUser() == nil {
+		return nil, ErrEmptyMessage
+	}
+
+	u := grpc_mapping.MapProtoToUser(req.GetUser())
+	u.ID = req.GetId()
+	u.Version = req.GetVersion()
+
+	if err := us.service.Update(ctx, u); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateUserResponse{Version: u.Version}, nil
+}
+
+func (us *userServer) PatchUser(ctx context.Context, req *operationsv1.PatchUserRequest) (*operationsv1.UpdateUserResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	fields, err := maskedFields(req.GetUpdateMask(), (*operationsv1.UserInput)(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	u := grpc_mapping.MapProtoToUser(req.GetUser())
+	u.ID = req.GetId()
+	u.Version = req.GetVersion()
+
+	if err := us.service.Patch(ctx, u, fields); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateUserResponse{Version: u.Version}, nil
+}
+
+func (us *userServer) DeleteUser(ctx context.Context, req *operationsv1.DeleteUserRequest) (*emptypb.Empty, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	if err := us.service.Delete(ctx, req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (us *userServer) ListDeletedUsers(ctx context.Context, req *operationsv1.ListDeletedUsersRequest) (*operationsv1.ListUsersResponse, error) {
+	users, err := us.service.ListDeleted(ctx, &user.UserSpecification{
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.ListUsersResponse{Users: grpc_mapping.MapUserListToProto(*users)}, nil
+}
+
+func (us *userServer) RestoreUser(ctx context.Context, req *operationsv1.RestoreUserRequest) (*operationsv1.User, error) {
+	if err := us.service.Restore(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return us.GetUser(ctx, &operationsv1.GetUserRequest{Id: req.GetId()})
+}
+
+func (us *userServer) PurgeUser(ctx context.Context, req *operationsv1.PurgeUserRequest) (*emptypb.Empty, error) {
+	if err := us.service.Purge(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
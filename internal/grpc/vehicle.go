@@ -0,0 +1,172 @@
+package grpc
+
+import (
+	"context"
+
+	grpc_mapping "github.com/LucasMateus-eng/operations-service/internal/grpc/mapping"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type vehicleServer struct {
+	operationsv1.UnimplementedVehicleServiceServer
+	service                vehicle.UseCase
+	decommissioningService vehicle.DecommissioningUseCase
+}
+
+func newVehicleServer(service vehicle.UseCase, decommissioningService vehicle.DecommissioningUseCase) *vehicleServer {
+	return &vehicleServer{
+		service:                service,
+		decommissioningService: decommissioningService,
+	}
+}
+
+func (vs *vehicleServer) GetVehicle(ctx context.Context, req *operationsv1.GetVehicleRequest) (*operationsv1.Vehicle, error) {
+	v, err := vs.service.GetByID(ctx, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapVehicleToProto(*v), nil
+}
+
+func (vs *vehicleServer) GetVehicleByPlate(ctx context.Context, req *operationsv1.GetVehicleByPlateRequest) (*operationsv1.Vehicle, error) {
+	v, err := vs.service.GetByPlate(ctx, req.GetPlate())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapVehicleToProto(*v), nil
+}
+
+func (vs *vehicleServer) GetVehicleByRenavam(ctx context.Context, req *operationsv1.GetVehicleByRenavamRequest) (*operationsv1.Vehicle, error) {
+	v, err := vs.service.GetByRenavam(ctx, req.GetRenavam())
+	if err != nil {
+		return nil, err
+	}
+
+	return grpc_mapping.MapVehicleToProto(*v), nil
+}
+
+func (vs *vehicleServer) ListVehicles(ctx context.Context, req *operationsv1.ListVehiclesRequest) (*operationsv1.ListVehiclesResponse, error) {
+	vehicles, err := vs.service.List(ctx, grpc_mapping.MapProtoToVehicleSpecification(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.ListVehiclesResponse{Vehicles: grpc_mapping.MapVehicleListToProto(*vehicles)}, nil
+}
+
+func (vs *vehicleServer) ExportVehicles(req *operationsv1.ListVehiclesRequest, stream operationsv1.VehicleService_ExportVehiclesServer) error {
+	return vs.service.Export(stream.Context(), grpc_mapping.MapProtoToVehicleSpecification(req), func(v *vehicle.Vehicle) error {
+		return stream.Send(grpc_mapping.MapVehicleToProto(*v))
+	})
+}
+
+func (vs *vehicleServer) CreateVehicle(ctx context.Context, req *operationsv1.CreateVehicleRequest) (*operationsv1.CreateVehicleResponse, error) {
+	v, err := validVehicle(req.GetVehicle())
+	if err != nil {
+		return nil, err
+	}
+
+	vehicleID, err := vs.service.Create(ctx, v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.CreateVehicleResponse{Id: vehicleID}, nil
+}
+
+func (vs *vehicleServer) UpdateVehicle(ctx context.Context, req *operationsv1.UpdateVehicleRequest) (*operationsv1.UpdateVehicleResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	v, err := validVehicle(req.GetVehicle())
+	if err != nil {
+		return nil, err
+	}
+	v.ID = req.GetId()
+	v.Version = req.GetVersion()
+
+	if err := vs.service.Update(ctx, v); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateVehicleResponse{Version: v.Version}, nil
+}
+
+func (vs *vehicleServer) PatchVehicle(ctx context.Context, req *operationsv1.PatchVehicleRequest) (*operationsv1.UpdateVehicleResponse, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	fields, err := maskedFields(req.GetUpdateMask(), (*operationsv1.VehicleInput)(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	v := grpc_mapping.MapProtoToVehicle(req.GetVehicle())
+	v.ID = req.GetId()
+	v.Version = req.GetVersion()
+
+	if err := vs.service.Patch(ctx, v, fields); err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.UpdateVehicleResponse{Version: v.Version}, nil
+}
+
+func (vs *vehicleServer) DeleteVehicle(ctx context.Context, req *operationsv1.DeleteVehicleRequest) (*emptypb.Empty, error) {
+	if req.GetVersion() < 1 {
+		return nil, ErrMissingVersion
+	}
+
+	if err := vs.decommissioningService.Decommission(ctx, req.GetId(), req.GetVersion()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (vs *vehicleServer) ListDeletedVehicles(ctx context.Context, req *operationsv1.ListDeletedVehiclesRequest) (*operationsv1.ListVehiclesResponse, error) {
+	vehicles, err := vs.service.ListDeleted(ctx, &vehicle.VehicleSpectification{
+		Page:     int(req.GetPage()),
+		PageSize: int(req.GetPageSize()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &operationsv1.ListVehiclesResponse{Vehicles: grpc_mapping.MapVehicleListToProto(*vehicles)}, nil
+}
+
+func (vs *vehicleServer) RestoreVehicle(ctx context.Context, req *operationsv1.RestoreVehicleRequest) (*operationsv1.Vehicle, error) {
+	if err := vs.service.Restore(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return vs.GetVehicle(ctx, &operationsv1.GetVehicleRequest{Id: req.GetId()})
+}
+
+func (vs *vehicleServer) PurgeVehicle(ctx context.Context, req *operationsv1.PurgeVehicleRequest) (*emptypb.Empty, error) {
+	if err := vs.decommissioningService.Purge(ctx, req.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func validVehicle(input *operationsv1.VehicleInput) (*vehicle.Vehicle, error) {
+	if input == nil {
+		return nil, ErrEmptyMessage
+	}
+
+	v := grpc_mapping.MapProtoToVehicle(input)
+	if err := v.Validate(); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	return v, nil
+}
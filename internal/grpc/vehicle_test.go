@@ -0,0 +1,134 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
+	vehicle_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/vehicle"
+	operationsv1 "github.com/LucasMateus-eng/operations-service/proto/operations/v1"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+func TestVehicleServer_PatchVehicle(t *testing.T) {
+	type prepare func(service *vehicle_mocks.MockUseCase)
+
+	tests := []struct {
+		name     string
+		req      *operationsv1.PatchVehicleRequest
+		prepare  prepare
+		want     *operationsv1.UpdateVehicleResponse
+		wantCode codes.Code
+	}{
+		{
+			name: "Dado uma máscara válida quando o método PatchVehicle é chamado então apenas os campos da máscara são alterados",
+			req: &operationsv1.PatchVehicleRequest{
+				Id:         1,
+				Version:    3,
+				Vehicle:    &operationsv1.VehicleInput{Model: "Onix"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"model"}},
+			},
+			prepare: func(service *vehicle_mocks.MockUseCase) {
+				service.EXPECT().Patch(gomock.Any(), gomock.Any(), []string{"model"}).DoAndReturn(func(_ context.Context, v *vehicle.Vehicle, _ []string) error {
+					v.Version++
+					return nil
+				})
+			},
+			want:     &operationsv1.UpdateVehicleResponse{Version: 4},
+			wantCode: codes.OK,
+		},
+		{
+			name: "Dado uma requisição sem versão quando o método PatchVehicle é chamado então o serviço não é chamado",
+			req: &operationsv1.PatchVehicleRequest{
+				Id:         1,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"model"}},
+			},
+			prepare:  func(service *vehicle_mocks.MockUseCase) {},
+			want:     nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Dado uma máscara com campo desconhecido quando o método PatchVehicle é chamado então o serviço não é chamado",
+			req: &operationsv1.PatchVehicleRequest{
+				Id:         1,
+				Version:    3,
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"color"}},
+			},
+			prepare:  func(service *vehicle_mocks.MockUseCase) {},
+			want:     nil,
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Dado uma versão desatualizada quando o método PatchVehicle é chamado então o código é Aborted",
+			req: &operationsv1.PatchVehicleRequest{
+				Id:         1,
+				Version:    2,
+				Vehicle:    &operationsv1.VehicleInput{Model: "Onix"},
+				UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"model"}},
+			},
+			prepare: func(service *vehicle_mocks.MockUseCase) {
+				service.EXPECT().Patch(gomock.Any(), gomock.Any(), []string{"model"}).Return(concurrency.ErrVersionConflict)
+			},
+			want:     nil,
+			wantCode: codes.Aborted,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			service := vehicle_mocks.NewMockUseCase(ctrl)
+			test.prepare(service)
+
+			vs := newVehicleServer(service, vehicle_mocks.NewMockDecommissioningUseCase(ctrl))
+			got, err := vs.PatchVehicle(context.Background(), test.req)
+			assert.Equal(tt, test.want, got)
+			assert.Equal(tt, test.wantCode, errorCodeOf(err))
+		})
+	}
+}
+
+func TestVehicleServer_CreateVehicle(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      *operationsv1.CreateVehicleRequest
+		wantCode codes.Code
+	}{
+		{
+			name:     "Dado uma requisição sem veículo quando o método CreateVehicle é chamado então o código é InvalidArgument",
+			req:      &operationsv1.CreateVehicleRequest{},
+			wantCode: codes.InvalidArgument,
+		},
+		{
+			name: "Dado um veículo inválido quando o método CreateVehicle é chamado então o código é InvalidArgument",
+			req: &operationsv1.CreateVehicleRequest{
+				Vehicle: &operationsv1.VehicleInput{Plate: "XX"},
+			},
+			wantCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			vs := newVehicleServer(vehicle_mocks.NewMockUseCase(ctrl), vehicle_mocks.NewMockDecommissioningUseCase(ctrl))
+			got, err := vs.CreateVehicle(context.Background(), test.req)
+			assert.Equal(tt, (*operationsv1.CreateVehicleResponse)(nil), got)
+			assert.Equal(tt, test.wantCode, errorCodeOf(err))
+		})
+	}
+}
+
+// errorCodeOf reads the code the interceptor would send for err.
+func errorCodeOf(err error) codes.Code {
+	return status.Code(toStatus(err))
+}
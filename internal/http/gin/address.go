@@ -1 +1,83 @@
 package gin
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+func getAddress(service *address.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get address", nil)
+
+		addressID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		address, err := service.GetByID(c.Request.Context(), addressID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		outputDTO := gin_mapping.MapAddressToOutputDTO(*address)
+
+		setETag(c, address.Version)
+		c.JSON(http.StatusOK, outputDTO)
+	}
+}
+
+func patchAddress(service *address.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Patch address", nil)
+
+		addressID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		current, err := service.GetByID(c.Request.Context(), addressID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if current.Version != version {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": concurrency.ErrVersionConflict.Error()})
+			return
+		}
+
+		dto, fields, err := mergePatch(c, gin_mapping.MapAddressToInputDTO(*current))
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		address := gin_mapping.MapInputDTOToAddress(*dto)
+		address.ID = addressID
+		address.Version = version
+
+		err = service.Patch(c.Request.Context(), address, fields)
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		setETag(c, address.Version)
+		c.Status(http.StatusNoContent)
+	}
+}
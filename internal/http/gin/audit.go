@@ -0,0 +1,55 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	ErrEmptyAuditLog = errors.New("no audit entries found for the query parameters used")
+)
+
+func listAuditEntries(service *audit.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List audit entries", nil)
+
+		var as gin_dto.AuditSpecificationInputDTO
+		if err := c.ShouldBindQuery(&as); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		entries, err := service.List(c.Request.Context(), &audit.EntrySpecification{
+			EntityType: as.Entity,
+			EntityID:   as.ID,
+			Page:       as.Page,
+			PageSize:   as.PageSize,
+		})
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, audit.ErrUnknownEntityType) || errors.Is(err, audit.ErrEmptyEntityID) {
+				status = http.StatusBadRequest
+			}
+			c.JSON(status, gin.H{"error": err.Error()})
+			return
+		}
+
+		if len(*entries) == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrEmptyAuditLog.Error()})
+			return
+		}
+
+		entriesDTO := make([]gin_dto.AuditEntryOutputDTO, 0, len(*entries))
+		for _, e := range *entries {
+			entriesDTO = append(entriesDTO, *gin_mapping.MapAuditEntryToOutputDTO(e))
+		}
+
+		c.JSON(http.StatusOK, entriesDTO)
+	}
+}
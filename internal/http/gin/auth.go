@@ -0,0 +1,62 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/auth"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/gin-gonic/gin"
+)
+
+const AUTHENTICATION_REALM = `Basic realm="operations-service"`
+
+// identified checks the HTTP Basic credentials sent with a request and
+// carries the authenticated user in the request context as its actor.
+// Requests without credentials go through anonymously; routes that need a
+// user are guarded by requireRole.
+func identified(authenticator *auth.Authenticator, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		username, password, ok := c.Request.BasicAuth()
+		if !ok {
+			c.Next()
+			return
+		}
+
+		u, err := authenticator.Authenticate(c.Request.Context(), username, password)
+		if err != nil {
+			status := http.StatusInternalServerError
+			if errors.Is(err, auth.ErrInvalidCredentials) {
+				c.Header("WWW-Authenticate", AUTHENTICATION_REALM)
+				status = http.StatusUnauthorized
+			}
+
+			c.AbortWithStatusJSON(status, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Request = c.Request.WithContext(actor.WithActor(c.Request.Context(), auth.NewActor(u)))
+		c.Next()
+	}
+}
+
+// requireRole lets the request through only when the authenticated user has
+// one of roles. It must run after identified.
+func requireRole(roles ...user.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := auth.RequireRole(c.Request.Context(), roles...); err != nil {
+			status := http.StatusForbidden
+			if errors.Is(err, auth.ErrUnauthenticated) {
+				c.Header("WWW-Authenticate", AUTHENTICATION_REALM)
+				status = http.StatusUnauthorized
+			}
+
+			c.AbortWithStatusJSON(status, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Next()
+	}
+}
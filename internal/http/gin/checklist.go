@@ -0,0 +1,285 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/gin-gonic/gin"
+)
+
+func checklistErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, checklist.ErrInvalidChecklist), errors.Is(err, checklist.ErrInvalidTemplate),
+		errors.Is(err, checklist.ErrNoTemplate), errors.Is(err, odometer.ErrInvalidRecord):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, checklist.ErrNotChecklistDriver):
+		return http.StatusForbidden
+	case errors.Is(err, checklist.ErrNotAssigned), errors.Is(err, checklist.ErrTemplateExists),
+		errors.Is(err, odometer.ErrDecreasingReading):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listChecklists lists the checklists, the latest submitted first, of the
+// submissions between from and to when they are given.
+func listChecklists(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List checklists", nil)
+
+		var cs gin_dto.ChecklistSpecificationInputDTO
+		if err := c.ShouldBindQuery(&cs); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		respondChecklists(c, service, cs)
+	}
+}
+
+// listVehicleChecklists lists the inspection history of the vehicle, the
+// latest first.
+func listVehicleChecklists(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List vehicle checklists", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var cs gin_dto.ChecklistSpecificationInputDTO
+		if err := c.ShouldBindQuery(&cs); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		cs.VehicleID = vehicleID
+
+		respondChecklists(c, service, cs)
+	}
+}
+
+func respondChecklists(c *gin.Context, service *checklist.Service, cs gin_dto.ChecklistSpecificationInputDTO) {
+	specification := &checklist.ChecklistSpecification{
+		VehicleID: cs.VehicleID,
+		DriverID:  cs.DriverID,
+		From:      cs.From,
+		To:        cs.To,
+		Page:      cs.Page,
+		PageSize:  cs.PageSize,
+	}
+	if len(cs.Kind) > 0 {
+		var err error
+		specification.Kind, err = checklist.GetKind(cs.Kind)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	checklists, err := service.List(c.Request.Context(), specification)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	checklistsDTO := make([]gin_dto.ChecklistOutputDTO, 0, len(*checklists))
+	for _, cl := range *checklists {
+		checklistsDTO = append(checklistsDTO, *gin_mapping.MapChecklistToOutputDTO(cl))
+	}
+
+	c.JSON(http.StatusOK, checklistsDTO)
+}
+
+func getChecklist(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get checklist", nil)
+
+		checklistID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		cl, err := service.GetByID(c.Request.Context(), checklistID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapChecklistToOutputDTO(*cl))
+	}
+}
+
+func submitChecklist(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Submit checklist", nil)
+
+		var dto gin_dto.ChecklistInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		cl := gin_mapping.MapInputDTOToChecklist(dto)
+
+		checklistID, err := service.Submit(c.Request.Context(), cl)
+		if err != nil {
+			c.JSON(checklistErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		cl.ID = checklistID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapChecklistToOutputDTO(*cl))
+	}
+}
+
+func listChecklistTemplates(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List checklist templates", nil)
+
+		var ts gin_dto.ChecklistTemplateSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ts); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &checklist.TemplateSpecification{
+			Page:     ts.Page,
+			PageSize: ts.PageSize,
+		}
+		if len(ts.Category) > 0 {
+			var err error
+			specification.Category, err = vehicle.GetCategory(ts.Category)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+		if len(ts.Kind) > 0 {
+			var err error
+			specification.Kind, err = checklist.GetKind(ts.Kind)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		templates, err := service.ListTemplates(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		templatesDTO := make([]gin_dto.ChecklistTemplateOutputDTO, 0, len(*templates))
+		for _, t := range *templates {
+			templatesDTO = append(templatesDTO, *gin_mapping.MapChecklistTemplateToOutputDTO(t))
+		}
+
+		c.JSON(http.StatusOK, templatesDTO)
+	}
+}
+
+func getChecklistTemplate(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get checklist template", nil)
+
+		templateID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		template, err := service.GetTemplate(c.Request.Context(), templateID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapChecklistTemplateToOutputDTO(*template))
+	}
+}
+
+func createChecklistTemplate(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create checklist template", nil)
+
+		var dto gin_dto.ChecklistTemplateInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		template := gin_mapping.MapInputDTOToChecklistTemplate(dto)
+
+		templateID, err := service.CreateTemplate(c.Request.Context(), template)
+		if err != nil {
+			c.JSON(checklistErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		template.ID = templateID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapChecklistTemplateToOutputDTO(*template))
+	}
+}
+
+func updateChecklistTemplate(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Update checklist template", nil)
+
+		templateID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.ChecklistTemplateInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		template := gin_mapping.MapInputDTOToChecklistTemplate(dto)
+		template.ID = templateID
+
+		err = service.UpdateTemplate(c.Request.Context(), template)
+		if err != nil {
+			c.JSON(checklistErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+func deleteChecklistTemplate(service *checklist.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Delete checklist template", nil)
+
+		templateID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.DeleteTemplate(c.Request.Context(), templateID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
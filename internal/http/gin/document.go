@@ -0,0 +1,272 @@
+package gin
+
+import (
+	"errors"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/document"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// signedFiles is a document storage whose signed URLs point back to the
+// API, as the filesystem one, which then serves the files itself.
+type signedFiles interface {
+	document.Storage
+	Verify(key, expires, signature string) error
+}
+
+func documentErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, document.ErrInvalidDocument):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, document.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, document.ErrObjectNotFound):
+		return http.StatusNotFound
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listDocuments lists the documents, the first to expire first, of those
+// expiring before expires_before when it is given.
+func listDocuments(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List documents", nil)
+
+		var ds gin_dto.DocumentSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ds); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		respondDocuments(c, service, ds)
+	}
+}
+
+func listDriverDocuments(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List driver documents", nil)
+
+		driverID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var ds gin_dto.DocumentSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ds); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ds.OwnerType, ds.OwnerID = string(document.DRIVER), driverID
+
+		respondDocuments(c, service, ds)
+	}
+}
+
+func listVehicleDocuments(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List vehicle documents", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var ds gin_dto.DocumentSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ds); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ds.OwnerType, ds.OwnerID = string(document.VEHICLE), vehicleID
+
+		respondDocuments(c, service, ds)
+	}
+}
+
+func respondDocuments(c *gin.Context, service *document.Service, ds gin_dto.DocumentSpecificationInputDTO) {
+	specification := &document.DocumentSpecification{
+		OwnerID:       ds.OwnerID,
+		ExpiresBefore: ds.ExpiresBefore,
+		Page:          ds.Page,
+		PageSize:      ds.PageSize,
+	}
+
+	var err error
+	if len(ds.OwnerType) > 0 {
+		specification.OwnerType, err = document.GetOwnerType(ds.OwnerType)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if len(ds.Type) > 0 {
+		specification.Type, err = document.GetType(ds.Type)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	documents, err := service.List(c.Request.Context(), specification)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	documentsDTO := make([]gin_dto.DocumentOutputDTO, 0, len(*documents))
+	for _, d := range *documents {
+		documentsDTO = append(documentsDTO, *gin_mapping.MapDocumentToOutputDTO(d))
+	}
+
+	c.JSON(http.StatusOK, documentsDTO)
+}
+
+func uploadDriverDocument(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Upload driver document", nil)
+
+		uploadDocument(c, service, document.DRIVER)
+	}
+}
+
+func uploadVehicleDocument(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Upload vehicle document", nil)
+
+		uploadDocument(c, service, document.VEHICLE)
+	}
+}
+
+// uploadDocument keeps the file of the multipart form as a document of the
+// owner in the id path parameter.
+func uploadDocument(c *gin.Context, service *document.Service, ownerType document.OwnerType) {
+	ownerID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	var dto gin_dto.DocumentInputDTO
+	if err := c.ShouldBind(&dto); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	file, err := dto.File.Open()
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+	defer file.Close()
+
+	d := gin_mapping.MapInputDTOToDocument(dto, ownerType, ownerID)
+
+	documentID, err := service.Upload(c.Request.Context(), d, file)
+	if err != nil {
+		c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
+		return
+	}
+
+	d.ID = documentID
+
+	c.JSON(http.StatusCreated, gin_mapping.MapDocumentToOutputDTO(*d))
+}
+
+func getDocument(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get document", nil)
+
+		documentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		d, err := service.GetByID(c.Request.Context(), documentID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapDocumentToOutputDTO(*d))
+	}
+}
+
+// getDocumentURL returns a URL from which the file of the document can be
+// downloaded without credentials until it expires.
+func getDocumentURL(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get document URL", nil)
+
+		documentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		url, expiresAt, err := service.SignURL(c.Request.Context(), documentID)
+		if err != nil {
+			c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_dto.DocumentURLOutputDTO{URL: url, ExpiresAt: expiresAt})
+	}
+}
+
+func deleteDocument(service *document.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Delete document", nil)
+
+		documentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err := service.Delete(c.Request.Context(), documentID); err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+// downloadDocumentFile serves a file of the storage to whoever holds a URL
+// signed by it, with no other credentials.
+func downloadDocumentFile(files signedFiles, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Download document file", nil)
+
+		key := strings.TrimPrefix(c.Param("key"), "/")
+		if err := files.Verify(key, c.Query("expires"), c.Query("signature")); err != nil {
+			c.JSON(http.StatusForbidden, gin.H{"error": err.Error()})
+			return
+		}
+
+		file, err := files.Get(c.Request.Context(), key)
+		if err != nil {
+			c.JSON(documentErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		c.DataFromReader(http.StatusOK, -1, mime.TypeByExtension(path.Ext(key)), file, map[string]string{
+			"X-Content-Type-Options": "nosniff",
+		})
+	}
+}
@@ -1,7 +1,7 @@
 package gin
 
 import (
-	"context"
+	"errors"
 	"net/http"
 	"strconv"
 
@@ -12,7 +12,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func listDriversByVehicleID(ctx context.Context, service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func listDriversByVehicleID(service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("List drivers by vehicle id", nil)
 
@@ -34,7 +34,7 @@ func listDriversByVehicleID(ctx context.Context, service *drivervehicle.Service,
 			PageSize:  ds.PageSize,
 		}
 
-		drivers, err := service.GetDriverListByVehicleID(ctx, driverVehicleSpecification)
+		drivers, err := service.GetDriverListByVehicleID(c.Request.Context(), driverVehicleSpecification)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -54,7 +54,7 @@ func listDriversByVehicleID(ctx context.Context, service *drivervehicle.Service,
 	}
 }
 
-func listVehiclesByDriverID(ctx context.Context, service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func listVehiclesByDriverID(service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("List vehicles by driver id", nil)
 
@@ -76,7 +76,7 @@ func listVehiclesByDriverID(ctx context.Context, service *drivervehicle.Service,
 			PageSize: ds.PageSize,
 		}
 
-		vehicles, err := service.GetVehicleListByDriverID(ctx, driverVehicleSpecification)
+		vehicles, err := service.GetVehicleListByDriverID(c.Request.Context(), driverVehicleSpecification)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -96,7 +96,7 @@ func listVehiclesByDriverID(ctx context.Context, service *drivervehicle.Service,
 	}
 }
 
-func createDriverVehicle(ctx context.Context, service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func createDriverVehicle(service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Create driver vehicle association", nil)
 
@@ -108,9 +108,15 @@ func createDriverVehicle(ctx context.Context, service *drivervehicle.Service, lo
 
 		driverVehicle := gin_mapping.MapInputDTOToDriverVehicle(dto)
 
-		driverVehicle, err := service.Create(ctx, driverVehicle)
+		driverVehicle, err := service.Create(c.Request.Context(), driverVehicle)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			status := http.StatusInternalServerError
+			if errors.Is(err, drivervehicle.ErrAlreadyAssigned) || errors.Is(err, drivervehicle.ErrOverdueMaintenance) ||
+				errors.Is(err, drivervehicle.ErrUninsured) {
+				status = http.StatusConflict
+			}
+
+			c.JSON(status, gin.H{"error": err.Error()})
 			return
 		}
 
@@ -120,7 +126,7 @@ func createDriverVehicle(ctx context.Context, service *drivervehicle.Service, lo
 	}
 }
 
-func deleteDriverVehicle(ctx context.Context, service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func deleteDriverVehicle(service *drivervehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Delete driver vehicle association", nil)
 
@@ -136,9 +142,9 @@ func deleteDriverVehicle(ctx context.Context, service *drivervehicle.Service, lo
 			return
 		}
 
-		err = service.Delete(ctx, driverID, vehicleID)
+		err = service.Delete(c.Request.Context(), driverID, vehicleID)
 		if err != nil {
-			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
 			return
 		}
 
@@ -1,16 +1,17 @@
 package gin
 
 import (
-	"context"
 	"errors"
 	"net/http"
 	"strconv"
 	"strings"
 
 	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
 	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
 	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/spreadsheet"
 	"github.com/gin-gonic/gin"
 )
 
@@ -22,7 +23,7 @@ var (
 	ErrEmptyDriverList = errors.New("no driver records found for the query parameters used")
 )
 
-func listDrivers(ctx context.Context, service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+func listDrivers(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("List drivers", nil)
 
@@ -47,9 +48,9 @@ func listDrivers(ctx context.Context, service *driver.Service, logger *logging.L
 
 		var drivers *[]driver.Driver
 		if isEagerLoading {
-			drivers, err = service.ListWithEagerLoading(ctx, driverSpecification)
+			drivers, err = service.ListWithEagerLoading(c.Request.Context(), driverSpecification)
 		} else {
-			drivers, err = service.List(ctx, driverSpecification)
+			drivers, err = service.List(c.Request.Context(), driverSpecification)
 		}
 
 		if err != nil {
@@ -71,7 +72,30 @@ func listDrivers(ctx context.Context, service *driver.Service, logger *logging.L
 	}
 }
 
-func createDriver(ctx context.Context, service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+func exportDrivers(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Export drivers", nil)
+
+		var dto gin_dto.DriverExportInputDTO
+		if err := c.ShouldBindQuery(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		export, err := newExport(c, dto.ExportInputDTO, gin_mapping.DriverExportColumns, "drivers")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		driverSpecification := gin_mapping.MapExportInputDTOToDriverSpecification(dto)
+
+		err = service.Export(c.Request.Context(), driverSpecification, export.Write)
+		export.Finish(err, logger)
+	}
+}
+
+func createDriver(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Create driver", nil)
 
@@ -83,7 +107,7 @@ func createDriver(ctx context.Context, service *driver.Service, logger *logging.
 
 		driver := gin_mapping.MapInputDTOToDriver(dto)
 
-		driverID, err := service.Create(ctx, driver)
+		driverID, err := service.Create(c.Request.Context(), driver)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -93,7 +117,111 @@ func createDriver(ctx context.Context, service *driver.Service, logger *logging.
 	}
 }
 
-func getDriver(ctx context.Context, service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+func onboardDriver(service *driver.OnboardingService, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Onboard driver", nil)
+
+		var dto gin_dto.DriverOnboardingInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		onboarding := gin_mapping.MapOnboardingInputDTOToOnboarding(dto)
+
+		onboardedDriver, err := service.Onboard(c.Request.Context(), onboarding)
+		if err != nil {
+			switch {
+			case errors.Is(err, driver.ErrInvalidOnboarding):
+				c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			case errors.Is(err, driver.ErrDuplicatedDriver):
+				c.JSON(http.StatusConflict, gin.H{"error": err.Error()})
+			default:
+				c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			}
+			return
+		}
+
+		c.JSON(http.StatusCreated, gin_mapping.MapDriverToOutputDTO(*onboardedDriver))
+	}
+}
+
+func importDrivers(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Import drivers", nil)
+
+		var dto gin_dto.ImportInputDTO
+		if err := c.ShouldBind(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		formatName := dto.Format
+		if len(strings.TrimSpace(formatName)) == 0 {
+			formatName = dto.File.Filename
+		}
+
+		format, err := spreadsheet.GetFormat(formatName)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		file, err := dto.File.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		records, err := spreadsheet.Read(format, file)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		rows := gin_mapping.MapRecordsToDriverImportRows(records)
+
+		report, err := service.Import(c.Request.Context(), rows)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if dto.ResultFormat != string(spreadsheet.CSV) {
+			c.JSON(http.StatusOK, report)
+			return
+		}
+
+		c.Header("Content-Disposition", `attachment; filename="drivers-import-result.csv"`)
+		c.Header("Content-Type", spreadsheet.ContentType(spreadsheet.CSV))
+		c.Status(http.StatusOK)
+
+		writer, err := spreadsheet.NewWriter(spreadsheet.CSV, c.Writer)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err := writer.Write(gin_mapping.DriverImportResultHeader); err != nil {
+			logger.Error("Import drivers - ERROR: ", map[string]any{"err": err.Error()})
+			return
+		}
+
+		for _, result := range report.Results {
+			if err := writer.Write(gin_mapping.MapDriverImportResultToRow(result)); err != nil {
+				logger.Error("Import drivers - ERROR: ", map[string]any{"err": err.Error()})
+				return
+			}
+		}
+
+		if err := writer.Flush(); err != nil {
+			logger.Error("Import drivers - ERROR: ", map[string]any{"err": err.Error()})
+		}
+	}
+}
+
+func getDriver(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Get driver", nil)
 
@@ -103,7 +231,7 @@ func getDriver(ctx context.Context, service *driver.Service, logger *logging.Log
 			return
 		}
 
-		driver, err := service.GetByID(ctx, driverID)
+		driver, err := service.GetByID(c.Request.Context(), driverID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -111,11 +239,12 @@ func getDriver(ctx context.Context, service *driver.Service, logger *logging.Log
 
 		outputDTO := gin_mapping.MapDriverToOutputDTO(*driver)
 
+		setETag(c, driver.Version)
 		c.JSON(http.StatusOK, outputDTO)
 	}
 }
 
-func updateDriver(ctx context.Context, service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+func updateDriver(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Update driver", nil)
 
@@ -125,6 +254,12 @@ func updateDriver(ctx context.Context, service *driver.Service, logger *logging.
 			return
 		}
 
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
 		var dto gin_dto.DriverInputDTO
 		if err := c.ShouldBindJSON(&dto); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -133,18 +268,68 @@ func updateDriver(ctx context.Context, service *driver.Service, logger *logging.
 
 		driver := gin_mapping.MapInputDTOToDriver(dto)
 		driver.ID = driverID
+		driver.Version = version
 
-		err = service.Update(ctx, driver)
+		err = service.Update(c.Request.Context(), driver)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		setETag(c, driver.Version)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func patchDriver(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Patch driver", nil)
+
+		driverID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		current, err := service.GetByID(c.Request.Context(), driverID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
+		if current.Version != version {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": concurrency.ErrVersionConflict.Error()})
+			return
+		}
+
+		dto, fields, err := mergePatch(c, gin_mapping.MapDriverToInputDTO(*current))
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		driver := gin_mapping.MapInputDTOToDriver(*dto)
+		driver.ID = driverID
+		driver.Version = version
+
+		err = service.Patch(c.Request.Context(), driver, fields)
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		setETag(c, driver.Version)
 		c.Status(http.StatusNoContent)
 	}
 }
 
-func deleteDriver(ctx context.Context, service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+func deleteDriver(service *driver.OffboardingService, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Delete driver", nil)
 
@@ -154,12 +339,93 @@ func deleteDriver(ctx context.Context, service *driver.Service, logger *logging.
 			return
 		}
 
-		err = service.Delete(ctx, driverID)
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.Offboard(c.Request.Context(), driverID, version)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func listDeletedDrivers(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List deleted drivers", nil)
+
+		var ts gin_dto.TrashSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ts); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		drivers, err := service.ListDeleted(c.Request.Context(), &driver.DriverSpecification{Page: ts.Page, PageSize: ts.PageSize})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if len(*drivers) == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrEmptyTrash.Error()})
+			return
+		}
+
+		driversDTO := make([]gin_dto.DriverOutputDTO, 0, len(*drivers))
+		for _, d := range *drivers {
+			driversDTO = append(driversDTO, *gin_mapping.MapDriverToOutputDTO(d))
+		}
+
+		c.JSON(http.StatusOK, driversDTO)
+	}
+}
+
+func restoreDriver(service *driver.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Restore driver", nil)
+
+		driverID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err = service.Restore(c.Request.Context(), driverID); err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		driver, err := service.GetByID(c.Request.Context(), driverID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
+		setETag(c, driver.Version)
+		c.JSON(http.StatusOK, gin_mapping.MapDriverToOutputDTO(*driver))
+	}
+}
+
+func purgeDriver(service *driver.OffboardingService, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Purge driver", nil)
+
+		driverID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err = service.Purge(c.Request.Context(), driverID); err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
 		c.Status(http.StatusNoContent)
 	}
 }
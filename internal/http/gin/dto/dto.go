@@ -1,6 +1,8 @@
 package dto
 
 import (
+	"encoding/json"
+	"mime/multipart"
 	"time"
 
 	"github.com/LucasMateus-eng/operations-service/address"
@@ -19,11 +21,24 @@ type AddressOutputDTO struct {
 	State        address.BrazilianState `json:"state,omitempty"`
 	CEP          string                 `json:"cep,omitempty"`
 	Country      string                 `json:"country,omitempty"`
+	Version      int64                  `json:"version,omitempty"`
 	CreatedAt    time.Time              `json:"created_at,omitempty"`
 	UpdatedAt    time.Time              `json:"updated_at,omitempty"`
 	DeletedAt    time.Time              `json:"deleted_at,omitempty"`
 }
 
+type AddressInputDTO struct {
+	ID           int64                  `json:"id"`
+	Locality     string                 `json:"locality" binding:"required"`
+	Number       string                 `json:"number" binding:"required"`
+	Complement   string                 `json:"complement"`
+	Neighborhood string                 `json:"neighborhood" binding:"required"`
+	City         string                 `json:"city" binding:"required"`
+	State        address.BrazilianState `json:"state" binding:"required"`
+	CEP          string                 `json:"cep" binding:"required"`
+	Country      string                 `json:"country" binding:"required"`
+}
+
 type DriverVehicleOutputDTO struct {
 	DriverID  int64     `json:"driver_id"`
 	VehicleID int64     `json:"vehicle_id"`
@@ -54,6 +69,7 @@ type DriverOutputDTO struct {
 	Email         string             `json:"email,omitempty"`
 	Address       *AddressOutputDTO  `json:"address,omitempty"`
 	Vehicles      []VehicleOutputDTO `json:"vehicles,omitempty"`
+	Version       int64              `json:"version,omitempty"`
 	CreatedAt     time.Time          `json:"created_at,omitempty"`
 	UpdatedAt     time.Time          `json:"updated_at,omitempty"`
 	DeletedAt     time.Time          `json:"deleted_at,omitempty"`
@@ -70,16 +86,60 @@ type DriverInputDTO struct {
 	Email         string    `json:"email" binding:"required"`
 }
 
+type DriverOnboardingInputDTO struct {
+	Username string          `json:"username"`
+	Password string          `json:"password" binding:"required"`
+	Driver   DriverInputDTO  `json:"driver" binding:"required"`
+	Address  AddressInputDTO `json:"address" binding:"required"`
+}
+
 type DriverSpecificationInputDTO struct {
 	Page     int `form:"page" binding:"required"`
 	PageSize int `form:"pageSize" binding:"required"`
 }
 
+type DriverExportInputDTO struct {
+	Page     int `form:"page"`
+	PageSize int `form:"pageSize"`
+	ExportInputDTO
+}
+
+type TrashSpecificationInputDTO struct {
+	Page     int `form:"page" binding:"required"`
+	PageSize int `form:"pageSize" binding:"required"`
+}
+
+type AuditSpecificationInputDTO struct {
+	Entity   string `form:"entity" binding:"required"`
+	ID       string `form:"id" binding:"required"`
+	Page     int    `form:"page"`
+	PageSize int    `form:"pageSize"`
+}
+
+type AuditChangeOutputDTO struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
+
+type AuditEntryOutputDTO struct {
+	ID            int64                  `json:"id"`
+	EntityType    string                 `json:"entity_type"`
+	EntityID      string                 `json:"entity_id"`
+	Operation     string                 `json:"operation"`
+	ActorID       int64                  `json:"actor_id,omitempty"`
+	ActorUsername string                 `json:"actor_username,omitempty"`
+	RequestID     string                 `json:"request_id,omitempty"`
+	Changes       []AuditChangeOutputDTO `json:"changes"`
+	CreatedAt     time.Time              `json:"created_at"`
+}
+
 type UserOutputDTO struct {
 	ID             int64     `json:"id"`
 	Username       string    `json:"username,omitempty"`
 	HashedPassword string    `json:"hashed_password,omitempty"`
 	Role           user.Role `json:"role,omitempty"`
+	Version        int64     `json:"version,omitempty"`
 	CreatedAt      time.Time `json:"created_at,omitempty"`
 	UpdatedAt      time.Time `json:"updated_at,omitempty"`
 	DeletedAt      time.Time `json:"deleted_at,omitempty"`
@@ -97,10 +157,13 @@ type VehicleOutputDTO struct {
 	Brand               string                  `json:"brand,omitempty"`
 	Model               string                  `json:"model,omitempty"`
 	YearOfManufacture   time.Time               `json:"year_of_manufacture,omitempty"`
+	Category            vehicle.Category        `json:"category,omitempty"`
 	Plate               string                  `json:"plate,omitempty"`
 	Renavam             string                  `json:"renavam,omitempty"`
 	LicensingExpiryDate time.Time               `json:"licensing_expiry_date,omitempty"`
 	LicensingStatus     vehicle.LicensingStatus `json:"licensing_status,omitempty"`
+	Odometer            int64                   `json:"odometer"`
+	Version             int64                   `json:"version,omitempty"`
 	CreatedAt           time.Time               `json:"created_at,omitempty"`
 	UpdatedAt           time.Time               `json:"updated_at,omitempty"`
 	DeletedAt           time.Time               `json:"deleted_at,omitempty"`
@@ -111,6 +174,7 @@ type VehicleInputDTO struct {
 	Brand               string                  `json:"brand" binding:"required"`
 	Model               string                  `json:"model" binding:"required"`
 	YearOfManufacture   time.Time               `json:"year_of_manufacture" binding:"required"`
+	Category            vehicle.Category        `json:"category,omitempty"`
 	Plate               string                  `json:"plate" binding:"required"`
 	Renavam             string                  `json:"renavam" binding:"required"`
 	LicensingExpiryDate time.Time               `json:"licensing_expiry_date" binding:"required"`
@@ -121,8 +185,754 @@ type VehicleSpecificationInputDTO struct {
 	Brand               string                  `form:"brand"`
 	Model               string                  `form:"model"`
 	YearOfManufacture   time.Time               `form:"year_of_manufacture"`
+	Category            vehicle.Category        `form:"category"`
 	LicensingExpiryDate time.Time               `form:"licensing_expiry_date"`
 	LicensingStatus     vehicle.LicensingStatus `form:"licensing_status"`
+	Uninsured           bool                    `form:"uninsured"`
 	Page                int                     `form:"page" binding:"required"`
 	PageSize            int                     `form:"pageSize" binding:"required"`
 }
+
+type VehicleExportInputDTO struct {
+	Brand               string                  `form:"brand"`
+	Model               string                  `form:"model"`
+	YearOfManufacture   time.Time               `form:"year_of_manufacture"`
+	Category            vehicle.Category        `form:"category"`
+	LicensingExpiryDate time.Time               `form:"licensing_expiry_date"`
+	LicensingStatus     vehicle.LicensingStatus `form:"licensing_status"`
+	Uninsured           bool                    `form:"uninsured"`
+	Page                int                     `form:"page"`
+	PageSize            int                     `form:"pageSize"`
+	ExportInputDTO
+}
+
+type ExportInputDTO struct {
+	Format  string `form:"format"`
+	Columns string `form:"columns"`
+	Locale  string `form:"locale"`
+}
+
+type ImportInputDTO struct {
+	File         *multipart.FileHeader `form:"file" binding:"required"`
+	Format       string                `form:"format"`
+	DryRun       bool                  `form:"dry_run"`
+	ResultFormat string                `form:"result_format"`
+}
+
+type WebhookInputDTO struct {
+	URL        string   `json:"url" binding:"required"`
+	EventTypes []string `json:"event_types" binding:"required"`
+	Secret     string   `json:"secret"`
+	Active     *bool    `json:"active"`
+}
+
+// WebhookOutputDTO only carries the secret in the response to the creation
+// of the subscription.
+type WebhookOutputDTO struct {
+	ID         int64     `json:"id"`
+	URL        string    `json:"url"`
+	EventTypes []string  `json:"event_types"`
+	Secret     string    `json:"secret,omitempty"`
+	Active     bool      `json:"active"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+}
+
+type WebhookSpecificationInputDTO struct {
+	Page     int `form:"page"`
+	PageSize int `form:"pageSize"`
+}
+
+type WebhookDeliverySpecificationInputDTO struct {
+	Status   string `form:"status"`
+	Page     int    `form:"page"`
+	PageSize int    `form:"pageSize"`
+}
+
+type WebhookAttemptSpecificationInputDTO struct {
+	DeliveryID int64 `form:"delivery_id"`
+	Page       int   `form:"page"`
+	PageSize   int   `form:"pageSize"`
+}
+
+type WebhookDeliveryOutputDTO struct {
+	ID             int64           `json:"id"`
+	SubscriptionID int64           `json:"subscription_id"`
+	EventID        int64           `json:"event_id"`
+	EventType      string          `json:"event_type"`
+	Body           json.RawMessage `json:"body"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  time.Time       `json:"next_attempt_at,omitempty"`
+	LastStatusCode int             `json:"last_status_code,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	DeliveredAt    *time.Time      `json:"delivered_at,omitempty"`
+	CreatedAt      time.Time       `json:"created_at,omitempty"`
+	UpdatedAt      time.Time       `json:"updated_at,omitempty"`
+}
+
+type WebhookAttemptOutputDTO struct {
+	ID         int64     `json:"id"`
+	DeliveryID int64     `json:"delivery_id"`
+	Number     int       `json:"number"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	DurationMS int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+type GraphQLInputDTO struct {
+	Query         string         `json:"query" binding:"required"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+type ErrorOutputDTO struct {
+	Error string `json:"error"`
+}
+
+type MaintenancePartDTO struct {
+	Name     string `json:"name" binding:"required"`
+	Quantity int    `json:"quantity" binding:"required"`
+	UnitCost int64  `json:"unit_cost"`
+}
+
+// MaintenanceOrderInputDTO carries the costs in cents. PlanID is only set on
+// the preventive orders that fulfill a plan.
+type MaintenanceOrderInputDTO struct {
+	VehicleID   int64                `json:"vehicle_id" binding:"required"`
+	PlanID      int64                `json:"plan_id"`
+	Type        string               `json:"type" binding:"required"`
+	PerformedAt time.Time            `json:"performed_at" binding:"required"`
+	Odometer    int64                `json:"odometer"`
+	Cost        int64                `json:"cost"`
+	Supplier    string               `json:"supplier"`
+	Parts       []MaintenancePartDTO `json:"parts"`
+	Notes       string               `json:"notes"`
+}
+
+type MaintenanceOrderOutputDTO struct {
+	ID          int64                `json:"id"`
+	VehicleID   int64                `json:"vehicle_id"`
+	PlanID      int64                `json:"plan_id,omitempty"`
+	Type        string               `json:"type"`
+	PerformedAt time.Time            `json:"performed_at"`
+	Odometer    int64                `json:"odometer"`
+	Cost        int64                `json:"cost"`
+	Supplier    string               `json:"supplier,omitempty"`
+	Parts       []MaintenancePartDTO `json:"parts"`
+	Notes       string               `json:"notes,omitempty"`
+	CreatedAt   time.Time            `json:"created_at,omitempty"`
+	UpdatedAt   time.Time            `json:"updated_at,omitempty"`
+}
+
+type MaintenanceOrderSpecificationInputDTO struct {
+	VehicleID int64 `form:"vehicle_id"`
+	Page      int   `form:"page"`
+	PageSize  int   `form:"pageSize"`
+}
+
+type MaintenancePlanInputDTO struct {
+	Name         string `json:"name" binding:"required"`
+	Brand        string `json:"brand" binding:"required"`
+	Model        string `json:"model" binding:"required"`
+	IntervalKm   int64  `json:"interval_km"`
+	IntervalDays int    `json:"interval_days"`
+	Critical     bool   `json:"critical"`
+}
+
+type MaintenancePlanOutputDTO struct {
+	ID           int64     `json:"id"`
+	Name         string    `json:"name"`
+	Brand        string    `json:"brand"`
+	Model        string    `json:"model"`
+	IntervalKm   int64     `json:"interval_km,omitempty"`
+	IntervalDays int       `json:"interval_days,omitempty"`
+	Critical     bool      `json:"critical"`
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+}
+
+type MaintenancePlanSpecificationInputDTO struct {
+	Brand    string `form:"brand"`
+	Model    string `form:"model"`
+	Page     int    `form:"page"`
+	PageSize int    `form:"pageSize"`
+}
+
+type MaintenanceOverdueSpecificationInputDTO struct {
+	Critical bool `form:"critical"`
+	Page     int  `form:"page"`
+	PageSize int  `form:"pageSize"`
+}
+
+type MaintenanceTicketOutputDTO struct {
+	ID          int64      `json:"id"`
+	VehicleID   int64      `json:"vehicle_id"`
+	ChecklistID int64      `json:"checklist_id,omitempty"`
+	Description string     `json:"description"`
+	Status      string     `json:"status"`
+	OrderID     int64      `json:"order_id,omitempty"`
+	ClosedAt    *time.Time `json:"closed_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at,omitempty"`
+	UpdatedAt   time.Time  `json:"updated_at,omitempty"`
+}
+
+type MaintenanceTicketSpecificationInputDTO struct {
+	VehicleID   int64  `form:"vehicle_id"`
+	ChecklistID int64  `form:"checklist_id"`
+	Status      string `form:"status"`
+	Page        int    `form:"page"`
+	PageSize    int    `form:"pageSize"`
+}
+
+// MaintenanceTicketCloseInputDTO only carries an order when a service order
+// fixed what the ticket is about.
+type MaintenanceTicketCloseInputDTO struct {
+	OrderID int64 `json:"order_id"`
+}
+
+// MaintenanceDueOutputDTO leaves out due_at on the plans without a time
+// interval and due_odometer on those without a distance one.
+type MaintenanceDueOutputDTO struct {
+	VehicleID       int64                    `json:"vehicle_id"`
+	Plan            MaintenancePlanOutputDTO `json:"plan"`
+	LastPerformedAt *time.Time               `json:"last_performed_at,omitempty"`
+	LastOdometer    int64                    `json:"last_odometer,omitempty"`
+	Odometer        int64                    `json:"odometer"`
+	DueAt           *time.Time               `json:"due_at,omitempty"`
+	DueOdometer     int64                    `json:"due_odometer,omitempty"`
+	Overdue         bool                     `json:"overdue"`
+}
+
+// OdometerRecordInputDTO only carries a reason on corrections.
+type OdometerRecordInputDTO struct {
+	ReadAt     time.Time `json:"read_at" binding:"required"`
+	Km         int64     `json:"km"`
+	Source     string    `json:"source" binding:"required"`
+	Correction bool      `json:"correction"`
+	Reason     string    `json:"reason"`
+}
+
+type OdometerRecordOutputDTO struct {
+	ID         int64     `json:"id"`
+	VehicleID  int64     `json:"vehicle_id"`
+	ReadAt     time.Time `json:"read_at"`
+	Km         int64     `json:"km"`
+	Source     string    `json:"source"`
+	Correction bool      `json:"correction"`
+	Reason     string    `json:"reason,omitempty"`
+	Anomaly    bool      `json:"anomaly"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+}
+
+type OdometerRecordSpecificationInputDTO struct {
+	Anomaly  bool `form:"anomaly"`
+	Page     int  `form:"page"`
+	PageSize int  `form:"pageSize"`
+}
+
+// RefuelInputDTO carries the price in cents. The driver is only needed when
+// the vehicle is assigned to more than one.
+type RefuelInputDTO struct {
+	VehicleID int64     `json:"vehicle_id" binding:"required"`
+	DriverID  int64     `json:"driver_id"`
+	FueledAt  time.Time `json:"fueled_at" binding:"required"`
+	Litres    float64   `json:"litres" binding:"required"`
+	FuelType  string    `json:"fuel_type" binding:"required"`
+	Price     int64     `json:"price"`
+	Station   string    `json:"station"`
+	Odometer  int64     `json:"odometer" binding:"required"`
+}
+
+type RefuelOutputDTO struct {
+	ID        int64     `json:"id"`
+	VehicleID int64     `json:"vehicle_id"`
+	DriverID  int64     `json:"driver_id"`
+	FueledAt  time.Time `json:"fueled_at"`
+	Litres    float64   `json:"litres"`
+	FuelType  string    `json:"fuel_type"`
+	Price     int64     `json:"price"`
+	Station   string    `json:"station,omitempty"`
+	Odometer  int64     `json:"odometer"`
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+type RefuelSpecificationInputDTO struct {
+	VehicleID int64     `form:"vehicle_id"`
+	DriverID  int64     `form:"driver_id"`
+	From      time.Time `form:"from"`
+	To        time.Time `form:"to"`
+	Page      int       `form:"page"`
+	PageSize  int       `form:"pageSize"`
+}
+
+type FuelEfficiencySpecificationInputDTO struct {
+	From time.Time `form:"from"`
+	To   time.Time `form:"to"`
+}
+
+type FuelSegmentOutputDTO struct {
+	RefuelID   int64     `json:"refuel_id"`
+	VehicleID  int64     `json:"vehicle_id"`
+	DriverID   int64     `json:"driver_id"`
+	FueledAt   time.Time `json:"fueled_at"`
+	Distance   int64     `json:"distance"`
+	Litres     float64   `json:"litres"`
+	KmPerLitre float64   `json:"km_per_litre"`
+	Outlier    bool      `json:"outlier"`
+}
+
+type FuelEfficiencyOutputDTO struct {
+	Distance   int64                  `json:"distance"`
+	Litres     float64                `json:"litres"`
+	KmPerLitre float64                `json:"km_per_litre"`
+	Outliers   int                    `json:"outliers"`
+	Segments   []FuelSegmentOutputDTO `json:"segments"`
+}
+
+// FineInputDTO carries the amount in cents and the deadlines of the notice,
+// both optional.
+type FineInputDTO struct {
+	Plate           string    `json:"plate" binding:"required"`
+	CommittedAt     time.Time `json:"committed_at" binding:"required"`
+	Location        string    `json:"location"`
+	Article         string    `json:"article" binding:"required"`
+	Points          int       `json:"points" binding:"required"`
+	Amount          int64     `json:"amount"`
+	PaymentDueAt    time.Time `json:"payment_due_at"`
+	IndicationDueAt time.Time `json:"indication_due_at"`
+}
+
+type FineOutputDTO struct {
+	ID              int64     `json:"id"`
+	VehicleID       int64     `json:"vehicle_id"`
+	Plate           string    `json:"plate"`
+	DriverID        int64     `json:"driver_id,omitempty"`
+	CommittedAt     time.Time `json:"committed_at"`
+	Location        string    `json:"location,omitempty"`
+	Article         string    `json:"article"`
+	Points          int       `json:"points"`
+	Amount          int64     `json:"amount"`
+	PaymentDueAt    time.Time `json:"payment_due_at,omitempty"`
+	IndicationDueAt time.Time `json:"indication_due_at,omitempty"`
+	PaidAt          time.Time `json:"paid_at,omitempty"`
+	IndicatedAt     time.Time `json:"indicated_at,omitempty"`
+	CreatedAt       time.Time `json:"created_at,omitempty"`
+	UpdatedAt       time.Time `json:"updated_at,omitempty"`
+}
+
+type FineSpecificationInputDTO struct {
+	VehicleID int64     `form:"vehicle_id"`
+	DriverID  int64     `form:"driver_id"`
+	Unpaid    bool      `form:"unpaid"`
+	DueBefore time.Time `form:"due_before"`
+	Page      int       `form:"page"`
+	PageSize  int       `form:"pageSize"`
+}
+
+// FinePaymentInputDTO records the payment now when paid_at is left out.
+type FinePaymentInputDTO struct {
+	PaidAt time.Time `json:"paid_at"`
+}
+
+// FineIndicationInputDTO keeps the attributed driver when driver_id is left
+// out.
+type FineIndicationInputDTO struct {
+	DriverID int64 `json:"driver_id"`
+}
+
+type DriverPointsOutputDTO struct {
+	DriverID    int64 `json:"driver_id"`
+	Points      int   `json:"points"`
+	Gravissimas int   `json:"gravissimas"`
+	Threshold   int   `json:"threshold"`
+	Alert       bool  `json:"alert"`
+	Suspended   bool  `json:"suspended"`
+}
+
+// PlaceDTO is the location part of an address, used by the origin and the
+// destination of a trip.
+type PlaceDTO struct {
+	Locality     string                 `json:"locality" binding:"required"`
+	Number       string                 `json:"number" binding:"required"`
+	Complement   string                 `json:"complement,omitempty"`
+	Neighborhood string                 `json:"neighborhood" binding:"required"`
+	City         string                 `json:"city" binding:"required"`
+	State        address.BrazilianState `json:"state" binding:"required"`
+	CEP          string                 `json:"cep" binding:"required"`
+	Country      string                 `json:"country" binding:"required"`
+}
+
+type TripInputDTO struct {
+	DriverID       int64     `json:"driver_id" binding:"required"`
+	VehicleID      int64     `json:"vehicle_id" binding:"required"`
+	Origin         PlaceDTO  `json:"origin" binding:"required"`
+	Destination    PlaceDTO  `json:"destination" binding:"required"`
+	PlannedStartAt time.Time `json:"planned_start_at" binding:"required"`
+	PlannedEndAt   time.Time `json:"planned_end_at"`
+}
+
+type TripOutputDTO struct {
+	ID             int64     `json:"id"`
+	DriverID       int64     `json:"driver_id"`
+	VehicleID      int64     `json:"vehicle_id"`
+	Origin         PlaceDTO  `json:"origin"`
+	Destination    PlaceDTO  `json:"destination"`
+	PlannedStartAt time.Time `json:"planned_start_at"`
+	PlannedEndAt   time.Time `json:"planned_end_at,omitempty"`
+	StartedAt      time.Time `json:"started_at,omitempty"`
+	EndedAt        time.Time `json:"ended_at,omitempty"`
+	StartOdometer  int64     `json:"start_odometer,omitempty"`
+	EndOdometer    int64     `json:"end_odometer,omitempty"`
+	Distance       int64     `json:"distance,omitempty"`
+	Status         string    `json:"status"`
+	CreatedAt      time.Time `json:"created_at,omitempty"`
+	UpdatedAt      time.Time `json:"updated_at,omitempty"`
+}
+
+type TripSpecificationInputDTO struct {
+	DriverID  int64     `form:"driver_id"`
+	VehicleID int64     `form:"vehicle_id"`
+	Status    string    `form:"status"`
+	From      time.Time `form:"from"`
+	To        time.Time `form:"to"`
+	Page      int       `form:"page"`
+	PageSize  int       `form:"pageSize"`
+}
+
+// TripOdometerInputDTO is the odometer of the vehicle when the trip is
+// started or finished.
+type TripOdometerInputDTO struct {
+	Odometer int64 `json:"odometer" binding:"required"`
+}
+
+type ShiftInputDTO struct {
+	DriverID       int64     `json:"driver_id" binding:"required"`
+	PlannedStartAt time.Time `json:"planned_start_at" binding:"required"`
+	PlannedEndAt   time.Time `json:"planned_end_at" binding:"required"`
+}
+
+type ShiftPeriodOutputDTO struct {
+	ID        int64     `json:"id"`
+	Activity  string    `json:"activity"`
+	StartedAt time.Time `json:"started_at"`
+	EndedAt   time.Time `json:"ended_at,omitempty"`
+}
+
+type ShiftOutputDTO struct {
+	ID             int64                  `json:"id"`
+	DriverID       int64                  `json:"driver_id"`
+	PlannedStartAt time.Time              `json:"planned_start_at"`
+	PlannedEndAt   time.Time              `json:"planned_end_at"`
+	ClockInAt      time.Time              `json:"clock_in_at,omitempty"`
+	ClockOutAt     time.Time              `json:"clock_out_at,omitempty"`
+	Status         string                 `json:"status"`
+	Periods        []ShiftPeriodOutputDTO `json:"periods"`
+	CreatedAt      time.Time              `json:"created_at,omitempty"`
+	UpdatedAt      time.Time              `json:"updated_at,omitempty"`
+}
+
+type ShiftSpecificationInputDTO struct {
+	DriverID int64     `form:"driver_id"`
+	Status   string    `form:"status"`
+	From     time.Time `form:"from"`
+	To       time.Time `form:"to"`
+	Page     int       `form:"page"`
+	PageSize int       `form:"pageSize"`
+}
+
+// ShiftActivityInputDTO is the activity the driver switches to: DRIVING,
+// REST or WORK.
+type ShiftActivityInputDTO struct {
+	Activity string `json:"activity" binding:"required"`
+}
+
+type WorkingHoursSpecificationInputDTO struct {
+	From time.Time `form:"from"`
+	To   time.Time `form:"to"`
+}
+
+type WorkingHoursViolationOutputDTO struct {
+	Rule          string    `json:"rule"`
+	ShiftID       int64     `json:"shift_id"`
+	At            time.Time `json:"at"`
+	ActualMinutes int64     `json:"actual_minutes"`
+	LimitMinutes  int64     `json:"limit_minutes"`
+}
+
+type WorkingHoursOutputDTO struct {
+	DriverID       int64                            `json:"driver_id"`
+	From           time.Time                        `json:"from"`
+	To             time.Time                        `json:"to"`
+	Shifts         int                              `json:"shifts"`
+	JourneyMinutes int64                            `json:"journey_minutes"`
+	DrivingMinutes int64                            `json:"driving_minutes"`
+	RestMinutes    int64                            `json:"rest_minutes"`
+	Violations     []WorkingHoursViolationOutputDTO `json:"violations"`
+}
+
+type ChecklistItemDTO struct {
+	Key      string `json:"key" binding:"required"`
+	Label    string `json:"label" binding:"required"`
+	Critical bool   `json:"critical"`
+}
+
+// ChecklistTemplateInputDTO leaves out the category on the default template
+// of a kind.
+type ChecklistTemplateInputDTO struct {
+	Name     string             `json:"name" binding:"required"`
+	Category string             `json:"category"`
+	Kind     string             `json:"kind" binding:"required"`
+	Items    []ChecklistItemDTO `json:"items" binding:"required,dive"`
+}
+
+type ChecklistTemplateOutputDTO struct {
+	ID        int64              `json:"id"`
+	Name      string             `json:"name"`
+	Category  string             `json:"category,omitempty"`
+	Kind      string             `json:"kind"`
+	Items     []ChecklistItemDTO `json:"items"`
+	CreatedAt time.Time          `json:"created_at,omitempty"`
+	UpdatedAt time.Time          `json:"updated_at,omitempty"`
+}
+
+type ChecklistTemplateSpecificationInputDTO struct {
+	Category string `form:"category"`
+	Kind     string `form:"kind"`
+	Page     int    `form:"page"`
+	PageSize int    `form:"pageSize"`
+}
+
+type ChecklistAnswerInputDTO struct {
+	Key    string `json:"key" binding:"required"`
+	Passed bool   `json:"passed"`
+	Notes  string `json:"notes"`
+}
+
+type ChecklistAnswerOutputDTO struct {
+	Key      string `json:"key"`
+	Label    string `json:"label"`
+	Critical bool   `json:"critical"`
+	Passed   bool   `json:"passed"`
+	Notes    string `json:"notes,omitempty"`
+}
+
+// ChecklistPhotoDTO is the metadata of a photo uploaded elsewhere. ItemKey
+// is only set on the photos of an item.
+type ChecklistPhotoDTO struct {
+	ItemKey     string    `json:"item_key,omitempty"`
+	URL         string    `json:"url" binding:"required"`
+	ContentType string    `json:"content_type,omitempty"`
+	TakenAt     time.Time `json:"taken_at"`
+}
+
+// ChecklistInputDTO carries the fuel level as a percentage of the tank.
+type ChecklistInputDTO struct {
+	Kind      string                    `json:"kind" binding:"required"`
+	DriverID  int64                     `json:"driver_id" binding:"required"`
+	VehicleID int64                     `json:"vehicle_id" binding:"required"`
+	Odometer  int64                     `json:"odometer"`
+	FuelLevel int                       `json:"fuel_level"`
+	Answers   []ChecklistAnswerInputDTO `json:"answers" binding:"required,dive"`
+	Photos    []ChecklistPhotoDTO       `json:"photos" binding:"dive"`
+	Notes     string                    `json:"notes"`
+}
+
+type ChecklistOutputDTO struct {
+	ID          int64                      `json:"id"`
+	Kind        string                     `json:"kind"`
+	TemplateID  int64                      `json:"template_id,omitempty"`
+	DriverID    int64                      `json:"driver_id"`
+	VehicleID   int64                      `json:"vehicle_id"`
+	Odometer    int64                      `json:"odometer"`
+	FuelLevel   int                        `json:"fuel_level"`
+	Answers     []ChecklistAnswerOutputDTO `json:"answers"`
+	Photos      []ChecklistPhotoDTO        `json:"photos"`
+	Notes       string                     `json:"notes,omitempty"`
+	SubmittedAt time.Time                  `json:"submitted_at"`
+	CreatedAt   time.Time                  `json:"created_at,omitempty"`
+}
+
+type ChecklistSpecificationInputDTO struct {
+	VehicleID int64     `form:"vehicle_id"`
+	DriverID  int64     `form:"driver_id"`
+	Kind      string    `form:"kind"`
+	From      time.Time `form:"from"`
+	To        time.Time `form:"to"`
+	Page      int       `form:"page"`
+	PageSize  int       `form:"pageSize"`
+}
+
+type IncidentThirdPartyDTO struct {
+	Name     string `json:"name" binding:"required"`
+	Document string `json:"document,omitempty"`
+	Phone    string `json:"phone,omitempty"`
+	Plate    string `json:"plate,omitempty"`
+	Insurer  string `json:"insurer,omitempty"`
+}
+
+// IncidentCostEstimateDTO carries the amount in cents.
+type IncidentCostEstimateDTO struct {
+	Description string `json:"description" binding:"required"`
+	Amount      int64  `json:"amount"`
+}
+
+// IncidentInputDTO leaves out the driver, attributed from the assignment of
+// the vehicle when the incident occurred.
+type IncidentInputDTO struct {
+	Type          string                    `json:"type" binding:"required"`
+	VehicleID     int64                     `json:"vehicle_id" binding:"required"`
+	OccurredAt    time.Time                 `json:"occurred_at" binding:"required"`
+	Location      string                    `json:"location" binding:"required"`
+	Description   string                    `json:"description" binding:"required"`
+	PoliceReport  string                    `json:"police_report"`
+	ThirdParties  []IncidentThirdPartyDTO   `json:"third_parties" binding:"dive"`
+	CostEstimates []IncidentCostEstimateDTO `json:"cost_estimates" binding:"dive"`
+}
+
+type IncidentOutputDTO struct {
+	ID            int64                     `json:"id"`
+	Type          string                    `json:"type"`
+	VehicleID     int64                     `json:"vehicle_id"`
+	DriverID      int64                     `json:"driver_id,omitempty"`
+	OccurredAt    time.Time                 `json:"occurred_at"`
+	Location      string                    `json:"location"`
+	Description   string                    `json:"description"`
+	PoliceReport  string                    `json:"police_report,omitempty"`
+	ThirdParties  []IncidentThirdPartyDTO   `json:"third_parties"`
+	CostEstimates []IncidentCostEstimateDTO `json:"cost_estimates"`
+	EstimatedCost int64                     `json:"estimated_cost"`
+	CreatedAt     time.Time                 `json:"created_at,omitempty"`
+	UpdatedAt     time.Time                 `json:"updated_at,omitempty"`
+}
+
+type IncidentSpecificationInputDTO struct {
+	VehicleID int64     `form:"vehicle_id"`
+	DriverID  int64     `form:"driver_id"`
+	Type      string    `form:"type"`
+	From      time.Time `form:"from"`
+	To        time.Time `form:"to"`
+	Page      int       `form:"page"`
+	PageSize  int       `form:"pageSize"`
+}
+
+// InsurancePolicyInputDTO carries the premium and the deductible in cents.
+// The vehicle_id is ignored on an update, as the vehicle of a policy never
+// changes.
+type InsurancePolicyInputDTO struct {
+	VehicleID  int64     `json:"vehicle_id"`
+	Insurer    string    `json:"insurer" binding:"required"`
+	Number     string    `json:"number" binding:"required"`
+	Coverage   string    `json:"coverage" binding:"required"`
+	StartsAt   time.Time `json:"starts_at" binding:"required"`
+	EndsAt     time.Time `json:"ends_at" binding:"required"`
+	Premium    int64     `json:"premium"`
+	Deductible int64     `json:"deductible"`
+}
+
+type InsurancePolicyOutputDTO struct {
+	ID         int64     `json:"id"`
+	VehicleID  int64     `json:"vehicle_id"`
+	Insurer    string    `json:"insurer"`
+	Number     string    `json:"number"`
+	Coverage   string    `json:"coverage"`
+	StartsAt   time.Time `json:"starts_at"`
+	EndsAt     time.Time `json:"ends_at"`
+	Premium    int64     `json:"premium"`
+	Deductible int64     `json:"deductible"`
+	Alert      bool      `json:"alert"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+}
+
+type InsurancePolicySpecificationInputDTO struct {
+	VehicleID int64     `form:"vehicle_id"`
+	Insurer   string    `form:"insurer"`
+	Coverage  string    `form:"coverage"`
+	ValidAt   time.Time `form:"valid_at"`
+	Page      int       `form:"page"`
+	PageSize  int       `form:"pageSize"`
+}
+
+// InsuranceClaimInputDTO claims the estimated cost of the incident when the
+// amount is left out.
+type InsuranceClaimInputDTO struct {
+	PolicyID   int64  `json:"policy_id" binding:"required"`
+	IncidentID int64  `json:"incident_id" binding:"required"`
+	Number     string `json:"number"`
+	Amount     int64  `json:"amount"`
+	Notes      string `json:"notes"`
+}
+
+// InsuranceClaimStatusInputDTO keeps the claim number when number is left
+// out.
+type InsuranceClaimStatusInputDTO struct {
+	Status string `json:"status" binding:"required"`
+	Number string `json:"number"`
+}
+
+type InsuranceClaimOutputDTO struct {
+	ID         int64     `json:"id"`
+	PolicyID   int64     `json:"policy_id"`
+	IncidentID int64     `json:"incident_id"`
+	VehicleID  int64     `json:"vehicle_id"`
+	Number     string    `json:"number,omitempty"`
+	Status     string    `json:"status"`
+	Amount     int64     `json:"amount"`
+	Notes      string    `json:"notes,omitempty"`
+	ClosedAt   time.Time `json:"closed_at,omitempty"`
+	CreatedAt  time.Time `json:"created_at,omitempty"`
+	UpdatedAt  time.Time `json:"updated_at,omitempty"`
+}
+
+type InsuranceClaimSpecificationInputDTO struct {
+	PolicyID   int64  `form:"policy_id"`
+	IncidentID int64  `form:"incident_id"`
+	VehicleID  int64  `form:"vehicle_id"`
+	Status     string `form:"status"`
+	Page       int    `form:"page"`
+	PageSize   int    `form:"pageSize"`
+}
+
+// DocumentInputDTO is the multipart form of an upload. The name defaults to
+// the one of the file and the checksum, a SHA-256 in hex, is checked
+// against the file when given.
+type DocumentInputDTO struct {
+	File       *multipart.FileHeader `form:"file" binding:"required"`
+	Type       string                `form:"type" binding:"required"`
+	Name       string                `form:"name"`
+	Checksum   string                `form:"checksum"`
+	ValidFrom  time.Time             `form:"valid_from"`
+	ValidUntil time.Time             `form:"valid_until"`
+}
+
+type DocumentOutputDTO struct {
+	ID          int64     `json:"id"`
+	OwnerType   string    `json:"owner_type"`
+	OwnerID     int64     `json:"owner_id"`
+	Type        string    `json:"type"`
+	Name        string    `json:"name"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	Checksum    string    `json:"checksum"`
+	ValidFrom   time.Time `json:"valid_from,omitempty"`
+	ValidUntil  time.Time `json:"valid_until,omitempty"`
+	Expired     bool      `json:"expired"`
+	CreatedAt   time.Time `json:"created_at,omitempty"`
+	UpdatedAt   time.Time `json:"updated_at,omitempty"`
+}
+
+type DocumentSpecificationInputDTO struct {
+	OwnerType     string    `form:"owner_type"`
+	OwnerID       int64     `form:"owner_id"`
+	Type          string    `form:"type"`
+	ExpiresBefore time.Time `form:"expires_before"`
+	Page          int       `form:"page"`
+	PageSize      int       `form:"pageSize"`
+}
+
+type DocumentURLOutputDTO struct {
+	URL       string    `json:"url"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
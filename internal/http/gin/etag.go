@@ -0,0 +1,73 @@
+package gin
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
+	"github.com/LucasMateus-eng/operations-service/internal/trash"
+	"github.com/gin-gonic/gin"
+)
+
+var (
+	ErrMissingIfMatch = errors.New("the If-Match header is required, send the ETag returned when the resource was read")
+	ErrInvalidIfMatch = errors.New("the If-Match header must hold a single ETag returned when the resource was read")
+)
+
+// setETag exposes the version of a resource as a strong ETag, e.g. "3".
+func setETag(c *gin.Context, version int64) {
+	c.Header("ETag", fmt.Sprintf("%q", strconv.FormatInt(version, 10)))
+}
+
+// ifMatchVersion reads the version the client expects to change from the
+// If-Match header. Weak ETags are accepted as well, since only the version
+// number is compared.
+func ifMatchVersion(c *gin.Context) (int64, error) {
+	header := strings.TrimSpace(c.GetHeader("If-Match"))
+	if len(header) == 0 {
+		return 0, ErrMissingIfMatch
+	}
+
+	tag, err := strconv.Unquote(strings.TrimPrefix(header, "W/"))
+	if err != nil {
+		return 0, fmt.Errorf("%w: [%s]", ErrInvalidIfMatch, header)
+	}
+
+	version, err := strconv.ParseInt(tag, 10, 64)
+	if err != nil || version < 1 {
+		return 0, fmt.Errorf("%w: [%s]", ErrInvalidIfMatch, header)
+	}
+
+	return version, nil
+}
+
+func ifMatchErrorStatus(err error) int {
+	if errors.Is(err, ErrMissingIfMatch) {
+		return http.StatusPreconditionRequired
+	}
+
+	return http.StatusBadRequest
+}
+
+func writeErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, concurrency.ErrVersionConflict):
+		return http.StatusPreconditionFailed
+	case errors.Is(err, mergepatch.ErrImmutableField):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, trash.ErrNotInTrash):
+		return http.StatusNotFound
+	case errors.Is(err, trash.ErrRestoreConflict),
+		errors.Is(err, trash.ErrPurgeConflict):
+		return http.StatusConflict
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
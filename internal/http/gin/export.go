@@ -0,0 +1,111 @@
+package gin
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/spreadsheet"
+	"github.com/gin-gonic/gin"
+)
+
+// export streams the items handed over by a service straight into the
+// response. Headers are only sent with the first row, so that a failure
+// before any row is written can still be reported as a JSON error.
+type export[T any] struct {
+	c         *gin.Context
+	name      string
+	format    spreadsheet.Format
+	columns   []gin_mapping.ExportColumn[T]
+	formatter *spreadsheet.Formatter
+	writer    spreadsheet.Writer
+	started   bool
+}
+
+func newExport[T any](c *gin.Context, input gin_dto.ExportInputDTO, available []gin_mapping.ExportColumn[T], name string) (*export[T], error) {
+	formatName := input.Format
+	if len(strings.TrimSpace(formatName)) == 0 {
+		formatName = string(spreadsheet.CSV)
+	}
+
+	format, err := spreadsheet.GetFormat(formatName)
+	if err != nil || format == spreadsheet.JSONL {
+		return nil, fmt.Errorf("%w: [%s]", spreadsheet.ErrUnsupportedFormat, formatName)
+	}
+
+	columns, err := gin_mapping.SelectExportColumns(available, input.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	formatter, err := spreadsheet.NewFormatter(input.Locale)
+	if err != nil {
+		return nil, err
+	}
+
+	writer, err := spreadsheet.NewWriter(format, c.Writer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &export[T]{
+		c:         c,
+		name:      name,
+		format:    format,
+		columns:   columns,
+		formatter: formatter,
+		writer:    writer,
+	}, nil
+}
+
+func (e *export[T]) start() error {
+	e.started = true
+
+	e.c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.%s"`, e.name, e.format))
+	e.c.Header("Content-Type", spreadsheet.ContentType(e.format))
+	e.c.Status(http.StatusOK)
+
+	return e.writer.Write(gin_mapping.MapExportColumnsToHeader(e.columns))
+}
+
+func (e *export[T]) Write(item *T) error {
+	if !e.started {
+		if err := e.start(); err != nil {
+			return err
+		}
+	}
+
+	return e.writer.Write(gin_mapping.MapItemToExportRow(item, e.columns, e.formatter))
+}
+
+func (e *export[T]) Finish(err error, logger *logging.Logging) {
+	if err != nil {
+		if !e.started {
+			e.c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		logger.Error("Export - ERROR: ", map[string]any{
+			"export": e.name,
+			"err":    err.Error(),
+		})
+		return
+	}
+
+	if !e.started {
+		if err := e.start(); err != nil {
+			e.c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	if err := e.writer.Flush(); err != nil {
+		logger.Error("Export - ERROR: ", map[string]any{
+			"export": e.name,
+			"err":    err.Error(),
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/fine"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+func fineErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, fine.ErrInvalidFine), errors.Is(err, fine.ErrUnknownPlate):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, fine.ErrAlreadyPaid), errors.Is(err, fine.ErrAlreadyIndicated), errors.Is(err, fine.ErrMissingDriver):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listFines lists the fines, only the unpaid ones when unpaid=true and only
+// the ones still to be paid or indicated by a deadline before due_before
+// when it is given.
+func listFines(service *fine.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List fines", nil)
+
+		var fs gin_dto.FineSpecificationInputDTO
+		if err := c.ShouldBindQuery(&fs); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		fines, err := service.List(c.Request.Context(), &fine.FineSpecification{
+			VehicleID: fs.VehicleID,
+			DriverID:  fs.DriverID,
+			Unpaid:    fs.Unpaid,
+			DueBefore: fs.DueBefore,
+			Page:      fs.Page,
+			PageSize:  fs.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		finesDTO := make([]gin_dto.FineOutputDTO, 0, len(*fines))
+		for _, f := range *fines {
+			finesDTO = append(finesDTO, *gin_mapping.MapFineToOutputDTO(f))
+		}
+
+		c.JSON(http.StatusOK, finesDTO)
+	}
+}
+
+func getFine(service *fine.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get fine", nil)
+
+		fineID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		f, err := service.GetByID(c.Request.Context(), fineID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapFineToOutputDTO(*f))
+	}
+}
+
+func createFine(service *fine.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create fine", nil)
+
+		var dto gin_dto.FineInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		f := gin_mapping.MapInputDTOToFine(dto)
+
+		fineID, err := service.Create(c.Request.Context(), f)
+		if err != nil {
+			c.JSON(fineErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		f.ID = fineID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapFineToOutputDTO(*f))
+	}
+}
+
+func payFine(service *fine.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Pay fine", nil)
+
+		fineID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.FinePaymentInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		f, err := service.Pay(c.Request.Context(), fineID, dto.PaidAt)
+		if err != nil {
+			c.JSON(fineErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapFineToOutputDTO(*f))
+	}
+}
+
+func indicateFine(service *fine.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Indicate fine driver", nil)
+
+		fineID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.FineIndicationInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		f, err := service.Indicate(c.Request.Context(), fineID, dto.DriverID)
+		if err != nil {
+			c.JSON(fineErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapFineToOutputDTO(*f))
+	}
+}
+
+func getDriverPoints(service *fine.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get driver points", nil)
+
+		driverID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		standing, err := service.Standing(c.Request.Context(), driverID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapStandingToOutputDTO(*standing))
+	}
+}
+
+// listPointsAlerts lists the drivers near or past the suspension of the
+// CNH, the most points first.
+func listPointsAlerts(service *fine.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List points alerts", nil)
+
+		standings, err := service.ListAlerts(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		standingsDTO := make([]gin_dto.DriverPointsOutputDTO, 0, len(*standings))
+		for _, s := range *standings {
+			standingsDTO = append(standingsDTO, *gin_mapping.MapStandingToOutputDTO(s))
+		}
+
+		c.JSON(http.StatusOK, standingsDTO)
+	}
+}
@@ -0,0 +1,145 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/gin-gonic/gin"
+)
+
+func fuelErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, fuel.ErrInvalidRefuel), errors.Is(err, odometer.ErrInvalidRecord):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, fuel.ErrNoAssignedDriver), errors.Is(err, fuel.ErrAmbiguousDriver),
+		errors.Is(err, fuel.ErrDriverNotAssigned), errors.Is(err, odometer.ErrDecreasingReading):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+func listRefuels(service *fuel.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List refuels", nil)
+
+		var rs gin_dto.RefuelSpecificationInputDTO
+		if err := c.ShouldBindQuery(&rs); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		refuels, err := service.List(c.Request.Context(), &fuel.RefuelSpecification{
+			VehicleID: rs.VehicleID,
+			DriverID:  rs.DriverID,
+			From:      rs.From,
+			To:        rs.To,
+			Page:      rs.Page,
+			PageSize:  rs.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		refuelsDTO := make([]gin_dto.RefuelOutputDTO, 0, len(*refuels))
+		for _, r := range *refuels {
+			refuelsDTO = append(refuelsDTO, *gin_mapping.MapRefuelToOutputDTO(r))
+		}
+
+		c.JSON(http.StatusOK, refuelsDTO)
+	}
+}
+
+func getRefuel(service *fuel.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get refuel", nil)
+
+		refuelID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		refuel, err := service.GetByID(c.Request.Context(), refuelID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapRefuelToOutputDTO(*refuel))
+	}
+}
+
+func createRefuel(service *fuel.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create refuel", nil)
+
+		var dto gin_dto.RefuelInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		refuel := gin_mapping.MapInputDTOToRefuel(dto)
+
+		refuelID, err := service.Create(c.Request.Context(), refuel)
+		if err != nil {
+			c.JSON(fuelErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		refuel.ID = refuelID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapRefuelToOutputDTO(*refuel))
+	}
+}
+
+func getVehicleFuelEfficiency(service *fuel.Service, logger *logging.Logging) gin.HandlerFunc {
+	return fuelEfficiency(service, logger, func(id int64, es *fuel.EfficiencySpecification) {
+		es.VehicleID = id
+	})
+}
+
+func getDriverFuelEfficiency(service *fuel.Service, logger *logging.Logging) gin.HandlerFunc {
+	return fuelEfficiency(service, logger, func(id int64, es *fuel.EfficiencySpecification) {
+		es.DriverID = id
+	})
+}
+
+// fuelEfficiency computes the km/l over the period in the query string of
+// the subject identified in the path, which subject sets on the specification.
+func fuelEfficiency(service *fuel.Service, logger *logging.Logging, subject func(int64, *fuel.EfficiencySpecification)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get fuel efficiency", nil)
+
+		id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var es gin_dto.FuelEfficiencySpecificationInputDTO
+		if err := c.ShouldBindQuery(&es); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &fuel.EfficiencySpecification{From: es.From, To: es.To}
+		subject(id, specification)
+
+		efficiency, err := service.Efficiency(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapFuelEfficiencyToOutputDTO(*efficiency))
+	}
+}
@@ -0,0 +1,38 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/LucasMateus-eng/operations-service/internal/graphql"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+// queryGraphQL answers with 200 once the query is executed, even when some
+// fields failed, as GraphQL reports them in the errors of the response.
+// Queries that are rejected before the execution answer with 400.
+func queryGraphQL(server *graphql.Server, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Query GraphQL", nil)
+
+		var dto gin_dto.GraphQLInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		result := server.Do(c.Request.Context(), graphql.Request{
+			Query:         dto.Query,
+			OperationName: dto.OperationName,
+			Variables:     dto.Variables,
+		})
+
+		status := http.StatusOK
+		if result.Data == nil && result.HasErrors() {
+			status = http.StatusBadRequest
+		}
+
+		c.JSON(status, result)
+	}
+}
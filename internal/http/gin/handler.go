@@ -1,13 +1,48 @@
 package gin
 
 import (
-	"context"
+	"log"
 
+	"github.com/LucasMateus-eng/operations-service/address"
+	postgres_address "github.com/LucasMateus-eng/operations-service/address/postgres"
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	postgres_checklist "github.com/LucasMateus-eng/operations-service/checklist/postgres"
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/document"
+	postgres_document "github.com/LucasMateus-eng/operations-service/document/postgres"
+	"github.com/LucasMateus-eng/operations-service/document/storage"
 	"github.com/LucasMateus-eng/operations-service/driver"
 	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
 	postgres_driver_vehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle/postgres"
 	postgres_driver "github.com/LucasMateus-eng/operations-service/driver/postgres"
+	"github.com/LucasMateus-eng/operations-service/fine"
+	postgres_fine "github.com/LucasMateus-eng/operations-service/fine/postgres"
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	postgres_fuel "github.com/LucasMateus-eng/operations-service/fuel/postgres"
+	"github.com/LucasMateus-eng/operations-service/incident"
+	postgres_incident "github.com/LucasMateus-eng/operations-service/incident/postgres"
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	postgres_insurance "github.com/LucasMateus-eng/operations-service/insurance/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	postgres_audit "github.com/LucasMateus-eng/operations-service/internal/audit/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/auth"
+	"github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/graphql"
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency"
+	postgres_idempotency "github.com/LucasMateus-eng/operations-service/internal/idempotency/postgres"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	postgres_outbox "github.com/LucasMateus-eng/operations-service/internal/outbox/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	postgres_webhook "github.com/LucasMateus-eng/operations-service/internal/webhook/postgres"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	postgres_maintenance "github.com/LucasMateus-eng/operations-service/maintenance/postgres"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	postgres_odometer "github.com/LucasMateus-eng/operations-service/odometer/postgres"
+	"github.com/LucasMateus-eng/operations-service/shift"
+	postgres_shift "github.com/LucasMateus-eng/operations-service/shift/postgres"
+	"github.com/LucasMateus-eng/operations-service/trip"
+	postgres_trip "github.com/LucasMateus-eng/operations-service/trip/postgres"
 	"github.com/LucasMateus-eng/operations-service/user"
 	postgres_user "github.com/LucasMateus-eng/operations-service/user/postgres"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
@@ -16,54 +51,242 @@ import (
 	"github.com/uptrace/bun"
 )
 
-func Handlers(ctx context.Context, db *bun.DB, logger *logging.Logging) *gin.Engine {
+func Handlers(config *config.Config, db *bun.DB, logger *logging.Logging) *gin.Engine {
+	transactor := postgres.NewTransactor(db)
+	auditRepo := postgres_audit.New(db)
+	auditService := audit.NewService(transactor, auditRepo, logger)
+	outboxService := outbox.NewService(postgres_outbox.New(db), logger)
 	userRepo := postgres_user.New(db)
-	userService := user.NewService(userRepo, logger)
+	userService := user.NewService(userRepo, auditService, logger)
 	driverRepo := postgres_driver.New(db)
-	driverService := driver.NewService(driverRepo, logger)
+	driverService := driver.NewService(driverRepo, auditService, outboxService, logger)
+	addressRepo := postgres_address.New(db)
+	addressService := address.NewService(addressRepo, auditService, logger)
+	onboardingService := driver.NewOnboardingService(transactor, auditService, outboxService, userRepo, addressRepo, driverRepo, logger)
 	vehicleRepo := postgres_vehicle.New(db)
-	vehicleService := vehicle.NewService(vehicleRepo, logger)
+	vehicleService := vehicle.NewService(vehicleRepo, auditService, outboxService, logger)
 	driverVehicleRepo := postgres_driver_vehicle.New(db)
-	driverVehicleService := drivervehicle.NewService(driverVehicleRepo, logger)
+	maintenanceService := maintenance.NewService(postgres_maintenance.New(db), auditService, logger)
+	odometerService := odometer.NewService(postgres_odometer.New(db), auditService, config.OdometerMaximumDailyKm, logger)
+	insuranceRepo := postgres_insurance.New(db)
+	driverVehicleService := drivervehicle.NewService(driverVehicleRepo, auditService, outboxService, maintenanceService, insuranceRepo, config.InsuranceRequiredForAssignment, logger)
+	fuelService := fuel.NewService(postgres_fuel.New(db), auditService, driverVehicleService, odometerService, config.FuelOutlierTolerance, logger)
+	fineService := fine.NewService(postgres_fine.New(db), auditService, outboxService, vehicleRepo, driverVehicleService, config.FinePointsAlertMargin, logger)
+	tripService := trip.NewService(postgres_trip.New(db), auditService, driverVehicleService, driverService, odometerService, logger)
+	shiftService := shift.NewService(postgres_shift.New(db), auditService, driverService, logger)
+	checklistService := checklist.NewService(postgres_checklist.New(db), auditService, driverVehicleService, vehicleService, driverService, odometerService, maintenanceService, logger)
+	incidentService := incident.NewService(postgres_incident.New(db), auditService, outboxService, vehicleService, driverVehicleService, driverVehicleRepo, logger)
+	insuranceService := insurance.NewService(insuranceRepo, auditService, vehicleService, incidentService, config.InsuranceExpiryAlertDays, logger)
+	documentStorage, err := storage.New(config, logger)
+	if err != nil {
+		log.Fatalf("error when initializing the document storage: %s", err.Error())
+	}
+	documentService := document.NewService(postgres_document.New(db), auditService, documentStorage, driverService, vehicleService, config.DocumentMaximumSize, config.DocumentURLTTL, logger)
+	offboardingService := driver.NewOffboardingService(transactor, auditService, outboxService, driverVehicleRepo, driverVehicleRepo, documentService, driverRepo, logger)
+	decommissioningService := vehicle.NewDecommissioningService(transactor, auditService, outboxService, driverVehicleRepo, driverVehicleRepo, documentService, vehicleRepo, logger)
+	authenticator := auth.NewAuthenticator(userRepo, logger)
+	idempotencyRepo := postgres_idempotency.New(db)
+	idempotencyService := idempotency.NewService(idempotencyRepo, config.IdempotencyTTL, config.IdempotencyLockTimeout, logger)
+	idempotencyMiddleware := idempotent(idempotencyService, logger)
+	webhookService := webhook.NewService(postgres_webhook.New(db), logger)
+	graphQLServer, err := graphql.NewServer(userService, addressService, driverService, vehicleService, driverVehicleService, config.GraphQLMaximumCost, config.GraphQLMaximumDepth, logger)
+	if err != nil {
+		log.Fatalf("error when building the GraphQL schema: %s", err.Error())
+	}
+	administrator := requireRole(user.ADMINISTRATOR)
+	driverOnly := requireRole(user.DRIVER)
 
 	r := gin.Default()
+	r.Use(requestID(), identified(authenticator, logger))
 
 	v1 := r.Group("v1")
 	uGroup := v1.Group("/users")
 	{
-		uGroup.POST("/", createUser(ctx, userService, logger))
-		uGroup.GET(":id", getUser(ctx, userService, logger))
-		uGroup.PUT(":id", updateUser(ctx, userService, logger))
-		uGroup.DELETE(":id", deleteUser(ctx, userService, logger))
+		uGroup.POST("/", idempotencyMiddleware, createUser(userService, logger))
+		uGroup.GET("/trash", listDeletedUsers(userService, logger))
+		uGroup.POST("/:id/restore", idempotencyMiddleware, restoreUser(userService, logger))
+		uGroup.DELETE("/trash/:id", administrator, purgeUser(userService, logger))
+		uGroup.GET(":id", getUser(userService, logger))
+		uGroup.PUT(":id", updateUser(userService, logger))
+		uGroup.PATCH(":id", patchUser(userService, logger))
+		uGroup.DELETE(":id", deleteUser(userService, logger))
 	}
 
 	dGroup := v1.Group("drivers")
 	{
-		dGroup.GET("/", listDrivers(ctx, driverService, logger))
-		dGroup.POST("/", createDriver(ctx, driverService, logger))
-		dGroup.GET("/:id", getDriver(ctx, driverService, logger))
-		dGroup.PUT("/:id", updateDriver(ctx, driverService, logger))
-		dGroup.DELETE("/:id", deleteDriver(ctx, driverService, logger))
+		dGroup.GET("/", listDrivers(driverService, logger))
+		dGroup.GET("/export", exportDrivers(driverService, logger))
+		dGroup.POST("/", idempotencyMiddleware, createDriver(driverService, logger))
+		dGroup.POST("/import", idempotencyMiddleware, importDrivers(driverService, logger))
+		dGroup.POST("/onboard", idempotencyMiddleware, onboardDriver(onboardingService, logger))
+		dGroup.GET("/trash", listDeletedDrivers(driverService, logger))
+		dGroup.POST("/:id/restore", idempotencyMiddleware, restoreDriver(driverService, logger))
+		dGroup.DELETE("/trash/:id", administrator, purgeDriver(offboardingService, logger))
+		dGroup.GET("/:id", getDriver(driverService, logger))
+		dGroup.GET("/:id/fuel-efficiency", getDriverFuelEfficiency(fuelService, logger))
+		dGroup.GET("/:id/points", getDriverPoints(fineService, logger))
+		dGroup.GET("/:id/working-hours", getDriverWorkingHours(shiftService, logger))
+		dGroup.GET("/:id/incidents", listDriverIncidents(incidentService, logger))
+		dGroup.GET("/:id/documents", listDriverDocuments(documentService, logger))
+		dGroup.POST("/:id/documents", idempotencyMiddleware, uploadDriverDocument(documentService, logger))
+		dGroup.PUT("/:id", updateDriver(driverService, logger))
+		dGroup.PATCH("/:id", patchDriver(driverService, logger))
+		dGroup.DELETE("/:id", deleteDriver(offboardingService, logger))
 	}
 
 	vGroup := v1.Group("vehicles")
 	{
-		vGroup.GET("/", listVehicles(ctx, vehicleService, logger))
-		vGroup.POST("/", createVehicle(ctx, vehicleService, logger))
-		vGroup.GET("/:id", getVehicle(ctx, vehicleService, logger))
-		vGroup.PUT("/:id", updateVehicle(ctx, vehicleService, logger))
-		vGroup.DELETE("/:id", deleteVehicle(ctx, vehicleService, logger))
+		vGroup.GET("/", listVehicles(vehicleService, logger))
+		vGroup.GET("/export", exportVehicles(vehicleService, logger))
+		vGroup.POST("/", idempotencyMiddleware, createVehicle(vehicleService, logger))
+		vGroup.POST("/import", idempotencyMiddleware, importVehicles(vehicleService, logger))
+		vGroup.GET("/trash", listDeletedVehicles(vehicleService, logger))
+		vGroup.POST("/:id/restore", idempotencyMiddleware, restoreVehicle(vehicleService, logger))
+		vGroup.DELETE("/trash/:id", administrator, purgeVehicle(decommissioningService, logger))
+		vGroup.GET("/:id", getVehicle(vehicleService, logger))
+		vGroup.GET("/:id/maintenance", listVehicleMaintenance(maintenanceService, logger))
+		vGroup.GET("/:id/odometer", listOdometerRecords(odometerService, logger))
+		vGroup.POST("/:id/odometer", idempotencyMiddleware, createOdometerRecord(odometerService, logger))
+		vGroup.GET("/:id/fuel-efficiency", getVehicleFuelEfficiency(fuelService, logger))
+		vGroup.GET("/:id/checklists", listVehicleChecklists(checklistService, logger))
+		vGroup.GET("/:id/incidents", listVehicleIncidents(incidentService, logger))
+		vGroup.GET("/:id/documents", listVehicleDocuments(documentService, logger))
+		vGroup.POST("/:id/documents", idempotencyMiddleware, uploadVehicleDocument(documentService, logger))
+		vGroup.PUT("/:id", updateVehicle(vehicleService, logger))
+		vGroup.PATCH("/:id", patchVehicle(vehicleService, logger))
+		vGroup.DELETE("/:id", deleteVehicle(decommissioningService, logger))
+	}
+
+	aGroup := v1.Group("addresses")
+	{
+		aGroup.GET("/:id", getAddress(addressService, logger))
+		aGroup.PATCH("/:id", patchAddress(addressService, logger))
 	}
 
 	dvGroup := v1.Group("drivers-vehicles")
 	{
-		dvGroup.POST("/", createDriverVehicle(ctx, driverVehicleService, logger))
-		dvGroup.GET("/vehicles/:driver_id", listVehiclesByDriverID(ctx, driverVehicleService, logger))
-		dvGroup.GET("/drivers/:vehicle_id", listDriversByVehicleID(ctx, driverVehicleService, logger))
-		dvGroup.DELETE("/:driver_id/:vehicle_id", deleteDriverVehicle(ctx, driverVehicleService, logger))
+		dvGroup.POST("/", idempotencyMiddleware, createDriverVehicle(driverVehicleService, logger))
+		dvGroup.GET("/vehicles/:driver_id", listVehiclesByDriverID(driverVehicleService, logger))
+		dvGroup.GET("/drivers/:vehicle_id", listDriversByVehicleID(driverVehicleService, logger))
+		dvGroup.DELETE("/:driver_id/:vehicle_id", deleteDriverVehicle(driverVehicleService, logger))
+	}
+
+	mGroup := v1.Group("maintenance")
+	{
+		mGroup.GET("/plans", listMaintenancePlans(maintenanceService, logger))
+		mGroup.POST("/plans", idempotencyMiddleware, createMaintenancePlan(maintenanceService, logger))
+		mGroup.GET("/plans/:id", getMaintenancePlan(maintenanceService, logger))
+		mGroup.PUT("/plans/:id", updateMaintenancePlan(maintenanceService, logger))
+		mGroup.DELETE("/plans/:id", deleteMaintenancePlan(maintenanceService, logger))
+		mGroup.GET("/orders", listMaintenanceOrders(maintenanceService, logger))
+		mGroup.POST("/orders", idempotencyMiddleware, createMaintenanceOrder(maintenanceService, logger))
+		mGroup.GET("/orders/:id", getMaintenanceOrder(maintenanceService, logger))
+		mGroup.DELETE("/orders/:id", deleteMaintenanceOrder(maintenanceService, logger))
+		mGroup.GET("/overdue", listOverdueMaintenance(maintenanceService, logger))
+		mGroup.GET("/tickets", listMaintenanceTickets(maintenanceService, logger))
+		mGroup.GET("/tickets/:id", getMaintenanceTicket(maintenanceService, logger))
+		mGroup.POST("/tickets/:id/close", idempotencyMiddleware, closeMaintenanceTicket(maintenanceService, logger))
+	}
+
+	fGroup := v1.Group("fuel")
+	{
+		fGroup.GET("/refuels", listRefuels(fuelService, logger))
+		fGroup.POST("/refuels", idempotencyMiddleware, createRefuel(fuelService, logger))
+		fGroup.GET("/refuels/:id", getRefuel(fuelService, logger))
+	}
+
+	fnGroup := v1.Group("fines")
+	{
+		fnGroup.GET("/", listFines(fineService, logger))
+		fnGroup.POST("/", idempotencyMiddleware, createFine(fineService, logger))
+		fnGroup.GET("/alerts", listPointsAlerts(fineService, logger))
+		fnGroup.GET("/:id", getFine(fineService, logger))
+		fnGroup.POST("/:id/payment", idempotencyMiddleware, payFine(fineService, logger))
+		fnGroup.POST("/:id/indication", idempotencyMiddleware, indicateFine(fineService, logger))
+	}
+
+	tGroup := v1.Group("trips")
+	{
+		tGroup.GET("/", listTrips(tripService, logger))
+		tGroup.POST("/", idempotencyMiddleware, createTrip(tripService, logger))
+		tGroup.GET("/:id", getTrip(tripService, logger))
+		tGroup.POST("/:id/start", idempotencyMiddleware, startTrip(tripService, logger))
+		tGroup.POST("/:id/finish", idempotencyMiddleware, finishTrip(tripService, logger))
+		tGroup.POST("/:id/cancel", idempotencyMiddleware, cancelTrip(tripService, logger))
+	}
+
+	sGroup := v1.Group("shifts")
+	{
+		sGroup.GET("/", listShifts(shiftService, logger))
+		sGroup.POST("/", idempotencyMiddleware, createShift(shiftService, logger))
+		sGroup.GET("/:id", getShift(shiftService, logger))
+		sGroup.POST("/:id/clock-in", driverOnly, idempotencyMiddleware, clockIn(shiftService, logger))
+		sGroup.POST("/:id/clock-out", driverOnly, idempotencyMiddleware, clockOut(shiftService, logger))
+		sGroup.POST("/:id/activity", driverOnly, idempotencyMiddleware, switchShiftActivity(shiftService, logger))
+	}
+
+	cGroup := v1.Group("checklists")
+	{
+		cGroup.GET("/", listChecklists(checklistService, logger))
+		cGroup.POST("/", idempotencyMiddleware, submitChecklist(checklistService, logger))
+		cGroup.GET("/templates", listChecklistTemplates(checklistService, logger))
+		cGroup.POST("/templates", administrator, idempotencyMiddleware, createChecklistTemplate(checklistService, logger))
+		cGroup.GET("/templates/:id", getChecklistTemplate(checklistService, logger))
+		cGroup.PUT("/templates/:id", administrator, updateChecklistTemplate(checklistService, logger))
+		cGroup.DELETE("/templates/:id", administrator, deleteChecklistTemplate(checklistService, logger))
+		cGroup.GET("/:id", getChecklist(checklistService, logger))
+	}
+
+	iGroup := v1.Group("incidents")
+	{
+		iGroup.GET("/", listIncidents(incidentService, logger))
+		iGroup.POST("/", idempotencyMiddleware, reportIncident(incidentService, logger))
+		iGroup.GET("/:id", getIncident(incidentService, logger))
+	}
+
+	insGroup := v1.Group("insurance")
+	{
+		insGroup.GET("/policies", listInsurancePolicies(insuranceService, logger))
+		insGroup.POST("/policies", idempotencyMiddleware, createInsurancePolicy(insuranceService, logger))
+		insGroup.GET("/policies/alerts", listInsuranceAlerts(insuranceService, logger))
+		insGroup.GET("/policies/:id", getInsurancePolicy(insuranceService, logger))
+		insGroup.PUT("/policies/:id", updateInsurancePolicy(insuranceService, logger))
+		insGroup.GET("/claims", listInsuranceClaims(insuranceService, logger))
+		insGroup.POST("/claims", idempotencyMiddleware, openInsuranceClaim(insuranceService, logger))
+		insGroup.GET("/claims/:id", getInsuranceClaim(insuranceService, logger))
+		insGroup.POST("/claims/:id/status", idempotencyMiddleware, changeInsuranceClaimStatus(insuranceService, logger))
 	}
 
+	docGroup := v1.Group("documents")
+	{
+		docGroup.GET("/", listDocuments(documentService, logger))
+		docGroup.GET("/:id", getDocument(documentService, logger))
+		docGroup.GET("/:id/url", getDocumentURL(documentService, logger))
+		docGroup.DELETE("/:id", deleteDocument(documentService, logger))
+	}
+
+	if files, ok := documentStorage.(signedFiles); ok {
+		r.GET(storage.FILES_PATH+"/*key", downloadDocumentFile(files, logger))
+	}
+
+	wGroup := v1.Group("webhooks", administrator)
+	{
+		wGroup.GET("/", listWebhooks(webhookService, logger))
+		wGroup.POST("/", idempotencyMiddleware, createWebhook(webhookService, logger))
+		wGroup.GET("/:id", getWebhook(webhookService, logger))
+		wGroup.PUT("/:id", updateWebhook(webhookService, logger))
+		wGroup.DELETE("/:id", deleteWebhook(webhookService, logger))
+		wGroup.GET("/:id/deliveries", listWebhookDeliveries(webhookService, logger))
+		wGroup.POST("/:id/deliveries/:delivery_id/redeliver", idempotencyMiddleware, redeliverWebhook(webhookService, logger))
+		wGroup.GET("/:id/attempts", listWebhookAttempts(webhookService, logger))
+	}
+
+	v1.GET("/audit", listAuditEntries(auditService, logger))
+	v1.POST("/graphql", queryGraphQL(graphQLServer, logger))
+
 	r.GET("/health", healthHandler)
+	r.GET(OPENAPI_PATH, openAPIHandler(openAPIDocument()))
+	r.GET("/swagger/*filepath", swaggerUI)
 
 	return r
 }
@@ -0,0 +1,125 @@
+package gin
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	IDEMPOTENCY_KEY_HEADER      = "Idempotency-Key"
+	IDEMPOTENCY_REPLAYED_HEADER = "Idempotency-Replayed"
+)
+
+// recordingWriter keeps a copy of the response body so it can be stored
+// alongside the idempotency key.
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// idempotent makes a POST safe to retry when the client sends an
+// Idempotency-Key header: the first response is stored and returned again for
+// every retry with the same key and body until the key expires. Keys are
+// scoped to the authenticated user. Requests without the header are handled
+// as usual. Server errors release the key, so the client can retry them.
+func idempotent(service idempotency.UseCase, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key, ok := c.Request.Header[IDEMPOTENCY_KEY_HEADER]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		if len(key) != 1 {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": idempotency.ErrInvalidKey.Error()})
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		requestHash := idempotency.Hash(c.Request.Method, c.Request.URL.Path, body)
+		record, err := service.Begin(c.Request.Context(), key[0], requestHash)
+		if err != nil {
+			c.AbortWithStatusJSON(idempotencyErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		if record != nil {
+			logger.Info("Replay idempotent request", map[string]any{
+				"idempotencyKey": record.Key,
+			})
+			c.Header(IDEMPOTENCY_REPLAYED_HEADER, "true")
+			c.Data(record.StatusCode, record.ContentType, record.Body)
+			c.Abort()
+			return
+		}
+
+		w := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = w
+
+		c.Next()
+
+		// The outcome is stored even if the client has already gone away,
+		// since that is exactly when it is going to retry.
+		ctx := context.WithoutCancel(c.Request.Context())
+
+		if w.Status() >= http.StatusInternalServerError {
+			if err := service.Release(ctx, key[0]); err != nil {
+				logger.Error("Release idempotency key - ERROR: ", map[string]any{
+					"idempotencyKey": key[0],
+					"err":            err.Error(),
+				})
+			}
+			return
+		}
+
+		err = service.Complete(ctx, &idempotency.Record{
+			Key:         key[0],
+			RequestHash: requestHash,
+			StatusCode:  w.Status(),
+			ContentType: w.Header().Get("Content-Type"),
+			Body:        w.body.Bytes(),
+		})
+		if err != nil {
+			logger.Error("Complete idempotency key - ERROR: ", map[string]any{
+				"idempotencyKey": key[0],
+				"err":            err.Error(),
+			})
+		}
+	}
+}
+
+func idempotencyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, idempotency.ErrInvalidKey):
+		return http.StatusBadRequest
+	case errors.Is(err, idempotency.ErrKeyReused):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, idempotency.ErrRequestInProgress):
+		return http.StatusConflict
+	}
+
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,159 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/incident"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+func incidentErrorStatus(err error) int {
+	if errors.Is(err, incident.ErrInvalidIncident) {
+		return http.StatusUnprocessableEntity
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listIncidents lists the incidents, the latest to occur first, of those
+// that occurred between from and to when they are given.
+func listIncidents(service *incident.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List incidents", nil)
+
+		var is gin_dto.IncidentSpecificationInputDTO
+		if err := c.ShouldBindQuery(&is); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		respondIncidents(c, service, is)
+	}
+}
+
+func listVehicleIncidents(service *incident.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List vehicle incidents", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var is gin_dto.IncidentSpecificationInputDTO
+		if err := c.ShouldBindQuery(&is); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		is.VehicleID = vehicleID
+
+		respondIncidents(c, service, is)
+	}
+}
+
+func listDriverIncidents(service *incident.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List driver incidents", nil)
+
+		driverID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var is gin_dto.IncidentSpecificationInputDTO
+		if err := c.ShouldBindQuery(&is); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		is.DriverID = driverID
+
+		respondIncidents(c, service, is)
+	}
+}
+
+func respondIncidents(c *gin.Context, service *incident.Service, is gin_dto.IncidentSpecificationInputDTO) {
+	specification := &incident.IncidentSpecification{
+		VehicleID: is.VehicleID,
+		DriverID:  is.DriverID,
+		From:      is.From,
+		To:        is.To,
+		Page:      is.Page,
+		PageSize:  is.PageSize,
+	}
+	if len(is.Type) > 0 {
+		var err error
+		specification.Type, err = incident.GetType(is.Type)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	incidents, err := service.List(c.Request.Context(), specification)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	incidentsDTO := make([]gin_dto.IncidentOutputDTO, 0, len(*incidents))
+	for _, i := range *incidents {
+		incidentsDTO = append(incidentsDTO, *gin_mapping.MapIncidentToOutputDTO(i))
+	}
+
+	c.JSON(http.StatusOK, incidentsDTO)
+}
+
+func getIncident(service *incident.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get incident", nil)
+
+		incidentID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		i, err := service.GetByID(c.Request.Context(), incidentID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapIncidentToOutputDTO(*i))
+	}
+}
+
+// reportIncident records the incident. A theft or a robbery also marks the
+// vehicle as stolen and ends its assignments.
+func reportIncident(service *incident.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Report incident", nil)
+
+		var dto gin_dto.IncidentInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		i := gin_mapping.MapInputDTOToIncident(dto)
+
+		incidentID, err := service.Report(c.Request.Context(), i)
+		if err != nil {
+			c.JSON(incidentErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		i.ID = incidentID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapIncidentToOutputDTO(*i))
+	}
+}
@@ -0,0 +1,278 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/gin-gonic/gin"
+)
+
+func insuranceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, insurance.ErrInvalidPolicy), errors.Is(err, insurance.ErrInvalidClaim),
+		errors.Is(err, insurance.ErrInvalidClaimStatus), errors.Is(err, insurance.ErrNotCovered):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, insurance.ErrPolicyExists), errors.Is(err, insurance.ErrClaimExists),
+		errors.Is(err, insurance.ErrInvalidClaimTransition):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listInsurancePolicies lists the policies, the latest to end first, only
+// those in force at valid_at when it is given.
+func listInsurancePolicies(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List insurance policies", nil)
+
+		var ps gin_dto.InsurancePolicySpecificationInputDTO
+		if err := c.ShouldBindQuery(&ps); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &insurance.PolicySpecification{
+			VehicleID: ps.VehicleID,
+			Insurer:   ps.Insurer,
+			ValidAt:   ps.ValidAt,
+			Page:      ps.Page,
+			PageSize:  ps.PageSize,
+		}
+		if len(ps.Coverage) > 0 {
+			var err error
+			specification.Coverage, err = insurance.GetCoverage(ps.Coverage)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		policies, err := service.ListPolicies(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		respondInsurancePolicies(c, policies)
+	}
+}
+
+func listInsuranceAlerts(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List insurance alerts", nil)
+
+		policies, err := service.ListAlerts(c.Request.Context())
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		respondInsurancePolicies(c, policies)
+	}
+}
+
+func respondInsurancePolicies(c *gin.Context, policies *[]insurance.Policy) {
+	policiesDTO := make([]gin_dto.InsurancePolicyOutputDTO, 0, len(*policies))
+	for _, p := range *policies {
+		policiesDTO = append(policiesDTO, *gin_mapping.MapInsurancePolicyToOutputDTO(p))
+	}
+
+	c.JSON(http.StatusOK, policiesDTO)
+}
+
+func getInsurancePolicy(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get insurance policy", nil)
+
+		policyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		p, err := service.GetPolicy(c.Request.Context(), policyID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapInsurancePolicyToOutputDTO(*p))
+	}
+}
+
+func createInsurancePolicy(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create insurance policy", nil)
+
+		var dto gin_dto.InsurancePolicyInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		p := gin_mapping.MapInputDTOToInsurancePolicy(dto)
+
+		policyID, err := service.CreatePolicy(c.Request.Context(), p)
+		if err != nil {
+			c.JSON(insuranceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		p.ID = policyID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapInsurancePolicyToOutputDTO(*p))
+	}
+}
+
+func updateInsurancePolicy(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Update insurance policy", nil)
+
+		policyID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.InsurancePolicyInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		p := gin_mapping.MapInputDTOToInsurancePolicy(dto)
+		p.ID = policyID
+
+		err = service.UpdatePolicy(c.Request.Context(), p)
+		if err != nil {
+			c.JSON(insuranceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+// listInsuranceClaims lists the claims, the latest opened first.
+func listInsuranceClaims(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List insurance claims", nil)
+
+		var cs gin_dto.InsuranceClaimSpecificationInputDTO
+		if err := c.ShouldBindQuery(&cs); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &insurance.ClaimSpecification{
+			PolicyID:   cs.PolicyID,
+			IncidentID: cs.IncidentID,
+			VehicleID:  cs.VehicleID,
+			Page:       cs.Page,
+			PageSize:   cs.PageSize,
+		}
+		if len(cs.Status) > 0 {
+			var err error
+			specification.Status, err = insurance.GetClaimStatus(cs.Status)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		claims, err := service.ListClaims(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		claimsDTO := make([]gin_dto.InsuranceClaimOutputDTO, 0, len(*claims))
+		for _, cl := range *claims {
+			claimsDTO = append(claimsDTO, *gin_mapping.MapInsuranceClaimToOutputDTO(cl))
+		}
+
+		c.JSON(http.StatusOK, claimsDTO)
+	}
+}
+
+func getInsuranceClaim(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get insurance claim", nil)
+
+		claimID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		cl, err := service.GetClaim(c.Request.Context(), claimID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapInsuranceClaimToOutputDTO(*cl))
+	}
+}
+
+func openInsuranceClaim(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Open insurance claim", nil)
+
+		var dto gin_dto.InsuranceClaimInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		cl := gin_mapping.MapInputDTOToInsuranceClaim(dto)
+
+		claimID, err := service.OpenClaim(c.Request.Context(), cl)
+		if err != nil {
+			c.JSON(insuranceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		cl.ID = claimID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapInsuranceClaimToOutputDTO(*cl))
+	}
+}
+
+func changeInsuranceClaimStatus(service *insurance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Change insurance claim status", nil)
+
+		claimID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.InsuranceClaimStatusInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		status, err := insurance.GetClaimStatus(dto.Status)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		cl, err := service.ChangeClaimStatus(c.Request.Context(), claimID, status, dto.Number)
+		if err != nil {
+			c.JSON(insuranceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapInsuranceClaimToOutputDTO(*cl))
+	}
+}
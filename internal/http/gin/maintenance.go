@@ -0,0 +1,383 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/gin-gonic/gin"
+)
+
+func maintenanceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, maintenance.ErrInvalidPlan), errors.Is(err, maintenance.ErrInvalidOrder),
+		errors.Is(err, maintenance.ErrInvalidTicket), errors.Is(err, maintenance.ErrTicketOrderMismatch):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, maintenance.ErrTicketClosed):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+func listMaintenancePlans(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List maintenance plans", nil)
+
+		var ps gin_dto.MaintenancePlanSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ps); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		plans, err := service.ListPlans(c.Request.Context(), &maintenance.PlanSpecification{
+			Brand:    ps.Brand,
+			Model:    ps.Model,
+			Page:     ps.Page,
+			PageSize: ps.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		plansDTO := make([]gin_dto.MaintenancePlanOutputDTO, 0, len(*plans))
+		for _, p := range *plans {
+			plansDTO = append(plansDTO, *gin_mapping.MapMaintenancePlanToOutputDTO(p))
+		}
+
+		c.JSON(http.StatusOK, plansDTO)
+	}
+}
+
+func getMaintenancePlan(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get maintenance plan", nil)
+
+		planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		plan, err := service.GetPlan(c.Request.Context(), planID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapMaintenancePlanToOutputDTO(*plan))
+	}
+}
+
+func createMaintenancePlan(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create maintenance plan", nil)
+
+		var dto gin_dto.MaintenancePlanInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		plan := gin_mapping.MapInputDTOToMaintenancePlan(dto)
+
+		planID, err := service.CreatePlan(c.Request.Context(), plan)
+		if err != nil {
+			c.JSON(maintenanceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		plan.ID = planID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapMaintenancePlanToOutputDTO(*plan))
+	}
+}
+
+func updateMaintenancePlan(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Update maintenance plan", nil)
+
+		planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.MaintenancePlanInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		plan := gin_mapping.MapInputDTOToMaintenancePlan(dto)
+		plan.ID = planID
+
+		err = service.UpdatePlan(c.Request.Context(), plan)
+		if err != nil {
+			c.JSON(maintenanceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+func deleteMaintenancePlan(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Delete maintenance plan", nil)
+
+		planID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.DeletePlan(c.Request.Context(), planID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+func listMaintenanceOrders(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List service orders", nil)
+
+		var ms gin_dto.MaintenanceOrderSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ms); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		orders, err := service.ListOrders(c.Request.Context(), &maintenance.OrderSpecification{
+			VehicleID: ms.VehicleID,
+			Page:      ms.Page,
+			PageSize:  ms.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		ordersDTO := make([]gin_dto.MaintenanceOrderOutputDTO, 0, len(*orders))
+		for _, o := range *orders {
+			ordersDTO = append(ordersDTO, *gin_mapping.MapMaintenanceOrderToOutputDTO(o))
+		}
+
+		c.JSON(http.StatusOK, ordersDTO)
+	}
+}
+
+func getMaintenanceOrder(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get service order", nil)
+
+		orderID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		order, err := service.GetOrder(c.Request.Context(), orderID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapMaintenanceOrderToOutputDTO(*order))
+	}
+}
+
+func createMaintenanceOrder(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create service order", nil)
+
+		var dto gin_dto.MaintenanceOrderInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		order := gin_mapping.MapInputDTOToMaintenanceOrder(dto)
+
+		orderID, err := service.CreateOrder(c.Request.Context(), order)
+		if err != nil {
+			c.JSON(maintenanceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		order.ID = orderID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapMaintenanceOrderToOutputDTO(*order))
+	}
+}
+
+func deleteMaintenanceOrder(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Delete service order", nil)
+
+		orderID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.DeleteOrder(c.Request.Context(), orderID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+// listOverdueMaintenance lists the plans overdue on the fleet, only the
+// critical ones when critical=true.
+func listOverdueMaintenance(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List overdue maintenance", nil)
+
+		var ms gin_dto.MaintenanceOverdueSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ms); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		dues, err := service.ListOverdue(c.Request.Context(), &maintenance.OverdueSpecification{
+			Critical: ms.Critical,
+			Page:     ms.Page,
+			PageSize: ms.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, mapMaintenanceDues(*dues))
+	}
+}
+
+func listVehicleMaintenance(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List vehicle maintenance", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		dues, err := service.ListDue(c.Request.Context(), vehicleID)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, mapMaintenanceDues(*dues))
+	}
+}
+
+// listMaintenanceTickets lists the tickets, the latest first.
+func listMaintenanceTickets(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List maintenance tickets", nil)
+
+		var ts gin_dto.MaintenanceTicketSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ts); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &maintenance.TicketSpecification{
+			VehicleID:   ts.VehicleID,
+			ChecklistID: ts.ChecklistID,
+			Page:        ts.Page,
+			PageSize:    ts.PageSize,
+		}
+		if len(ts.Status) > 0 {
+			var err error
+			specification.Status, err = maintenance.GetTicketStatus(ts.Status)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		tickets, err := service.ListTickets(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		ticketsDTO := make([]gin_dto.MaintenanceTicketOutputDTO, 0, len(*tickets))
+		for _, t := range *tickets {
+			ticketsDTO = append(ticketsDTO, *gin_mapping.MapMaintenanceTicketToOutputDTO(t))
+		}
+
+		c.JSON(http.StatusOK, ticketsDTO)
+	}
+}
+
+func getMaintenanceTicket(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get maintenance ticket", nil)
+
+		ticketID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ticket, err := service.GetTicket(c.Request.Context(), ticketID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapMaintenanceTicketToOutputDTO(*ticket))
+	}
+}
+
+func closeMaintenanceTicket(service *maintenance.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Close maintenance ticket", nil)
+
+		ticketID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.MaintenanceTicketCloseInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		ticket, err := service.CloseTicket(c.Request.Context(), ticketID, dto.OrderID)
+		if err != nil {
+			c.JSON(maintenanceErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapMaintenanceTicketToOutputDTO(*ticket))
+	}
+}
+
+func mapMaintenanceDues(dues []maintenance.Due) []gin_dto.MaintenanceDueOutputDTO {
+	duesDTO := make([]gin_dto.MaintenanceDueOutputDTO, 0, len(dues))
+	for _, d := range dues {
+		duesDTO = append(duesDTO, *gin_mapping.MapMaintenanceDueToOutputDTO(d))
+	}
+
+	return duesDTO
+}
@@ -0,0 +1,120 @@
+package mapping
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/internal/spreadsheet"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+)
+
+// ExportColumn is a column of an exported spreadsheet. Name is both the
+// header and the identifier accepted by the columns query parameter.
+type ExportColumn[T any] struct {
+	Name  string
+	Value func(item *T, f *spreadsheet.Formatter) string
+}
+
+var (
+	VehicleExportColumns = []ExportColumn[vehicle.Vehicle]{
+		{"id", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string { return strconv.FormatInt(v.ID, 10) }},
+		{"brand", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string { return v.Attributes.Brand }},
+		{"model", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string { return v.Attributes.Model }},
+		{"year_of_manufacture", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string {
+			if v.Attributes.YearOfManufacture.IsZero() {
+				return ""
+			}
+			return strconv.Itoa(v.Attributes.YearOfManufacture.Year())
+		}},
+		{"category", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string { return string(v.Attributes.Category) }},
+		{"plate", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string { return v.LegalInformation.Plate }},
+		{"renavam", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string { return v.LegalInformation.Renavam }},
+		{"licensing_expiry_date", func(v *vehicle.Vehicle, f *spreadsheet.Formatter) string {
+			return f.Date(v.LegalInformation.Licensing.ExpiryDate)
+		}},
+		{"licensing_status", func(v *vehicle.Vehicle, _ *spreadsheet.Formatter) string {
+			return v.LegalInformation.Licensing.Status.String()
+		}},
+		{"created_at", func(v *vehicle.Vehicle, f *spreadsheet.Formatter) string { return f.DateTime(v.CreatedAt) }},
+		{"updated_at", func(v *vehicle.Vehicle, f *spreadsheet.Formatter) string { return f.DateTime(v.UpdatedAt) }},
+	}
+
+	DriverExportColumns = []ExportColumn[driver.Driver]{
+		{"id", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return strconv.FormatInt(d.ID, 10) }},
+		{"user_id", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return strconv.FormatInt(d.UserID, 10) }},
+		{"name", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return d.Attributes.Name }},
+		{"date_of_birth", func(d *driver.Driver, f *spreadsheet.Formatter) string { return f.Date(d.Attributes.DateOfBirth) }},
+		{"rg", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return d.LegalInformation.RG }},
+		{"cpf", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return d.LegalInformation.CPF }},
+		{"driver_license", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return d.LegalInformation.DriverLicense }},
+		{"cell_phone", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return d.Contact.CellPhone }},
+		{"email", func(d *driver.Driver, _ *spreadsheet.Formatter) string { return d.Contact.Email }},
+		{"locality", driverAddressColumn(func(d *driver.Driver) string { return d.Address.Locality })},
+		{"number", driverAddressColumn(func(d *driver.Driver) string { return d.Address.Number })},
+		{"complement", driverAddressColumn(func(d *driver.Driver) string { return d.Address.Complement })},
+		{"neighborhood", driverAddressColumn(func(d *driver.Driver) string { return d.Address.Neighborhood })},
+		{"city", driverAddressColumn(func(d *driver.Driver) string { return d.Address.City })},
+		{"state", driverAddressColumn(func(d *driver.Driver) string { return d.Address.State.String() })},
+		{"cep", driverAddressColumn(func(d *driver.Driver) string { return d.Address.CEP })},
+		{"country", driverAddressColumn(func(d *driver.Driver) string { return d.Address.Country })},
+		{"created_at", func(d *driver.Driver, f *spreadsheet.Formatter) string { return f.DateTime(d.CreatedAt) }},
+		{"updated_at", func(d *driver.Driver, f *spreadsheet.Formatter) string { return f.DateTime(d.UpdatedAt) }},
+	}
+)
+
+func driverAddressColumn(value func(d *driver.Driver) string) func(d *driver.Driver, f *spreadsheet.Formatter) string {
+	return func(d *driver.Driver, _ *spreadsheet.Formatter) string {
+		if d.Address == nil {
+			return ""
+		}
+		return value(d)
+	}
+}
+
+// SelectExportColumns returns the requested columns, in the requested
+// order, or every column when nothing is requested.
+func SelectExportColumns[T any](columns []ExportColumn[T], requested string) ([]ExportColumn[T], error) {
+	if len(strings.TrimSpace(requested)) == 0 {
+		return columns, nil
+	}
+
+	selected := make([]ExportColumn[T], 0, len(columns))
+	for _, name := range strings.Split(requested, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+
+		found := false
+		for _, column := range columns {
+			if column.Name == name {
+				selected = append(selected, column)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			return nil, fmt.Errorf("the given export column [%s] does not exist", name)
+		}
+	}
+
+	return selected, nil
+}
+
+func MapExportColumnsToHeader[T any](columns []ExportColumn[T]) []string {
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Name
+	}
+
+	return header
+}
+
+func MapItemToExportRow[T any](item *T, columns []ExportColumn[T], f *spreadsheet.Formatter) []string {
+	row := make([]string, len(columns))
+	for i, column := range columns {
+		row[i] = column.Value(item, f)
+	}
+
+	return row
+}
@@ -1,10 +1,26 @@
 package mapping
 
 import (
+	"mime"
+	"strings"
+	"time"
+
 	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/checklist"
+	"github.com/LucasMateus-eng/operations-service/document"
 	"github.com/LucasMateus-eng/operations-service/driver"
 	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/fine"
+	"github.com/LucasMateus-eng/operations-service/fuel"
+	"github.com/LucasMateus-eng/operations-service/incident"
+	"github.com/LucasMateus-eng/operations-service/insurance"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
 	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/shift"
+	"github.com/LucasMateus-eng/operations-service/trip"
 	"github.com/LucasMateus-eng/operations-service/user"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 )
@@ -21,12 +37,64 @@ func MapAddressToOutputDTO(address address.Address) *gin_dto.AddressOutputDTO {
 		State:        address.State,
 		CEP:          address.CEP,
 		Country:      address.Country,
+		Version:      address.Version,
 		CreatedAt:    address.CreatedAt,
 		UpdatedAt:    address.UpdatedAt,
 		DeletedAt:    address.DeletedAt,
 	}
 }
 
+func MapInputDTOToAddress(input gin_dto.AddressInputDTO) *address.Address {
+	return &address.Address{
+		ID:           input.ID,
+		Locality:     input.Locality,
+		Number:       input.Number,
+		Complement:   input.Complement,
+		Neighborhood: input.Neighborhood,
+		City:         input.City,
+		State:        input.State,
+		CEP:          input.CEP,
+		Country:      input.Country,
+	}
+}
+
+func MapAddressToInputDTO(address address.Address) *gin_dto.AddressInputDTO {
+	return &gin_dto.AddressInputDTO{
+		ID:           address.ID,
+		Locality:     address.Locality,
+		Number:       address.Number,
+		Complement:   address.Complement,
+		Neighborhood: address.Neighborhood,
+		City:         address.City,
+		State:        address.State,
+		CEP:          address.CEP,
+		Country:      address.Country,
+	}
+}
+
+func MapAuditEntryToOutputDTO(entry audit.Entry) *gin_dto.AuditEntryOutputDTO {
+	changes := make([]gin_dto.AuditChangeOutputDTO, 0, len(entry.Changes))
+	for _, change := range entry.Changes {
+		changes = append(changes, gin_dto.AuditChangeOutputDTO{
+			Field:  change.Field,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+
+	return &gin_dto.AuditEntryOutputDTO{
+		ID:            entry.ID,
+		EntityType:    entry.EntityType,
+		EntityID:      entry.EntityID,
+		Operation:     string(entry.Operation),
+		ActorID:       entry.ActorID,
+		ActorUsername: entry.ActorUsername,
+		RequestID:     entry.RequestID,
+		Changes:       changes,
+		CreatedAt:     entry.CreatedAt,
+	}
+}
+
 func MapDriverVehicleToOutputDTO(driverVehicle drivervehicle.DriverVehicle) *gin_dto.DriverVehicleOutputDTO {
 	return &gin_dto.DriverVehicleOutputDTO{
 		DriverID:  driverVehicle.DriverID,
@@ -45,6 +113,11 @@ func MapInputDTOToDriverVehicle(input gin_dto.DriverVehicleInputDTO) *drivervehi
 }
 
 func MapDriverToOutputDTO(driver driver.Driver) *gin_dto.DriverOutputDTO {
+	var addressDTO *gin_dto.AddressOutputDTO
+	if driver.Address != nil {
+		addressDTO = MapAddressToOutputDTO(*driver.Address)
+	}
+
 	return &gin_dto.DriverOutputDTO{
 		ID:            driver.ID,
 		UserID:        driver.UserID,
@@ -55,8 +128,9 @@ func MapDriverToOutputDTO(driver driver.Driver) *gin_dto.DriverOutputDTO {
 		DriverLicense: driver.LegalInformation.DriverLicense,
 		CellPhone:     driver.Contact.CellPhone,
 		Email:         driver.Contact.Email,
-		Address:       MapAddressToOutputDTO(*driver.Address),
+		Address:       addressDTO,
 		Vehicles:      MapVehicleListToOutputDTO(driver.Vehicles),
+		Version:       driver.Version,
 		CreatedAt:     driver.CreatedAt,
 		UpdatedAt:     driver.UpdatedAt,
 		DeletedAt:     driver.DeletedAt,
@@ -82,6 +156,30 @@ func MapInputDTOToDriver(input gin_dto.DriverInputDTO) *driver.Driver {
 	}
 }
 
+func MapDriverToInputDTO(driver driver.Driver) *gin_dto.DriverInputDTO {
+	return &gin_dto.DriverInputDTO{
+		ID:            driver.ID,
+		Name:          driver.Attributes.Name,
+		DateOfBirth:   driver.Attributes.DateOfBirth,
+		RG:            driver.LegalInformation.RG,
+		CPF:           driver.LegalInformation.CPF,
+		DriverLicense: driver.LegalInformation.DriverLicense,
+		CellPhone:     driver.Contact.CellPhone,
+		Email:         driver.Contact.Email,
+	}
+}
+
+func MapOnboardingInputDTOToOnboarding(input gin_dto.DriverOnboardingInputDTO) *driver.Onboarding {
+	d := MapInputDTOToDriver(input.Driver)
+	d.Address = MapInputDTOToAddress(input.Address)
+
+	return &driver.Onboarding{
+		Username: input.Username,
+		Password: input.Password,
+		Driver:   d,
+	}
+}
+
 func MapInputDTOToDriverSpecification(input gin_dto.DriverSpecificationInputDTO) *driver.DriverSpecification {
 	return &driver.DriverSpecification{
 		Page:     input.Page,
@@ -89,18 +187,35 @@ func MapInputDTOToDriverSpecification(input gin_dto.DriverSpecificationInputDTO)
 	}
 }
 
+func MapExportInputDTOToDriverSpecification(input gin_dto.DriverExportInputDTO) *driver.DriverSpecification {
+	return &driver.DriverSpecification{
+		Page:     input.Page,
+		PageSize: input.PageSize,
+	}
+}
+
 func MapUserToOutputDTO(user user.User) *gin_dto.UserOutputDTO {
 	return &gin_dto.UserOutputDTO{
 		ID:             user.ID,
 		Username:       user.Username,
 		HashedPassword: user.HashedPassword,
 		Role:           user.Role,
+		Version:        user.Version,
 		CreatedAt:      user.CreatedAt,
 		UpdatedAt:      user.UpdatedAt,
 		DeletedAt:      user.DeletedAt,
 	}
 }
 
+func MapUserToInputDTO(user user.User) *gin_dto.UserInputDTO {
+	return &gin_dto.UserInputDTO{
+		ID:             user.ID,
+		Username:       user.Username,
+		HashedPassword: user.HashedPassword,
+		Role:           user.Role,
+	}
+}
+
 func MapInputDTOToUser(input gin_dto.UserInputDTO) *user.User {
 	return &user.User{
 		ID:             input.ID,
@@ -116,13 +231,33 @@ func MapInputDTOToVehicleSpecification(input gin_dto.VehicleSpecificationInputDT
 			Brand:             input.Brand,
 			Model:             input.Model,
 			YearOfManufacture: input.YearOfManufacture,
+			Category:          input.Category,
 		},
 		Licensing: vehicle.Licensing{
 			ExpiryDate: input.LicensingExpiryDate,
 			Status:     input.LicensingStatus,
 		},
-		Page:     input.Page,
-		PageSize: input.PageSize,
+		Uninsured: input.Uninsured,
+		Page:      input.Page,
+		PageSize:  input.PageSize,
+	}
+}
+
+func MapExportInputDTOToVehicleSpecification(input gin_dto.VehicleExportInputDTO) *vehicle.VehicleSpectification {
+	return &vehicle.VehicleSpectification{
+		Attributes: vehicle.VehicleAttributes{
+			Brand:             input.Brand,
+			Model:             input.Model,
+			YearOfManufacture: input.YearOfManufacture,
+			Category:          input.Category,
+		},
+		Licensing: vehicle.Licensing{
+			ExpiryDate: input.LicensingExpiryDate,
+			Status:     input.LicensingStatus,
+		},
+		Uninsured: input.Uninsured,
+		Page:      input.Page,
+		PageSize:  input.PageSize,
 	}
 }
 
@@ -132,16 +267,33 @@ func MapVehicleToOutputDTO(vehicle vehicle.Vehicle) *gin_dto.VehicleOutputDTO {
 		Brand:               vehicle.Attributes.Brand,
 		Model:               vehicle.Attributes.Model,
 		YearOfManufacture:   vehicle.Attributes.YearOfManufacture,
+		Category:            vehicle.Attributes.Category,
 		Plate:               vehicle.LegalInformation.Plate,
 		Renavam:             vehicle.LegalInformation.Renavam,
 		LicensingExpiryDate: vehicle.LegalInformation.Licensing.ExpiryDate,
 		LicensingStatus:     vehicle.LegalInformation.Licensing.Status,
+		Odometer:            vehicle.Odometer,
+		Version:             vehicle.Version,
 		CreatedAt:           vehicle.CreatedAt,
 		UpdatedAt:           vehicle.UpdatedAt,
 		DeletedAt:           vehicle.DeletedAt,
 	}
 }
 
+func MapVehicleToInputDTO(vehicle vehicle.Vehicle) *gin_dto.VehicleInputDTO {
+	return &gin_dto.VehicleInputDTO{
+		ID:                  vehicle.ID,
+		Brand:               vehicle.Attributes.Brand,
+		Model:               vehicle.Attributes.Model,
+		YearOfManufacture:   vehicle.Attributes.YearOfManufacture,
+		Category:            vehicle.Attributes.Category,
+		Plate:               vehicle.LegalInformation.Plate,
+		Renavam:             vehicle.LegalInformation.Renavam,
+		LicensingExpiryDate: vehicle.LegalInformation.Licensing.ExpiryDate,
+		LicensingStatus:     vehicle.LegalInformation.Licensing.Status,
+	}
+}
+
 func MapInputDTOToVehicle(input gin_dto.VehicleInputDTO) *vehicle.Vehicle {
 	return &vehicle.Vehicle{
 		ID: input.ID,
@@ -149,6 +301,7 @@ func MapInputDTOToVehicle(input gin_dto.VehicleInputDTO) *vehicle.Vehicle {
 			Brand:             input.Brand,
 			Model:             input.Model,
 			YearOfManufacture: input.YearOfManufacture,
+			Category:          input.Category,
 		},
 		LegalInformation: vehicle.VehicleLegalInformation{
 			Plate:   input.Plate,
@@ -168,3 +321,690 @@ func MapVehicleListToOutputDTO(vehicles []vehicle.Vehicle) []gin_dto.VehicleOutp
 	}
 	return vehicleDTOs
 }
+
+func MapInputDTOToWebhook(input gin_dto.WebhookInputDTO) *webhook.Subscription {
+	active := true
+	if input.Active != nil {
+		active = *input.Active
+	}
+
+	return &webhook.Subscription{
+		URL:        input.URL,
+		EventTypes: input.EventTypes,
+		Secret:     input.Secret,
+		Active:     active,
+	}
+}
+
+func MapWebhookToOutputDTO(subscription webhook.Subscription) *gin_dto.WebhookOutputDTO {
+	return &gin_dto.WebhookOutputDTO{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
+
+func MapWebhookDeliveryToOutputDTO(delivery webhook.Delivery) *gin_dto.WebhookDeliveryOutputDTO {
+	var deliveredAt *time.Time
+	if !delivery.DeliveredAt.IsZero() {
+		deliveredAt = &delivery.DeliveredAt
+	}
+
+	return &gin_dto.WebhookDeliveryOutputDTO{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Body:           delivery.Body,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    deliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func MapWebhookAttemptToOutputDTO(attempt webhook.Attempt) *gin_dto.WebhookAttemptOutputDTO {
+	return &gin_dto.WebhookAttemptOutputDTO{
+		ID:         attempt.ID,
+		DeliveryID: attempt.DeliveryID,
+		Number:     attempt.Number,
+		StatusCode: attempt.StatusCode,
+		Error:      attempt.Error,
+		DurationMS: attempt.Duration.Milliseconds(),
+		CreatedAt:  attempt.CreatedAt,
+	}
+}
+
+func MapInputDTOToMaintenanceOrder(input gin_dto.MaintenanceOrderInputDTO) *maintenance.Order {
+	parts := make([]maintenance.Part, 0, len(input.Parts))
+	for _, p := range input.Parts {
+		parts = append(parts, maintenance.Part{
+			Name:     p.Name,
+			Quantity: p.Quantity,
+			UnitCost: p.UnitCost,
+		})
+	}
+
+	return &maintenance.Order{
+		VehicleID:   input.VehicleID,
+		PlanID:      input.PlanID,
+		Type:        maintenance.OrderType(input.Type),
+		PerformedAt: input.PerformedAt,
+		Odometer:    input.Odometer,
+		Cost:        input.Cost,
+		Supplier:    input.Supplier,
+		Parts:       parts,
+		Notes:       input.Notes,
+	}
+}
+
+func MapMaintenanceOrderToOutputDTO(order maintenance.Order) *gin_dto.MaintenanceOrderOutputDTO {
+	parts := make([]gin_dto.MaintenancePartDTO, 0, len(order.Parts))
+	for _, p := range order.Parts {
+		parts = append(parts, gin_dto.MaintenancePartDTO{
+			Name:     p.Name,
+			Quantity: p.Quantity,
+			UnitCost: p.UnitCost,
+		})
+	}
+
+	return &gin_dto.MaintenanceOrderOutputDTO{
+		ID:          order.ID,
+		VehicleID:   order.VehicleID,
+		PlanID:      order.PlanID,
+		Type:        string(order.Type),
+		PerformedAt: order.PerformedAt,
+		Odometer:    order.Odometer,
+		Cost:        order.Cost,
+		Supplier:    order.Supplier,
+		Parts:       parts,
+		Notes:       order.Notes,
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+}
+
+func MapInputDTOToMaintenancePlan(input gin_dto.MaintenancePlanInputDTO) *maintenance.Plan {
+	return &maintenance.Plan{
+		Name:         input.Name,
+		Brand:        input.Brand,
+		Model:        input.Model,
+		IntervalKm:   input.IntervalKm,
+		IntervalDays: input.IntervalDays,
+		Critical:     input.Critical,
+	}
+}
+
+func MapMaintenancePlanToOutputDTO(plan maintenance.Plan) *gin_dto.MaintenancePlanOutputDTO {
+	return &gin_dto.MaintenancePlanOutputDTO{
+		ID:           plan.ID,
+		Name:         plan.Name,
+		Brand:        plan.Brand,
+		Model:        plan.Model,
+		IntervalKm:   plan.IntervalKm,
+		IntervalDays: plan.IntervalDays,
+		Critical:     plan.Critical,
+		CreatedAt:    plan.CreatedAt,
+		UpdatedAt:    plan.UpdatedAt,
+	}
+}
+
+func MapMaintenanceTicketToOutputDTO(ticket maintenance.Ticket) *gin_dto.MaintenanceTicketOutputDTO {
+	var closedAt *time.Time
+	if !ticket.ClosedAt.IsZero() {
+		closedAt = &ticket.ClosedAt
+	}
+
+	return &gin_dto.MaintenanceTicketOutputDTO{
+		ID:          ticket.ID,
+		VehicleID:   ticket.VehicleID,
+		ChecklistID: ticket.ChecklistID,
+		Description: ticket.Description,
+		Status:      string(ticket.Status),
+		OrderID:     ticket.OrderID,
+		ClosedAt:    closedAt,
+		CreatedAt:   ticket.CreatedAt,
+		UpdatedAt:   ticket.UpdatedAt,
+	}
+}
+
+func MapMaintenanceDueToOutputDTO(due maintenance.Due) *gin_dto.MaintenanceDueOutputDTO {
+	var lastPerformedAt, dueAt *time.Time
+	if !due.LastPerformedAt.IsZero() {
+		lastPerformedAt = &due.LastPerformedAt
+	}
+	if !due.DueAt.IsZero() {
+		dueAt = &due.DueAt
+	}
+
+	return &gin_dto.MaintenanceDueOutputDTO{
+		VehicleID:       due.VehicleID,
+		Plan:            *MapMaintenancePlanToOutputDTO(due.Plan),
+		LastPerformedAt: lastPerformedAt,
+		LastOdometer:    due.LastOdometer,
+		Odometer:        due.Odometer,
+		DueAt:           dueAt,
+		DueOdometer:     due.DueOdometer,
+		Overdue:         due.Overdue,
+	}
+}
+
+func MapInputDTOToOdometerRecord(input gin_dto.OdometerRecordInputDTO) *odometer.Record {
+	return &odometer.Record{
+		ReadAt:     input.ReadAt,
+		Km:         input.Km,
+		Source:     odometer.Source(input.Source),
+		Correction: input.Correction,
+		Reason:     input.Reason,
+	}
+}
+
+func MapOdometerRecordToOutputDTO(record odometer.Record) *gin_dto.OdometerRecordOutputDTO {
+	return &gin_dto.OdometerRecordOutputDTO{
+		ID:         record.ID,
+		VehicleID:  record.VehicleID,
+		ReadAt:     record.ReadAt,
+		Km:         record.Km,
+		Source:     string(record.Source),
+		Correction: record.Correction,
+		Reason:     record.Reason,
+		Anomaly:    record.Anomaly,
+		CreatedAt:  record.CreatedAt,
+	}
+}
+
+func MapInputDTOToRefuel(input gin_dto.RefuelInputDTO) *fuel.Refuel {
+	return &fuel.Refuel{
+		VehicleID: input.VehicleID,
+		DriverID:  input.DriverID,
+		FueledAt:  input.FueledAt,
+		Litres:    input.Litres,
+		FuelType:  fuel.FuelType(input.FuelType),
+		Price:     input.Price,
+		Station:   input.Station,
+		Odometer:  input.Odometer,
+	}
+}
+
+func MapRefuelToOutputDTO(refuel fuel.Refuel) *gin_dto.RefuelOutputDTO {
+	return &gin_dto.RefuelOutputDTO{
+		ID:        refuel.ID,
+		VehicleID: refuel.VehicleID,
+		DriverID:  refuel.DriverID,
+		FueledAt:  refuel.FueledAt,
+		Litres:    refuel.Litres,
+		FuelType:  string(refuel.FuelType),
+		Price:     refuel.Price,
+		Station:   refuel.Station,
+		Odometer:  refuel.Odometer,
+		CreatedAt: refuel.CreatedAt,
+	}
+}
+
+func MapFuelEfficiencyToOutputDTO(efficiency fuel.Efficiency) *gin_dto.FuelEfficiencyOutputDTO {
+	outputDTO := &gin_dto.FuelEfficiencyOutputDTO{
+		Distance:   efficiency.Distance,
+		Litres:     efficiency.Litres,
+		KmPerLitre: efficiency.KmPerLitre(),
+		Segments:   make([]gin_dto.FuelSegmentOutputDTO, 0, len(efficiency.Segments)),
+	}
+
+	for _, s := range efficiency.Segments {
+		if s.Outlier {
+			outputDTO.Outliers++
+		}
+
+		outputDTO.Segments = append(outputDTO.Segments, gin_dto.FuelSegmentOutputDTO{
+			RefuelID:   s.RefuelID,
+			VehicleID:  s.VehicleID,
+			DriverID:   s.DriverID,
+			FueledAt:   s.FueledAt,
+			Distance:   s.Distance,
+			Litres:     s.Litres,
+			KmPerLitre: s.KmPerLitre(),
+			Outlier:    s.Outlier,
+		})
+	}
+
+	return outputDTO
+}
+
+func MapInputDTOToFine(input gin_dto.FineInputDTO) *fine.Fine {
+	return &fine.Fine{
+		Plate:           input.Plate,
+		CommittedAt:     input.CommittedAt,
+		Location:        input.Location,
+		Article:         input.Article,
+		Points:          input.Points,
+		Amount:          input.Amount,
+		PaymentDueAt:    input.PaymentDueAt,
+		IndicationDueAt: input.IndicationDueAt,
+	}
+}
+
+func MapFineToOutputDTO(f fine.Fine) *gin_dto.FineOutputDTO {
+	return &gin_dto.FineOutputDTO{
+		ID:              f.ID,
+		VehicleID:       f.VehicleID,
+		Plate:           f.Plate,
+		DriverID:        f.DriverID,
+		CommittedAt:     f.CommittedAt,
+		Location:        f.Location,
+		Article:         f.Article,
+		Points:          f.Points,
+		Amount:          f.Amount,
+		PaymentDueAt:    f.PaymentDueAt,
+		IndicationDueAt: f.IndicationDueAt,
+		PaidAt:          f.PaidAt,
+		IndicatedAt:     f.IndicatedAt,
+		CreatedAt:       f.CreatedAt,
+		UpdatedAt:       f.UpdatedAt,
+	}
+}
+
+func MapStandingToOutputDTO(standing fine.Standing) *gin_dto.DriverPointsOutputDTO {
+	return &gin_dto.DriverPointsOutputDTO{
+		DriverID:    standing.DriverID,
+		Points:      standing.Points,
+		Gravissimas: standing.Gravissimas,
+		Threshold:   standing.Threshold(),
+		Alert:       standing.Alert,
+		Suspended:   standing.Suspended(),
+	}
+}
+
+func MapInputDTOToTrip(input gin_dto.TripInputDTO) *trip.Trip {
+	return &trip.Trip{
+		DriverID:       input.DriverID,
+		VehicleID:      input.VehicleID,
+		Origin:         mapPlaceDTOToAddress(input.Origin),
+		Destination:    mapPlaceDTOToAddress(input.Destination),
+		PlannedStartAt: input.PlannedStartAt,
+		PlannedEndAt:   input.PlannedEndAt,
+	}
+}
+
+func MapTripToOutputDTO(t trip.Trip) *gin_dto.TripOutputDTO {
+	return &gin_dto.TripOutputDTO{
+		ID:             t.ID,
+		DriverID:       t.DriverID,
+		VehicleID:      t.VehicleID,
+		Origin:         mapAddressToPlaceDTO(t.Origin),
+		Destination:    mapAddressToPlaceDTO(t.Destination),
+		PlannedStartAt: t.PlannedStartAt,
+		PlannedEndAt:   t.PlannedEndAt,
+		StartedAt:      t.StartedAt,
+		EndedAt:        t.EndedAt,
+		StartOdometer:  t.StartOdometer,
+		EndOdometer:    t.EndOdometer,
+		Distance:       t.Distance(),
+		Status:         string(t.Status),
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+}
+
+func mapPlaceDTOToAddress(place gin_dto.PlaceDTO) address.Address {
+	return address.Address{
+		Locality:     place.Locality,
+		Number:       place.Number,
+		Complement:   place.Complement,
+		Neighborhood: place.Neighborhood,
+		City:         place.City,
+		State:        place.State,
+		CEP:          place.CEP,
+		Country:      place.Country,
+	}
+}
+
+func mapAddressToPlaceDTO(a address.Address) gin_dto.PlaceDTO {
+	return gin_dto.PlaceDTO{
+		Locality:     a.Locality,
+		Number:       a.Number,
+		Complement:   a.Complement,
+		Neighborhood: a.Neighborhood,
+		City:         a.City,
+		State:        a.State,
+		CEP:          a.CEP,
+		Country:      a.Country,
+	}
+}
+
+func MapInputDTOToShift(input gin_dto.ShiftInputDTO) *shift.Shift {
+	return &shift.Shift{
+		DriverID:       input.DriverID,
+		PlannedStartAt: input.PlannedStartAt,
+		PlannedEndAt:   input.PlannedEndAt,
+	}
+}
+
+func MapShiftToOutputDTO(s shift.Shift) *gin_dto.ShiftOutputDTO {
+	periodsDTO := make([]gin_dto.ShiftPeriodOutputDTO, 0, len(s.Periods))
+	for _, p := range s.Periods {
+		periodsDTO = append(periodsDTO, gin_dto.ShiftPeriodOutputDTO{
+			ID:        p.ID,
+			Activity:  string(p.Activity),
+			StartedAt: p.StartedAt,
+			EndedAt:   p.EndedAt,
+		})
+	}
+
+	return &gin_dto.ShiftOutputDTO{
+		ID:             s.ID,
+		DriverID:       s.DriverID,
+		PlannedStartAt: s.PlannedStartAt,
+		PlannedEndAt:   s.PlannedEndAt,
+		ClockInAt:      s.ClockInAt,
+		ClockOutAt:     s.ClockOutAt,
+		Status:         string(s.Status),
+		Periods:        periodsDTO,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+}
+
+func MapReportToWorkingHoursOutputDTO(r shift.Report) *gin_dto.WorkingHoursOutputDTO {
+	violationsDTO := make([]gin_dto.WorkingHoursViolationOutputDTO, 0, len(r.Violations))
+	for _, v := range r.Violations {
+		violationsDTO = append(violationsDTO, gin_dto.WorkingHoursViolationOutputDTO{
+			Rule:          string(v.Rule),
+			ShiftID:       v.ShiftID,
+			At:            v.At,
+			ActualMinutes: int64(v.Actual / time.Minute),
+			LimitMinutes:  int64(v.Limit / time.Minute),
+		})
+	}
+
+	return &gin_dto.WorkingHoursOutputDTO{
+		DriverID:       r.DriverID,
+		From:           r.From,
+		To:             r.To,
+		Shifts:         r.Shifts,
+		JourneyMinutes: int64(r.Journey / time.Minute),
+		DrivingMinutes: int64(r.Driving / time.Minute),
+		RestMinutes:    int64(r.Rest / time.Minute),
+		Violations:     violationsDTO,
+	}
+}
+
+func MapInputDTOToChecklistTemplate(input gin_dto.ChecklistTemplateInputDTO) *checklist.Template {
+	items := make([]checklist.Item, 0, len(input.Items))
+	for _, item := range input.Items {
+		items = append(items, checklist.Item{
+			Key:      item.Key,
+			Label:    item.Label,
+			Critical: item.Critical,
+		})
+	}
+
+	return &checklist.Template{
+		Name:     input.Name,
+		Category: vehicle.Category(input.Category),
+		Kind:     checklist.Kind(input.Kind),
+		Items:    items,
+	}
+}
+
+func MapChecklistTemplateToOutputDTO(template checklist.Template) *gin_dto.ChecklistTemplateOutputDTO {
+	items := make([]gin_dto.ChecklistItemDTO, 0, len(template.Items))
+	for _, item := range template.Items {
+		items = append(items, gin_dto.ChecklistItemDTO{
+			Key:      item.Key,
+			Label:    item.Label,
+			Critical: item.Critical,
+		})
+	}
+
+	return &gin_dto.ChecklistTemplateOutputDTO{
+		ID:        template.ID,
+		Name:      template.Name,
+		Category:  string(template.Category),
+		Kind:      string(template.Kind),
+		Items:     items,
+		CreatedAt: template.CreatedAt,
+		UpdatedAt: template.UpdatedAt,
+	}
+}
+
+func MapInputDTOToChecklist(input gin_dto.ChecklistInputDTO) *checklist.Checklist {
+	answers := make([]checklist.Answer, 0, len(input.Answers))
+	for _, a := range input.Answers {
+		answers = append(answers, checklist.Answer{
+			Key:    a.Key,
+			Passed: a.Passed,
+			Notes:  a.Notes,
+		})
+	}
+
+	photos := make([]checklist.Photo, 0, len(input.Photos))
+	for _, p := range input.Photos {
+		photos = append(photos, checklist.Photo{
+			ItemKey:     p.ItemKey,
+			URL:         p.URL,
+			ContentType: p.ContentType,
+			TakenAt:     p.TakenAt,
+		})
+	}
+
+	return &checklist.Checklist{
+		Kind:      checklist.Kind(input.Kind),
+		DriverID:  input.DriverID,
+		VehicleID: input.VehicleID,
+		Odometer:  input.Odometer,
+		FuelLevel: input.FuelLevel,
+		Answers:   answers,
+		Photos:    photos,
+		Notes:     input.Notes,
+	}
+}
+
+func MapChecklistToOutputDTO(c checklist.Checklist) *gin_dto.ChecklistOutputDTO {
+	answers := make([]gin_dto.ChecklistAnswerOutputDTO, 0, len(c.Answers))
+	for _, a := range c.Answers {
+		answers = append(answers, gin_dto.ChecklistAnswerOutputDTO{
+			Key:      a.Key,
+			Label:    a.Label,
+			Critical: a.Critical,
+			Passed:   a.Passed,
+			Notes:    a.Notes,
+		})
+	}
+
+	photos := make([]gin_dto.ChecklistPhotoDTO, 0, len(c.Photos))
+	for _, p := range c.Photos {
+		photos = append(photos, gin_dto.ChecklistPhotoDTO{
+			ItemKey:     p.ItemKey,
+			URL:         p.URL,
+			ContentType: p.ContentType,
+			TakenAt:     p.TakenAt,
+		})
+	}
+
+	return &gin_dto.ChecklistOutputDTO{
+		ID:          c.ID,
+		Kind:        string(c.Kind),
+		TemplateID:  c.TemplateID,
+		DriverID:    c.DriverID,
+		VehicleID:   c.VehicleID,
+		Odometer:    c.Odometer,
+		FuelLevel:   c.FuelLevel,
+		Answers:     answers,
+		Photos:      photos,
+		Notes:       c.Notes,
+		SubmittedAt: c.SubmittedAt,
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+func MapInputDTOToIncident(input gin_dto.IncidentInputDTO) *incident.Incident {
+	thirdParties := make([]incident.ThirdParty, 0, len(input.ThirdParties))
+	for _, p := range input.ThirdParties {
+		thirdParties = append(thirdParties, incident.ThirdParty{
+			Name:     p.Name,
+			Document: p.Document,
+			Phone:    p.Phone,
+			Plate:    p.Plate,
+			Insurer:  p.Insurer,
+		})
+	}
+
+	costEstimates := make([]incident.CostEstimate, 0, len(input.CostEstimates))
+	for _, e := range input.CostEstimates {
+		costEstimates = append(costEstimates, incident.CostEstimate{
+			Description: e.Description,
+			Amount:      e.Amount,
+		})
+	}
+
+	return &incident.Incident{
+		Type:          incident.Type(input.Type),
+		VehicleID:     input.VehicleID,
+		OccurredAt:    input.OccurredAt,
+		Location:      input.Location,
+		Description:   input.Description,
+		PoliceReport:  input.PoliceReport,
+		ThirdParties:  thirdParties,
+		CostEstimates: costEstimates,
+	}
+}
+
+func MapIncidentToOutputDTO(i incident.Incident) *gin_dto.IncidentOutputDTO {
+	thirdParties := make([]gin_dto.IncidentThirdPartyDTO, 0, len(i.ThirdParties))
+	for _, p := range i.ThirdParties {
+		thirdParties = append(thirdParties, gin_dto.IncidentThirdPartyDTO{
+			Name:     p.Name,
+			Document: p.Document,
+			Phone:    p.Phone,
+			Plate:    p.Plate,
+			Insurer:  p.Insurer,
+		})
+	}
+
+	costEstimates := make([]gin_dto.IncidentCostEstimateDTO, 0, len(i.CostEstimates))
+	for _, e := range i.CostEstimates {
+		costEstimates = append(costEstimates, gin_dto.IncidentCostEstimateDTO{
+			Description: e.Description,
+			Amount:      e.Amount,
+		})
+	}
+
+	return &gin_dto.IncidentOutputDTO{
+		ID:            i.ID,
+		Type:          string(i.Type),
+		VehicleID:     i.VehicleID,
+		DriverID:      i.DriverID,
+		OccurredAt:    i.OccurredAt,
+		Location:      i.Location,
+		Description:   i.Description,
+		PoliceReport:  i.PoliceReport,
+		ThirdParties:  thirdParties,
+		CostEstimates: costEstimates,
+		EstimatedCost: i.EstimatedCost(),
+		CreatedAt:     i.CreatedAt,
+		UpdatedAt:     i.UpdatedAt,
+	}
+}
+
+func MapInputDTOToInsurancePolicy(input gin_dto.InsurancePolicyInputDTO) *insurance.Policy {
+	return &insurance.Policy{
+		VehicleID:  input.VehicleID,
+		Insurer:    input.Insurer,
+		Number:     input.Number,
+		Coverage:   insurance.Coverage(input.Coverage),
+		StartsAt:   input.StartsAt,
+		EndsAt:     input.EndsAt,
+		Premium:    input.Premium,
+		Deductible: input.Deductible,
+	}
+}
+
+func MapInsurancePolicyToOutputDTO(p insurance.Policy) *gin_dto.InsurancePolicyOutputDTO {
+	return &gin_dto.InsurancePolicyOutputDTO{
+		ID:         p.ID,
+		VehicleID:  p.VehicleID,
+		Insurer:    p.Insurer,
+		Number:     p.Number,
+		Coverage:   string(p.Coverage),
+		StartsAt:   p.StartsAt,
+		EndsAt:     p.EndsAt,
+		Premium:    p.Premium,
+		Deductible: p.Deductible,
+		Alert:      p.Alert,
+		CreatedAt:  p.CreatedAt,
+		UpdatedAt:  p.UpdatedAt,
+	}
+}
+
+func MapInputDTOToInsuranceClaim(input gin_dto.InsuranceClaimInputDTO) *insurance.Claim {
+	return &insurance.Claim{
+		PolicyID:   input.PolicyID,
+		IncidentID: input.IncidentID,
+		Number:     input.Number,
+		Amount:     input.Amount,
+		Notes:      input.Notes,
+	}
+}
+
+func MapInsuranceClaimToOutputDTO(c insurance.Claim) *gin_dto.InsuranceClaimOutputDTO {
+	return &gin_dto.InsuranceClaimOutputDTO{
+		ID:         c.ID,
+		PolicyID:   c.PolicyID,
+		IncidentID: c.IncidentID,
+		VehicleID:  c.VehicleID,
+		Number:     c.Number,
+		Status:     string(c.Status),
+		Amount:     c.Amount,
+		Notes:      c.Notes,
+		ClosedAt:   c.ClosedAt,
+		CreatedAt:  c.CreatedAt,
+		UpdatedAt:  c.UpdatedAt,
+	}
+}
+
+// MapInputDTOToDocument takes the content type and the size from the
+// uploaded file, and its name when none is given.
+func MapInputDTOToDocument(input gin_dto.DocumentInputDTO, ownerType document.OwnerType, ownerID int64) *document.Document {
+	name := input.Name
+	if len(strings.TrimSpace(name)) == 0 {
+		name = input.File.Filename
+	}
+
+	contentType, _, _ := mime.ParseMediaType(input.File.Header.Get("Content-Type"))
+
+	return &document.Document{
+		OwnerType:   ownerType,
+		OwnerID:     ownerID,
+		Type:        document.Type(input.Type),
+		Name:        name,
+		ContentType: contentType,
+		Size:        input.File.Size,
+		Checksum:    input.Checksum,
+		ValidFrom:   input.ValidFrom,
+		ValidUntil:  input.ValidUntil,
+	}
+}
+
+func MapDocumentToOutputDTO(d document.Document) *gin_dto.DocumentOutputDTO {
+	return &gin_dto.DocumentOutputDTO{
+		ID:          d.ID,
+		OwnerType:   string(d.OwnerType),
+		OwnerID:     d.OwnerID,
+		Type:        string(d.Type),
+		Name:        d.Name,
+		ContentType: d.ContentType,
+		Size:        d.Size,
+		Checksum:    d.Checksum,
+		ValidFrom:   d.ValidFrom,
+		ValidUntil:  d.ValidUntil,
+		Expired:     d.ExpiredAt(time.Now()),
+		CreatedAt:   d.CreatedAt,
+		UpdatedAt:   d.UpdatedAt,
+	}
+}
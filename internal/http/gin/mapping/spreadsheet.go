@@ -0,0 +1,245 @@
+package mapping
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/spreadsheet"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/gin-gonic/gin/binding"
+)
+
+var (
+	spreadsheetDateLayouts = []string{
+		time.RFC3339,
+		"2006-01-02",
+		"02/01/2006",
+		"2006",
+	}
+
+	// vehicleColumnAliases maps the pt-BR headers used by the fleet
+	// spreadsheets to the json names of gin_dto.VehicleInputDTO.
+	vehicleColumnAliases = map[string]string{
+		"marca":                    "brand",
+		"modelo":                   "model",
+		"ano_fabricacao":           "year_of_manufacture",
+		"categoria":                "category",
+		"placa":                    "plate",
+		"vencimento_licenciamento": "licensing_expiry_date",
+		"situacao_licenciamento":   "licensing_status",
+	}
+
+	// driverColumnAliases does the same for gin_dto.DriverInputDTO and
+	// gin_dto.AddressInputDTO, which share a single flat row.
+	driverColumnAliases = map[string]string{
+		"nome":            "name",
+		"data_nascimento": "date_of_birth",
+		"cnh":             "driver_license",
+		"celular":         "cell_phone",
+		"logradouro":      "locality",
+		"numero":          "number",
+		"complemento":     "complement",
+		"bairro":          "neighborhood",
+		"cidade":          "city",
+		"uf":              "state",
+		"estado":          "state",
+		"pais":            "country",
+	}
+
+	DriverImportResultHeader = []string{
+		"line", "status", "cpf", "driver_id", "user_id", "address_id", "username", "password", "errors",
+	}
+)
+
+func ParseSpreadsheetDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+	if len(value) == 0 {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range spreadsheetDateLayouts {
+		if date, err := time.Parse(layout, value); err == nil {
+			return date, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("the given date [%s] does not match any of the accepted layouts %v", value, spreadsheetDateLayouts)
+}
+
+func getVehicleColumn(record spreadsheet.Record, column string) string {
+	return getColumn(record, column, vehicleColumnAliases)
+}
+
+func getDriverColumn(record spreadsheet.Record, column string) string {
+	return getColumn(record, column, driverColumnAliases)
+}
+
+func getColumn(record spreadsheet.Record, column string, aliases map[string]string) string {
+	if value := record.Get(column); len(value) > 0 {
+		return value
+	}
+
+	for alias, name := range aliases {
+		if name == column {
+			if value := record.Get(alias); len(value) > 0 {
+				return value
+			}
+		}
+	}
+
+	return ""
+}
+
+func MapRecordToVehicleInputDTO(record spreadsheet.Record) (*gin_dto.VehicleInputDTO, error) {
+	var errs []error
+
+	yearOfManufacture, err := ParseSpreadsheetDate(getVehicleColumn(record, "year_of_manufacture"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("year_of_manufacture: %w", err))
+	}
+
+	licensingExpiryDate, err := ParseSpreadsheetDate(getVehicleColumn(record, "licensing_expiry_date"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("licensing_expiry_date: %w", err))
+	}
+
+	licensingStatus, err := vehicle.GetLicensingStatus(getVehicleColumn(record, "licensing_status"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("licensing_status: %w", err))
+	}
+
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	dto := &gin_dto.VehicleInputDTO{
+		Brand:               getVehicleColumn(record, "brand"),
+		Model:               getVehicleColumn(record, "model"),
+		YearOfManufacture:   yearOfManufacture,
+		Category:            vehicle.Category(strings.ToUpper(getVehicleColumn(record, "category"))),
+		Plate:               vehicle.NormalizePlate(getVehicleColumn(record, "plate")),
+		Renavam:             getVehicleColumn(record, "renavam"),
+		LicensingExpiryDate: licensingExpiryDate,
+		LicensingStatus:     licensingStatus,
+	}
+
+	if err := binding.Validator.ValidateStruct(dto); err != nil {
+		return nil, err
+	}
+
+	return dto, nil
+}
+
+func MapRecordsToVehicleImportRows(records []spreadsheet.Record) []vehicle.ImportRow {
+	rows := make([]vehicle.ImportRow, 0, len(records))
+	for _, record := range records {
+		dto, err := MapRecordToVehicleInputDTO(record)
+		if err != nil {
+			rows = append(rows, vehicle.ImportRow{Line: record.Line, Err: err})
+			continue
+		}
+
+		rows = append(rows, vehicle.ImportRow{Line: record.Line, Vehicle: MapInputDTOToVehicle(*dto)})
+	}
+
+	return rows
+}
+
+func MapRecordToDriverInputDTO(record spreadsheet.Record) (*gin_dto.DriverInputDTO, *gin_dto.AddressInputDTO, error) {
+	var errs []error
+
+	dateOfBirth, err := ParseSpreadsheetDate(getDriverColumn(record, "date_of_birth"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("date_of_birth: %w", err))
+	}
+
+	state, err := address.ParseBrazilianState(getDriverColumn(record, "state"))
+	if err != nil {
+		errs = append(errs, fmt.Errorf("state: %w", err))
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	driverDTO := &gin_dto.DriverInputDTO{
+		Name:          getDriverColumn(record, "name"),
+		DateOfBirth:   dateOfBirth,
+		RG:            getDriverColumn(record, "rg"),
+		CPF:           driver.NormalizeDocument(getDriverColumn(record, "cpf")),
+		DriverLicense: driver.NormalizeDocument(getDriverColumn(record, "driver_license")),
+		CellPhone:     getDriverColumn(record, "cell_phone"),
+		Email:         getDriverColumn(record, "email"),
+	}
+
+	addressDTO := &gin_dto.AddressInputDTO{
+		Locality:     getDriverColumn(record, "locality"),
+		Number:       getDriverColumn(record, "number"),
+		Complement:   getDriverColumn(record, "complement"),
+		Neighborhood: getDriverColumn(record, "neighborhood"),
+		City:         getDriverColumn(record, "city"),
+		State:        state,
+		CEP:          getDriverColumn(record, "cep"),
+		Country:      getDriverColumn(record, "country"),
+	}
+
+	if err := binding.Validator.ValidateStruct(driverDTO); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := binding.Validator.ValidateStruct(addressDTO); err != nil {
+		errs = append(errs, err)
+	}
+
+	if len(errs) > 0 {
+		return nil, nil, errors.Join(errs...)
+	}
+
+	return driverDTO, addressDTO, nil
+}
+
+func MapRecordsToDriverImportRows(records []spreadsheet.Record) []driver.ImportRow {
+	rows := make([]driver.ImportRow, 0, len(records))
+	for _, record := range records {
+		driverDTO, addressDTO, err := MapRecordToDriverInputDTO(record)
+		if err != nil {
+			rows = append(rows, driver.ImportRow{Line: record.Line, Err: err})
+			continue
+		}
+
+		d := MapInputDTOToDriver(*driverDTO)
+		d.Address = MapInputDTOToAddress(*addressDTO)
+
+		rows = append(rows, driver.ImportRow{Line: record.Line, Driver: d})
+	}
+
+	return rows
+}
+
+func MapDriverImportResultToRow(result driver.ImportResult) []string {
+	return []string{
+		strconv.Itoa(result.Line),
+		string(result.Status),
+		result.CPF,
+		formatID(result.DriverID),
+		formatID(result.UserID),
+		formatID(result.AddressID),
+		result.Username,
+		result.Password,
+		strings.Join(result.Errors, " | "),
+	}
+}
+
+func formatID(id int64) string {
+	if id == 0 {
+		return ""
+	}
+
+	return strconv.FormatInt(id, 10)
+}
@@ -0,0 +1,93 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/gin-gonic/gin"
+)
+
+func odometerErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, odometer.ErrInvalidRecord):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, odometer.ErrDecreasingReading):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listOdometerRecords lists the odometer records of a vehicle, the latest
+// first, and only the anomalies when anomaly=true.
+func listOdometerRecords(service *odometer.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List odometer records", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var rs gin_dto.OdometerRecordSpecificationInputDTO
+		if err := c.ShouldBindQuery(&rs); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		records, err := service.List(c.Request.Context(), &odometer.RecordSpecification{
+			VehicleID: vehicleID,
+			Anomaly:   rs.Anomaly,
+			Page:      rs.Page,
+			PageSize:  rs.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		recordsDTO := make([]gin_dto.OdometerRecordOutputDTO, 0, len(*records))
+		for _, r := range *records {
+			recordsDTO = append(recordsDTO, *gin_mapping.MapOdometerRecordToOutputDTO(r))
+		}
+
+		c.JSON(http.StatusOK, recordsDTO)
+	}
+}
+
+func createOdometerRecord(service *odometer.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create odometer record", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.OdometerRecordInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		record := gin_mapping.MapInputDTOToOdometerRecord(dto)
+		record.VehicleID = vehicleID
+
+		recordID, err := service.Create(c.Request.Context(), record)
+		if err != nil {
+			c.JSON(odometerErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		record.ID = recordID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapOdometerRecordToOutputDTO(*record))
+	}
+}
@@ -0,0 +1,1466 @@
+package gin
+
+import (
+	"net/http"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/document/storage"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/openapi"
+	"github.com/LucasMateus-eng/operations-service/internal/spreadsheet"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	graphql_go "github.com/graphql-go/graphql"
+	swaggerFiles "github.com/swaggo/files/v2"
+)
+
+const (
+	OPENAPI_PATH        = "/openapi.json"
+	BASIC_AUTH_SCHEME   = "basicAuth"
+	CSV_MEDIA_TYPE      = "text/csv"
+	SWAGGER_INITIALIZER = "/swagger-initializer.js"
+)
+
+// swaggerInitializer replaces the one of the Swagger UI distribution, which
+// points to the petstore example, with one that loads our document.
+var swaggerInitializer = `window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: "` + OPENAPI_PATH + `",
+    dom_id: "#swagger-ui",
+    deepLinking: true,
+    presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+    plugins: [SwaggerUIBundle.plugins.DownloadUrl],
+    layout: "StandaloneLayout"
+  });
+};
+`
+
+func openAPIHandler(document *openapi.Document) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, document)
+	}
+}
+
+// swaggerUI serves the embedded Swagger UI under /swagger/.
+func swaggerUI(c *gin.Context) {
+	if c.Param("filepath") == SWAGGER_INITIALIZER {
+		c.Data(http.StatusOK, "application/javascript; charset=utf-8", []byte(swaggerInitializer))
+		return
+	}
+
+	c.FileFromFS(c.Param("filepath"), http.FS(swaggerFiles.FS))
+}
+
+// openAPIDocument describes every route registered by Handlers, with the
+// DTOs they bind and write. A test fails when a route is missing here.
+func openAPIDocument() *openapi.Document {
+	return openapi.NewBuilder(openapi.Info{
+		Title:       "Operations Service",
+		Description: "Manages the drivers, vehicles and their assignments.",
+		Version:     "1.0.0",
+	}).
+		Enum(user.UNDEFINED, enumValues(user.ADMINISTRATOR, user.EMPLOYEE, user.DRIVER)...).
+		Enum(vehicle.UNDEFINED, enumValues(vehicle.REGULAR, vehicle.LATE, vehicle.BLOCKED, vehicle.SEIZED, vehicle.STOLEN)...).
+		Enum(address.UNDEFINED, enumValues(
+			address.AC, address.AL, address.AP, address.AM, address.BA, address.CE, address.ES,
+			address.GO, address.MA, address.MT, address.MS, address.MG, address.PA, address.PB,
+			address.PR, address.PE, address.PI, address.RJ, address.RN, address.RS, address.RO,
+			address.RR, address.SC, address.SP, address.SE, address.TO, address.DF,
+		)...).
+		SecurityScheme(BASIC_AUTH_SCHEME, &openapi.SecurityScheme{
+			Type:        "http",
+			Scheme:      "basic",
+			Description: "Optional on most routes, where it identifies the actor of the writes.",
+		}).
+		Add(userRoutes()...).
+		Add(driverRoutes()...).
+		Add(vehicleRoutes()...).
+		Add(addressRoutes()...).
+		Add(driverVehicleRoutes()...).
+		Add(maintenanceRoutes()...).
+		Add(odometerRoutes()...).
+		Add(fuelRoutes()...).
+		Add(fineRoutes()...).
+		Add(tripRoutes()...).
+		Add(shiftRoutes()...).
+		Add(checklistRoutes()...).
+		Add(incidentRoutes()...).
+		Add(insuranceRoutes()...).
+		Add(documentRoutes()...).
+		Add(webhookRoutes()...).
+		Add(
+			openapi.Route{
+				Method:  http.MethodGet,
+				Path:    "/v1/audit",
+				Summary: "List the audit trail of an entity",
+				Tag:     "audit",
+				Query:   gin_dto.AuditSpecificationInputDTO{},
+				Responses: map[int]openapi.Reply{
+					http.StatusOK:                  jsonReply("The audit entries, the latest first.", []gin_dto.AuditEntryOutputDTO{}),
+					http.StatusBadRequest:          errorReply("The query parameters are invalid."),
+					http.StatusNotFound:            errorReply("No audit entry was found."),
+					http.StatusInternalServerError: errorReply("Unexpected error."),
+				},
+			},
+			openapi.Route{
+				Method:  http.MethodPost,
+				Path:    "/v1/graphql",
+				Summary: "Run a GraphQL query",
+				Tag:     "graphql",
+				Body:    jsonContent(gin_dto.GraphQLInputDTO{}),
+				Responses: map[int]openapi.Reply{
+					http.StatusOK:         jsonReply("The query was executed, fields that failed are reported in errors.", graphql_go.Result{}),
+					http.StatusBadRequest: jsonReply("The query was rejected before the execution.", graphql_go.Result{}),
+				},
+			},
+			openapi.Route{
+				Method:  http.MethodGet,
+				Path:    "/health",
+				Summary: "Check the health of the service",
+				Tag:     "health",
+				Responses: map[int]openapi.Reply{
+					http.StatusOK: {Description: "The service is healthy.", Content: []openapi.Content{{MediaType: binding.MIMEPlain}}},
+				},
+			},
+			openapi.Route{
+				Method:  http.MethodGet,
+				Path:    OPENAPI_PATH,
+				Summary: "Get this document",
+				Tag:     "documentation",
+				Responses: map[int]openapi.Reply{
+					http.StatusOK: {Description: "The OpenAPI document.", Content: []openapi.Content{{MediaType: binding.MIMEJSON}}},
+				},
+			},
+			openapi.Route{
+				Method:  http.MethodGet,
+				Path:    "/swagger/*filepath",
+				Summary: "Browse this document with Swagger UI",
+				Tag:     "documentation",
+				Responses: map[int]openapi.Reply{
+					http.StatusOK:       {Description: "A file of the Swagger UI.", Content: []openapi.Content{{MediaType: binding.MIMEHTML}}},
+					http.StatusNotFound: {Description: "The file does not exist."},
+				},
+			},
+		).
+		Document()
+}
+
+func userRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		createRoute("users", "/v1/users/", "Create a user", gin_dto.UserInputDTO{}, gin_dto.UserOutputDTO{}),
+	}
+	routes = append(routes, trashRoutes("users", "/v1/users", "user", []gin_dto.UserOutputDTO{}, gin_dto.UserOutputDTO{})...)
+	routes = append(routes, resourceRoutes("users", "/v1/users", "user", gin_dto.UserInputDTO{}, gin_dto.UserOutputDTO{})...)
+
+	return routes
+}
+
+func driverRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/drivers/",
+			Summary: "List drivers",
+			Tag:     "drivers",
+			Query:   gin_dto.DriverSpecificationInputDTO{},
+			Headers: []openapi.Parameter{{
+				Name:        "eager-loading",
+				In:          "header",
+				Description: "When true, the address and the vehicles of each driver are loaded as well.",
+				Schema:      &openapi.Schema{Type: "boolean"},
+			}},
+			Responses: listReplies("The drivers.", []gin_dto.DriverOutputDTO{}),
+		},
+		exportRoute("drivers", "/v1/drivers/export", "Export drivers", gin_dto.DriverExportInputDTO{}),
+		createRoute("drivers", "/v1/drivers/", "Create a driver", gin_dto.DriverInputDTO{}, gin_dto.DriverOutputDTO{}),
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/drivers/import",
+			Summary: "Import drivers from a spreadsheet",
+			Tag:     "drivers",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    []openapi.Content{{MediaType: binding.MIMEMultipartPOSTForm, Value: gin_dto.ImportInputDTO{}}},
+			Responses: map[int]openapi.Reply{
+				http.StatusOK: {
+					Description: "The outcome of each row, as a CSV file when result_format is csv.",
+					Content: []openapi.Content{
+						{MediaType: binding.MIMEJSON, Value: driver.ImportReport{}},
+						{MediaType: CSV_MEDIA_TYPE},
+					},
+				},
+				http.StatusBadRequest:          errorReply("The file or its format is invalid."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/drivers/onboard",
+			Summary: "Onboard a driver with its user and address",
+			Tag:     "drivers",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.DriverOnboardingInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The onboarded driver.", gin_dto.DriverOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body is invalid."),
+				http.StatusConflict:            errorReply("The driver is already registered."),
+				http.StatusUnprocessableEntity: errorReply("The driver, its user or its address are invalid."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+	routes = append(routes, trashRoutes("drivers", "/v1/drivers", "driver", []gin_dto.DriverOutputDTO{}, gin_dto.DriverOutputDTO{})...)
+	routes = append(routes, resourceRoutes("drivers", "/v1/drivers", "driver", gin_dto.DriverInputDTO{}, gin_dto.DriverOutputDTO{})...)
+
+	return routes
+}
+
+func vehicleRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/vehicles/",
+			Summary:   "List vehicles",
+			Tag:       "vehicles",
+			Query:     gin_dto.VehicleSpecificationInputDTO{},
+			Responses: listReplies("The vehicles.", []gin_dto.VehicleOutputDTO{}),
+		},
+		exportRoute("vehicles", "/v1/vehicles/export", "Export vehicles", gin_dto.VehicleExportInputDTO{}),
+		createRoute("vehicles", "/v1/vehicles/", "Create a vehicle", gin_dto.VehicleInputDTO{}, gin_dto.VehicleOutputDTO{}),
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/vehicles/import",
+			Summary: "Import vehicles from a spreadsheet",
+			Tag:     "vehicles",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    []openapi.Content{{MediaType: binding.MIMEMultipartPOSTForm, Value: gin_dto.ImportInputDTO{}}},
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("Every row is valid and, unless dry_run is set, was created.", vehicle.ImportReport{}),
+				http.StatusBadRequest:          errorReply("The file or its format is invalid."),
+				http.StatusUnprocessableEntity: jsonReply("Some rows are invalid and nothing was created.", vehicle.ImportReport{}),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+	routes = append(routes, trashRoutes("vehicles", "/v1/vehicles", "vehicle", []gin_dto.VehicleOutputDTO{}, gin_dto.VehicleOutputDTO{})...)
+	routes = append(routes, resourceRoutes("vehicles", "/v1/vehicles", "vehicle", gin_dto.VehicleInputDTO{}, gin_dto.VehicleOutputDTO{})...)
+
+	return routes
+}
+
+func addressRoutes() []openapi.Route {
+	resource := resourceRoutes("addresses", "/v1/addresses", "address", gin_dto.AddressInputDTO{}, gin_dto.AddressOutputDTO{})
+
+	routes := []openapi.Route{}
+	for _, route := range resource {
+		if route.Method == http.MethodGet || route.Method == http.MethodPatch {
+			routes = append(routes, route)
+		}
+	}
+
+	return routes
+}
+
+func driverVehicleRoutes() []openapi.Route {
+	return []openapi.Route{
+		assignRoute(),
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/drivers-vehicles/vehicles/:driver_id",
+			Summary:   "List the vehicles of a driver",
+			Tag:       "drivers-vehicles",
+			Query:     gin_dto.DriverVehicleSpectificationInputDTO{},
+			Responses: listReplies("The vehicles.", []gin_dto.VehicleOutputDTO{}),
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/drivers-vehicles/drivers/:vehicle_id",
+			Summary:   "List the drivers of a vehicle",
+			Tag:       "drivers-vehicles",
+			Query:     gin_dto.DriverVehicleSpectificationInputDTO{},
+			Responses: listReplies("The drivers.", []gin_dto.DriverOutputDTO{}),
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/drivers-vehicles/:driver_id/:vehicle_id",
+			Summary: "Unassign a vehicle from a driver",
+			Tag:     "drivers-vehicles",
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The assignment was removed."},
+				http.StatusBadRequest:          errorReply("The identifiers are invalid."),
+				http.StatusNotFound:            errorReply("The vehicle is not assigned to the driver."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+}
+
+// assignRoute is refused with 409 as well when the vehicle is already
+// assigned, overdue on a critical maintenance or, when insurance is
+// required, uninsured.
+func assignRoute() openapi.Route {
+	route := createRoute("drivers-vehicles", "/v1/drivers-vehicles/", "Assign a vehicle to a driver", gin_dto.DriverVehicleInputDTO{}, gin_dto.DriverVehicleOutputDTO{})
+	route.Responses[http.StatusConflict] = errorReply("The vehicle is already assigned, overdue on a critical maintenance or uninsured while insurance is required, or a request with the same Idempotency-Key is still in progress.")
+
+	return route
+}
+
+func maintenanceRoutes() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/maintenance/plans",
+			Summary:   "List the preventive maintenance plans",
+			Tag:       "maintenance",
+			Query:     gin_dto.MaintenancePlanSpecificationInputDTO{},
+			Responses: listReplies("The plans.", []gin_dto.MaintenancePlanOutputDTO{}),
+		},
+		maintenanceCreateRoute("/v1/maintenance/plans", "Create a preventive maintenance plan", gin_dto.MaintenancePlanInputDTO{}, gin_dto.MaintenancePlanOutputDTO{}),
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/maintenance/plans/:id",
+			Summary: "Get a maintenance plan",
+			Tag:     "maintenance",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The plan.", gin_dto.MaintenancePlanOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The plan does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodPut,
+			Path:    "/v1/maintenance/plans/:id",
+			Summary: "Replace a maintenance plan",
+			Tag:     "maintenance",
+			Body:    jsonContent(gin_dto.MaintenancePlanInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The plan was replaced."},
+				http.StatusBadRequest:          errorReply("The identifier or the body is invalid."),
+				http.StatusNotFound:            errorReply("The plan does not exist."),
+				http.StatusUnprocessableEntity: errorReply("The plan breaks a validation rule."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/maintenance/plans/:id",
+			Summary: "Delete a maintenance plan, keeping the service orders that fulfilled it",
+			Tag:     "maintenance",
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The plan was deleted."},
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The plan does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/maintenance/orders",
+			Summary:   "List the service orders, the latest first",
+			Tag:       "maintenance",
+			Query:     gin_dto.MaintenanceOrderSpecificationInputDTO{},
+			Responses: listReplies("The service orders.", []gin_dto.MaintenanceOrderOutputDTO{}),
+		},
+		maintenanceCreateRoute("/v1/maintenance/orders", "Record a service order", gin_dto.MaintenanceOrderInputDTO{}, gin_dto.MaintenanceOrderOutputDTO{}),
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/maintenance/orders/:id",
+			Summary: "Get a service order",
+			Tag:     "maintenance",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The service order.", gin_dto.MaintenanceOrderOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The service order does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/maintenance/orders/:id",
+			Summary: "Delete a service order",
+			Tag:     "maintenance",
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The service order was deleted."},
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The service order does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/maintenance/overdue",
+			Summary:   "List the plans overdue on the fleet",
+			Tag:       "maintenance",
+			Query:     gin_dto.MaintenanceOverdueSpecificationInputDTO{},
+			Responses: listReplies("The overdue plans, by vehicle.", []gin_dto.MaintenanceDueOutputDTO{}),
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/maintenance/tickets",
+			Summary:   "List the maintenance tickets, the latest first",
+			Tag:       "maintenance",
+			Query:     gin_dto.MaintenanceTicketSpecificationInputDTO{},
+			Responses: listReplies("The maintenance tickets.", []gin_dto.MaintenanceTicketOutputDTO{}),
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/maintenance/tickets/:id",
+			Summary: "Get a maintenance ticket",
+			Tag:     "maintenance",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The maintenance ticket.", gin_dto.MaintenanceTicketOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The maintenance ticket does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/maintenance/tickets/:id/close",
+			Summary: "Close an open maintenance ticket, optionally by the service order that fixed it",
+			Tag:     "maintenance",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.MaintenanceTicketCloseInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The closed maintenance ticket.", gin_dto.MaintenanceTicketOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier, the body or the Idempotency-Key is invalid."),
+				http.StatusNotFound:            errorReply("The maintenance ticket or the service order does not exist."),
+				http.StatusConflict:            errorReply("The maintenance ticket is already closed, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The service order is of another vehicle or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/vehicles/:id/maintenance",
+			Summary:   "List when each plan is next due on a vehicle",
+			Tag:       "maintenance",
+			Responses: listReplies("The plans of the model of the vehicle.", []gin_dto.MaintenanceDueOutputDTO{}),
+		},
+	}
+}
+
+func maintenanceCreateRoute(path, summary string, input, output any) openapi.Route {
+	route := createRoute("maintenance", path, summary, input, output)
+	route.Responses[http.StatusCreated] = jsonReply("The created record.", output)
+	route.Responses[http.StatusUnprocessableEntity] = errorReply("The record breaks a validation rule or the Idempotency-Key was used with another body.")
+	delete(route.Responses, http.StatusOK)
+
+	return route
+}
+
+func odometerRoutes() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/vehicles/:id/odometer",
+			Summary:   "List the odometer records of a vehicle, the latest first",
+			Tag:       "odometer",
+			Query:     gin_dto.OdometerRecordSpecificationInputDTO{},
+			Responses: listReplies("The odometer records.", []gin_dto.OdometerRecordOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/vehicles/:id/odometer",
+			Summary: "Record an odometer reading or correction",
+			Tag:     "odometer",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.OdometerRecordInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The record, flagged as an anomaly when the jump from the previous reading is implausible.", gin_dto.OdometerRecordOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier, the body or the Idempotency-Key is invalid."),
+				http.StatusNotFound:            errorReply("The vehicle does not exist."),
+				http.StatusConflict:            errorReply("The reading is lower than an earlier one or higher than a later one, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The record breaks a validation rule or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+}
+
+func fuelRoutes() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/fuel/refuels",
+			Summary:   "List the refuels, the latest first",
+			Tag:       "fuel",
+			Query:     gin_dto.RefuelSpecificationInputDTO{},
+			Responses: listReplies("The refuels.", []gin_dto.RefuelOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/fuel/refuels",
+			Summary: "Record a refuel and the odometer reading taken with it",
+			Tag:     "fuel",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.RefuelInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The refuel, with the driver resolved from the assignments of the vehicle.", gin_dto.RefuelOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusNotFound:            errorReply("The vehicle does not exist."),
+				http.StatusConflict:            errorReply("The driver cannot be resolved from the assignments, the odometer is lower than an earlier reading or higher than a later one, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The refuel breaks a validation rule or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/fuel/refuels/:id",
+			Summary: "Get a refuel",
+			Tag:     "fuel",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The refuel.", gin_dto.RefuelOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The refuel does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		fuelEfficiencyRoute("/v1/vehicles/:id/fuel-efficiency", "Compute the km/l of a vehicle over a period"),
+		fuelEfficiencyRoute("/v1/drivers/:id/fuel-efficiency", "Compute the km/l of a driver over a period"),
+	}
+}
+
+func fuelEfficiencyRoute(path, summary string) openapi.Route {
+	return openapi.Route{
+		Method:  http.MethodGet,
+		Path:    path,
+		Summary: summary,
+		Tag:     "fuel",
+		Query:   gin_dto.FuelEfficiencySpecificationInputDTO{},
+		Responses: map[int]openapi.Reply{
+			http.StatusOK:                  jsonReply("The distance between refuels over the litres that covered it, with every segment and whether it is an outlier.", gin_dto.FuelEfficiencyOutputDTO{}),
+			http.StatusBadRequest:          errorReply("The identifier or the query parameters are invalid."),
+			http.StatusInternalServerError: errorReply("Unexpected error."),
+		},
+	}
+}
+
+func fineRoutes() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/fines/",
+			Summary:   "List the fines, the latest infraction first",
+			Tag:       "fines",
+			Query:     gin_dto.FineSpecificationInputDTO{},
+			Responses: listReplies("The fines.", []gin_dto.FineOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/fines/",
+			Summary: "Register a fine and attribute it to the driver assigned to the vehicle when it was committed",
+			Tag:     "fines",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.FineInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The fine, without a driver when none or more than one was assigned to the vehicle.", gin_dto.FineOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusConflict:            errorReply("A request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The fine breaks a validation rule, no vehicle has its plate or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/fines/alerts",
+			Summary:   "List the drivers near or past the suspension of the CNH",
+			Tag:       "fines",
+			Responses: map[int]openapi.Reply{http.StatusOK: jsonReply("The points of the drivers, the most first.", []gin_dto.DriverPointsOutputDTO{})},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/fines/:id",
+			Summary: "Get a fine",
+			Tag:     "fines",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The fine.", gin_dto.FineOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The fine does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		fineActionRoute("/v1/fines/:id/payment", "Record the payment of a fine", gin_dto.FinePaymentInputDTO{}, "The fine is already paid"),
+		fineActionRoute("/v1/fines/:id/indication", "Record the indication of the driver of a fine, moving its points to them", gin_dto.FineIndicationInputDTO{}, "The driver is already indicated, or none is given and the fine is not attributed"),
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/drivers/:id/points",
+			Summary: "Get the CNH points of a driver over the last twelve months",
+			Tag:     "fines",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The points and the suspension threshold of the driver.", gin_dto.DriverPointsOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+}
+
+func fineActionRoute(path, summary string, input any, conflict string) openapi.Route {
+	return openapi.Route{
+		Method:  http.MethodPost,
+		Path:    path,
+		Summary: summary,
+		Tag:     "fines",
+		Headers: []openapi.Parameter{idempotencyKeyParameter()},
+		Body:    jsonContent(input),
+		Responses: map[int]openapi.Reply{
+			http.StatusOK:                  jsonReply("The updated fine.", gin_dto.FineOutputDTO{}),
+			http.StatusBadRequest:          errorReply("The identifier, the body or the Idempotency-Key is invalid."),
+			http.StatusNotFound:            errorReply("The fine does not exist."),
+			http.StatusConflict:            errorReply(conflict + ", or a request with the same Idempotency-Key is still in progress."),
+			http.StatusUnprocessableEntity: errorReply("The Idempotency-Key was used with another body."),
+			http.StatusInternalServerError: errorReply("Unexpected error."),
+		},
+	}
+}
+
+func tripRoutes() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/trips/",
+			Summary:   "List the trips, the latest planned start first",
+			Tag:       "trips",
+			Query:     gin_dto.TripSpecificationInputDTO{},
+			Responses: listReplies("The trips.", []gin_dto.TripOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/trips/",
+			Summary: "Schedule a trip of a driver in a vehicle assigned to them",
+			Tag:     "trips",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.TripInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The scheduled trip.", gin_dto.TripOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusConflict:            errorReply("The vehicle is not assigned to the driver, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The trip breaks a validation rule or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/trips/:id",
+			Summary: "Get a trip",
+			Tag:     "trips",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The trip.", gin_dto.TripOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The trip does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		tripActionRoute("/v1/trips/:id/start", "Start a scheduled trip, recording the odometer of the vehicle", gin_dto.TripOdometerInputDTO{}, "The trip is not scheduled, the vehicle is no longer assigned to the driver, the driver or the vehicle is already on a trip or the odometer is below the last reading"),
+		tripActionRoute("/v1/trips/:id/finish", "Finish a trip in progress, recording the odometer of the vehicle", gin_dto.TripOdometerInputDTO{}, "The trip is not in progress or the odometer is below the last reading"),
+		tripActionRoute("/v1/trips/:id/cancel", "Cancel a scheduled trip", nil, "The trip is not scheduled"),
+	}
+}
+
+func tripActionRoute(path, summary string, input any, conflict string) openapi.Route {
+	route := openapi.Route{
+		Method:  http.MethodPost,
+		Path:    path,
+		Summary: summary,
+		Tag:     "trips",
+		Headers: []openapi.Parameter{idempotencyKeyParameter()},
+		Responses: map[int]openapi.Reply{
+			http.StatusOK:                  jsonReply("The updated trip.", gin_dto.TripOutputDTO{}),
+			http.StatusBadRequest:          errorReply("The identifier, the body or the Idempotency-Key is invalid."),
+			http.StatusForbidden:           errorReply("The authenticated driver is not the driver of the trip."),
+			http.StatusNotFound:            errorReply("The trip does not exist."),
+			http.StatusConflict:            errorReply(conflict + ", or a request with the same Idempotency-Key is still in progress."),
+			http.StatusUnprocessableEntity: errorReply("The odometer is invalid or the Idempotency-Key was used with another body."),
+			http.StatusInternalServerError: errorReply("Unexpected error."),
+		},
+	}
+	if input != nil {
+		route.Body = jsonContent(input)
+	}
+
+	return route
+}
+
+func shiftRoutes() []openapi.Route {
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/shifts/",
+			Summary:   "List the shifts, the latest planned start first",
+			Tag:       "shifts",
+			Query:     gin_dto.ShiftSpecificationInputDTO{},
+			Responses: listReplies("The shifts.", []gin_dto.ShiftOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/shifts/",
+			Summary: "Schedule a shift of a driver",
+			Tag:     "shifts",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.ShiftInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The scheduled shift.", gin_dto.ShiftOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusConflict:            errorReply("A request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The shift breaks a validation rule or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/shifts/:id",
+			Summary: "Get a shift with its driving, rest and work periods",
+			Tag:     "shifts",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The shift.", gin_dto.ShiftOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The shift does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		shiftActionRoute("/v1/shifts/:id/clock-in", "Clock the authenticated driver in to a scheduled shift", nil, "The shift is not scheduled or the driver is clocked in to another shift"),
+		shiftActionRoute("/v1/shifts/:id/clock-out", "Clock the authenticated driver out of an open shift, ending its current activity", nil, "The shift is not open"),
+		shiftActionRoute("/v1/shifts/:id/activity", "Switch the current activity of the authenticated driver in an open shift", gin_dto.ShiftActivityInputDTO{}, "The shift is not open or its activity changed meanwhile"),
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/drivers/:id/working-hours",
+			Summary: "Report the working hours of a driver over a period, with the violations of Lei 13.103",
+			Tag:     "shifts",
+			Query:   gin_dto.WorkingHoursSpecificationInputDTO{},
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The journey, driving and rest time of the shifts clocked in over the period, the last seven days by default, and the limits they broke.", gin_dto.WorkingHoursOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier or the query parameters are invalid."),
+				http.StatusUnprocessableEntity: errorReply("The start of the period is not before its end."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+}
+
+// shiftActionRoute documents a route only the driver of the shift may call.
+func shiftActionRoute(path, summary string, input any, conflict string) openapi.Route {
+	route := openapi.Route{
+		Method:   http.MethodPost,
+		Path:     path,
+		Summary:  summary,
+		Tag:      "shifts",
+		Security: []string{BASIC_AUTH_SCHEME},
+		Headers:  []openapi.Parameter{idempotencyKeyParameter()},
+		Responses: map[int]openapi.Reply{
+			http.StatusOK:                  jsonReply("The updated shift.", gin_dto.ShiftOutputDTO{}),
+			http.StatusBadRequest:          errorReply("The identifier, the body or the Idempotency-Key is invalid."),
+			http.StatusUnauthorized:        errorReply("The credentials are missing or invalid."),
+			http.StatusForbidden:           errorReply("Only the driver of the shift is allowed."),
+			http.StatusNotFound:            errorReply("The shift does not exist."),
+			http.StatusConflict:            errorReply(conflict + ", or a request with the same Idempotency-Key is still in progress."),
+			http.StatusUnprocessableEntity: errorReply("The Idempotency-Key was used with another body."),
+			http.StatusInternalServerError: errorReply("Unexpected error."),
+		},
+	}
+	if input != nil {
+		route.Body = jsonContent(input)
+	}
+
+	return route
+}
+
+func checklistRoutes() []openapi.Route {
+	templateRoutes := []openapi.Route{
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/checklists/templates",
+			Summary: "Create the checklist template of a vehicle category, or the default one when the category is left out",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.ChecklistTemplateInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The created template.", gin_dto.ChecklistTemplateOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusConflict:            errorReply("There is already a template of the category and the kind, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The template breaks a validation rule or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodPut,
+			Path:    "/v1/checklists/templates/:id",
+			Summary: "Replace a checklist template, keeping the checklists already submitted as they were",
+			Body:    jsonContent(gin_dto.ChecklistTemplateInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The template was replaced."},
+				http.StatusBadRequest:          errorReply("The identifier or the body is invalid."),
+				http.StatusNotFound:            errorReply("The template does not exist."),
+				http.StatusConflict:            errorReply("There is already another template of the category and the kind."),
+				http.StatusUnprocessableEntity: errorReply("The template breaks a validation rule."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/checklists/templates/:id",
+			Summary: "Delete a checklist template, keeping the checklists submitted against it",
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The template was deleted."},
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The template does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+	for i := range templateRoutes {
+		administrated(&templateRoutes[i])
+	}
+
+	routes := []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/checklists/",
+			Summary:   "List the checklists, the latest submitted first",
+			Query:     gin_dto.ChecklistSpecificationInputDTO{},
+			Responses: listReplies("The checklists.", []gin_dto.ChecklistOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/checklists/",
+			Summary: "Submit the inspection of a vehicle, opening a maintenance ticket for each failed critical item",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.ChecklistInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The submitted checklist.", gin_dto.ChecklistOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusForbidden:           errorReply("The authenticated driver is not the driver of the checklist."),
+				http.StatusNotFound:            errorReply("The vehicle does not exist."),
+				http.StatusConflict:            errorReply("The driver is not assigned to the vehicle, the odometer is below the last reading or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The checklist breaks a validation rule, does not match the template of the vehicle, there is no template or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/checklists/:id",
+			Summary: "Get a checklist",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The checklist.", gin_dto.ChecklistOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The checklist does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/vehicles/:id/checklists",
+			Summary:   "List the inspection history of a vehicle, the latest first",
+			Query:     gin_dto.ChecklistSpecificationInputDTO{},
+			Responses: listReplies("The checklists of the vehicle.", []gin_dto.ChecklistOutputDTO{}),
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/checklists/templates",
+			Summary:   "List the checklist templates",
+			Query:     gin_dto.ChecklistTemplateSpecificationInputDTO{},
+			Responses: listReplies("The templates.", []gin_dto.ChecklistTemplateOutputDTO{}),
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/checklists/templates/:id",
+			Summary: "Get a checklist template",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The template.", gin_dto.ChecklistTemplateOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The template does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+	routes = append(routes, templateRoutes...)
+
+	for i := range routes {
+		routes[i].Tag = "checklists"
+	}
+
+	return routes
+}
+
+func incidentRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/incidents/",
+			Summary:   "List the incidents, the latest to occur first",
+			Query:     gin_dto.IncidentSpecificationInputDTO{},
+			Responses: listReplies("The incidents.", []gin_dto.IncidentOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/incidents/",
+			Summary: "Report an incident, marking the vehicle as stolen and ending its assignments on a theft or a robbery",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.IncidentInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The reported incident.", gin_dto.IncidentOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusNotFound:            errorReply("The vehicle does not exist."),
+				http.StatusConflict:            errorReply("A request with the same Idempotency-Key is still in progress."),
+				http.StatusPreconditionFailed:  errorReply("The vehicle was changed while it was being marked as stolen."),
+				http.StatusUnprocessableEntity: errorReply("The incident breaks a validation rule or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/incidents/:id",
+			Summary: "Get an incident",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The incident.", gin_dto.IncidentOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The incident does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/vehicles/:id/incidents",
+			Summary:   "List the incidents of a vehicle, the latest to occur first",
+			Query:     gin_dto.IncidentSpecificationInputDTO{},
+			Responses: listReplies("The incidents of the vehicle.", []gin_dto.IncidentOutputDTO{}),
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/drivers/:id/incidents",
+			Summary:   "List the incidents attributed to a driver, the latest to occur first",
+			Query:     gin_dto.IncidentSpecificationInputDTO{},
+			Responses: listReplies("The incidents of the driver.", []gin_dto.IncidentOutputDTO{}),
+		},
+	}
+
+	for i := range routes {
+		routes[i].Tag = "incidents"
+	}
+
+	return routes
+}
+
+func insuranceRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/insurance/policies",
+			Summary:   "List the insurance policies, the latest to end first",
+			Query:     gin_dto.InsurancePolicySpecificationInputDTO{},
+			Responses: listReplies("The policies.", []gin_dto.InsurancePolicyOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/insurance/policies",
+			Summary: "Insure a vehicle",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.InsurancePolicyInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The created policy.", gin_dto.InsurancePolicyOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusNotFound:            errorReply("The vehicle does not exist."),
+				http.StatusConflict:            errorReply("The insurer already has a policy with the number, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The policy breaks a validation rule or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/insurance/policies/alerts",
+			Summary:   "List the policies about to end that were not renewed",
+			Responses: map[int]openapi.Reply{http.StatusOK: jsonReply("The policies, the first to end first.", []gin_dto.InsurancePolicyOutputDTO{})},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/insurance/policies/:id",
+			Summary: "Get an insurance policy",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The policy.", gin_dto.InsurancePolicyOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The policy does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodPut,
+			Path:    "/v1/insurance/policies/:id",
+			Summary: "Replace the terms of an insurance policy, keeping its vehicle",
+			Body:    jsonContent(gin_dto.InsurancePolicyInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The policy was replaced."},
+				http.StatusBadRequest:          errorReply("The identifier or the body is invalid."),
+				http.StatusNotFound:            errorReply("The policy does not exist."),
+				http.StatusConflict:            errorReply("The insurer already has another policy with the number."),
+				http.StatusUnprocessableEntity: errorReply("The policy breaks a validation rule."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/insurance/claims",
+			Summary:   "List the insurance claims, the latest opened first",
+			Query:     gin_dto.InsuranceClaimSpecificationInputDTO{},
+			Responses: listReplies("The claims.", []gin_dto.InsuranceClaimOutputDTO{}),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/insurance/claims",
+			Summary: "Claim an incident on the policy that insured its vehicle when it occurred",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.InsuranceClaimInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:             jsonReply("The opened claim.", gin_dto.InsuranceClaimOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+				http.StatusNotFound:            errorReply("The policy or the incident does not exist."),
+				http.StatusConflict:            errorReply("The incident is already claimed on the policy, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The claim breaks a validation rule, the policy does not cover the incident or the Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/insurance/claims/:id",
+			Summary: "Get an insurance claim",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The claim.", gin_dto.InsuranceClaimOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The claim does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/insurance/claims/:id/status",
+			Summary: "Move an insurance claim along its processing by the insurer",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.InsuranceClaimStatusInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The changed claim.", gin_dto.InsuranceClaimOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier, the body, the status or the Idempotency-Key is invalid."),
+				http.StatusNotFound:            errorReply("The claim does not exist."),
+				http.StatusConflict:            errorReply("The claim cannot move to the status, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The Idempotency-Key was used with another body."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+
+	for i := range routes {
+		routes[i].Tag = "insurance"
+	}
+
+	return routes
+}
+
+func documentRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/documents/",
+			Summary:   "List the documents of drivers and vehicles, the first to expire first",
+			Query:     gin_dto.DocumentSpecificationInputDTO{},
+			Responses: listReplies("The documents.", []gin_dto.DocumentOutputDTO{}),
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/documents/:id",
+			Summary: "Get a document",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The document.", gin_dto.DocumentOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The document does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/documents/:id/url",
+			Summary: "Get a signed URL that downloads the file of a document without credentials until it expires",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  jsonReply("The signed URL.", gin_dto.DocumentURLOutputDTO{}),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The document does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/documents/:id",
+			Summary: "Delete a document and its file",
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:           {Description: "The document was deleted."},
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The document does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/drivers/:id/documents",
+			Summary:   "List the documents of a driver, the first to expire first",
+			Query:     gin_dto.DocumentSpecificationInputDTO{},
+			Responses: listReplies("The documents of the driver.", []gin_dto.DocumentOutputDTO{}),
+		},
+		documentUploadRoute("/v1/drivers/:id/documents", "Upload a CNH, exame toxicológico or medical exam of a driver", "driver"),
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/vehicles/:id/documents",
+			Summary:   "List the documents of a vehicle, the first to expire first",
+			Query:     gin_dto.DocumentSpecificationInputDTO{},
+			Responses: listReplies("The documents of the vehicle.", []gin_dto.DocumentOutputDTO{}),
+		},
+		documentUploadRoute("/v1/vehicles/:id/documents", "Upload the CRLV of a vehicle", "vehicle"),
+		{
+			Method:  http.MethodGet,
+			Path:    storage.FILES_PATH + "/*key",
+			Summary: "Download the file of a document through a URL signed by the filesystem storage",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK: {
+					Description: "The file.",
+					Content: []openapi.Content{
+						{MediaType: "application/pdf"},
+						{MediaType: "image/jpeg"},
+						{MediaType: "image/png"},
+					},
+				},
+				http.StatusForbidden:           errorReply("The signature is invalid or the URL has expired."),
+				http.StatusNotFound:            errorReply("The file does not exist."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+	}
+
+	for i := range routes {
+		routes[i].Tag = "documents"
+	}
+
+	return routes
+}
+
+func documentUploadRoute(path, summary, owner string) openapi.Route {
+	return openapi.Route{
+		Method:  http.MethodPost,
+		Path:    path,
+		Summary: summary,
+		Headers: []openapi.Parameter{idempotencyKeyParameter()},
+		Body:    []openapi.Content{{MediaType: binding.MIMEMultipartPOSTForm, Value: gin_dto.DocumentInputDTO{}}},
+		Responses: map[int]openapi.Reply{
+			http.StatusCreated:               jsonReply("The uploaded document.", gin_dto.DocumentOutputDTO{}),
+			http.StatusBadRequest:            errorReply("The identifier, the form or the Idempotency-Key is invalid."),
+			http.StatusNotFound:              errorReply("The " + owner + " does not exist."),
+			http.StatusConflict:              errorReply("A request with the same Idempotency-Key is still in progress."),
+			http.StatusRequestEntityTooLarge: errorReply("The file is larger than allowed."),
+			http.StatusUnprocessableEntity:   errorReply("The document breaks a validation rule, the file does not match its content type or checksum, or the Idempotency-Key was used with another body."),
+			http.StatusInternalServerError:   errorReply("Unexpected error."),
+		},
+	}
+}
+
+func webhookRoutes() []openapi.Route {
+	routes := []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      "/v1/webhooks/",
+			Summary:   "List webhook subscriptions",
+			Query:     gin_dto.WebhookSpecificationInputDTO{},
+			Responses: map[int]openapi.Reply{http.StatusOK: jsonReply("The subscriptions.", []gin_dto.WebhookOutputDTO{})},
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/webhooks/",
+			Summary: "Subscribe to events",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Body:    jsonContent(gin_dto.WebhookInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusCreated:    jsonReply("The subscription, the only response that carries its secret.", gin_dto.WebhookOutputDTO{}),
+				http.StatusBadRequest: errorReply("The subscription is invalid."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/webhooks/:id",
+			Summary: "Get a webhook subscription",
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:       jsonReply("The subscription.", gin_dto.WebhookOutputDTO{}),
+				http.StatusNotFound: errorReply("The subscription does not exist."),
+			},
+		},
+		{
+			Method:  http.MethodPut,
+			Path:    "/v1/webhooks/:id",
+			Summary: "Update a webhook subscription",
+			Body:    jsonContent(gin_dto.WebhookInputDTO{}),
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent:  {Description: "The subscription was updated."},
+				http.StatusBadRequest: errorReply("The subscription is invalid."),
+				http.StatusNotFound:   errorReply("The subscription does not exist."),
+			},
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/v1/webhooks/:id",
+			Summary: "Delete a webhook subscription",
+			Responses: map[int]openapi.Reply{
+				http.StatusNoContent: {Description: "The subscription was deleted."},
+				http.StatusNotFound:  errorReply("The subscription does not exist."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/webhooks/:id/deliveries",
+			Summary: "List the deliveries of a subscription",
+			Query:   gin_dto.WebhookDeliverySpecificationInputDTO{},
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:         jsonReply("The deliveries.", []gin_dto.WebhookDeliveryOutputDTO{}),
+				http.StatusBadRequest: errorReply("The query parameters are invalid."),
+			},
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/v1/webhooks/:id/deliveries/:delivery_id/redeliver",
+			Summary: "Deliver an event again",
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Responses: map[int]openapi.Reply{
+				http.StatusAccepted:            {Description: "The delivery was scheduled."},
+				http.StatusNotFound:            errorReply("The delivery does not exist."),
+				http.StatusConflict:            errorReply("The delivery is still pending, or a request with the same Idempotency-Key is still in progress."),
+				http.StatusUnprocessableEntity: errorReply("The Idempotency-Key was used with another body."),
+			},
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/v1/webhooks/:id/attempts",
+			Summary: "List the delivery attempts of a subscription",
+			Query:   gin_dto.WebhookAttemptSpecificationInputDTO{},
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:         jsonReply("The attempts.", []gin_dto.WebhookAttemptOutputDTO{}),
+				http.StatusBadRequest: errorReply("The query parameters are invalid."),
+			},
+		},
+	}
+
+	for i := range routes {
+		routes[i].Tag = "webhooks"
+		administrated(&routes[i])
+	}
+
+	return routes
+}
+
+// resourceRoutes describes the reads and the optimistic writes of a
+// resource by its identifier.
+func resourceRoutes(tag, base, name string, input, output any) []openapi.Route {
+	path := base + "/:id"
+
+	return []openapi.Route{
+		{
+			Method:  http.MethodGet,
+			Path:    path,
+			Summary: "Get a " + name,
+			Tag:     tag,
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  withETag(jsonReply("The "+name+".", output)),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		{
+			Method:    http.MethodPut,
+			Path:      path,
+			Summary:   "Replace a " + name,
+			Tag:       tag,
+			Headers:   []openapi.Parameter{ifMatchParameter()},
+			Body:      jsonContent(input),
+			Responses: writeReplies("The "+name+" was replaced.", false),
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    path,
+			Summary: "Change some fields of a " + name,
+			Tag:     tag,
+			Headers: []openapi.Parameter{ifMatchParameter()},
+			Body: []openapi.Content{
+				{MediaType: MERGE_PATCH_CONTENT_TYPE, Value: input},
+				{MediaType: binding.MIMEJSON, Value: input},
+			},
+			Responses: writeReplies("The "+name+" was changed.", true),
+		},
+		{
+			Method:    http.MethodDelete,
+			Path:      path,
+			Summary:   "Move a " + name + " to the trash",
+			Tag:       tag,
+			Headers:   []openapi.Parameter{ifMatchParameter()},
+			Responses: writeReplies("The "+name+" was moved to the trash.", false),
+		},
+	}
+}
+
+// trashRoutes describes the deleted records of a resource, which can be
+// restored or, by an administrator, purged.
+func trashRoutes(tag, base, name string, list, output any) []openapi.Route {
+	purge := openapi.Route{
+		Method:  http.MethodDelete,
+		Path:    base + "/trash/:id",
+		Summary: "Purge a deleted " + name,
+		Tag:     tag,
+		Responses: map[int]openapi.Reply{
+			http.StatusNoContent:           {Description: "The " + name + " was purged."},
+			http.StatusBadRequest:          errorReply("The identifier is invalid."),
+			http.StatusNotFound:            errorReply("The " + name + " is not in the trash."),
+			http.StatusConflict:            errorReply("Records that must be kept, such as fines or refuels, still refer to it."),
+			http.StatusInternalServerError: errorReply("Unexpected error."),
+		},
+	}
+	administrated(&purge)
+
+	return []openapi.Route{
+		{
+			Method:    http.MethodGet,
+			Path:      base + "/trash",
+			Summary:   "List deleted " + name + "s",
+			Tag:       tag,
+			Query:     gin_dto.TrashSpecificationInputDTO{},
+			Responses: listReplies("The deleted "+name+"s.", list),
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    base + "/:id/restore",
+			Summary: "Restore a deleted " + name,
+			Tag:     tag,
+			Headers: []openapi.Parameter{idempotencyKeyParameter()},
+			Responses: map[int]openapi.Reply{
+				http.StatusOK:                  withETag(jsonReply("The restored "+name+".", output)),
+				http.StatusBadRequest:          errorReply("The identifier is invalid."),
+				http.StatusNotFound:            errorReply("The " + name + " is not in the trash."),
+				http.StatusConflict:            errorReply("A live record already holds its unique fields."),
+				http.StatusInternalServerError: errorReply("Unexpected error."),
+			},
+		},
+		purge,
+	}
+}
+
+func createRoute(tag, path, summary string, input, output any) openapi.Route {
+	return openapi.Route{
+		Method:  http.MethodPost,
+		Path:    path,
+		Summary: summary,
+		Tag:     tag,
+		Headers: []openapi.Parameter{idempotencyKeyParameter()},
+		Body:    jsonContent(input),
+		Responses: map[int]openapi.Reply{
+			http.StatusOK:                  jsonReply("The identifier of the created record.", output),
+			http.StatusBadRequest:          errorReply("The body or the Idempotency-Key is invalid."),
+			http.StatusConflict:            errorReply("A request with the same Idempotency-Key is still in progress."),
+			http.StatusUnprocessableEntity: errorReply("The Idempotency-Key was used with another body."),
+			http.StatusInternalServerError: errorReply("Unexpected error."),
+		},
+	}
+}
+
+func exportRoute(tag, path, summary string, query any) openapi.Route {
+	return openapi.Route{
+		Method:  http.MethodGet,
+		Path:    path,
+		Summary: summary,
+		Tag:     tag,
+		Query:   query,
+		Responses: map[int]openapi.Reply{
+			http.StatusOK: {
+				Description: "The spreadsheet, streamed as it is written.",
+				Content: []openapi.Content{
+					{MediaType: CSV_MEDIA_TYPE},
+					{MediaType: spreadsheet.ContentType(spreadsheet.XLSX)},
+				},
+			},
+			http.StatusBadRequest: errorReply("The format, the columns or the locale are invalid."),
+		},
+	}
+}
+
+func listReplies(description string, list any) map[int]openapi.Reply {
+	return map[int]openapi.Reply{
+		http.StatusOK:                  jsonReply(description, list),
+		http.StatusBadRequest:          errorReply("The query parameters are invalid."),
+		http.StatusNotFound:            errorReply("No record was found."),
+		http.StatusInternalServerError: errorReply("Unexpected error."),
+	}
+}
+
+func writeReplies(description string, patch bool) map[int]openapi.Reply {
+	replies := map[int]openapi.Reply{
+		http.StatusNoContent:            withETag(openapi.Reply{Description: description}),
+		http.StatusBadRequest:           errorReply("The identifier, the body or the If-Match header is invalid."),
+		http.StatusNotFound:             errorReply("The record does not exist."),
+		http.StatusPreconditionFailed:   errorReply("The record was changed since the ETag sent in If-Match was read."),
+		http.StatusUnprocessableEntity:  errorReply("The change is not allowed."),
+		http.StatusPreconditionRequired: errorReply("The If-Match header is missing."),
+		http.StatusInternalServerError:  errorReply("Unexpected error."),
+	}
+	if patch {
+		replies[http.StatusUnsupportedMediaType] = errorReply("The patch is not sent as " + MERGE_PATCH_CONTENT_TYPE + ".")
+	}
+
+	return replies
+}
+
+func administrated(route *openapi.Route) {
+	route.Security = []string{BASIC_AUTH_SCHEME}
+	route.Responses[http.StatusUnauthorized] = errorReply("The credentials are missing or invalid.")
+	route.Responses[http.StatusForbidden] = errorReply("Only administrators are allowed.")
+}
+
+func jsonContent(value any) []openapi.Content {
+	return []openapi.Content{{MediaType: binding.MIMEJSON, Value: value}}
+}
+
+func jsonReply(description string, value any) openapi.Reply {
+	return openapi.Reply{Description: description, Content: jsonContent(value)}
+}
+
+func errorReply(description string) openapi.Reply {
+	return jsonReply(description, gin_dto.ErrorOutputDTO{})
+}
+
+func withETag(reply openapi.Reply) openapi.Reply {
+	reply.Headers = map[string]*openapi.Header{
+		"ETag": {
+			Description: "The version of the record, to be sent back in If-Match.",
+			Schema:      &openapi.Schema{Type: "string"},
+		},
+	}
+
+	return reply
+}
+
+func ifMatchParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        "If-Match",
+		In:          "header",
+		Description: "The ETag returned when the record was read.",
+		Required:    true,
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+}
+
+func idempotencyKeyParameter() openapi.Parameter {
+	return openapi.Parameter{
+		Name:        IDEMPOTENCY_KEY_HEADER,
+		In:          "header",
+		Description: "Makes the request safe to retry: the first response is replayed for the same key and body sent by the same user.",
+		Schema:      &openapi.Schema{Type: "string"},
+	}
+}
+
+func enumValues[T interface{ String() string }](values ...T) []string {
+	names := make([]string, 0, len(values))
+	for _, value := range values {
+		names = append(names, value.String())
+	}
+
+	return names
+}
@@ -0,0 +1,76 @@
+package gin
+
+import (
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/openapi"
+	"github.com/gin-gonic/gin"
+	"github.com/go-playground/assert/v2"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/driver/pgdriver"
+)
+
+// newTestEngine builds the routes of Handlers. The database is never
+// reached, as sql.OpenDB only connects on the first query.
+func newTestEngine() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	db := bun.NewDB(sql.OpenDB(pgdriver.NewConnector()), pgdialect.New())
+	return Handlers(&config.Config{}, db, logging.InitializerLogging(&config.Config{}))
+}
+
+func TestOpenAPIDocument(t *testing.T) {
+	document := openAPIDocument()
+
+	t.Run("Dado as rotas registradas em Handlers quando o documento é gerado então todas estão descritas", func(tt *testing.T) {
+		for _, route := range newTestEngine().Routes() {
+			path := openapi.Path(route.Path)
+
+			operations, ok := document.Paths[path]
+			if !ok {
+				tt.Errorf("the route %s %s is missing from the OpenAPI document", route.Method, path)
+				continue
+			}
+
+			if _, ok := operations[strings.ToLower(route.Method)]; !ok {
+				tt.Errorf("the route %s %s is missing from the OpenAPI document", route.Method, path)
+			}
+		}
+	})
+
+	t.Run("Dado o documento quando ele é gerado então os enums são descritos pelos seus nomes", func(tt *testing.T) {
+		schemas := document.Components.Schemas
+
+		assert.Equal(tt, []string{"ADMINISTRATOR", "EMPLOYEE", "DRIVER"}, schemas["Role"].Enum)
+		assert.Equal(tt, []string{"REGULAR", "LATE", "BLOCKED", "SEIZED", "STOLEN"}, schemas["LicensingStatus"].Enum)
+		assert.Equal(tt, 27, len(schemas["BrazilianState"].Enum))
+		assert.Equal(tt, "#/components/schemas/Role", schemas["UserInputDTO"].Properties["role"].Ref)
+	})
+
+	t.Run("Dado o documento quando GET /openapi.json é chamado então ele é servido como JSON", func(tt *testing.T) {
+		w := httptest.NewRecorder()
+		newTestEngine().ServeHTTP(w, httptest.NewRequest(http.MethodGet, OPENAPI_PATH, nil))
+
+		var served openapi.Document
+		assert.Equal(tt, http.StatusOK, w.Code)
+		assert.Equal(tt, nil, json.Unmarshal(w.Body.Bytes(), &served))
+		assert.Equal(tt, openapi.VERSION, served.OpenAPI)
+		assert.Equal(tt, len(document.Paths), len(served.Paths))
+	})
+
+	t.Run("Dado a Swagger UI quando o inicializador é pedido então ele aponta para o documento", func(tt *testing.T) {
+		w := httptest.NewRecorder()
+		newTestEngine().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/swagger"+SWAGGER_INITIALIZER, nil))
+
+		assert.Equal(tt, http.StatusOK, w.Code)
+		assert.Equal(tt, true, strings.Contains(w.Body.String(), `url: "`+OPENAPI_PATH+`"`))
+	})
+}
@@ -0,0 +1,105 @@
+package gin
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	MERGE_PATCH_CONTENT_TYPE = "application/merge-patch+json"
+)
+
+var (
+	ErrUnsupportedPatchContentType = fmt.Errorf("the patch must be sent as %s", MERGE_PATCH_CONTENT_TYPE)
+)
+
+// mergePatch applies the merge patch in the request body to the input DTO of
+// the current resource. Only the fields present in the patch are validated,
+// so that an explicit null is rejected for a required field but accepted for
+// an optional one. It returns the patched DTO and the patched field names.
+func mergePatch[T any](c *gin.Context, current *T) (*T, []string, error) {
+	if contentType := c.ContentType(); contentType != MERGE_PATCH_CONTENT_TYPE && contentType != binding.MIMEJSON {
+		return nil, nil, ErrUnsupportedPatchContentType
+	}
+
+	patch, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	fields, err := mergepatch.Fields(patch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	document, err := json.Marshal(current)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	merged, err := mergepatch.Apply(document, patch)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", mergepatch.ErrInvalidPatch, err)
+	}
+
+	var patched T
+
+	decoder := json.NewDecoder(bytes.NewReader(merged))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&patched); err != nil {
+		return nil, nil, fmt.Errorf("%w: %w", mergepatch.ErrInvalidPatch, err)
+	}
+
+	validate, ok := binding.Validator.Engine().(*validator.Validate)
+	if ok {
+		if err := validate.StructPartial(&patched, structFieldNames[T](fields)...); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return &patched, fields, nil
+}
+
+// structFieldNames translates json member names into the struct field names
+// expected by validator.StructPartial.
+func structFieldNames[T any](fields []string) []string {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	names := make([]string, 0, len(fields))
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		for _, field := range fields {
+			if name == field {
+				names = append(names, t.Field(i).Name)
+			}
+		}
+	}
+
+	return names
+}
+
+func patchErrorStatus(err error) int {
+	var validationErrors validator.ValidationErrors
+
+	switch {
+	case errors.Is(err, ErrUnsupportedPatchContentType):
+		return http.StatusUnsupportedMediaType
+	case errors.Is(err, mergepatch.ErrInvalidPatch):
+		return http.StatusBadRequest
+	case errors.As(err, &validationErrors):
+		return http.StatusUnprocessableEntity
+	}
+
+	return writeErrorStatus(err)
+}
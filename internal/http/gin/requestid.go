@@ -0,0 +1,21 @@
+package gin
+
+import (
+	"github.com/LucasMateus-eng/operations-service/internal/requestid"
+	"github.com/gin-gonic/gin"
+)
+
+// requestID tags every request with the X-Request-ID sent by the client, or
+// a new one when it is missing or unusable, and echoes it in the response.
+func requestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestid.HEADER)
+		if !requestid.IsUsable(id) {
+			id = requestid.New()
+		}
+
+		c.Header(requestid.HEADER, id)
+		c.Request = c.Request.WithContext(requestid.WithRequestID(c.Request.Context(), id))
+		c.Next()
+	}
+}
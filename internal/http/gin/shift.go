@@ -0,0 +1,205 @@
+package gin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/shift"
+	"github.com/gin-gonic/gin"
+)
+
+func shiftErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, shift.ErrInvalidShift), errors.Is(err, shift.ErrInvalidPeriod):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, shift.ErrNotShiftDriver):
+		return http.StatusForbidden
+	case errors.Is(err, shift.ErrInvalidTransition), errors.Is(err, shift.ErrShiftOpen),
+		errors.Is(err, shift.ErrShiftNotOpen), errors.Is(err, shift.ErrActivityChanged):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listShifts lists the shifts, the latest planned start first, of the
+// planned starts between from and to when they are given.
+func listShifts(service *shift.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List shifts", nil)
+
+		var ss gin_dto.ShiftSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ss); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &shift.ShiftSpecification{
+			DriverID: ss.DriverID,
+			From:     ss.From,
+			To:       ss.To,
+			Page:     ss.Page,
+			PageSize: ss.PageSize,
+		}
+		if len(ss.Status) > 0 {
+			var err error
+			specification.Status, err = shift.GetStatus(ss.Status)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		shifts, err := service.List(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		shiftsDTO := make([]gin_dto.ShiftOutputDTO, 0, len(*shifts))
+		for _, s := range *shifts {
+			shiftsDTO = append(shiftsDTO, *gin_mapping.MapShiftToOutputDTO(s))
+		}
+
+		c.JSON(http.StatusOK, shiftsDTO)
+	}
+}
+
+func getShift(service *shift.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get shift", nil)
+
+		shiftID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		s, err := service.GetByID(c.Request.Context(), shiftID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapShiftToOutputDTO(*s))
+	}
+}
+
+func createShift(service *shift.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create shift", nil)
+
+		var dto gin_dto.ShiftInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		s := gin_mapping.MapInputDTOToShift(dto)
+
+		shiftID, err := service.Create(c.Request.Context(), s)
+		if err != nil {
+			c.JSON(shiftErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		s.ID = shiftID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapShiftToOutputDTO(*s))
+	}
+}
+
+func clockIn(service *shift.Service, logger *logging.Logging) gin.HandlerFunc {
+	return moveShift(logger, "Clock in", service.ClockIn)
+}
+
+func clockOut(service *shift.Service, logger *logging.Logging) gin.HandlerFunc {
+	return moveShift(logger, "Clock out", service.ClockOut)
+}
+
+// moveShift clocks the authenticated driver in to or out of the shift
+// identified in the path.
+func moveShift(logger *logging.Logging, msg string, move func(ctx context.Context, id int64) (*shift.Shift, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(msg, nil)
+
+		shiftID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		s, err := move(c.Request.Context(), shiftID)
+		if err != nil {
+			c.JSON(shiftErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapShiftToOutputDTO(*s))
+	}
+}
+
+func switchShiftActivity(service *shift.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Switch shift activity", nil)
+
+		shiftID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.ShiftActivityInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		activity, err := shift.GetActivity(dto.Activity)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		s, err := service.Switch(c.Request.Context(), shiftID, activity)
+		if err != nil {
+			c.JSON(shiftErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapShiftToOutputDTO(*s))
+	}
+}
+
+// getDriverWorkingHours sums up the shifts of the driver worked between from
+// and to, along with the limits of the working hours they broke.
+func getDriverWorkingHours(service *shift.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get driver working hours", nil)
+
+		driverID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var ws gin_dto.WorkingHoursSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ws); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		report, err := service.Report(c.Request.Context(), driverID, ws.From, ws.To)
+		if err != nil {
+			c.JSON(shiftErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapReportToWorkingHoursOutputDTO(*report))
+	}
+}
@@ -0,0 +1,7 @@
+package gin
+
+import "errors"
+
+var (
+	ErrEmptyTrash = errors.New("no deleted records found for the query parameters used")
+)
@@ -0,0 +1,173 @@
+package gin
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/trip"
+	"github.com/gin-gonic/gin"
+)
+
+func tripErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, trip.ErrInvalidTrip), errors.Is(err, trip.ErrInvalidOdometer), errors.Is(err, odometer.ErrInvalidRecord):
+		return http.StatusUnprocessableEntity
+	case errors.Is(err, trip.ErrNotTripDriver):
+		return http.StatusForbidden
+	case errors.Is(err, trip.ErrInvalidTransition), errors.Is(err, trip.ErrNotAssigned),
+		errors.Is(err, trip.ErrTripInProgress), errors.Is(err, odometer.ErrDecreasingReading):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+// listTrips lists the trips, the latest planned start first, of the planned
+// starts between from and to when they are given.
+func listTrips(service *trip.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List trips", nil)
+
+		var ts gin_dto.TripSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ts); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &trip.TripSpecification{
+			DriverID:  ts.DriverID,
+			VehicleID: ts.VehicleID,
+			From:      ts.From,
+			To:        ts.To,
+			Page:      ts.Page,
+			PageSize:  ts.PageSize,
+		}
+		if len(ts.Status) > 0 {
+			var err error
+			specification.Status, err = trip.GetStatus(ts.Status)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		trips, err := service.List(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		tripsDTO := make([]gin_dto.TripOutputDTO, 0, len(*trips))
+		for _, t := range *trips {
+			tripsDTO = append(tripsDTO, *gin_mapping.MapTripToOutputDTO(t))
+		}
+
+		c.JSON(http.StatusOK, tripsDTO)
+	}
+}
+
+func getTrip(service *trip.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get trip", nil)
+
+		tripID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		t, err := service.GetByID(c.Request.Context(), tripID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapTripToOutputDTO(*t))
+	}
+}
+
+func createTrip(service *trip.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create trip", nil)
+
+		var dto gin_dto.TripInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		t := gin_mapping.MapInputDTOToTrip(dto)
+
+		tripID, err := service.Create(c.Request.Context(), t)
+		if err != nil {
+			c.JSON(tripErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		t.ID = tripID
+
+		c.JSON(http.StatusCreated, gin_mapping.MapTripToOutputDTO(*t))
+	}
+}
+
+func startTrip(service *trip.Service, logger *logging.Logging) gin.HandlerFunc {
+	return moveTrip(logger, "Start trip", service.Start)
+}
+
+func finishTrip(service *trip.Service, logger *logging.Logging) gin.HandlerFunc {
+	return moveTrip(logger, "Finish trip", service.Finish)
+}
+
+// moveTrip starts or finishes the trip identified in the path with the
+// odometer of the vehicle given in the body.
+func moveTrip(logger *logging.Logging, msg string, move func(ctx context.Context, id, odometer int64) (*trip.Trip, error)) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info(msg, nil)
+
+		tripID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.TripOdometerInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		t, err := move(c.Request.Context(), tripID, dto.Odometer)
+		if err != nil {
+			c.JSON(tripErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapTripToOutputDTO(*t))
+	}
+}
+
+func cancelTrip(service *trip.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Cancel trip", nil)
+
+		tripID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		t, err := service.Cancel(c.Request.Context(), tripID)
+		if err != nil {
+			c.JSON(tripErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapTripToOutputDTO(*t))
+	}
+}
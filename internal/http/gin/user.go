@@ -1,10 +1,10 @@
 package gin
 
 import (
-	"context"
 	"net/http"
 	"strconv"
 
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
 	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
 	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
@@ -12,7 +12,7 @@ import (
 	"github.com/gin-gonic/gin"
 )
 
-func getUser(ctx context.Context, service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+func getUser(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Get user", nil)
 
@@ -22,7 +22,7 @@ func getUser(ctx context.Context, service *user.Service, logger *logging.Logging
 			return
 		}
 
-		user, err := service.GetByID(ctx, userID)
+		user, err := service.GetByID(c.Request.Context(), userID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -30,11 +30,12 @@ func getUser(ctx context.Context, service *user.Service, logger *logging.Logging
 
 		outputDTO := gin_mapping.MapUserToOutputDTO(*user)
 
+		setETag(c, user.Version)
 		c.JSON(http.StatusOK, outputDTO)
 	}
 }
 
-func createUser(ctx context.Context, service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+func createUser(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Create user", nil)
 
@@ -46,7 +47,7 @@ func createUser(ctx context.Context, service *user.Service, logger *logging.Logg
 
 		user := gin_mapping.MapInputDTOToUser(dto)
 
-		userID, err := service.Create(ctx, user)
+		userID, err := service.Create(c.Request.Context(), user)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -56,7 +57,7 @@ func createUser(ctx context.Context, service *user.Service, logger *logging.Logg
 	}
 }
 
-func updateUser(ctx context.Context, service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+func updateUser(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Update user", nil)
 
@@ -66,6 +67,12 @@ func updateUser(ctx context.Context, service *user.Service, logger *logging.Logg
 			return
 		}
 
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
 		var dto gin_dto.UserInputDTO
 		if err = c.ShouldBindJSON(&dto); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -74,18 +81,68 @@ func updateUser(ctx context.Context, service *user.Service, logger *logging.Logg
 
 		user := gin_mapping.MapInputDTOToUser(dto)
 		user.ID = userID
+		user.Version = version
+
+		err = service.Update(c.Request.Context(), user)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		setETag(c, user.Version)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func patchUser(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Patch user", nil)
+
+		userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
 
-		err = service.Update(ctx, user)
+		current, err := service.GetByID(c.Request.Context(), userID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
+		if current.Version != version {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": concurrency.ErrVersionConflict.Error()})
+			return
+		}
+
+		dto, fields, err := mergePatch(c, gin_mapping.MapUserToInputDTO(*current))
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		user := gin_mapping.MapInputDTOToUser(*dto)
+		user.ID = userID
+		user.Version = version
+
+		err = service.Patch(c.Request.Context(), user, fields)
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		setETag(c, user.Version)
 		c.Status(http.StatusNoContent)
 	}
 }
 
-func deleteUser(ctx context.Context, service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+func deleteUser(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Delete user", nil)
 
@@ -95,12 +152,93 @@ func deleteUser(ctx context.Context, service *user.Service, logger *logging.Logg
 			return
 		}
 
-		err = service.Delete(ctx, userID)
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.Delete(c.Request.Context(), userID, version)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func listDeletedUsers(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List deleted users", nil)
+
+		var ts gin_dto.TrashSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ts); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		users, err := service.ListDeleted(c.Request.Context(), &user.UserSpecification{Page: ts.Page, PageSize: ts.PageSize})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if len(*users) == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrEmptyTrash.Error()})
+			return
+		}
+
+		usersDTO := make([]gin_dto.UserOutputDTO, 0, len(*users))
+		for _, u := range *users {
+			usersDTO = append(usersDTO, *gin_mapping.MapUserToOutputDTO(u))
+		}
+
+		c.JSON(http.StatusOK, usersDTO)
+	}
+}
+
+func restoreUser(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Restore user", nil)
+
+		userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err = service.Restore(c.Request.Context(), userID); err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		user, err := service.GetByID(c.Request.Context(), userID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
+		setETag(c, user.Version)
+		c.JSON(http.StatusOK, gin_mapping.MapUserToOutputDTO(*user))
+	}
+}
+
+func purgeUser(service *user.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Purge user", nil)
+
+		userID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err = service.Purge(c.Request.Context(), userID); err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
 		c.Status(http.StatusNoContent)
 	}
 }
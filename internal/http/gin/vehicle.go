@@ -1,14 +1,16 @@
 package gin
 
 import (
-	"context"
 	"errors"
 	"net/http"
 	"strconv"
+	"strings"
 
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
 	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
 	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/spreadsheet"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 	"github.com/gin-gonic/gin"
 )
@@ -17,7 +19,7 @@ var (
 	ErrEmptyVehicleList = errors.New("no vehicle records found for the query parameters used")
 )
 
-func listVehicles(ctx context.Context, service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func listVehicles(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("List vehicles", nil)
 
@@ -29,7 +31,7 @@ func listVehicles(ctx context.Context, service *vehicle.Service, logger *logging
 
 		vehicleSpecification := gin_mapping.MapInputDTOToVehicleSpecification(vs)
 
-		vehicles, err := service.List(ctx, vehicleSpecification)
+		vehicles, err := service.List(c.Request.Context(), vehicleSpecification)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -49,7 +51,30 @@ func listVehicles(ctx context.Context, service *vehicle.Service, logger *logging
 	}
 }
 
-func getVehicle(ctx context.Context, service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func exportVehicles(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Export vehicles", nil)
+
+		var dto gin_dto.VehicleExportInputDTO
+		if err := c.ShouldBindQuery(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		export, err := newExport(c, dto.ExportInputDTO, gin_mapping.VehicleExportColumns, "vehicles")
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		vehicleSpecification := gin_mapping.MapExportInputDTOToVehicleSpecification(dto)
+
+		err = service.Export(c.Request.Context(), vehicleSpecification, export.Write)
+		export.Finish(err, logger)
+	}
+}
+
+func getVehicle(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Get vehicle", nil)
 
@@ -59,7 +84,7 @@ func getVehicle(ctx context.Context, service *vehicle.Service, logger *logging.L
 			return
 		}
 
-		vehicle, err := service.GetByID(ctx, vehicleID)
+		vehicle, err := service.GetByID(c.Request.Context(), vehicleID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -67,11 +92,12 @@ func getVehicle(ctx context.Context, service *vehicle.Service, logger *logging.L
 
 		outputDTO := gin_mapping.MapVehicleToOutputDTO(*vehicle)
 
+		setETag(c, vehicle.Version)
 		c.JSON(http.StatusOK, outputDTO)
 	}
 }
 
-func createVehicle(ctx context.Context, service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func createVehicle(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Create vehicle", nil)
 
@@ -83,7 +109,7 @@ func createVehicle(ctx context.Context, service *vehicle.Service, logger *loggin
 
 		vehicle := gin_mapping.MapInputDTOToVehicle(dto)
 
-		vehicleID, err := service.Create(ctx, vehicle)
+		vehicleID, err := service.Create(c.Request.Context(), vehicle)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
@@ -93,7 +119,58 @@ func createVehicle(ctx context.Context, service *vehicle.Service, logger *loggin
 	}
 }
 
-func updateVehicle(ctx context.Context, service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func importVehicles(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Import vehicles", nil)
+
+		var dto gin_dto.ImportInputDTO
+		if err := c.ShouldBind(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		formatName := dto.Format
+		if len(strings.TrimSpace(formatName)) == 0 {
+			formatName = dto.File.Filename
+		}
+
+		format, err := spreadsheet.GetFormat(formatName)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		file, err := dto.File.Open()
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+		defer file.Close()
+
+		records, err := spreadsheet.Read(format, file)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		rows := gin_mapping.MapRecordsToVehicleImportRows(records)
+
+		report, err := service.Import(c.Request.Context(), rows, dto.DryRun)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if report.HasErrors() {
+			c.JSON(http.StatusUnprocessableEntity, report)
+			return
+		}
+
+		c.JSON(http.StatusOK, report)
+	}
+}
+
+func updateVehicle(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Update vehicle", nil)
 
@@ -103,6 +180,12 @@ func updateVehicle(ctx context.Context, service *vehicle.Service, logger *loggin
 			return
 		}
 
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
 		var dto gin_dto.VehicleInputDTO
 		if err = c.ShouldBindJSON(&dto); err != nil {
 			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
@@ -111,18 +194,68 @@ func updateVehicle(ctx context.Context, service *vehicle.Service, logger *loggin
 
 		vehicle := gin_mapping.MapInputDTOToVehicle(dto)
 		vehicle.ID = vehicleID
+		vehicle.Version = version
+
+		err = service.Update(c.Request.Context(), vehicle)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		setETag(c, vehicle.Version)
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func patchVehicle(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Patch vehicle", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
 
-		err = service.Update(ctx, vehicle)
+		current, err := service.GetByID(c.Request.Context(), vehicleID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
+		if current.Version != version {
+			c.JSON(http.StatusPreconditionFailed, gin.H{"error": concurrency.ErrVersionConflict.Error()})
+			return
+		}
+
+		dto, fields, err := mergePatch(c, gin_mapping.MapVehicleToInputDTO(*current))
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		vehicle := gin_mapping.MapInputDTOToVehicle(*dto)
+		vehicle.ID = vehicleID
+		vehicle.Version = version
+
+		err = service.Patch(c.Request.Context(), vehicle, fields)
+		if err != nil {
+			c.JSON(patchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		setETag(c, vehicle.Version)
 		c.Status(http.StatusNoContent)
 	}
 }
 
-func deleteVehicle(ctx context.Context, service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+func deleteVehicle(service *vehicle.DecommissioningService, logger *logging.Logging) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		logger.Info("Delete vehicle", nil)
 
@@ -132,12 +265,93 @@ func deleteVehicle(ctx context.Context, service *vehicle.Service, logger *loggin
 			return
 		}
 
-		err = service.Delete(ctx, vehicleID)
+		version, err := ifMatchVersion(c)
+		if err != nil {
+			c.JSON(ifMatchErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.Decommission(c.Request.Context(), vehicleID, version)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.Status(http.StatusNoContent)
+	}
+}
+
+func listDeletedVehicles(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List deleted vehicles", nil)
+
+		var ts gin_dto.TrashSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ts); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		vehicles, err := service.ListDeleted(c.Request.Context(), &vehicle.VehicleSpectification{Page: ts.Page, PageSize: ts.PageSize})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		if len(*vehicles) == 0 {
+			c.JSON(http.StatusNotFound, gin.H{"error": ErrEmptyTrash.Error()})
+			return
+		}
+
+		vehiclesDTO := make([]gin_dto.VehicleOutputDTO, 0, len(*vehicles))
+		for _, v := range *vehicles {
+			vehiclesDTO = append(vehiclesDTO, *gin_mapping.MapVehicleToOutputDTO(v))
+		}
+
+		c.JSON(http.StatusOK, vehiclesDTO)
+	}
+}
+
+func restoreVehicle(service *vehicle.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Restore vehicle", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err = service.Restore(c.Request.Context(), vehicleID); err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		vehicle, err := service.GetByID(c.Request.Context(), vehicleID)
 		if err != nil {
 			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
 			return
 		}
 
+		setETag(c, vehicle.Version)
+		c.JSON(http.StatusOK, gin_mapping.MapVehicleToOutputDTO(*vehicle))
+	}
+}
+
+func purgeVehicle(service *vehicle.DecommissioningService, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Purge vehicle", nil)
+
+		vehicleID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		if err = service.Purge(c.Request.Context(), vehicleID); err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
 		c.Status(http.StatusNoContent)
 	}
 }
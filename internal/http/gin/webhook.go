@@ -0,0 +1,260 @@
+package gin
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	gin_dto "github.com/LucasMateus-eng/operations-service/internal/http/gin/dto"
+	gin_mapping "github.com/LucasMateus-eng/operations-service/internal/http/gin/mapping"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+func webhookErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, webhook.ErrInvalidURL),
+		errors.Is(err, webhook.ErrEmptyEventTypes),
+		errors.Is(err, webhook.ErrUnknownEventType),
+		errors.Is(err, webhook.ErrInvalidStatus):
+		return http.StatusBadRequest
+	case errors.Is(err, webhook.ErrDeliveryPending):
+		return http.StatusConflict
+	}
+
+	return writeErrorStatus(err)
+}
+
+func listWebhooks(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List webhooks", nil)
+
+		var ws gin_dto.WebhookSpecificationInputDTO
+		if err := c.ShouldBindQuery(&ws); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		subscriptions, err := service.List(c.Request.Context(), &webhook.SubscriptionSpecification{
+			Page:     ws.Page,
+			PageSize: ws.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		subscriptionsDTO := make([]gin_dto.WebhookOutputDTO, 0, len(*subscriptions))
+		for _, s := range *subscriptions {
+			subscriptionsDTO = append(subscriptionsDTO, *gin_mapping.MapWebhookToOutputDTO(s))
+		}
+
+		c.JSON(http.StatusOK, subscriptionsDTO)
+	}
+}
+
+func getWebhook(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Get webhook", nil)
+
+		subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		subscription, err := service.GetByID(c.Request.Context(), subscriptionID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusOK, gin_mapping.MapWebhookToOutputDTO(*subscription))
+	}
+}
+
+// createWebhook answers with the secret of the subscription, which is not
+// shown again by any other route.
+func createWebhook(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Create webhook", nil)
+
+		var dto gin_dto.WebhookInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		subscription := gin_mapping.MapInputDTOToWebhook(dto)
+
+		subscriptionID, err := service.Create(c.Request.Context(), subscription)
+		if err != nil {
+			c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		subscription.ID = subscriptionID
+		outputDTO := gin_mapping.MapWebhookToOutputDTO(*subscription)
+		outputDTO.Secret = subscription.Secret
+
+		c.JSON(http.StatusCreated, outputDTO)
+	}
+}
+
+func updateWebhook(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Update webhook", nil)
+
+		subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var dto gin_dto.WebhookInputDTO
+		if err := c.ShouldBindJSON(&dto); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		subscription := gin_mapping.MapInputDTOToWebhook(dto)
+		subscription.ID = subscriptionID
+
+		err = service.Update(c.Request.Context(), subscription)
+		if err != nil {
+			c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+func deleteWebhook(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Delete webhook", nil)
+
+		subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.Delete(c.Request.Context(), subscriptionID)
+		if err != nil {
+			c.JSON(writeErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusNoContent, nil)
+	}
+}
+
+// listWebhookDeliveries lists the deliveries of a subscription. Filtering by
+// status=DEAD gives its dead letters.
+func listWebhookDeliveries(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List webhook deliveries", nil)
+
+		subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var ds gin_dto.WebhookDeliverySpecificationInputDTO
+		if err := c.ShouldBindQuery(&ds); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		specification := &webhook.DeliverySpecification{
+			SubscriptionID: subscriptionID,
+			Page:           ds.Page,
+			PageSize:       ds.PageSize,
+		}
+		if len(ds.Status) > 0 {
+			specification.Status, err = webhook.GetDeliveryStatus(ds.Status)
+			if err != nil {
+				c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+				return
+			}
+		}
+
+		deliveries, err := service.ListDeliveries(c.Request.Context(), specification)
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		deliveriesDTO := make([]gin_dto.WebhookDeliveryOutputDTO, 0, len(*deliveries))
+		for _, d := range *deliveries {
+			deliveriesDTO = append(deliveriesDTO, *gin_mapping.MapWebhookDeliveryToOutputDTO(d))
+		}
+
+		c.JSON(http.StatusOK, deliveriesDTO)
+	}
+}
+
+func listWebhookAttempts(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("List webhook attempts", nil)
+
+		subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		var as gin_dto.WebhookAttemptSpecificationInputDTO
+		if err := c.ShouldBindQuery(&as); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		attempts, err := service.ListAttempts(c.Request.Context(), &webhook.AttemptSpecification{
+			SubscriptionID: subscriptionID,
+			DeliveryID:     as.DeliveryID,
+			Page:           as.Page,
+			PageSize:       as.PageSize,
+		})
+		if err != nil {
+			c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+			return
+		}
+
+		attemptsDTO := make([]gin_dto.WebhookAttemptOutputDTO, 0, len(*attempts))
+		for _, a := range *attempts {
+			attemptsDTO = append(attemptsDTO, *gin_mapping.MapWebhookAttemptToOutputDTO(a))
+		}
+
+		c.JSON(http.StatusOK, attemptsDTO)
+	}
+}
+
+func redeliverWebhook(service *webhook.Service, logger *logging.Logging) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		logger.Info("Redeliver webhook", nil)
+
+		subscriptionID, err := strconv.ParseInt(c.Param("id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		deliveryID, err := strconv.ParseInt(c.Param("delivery_id"), 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+
+		err = service.Redeliver(c.Request.Context(), subscriptionID, deliveryID)
+		if err != nil {
+			c.JSON(webhookErrorStatus(err), gin.H{"error": err.Error()})
+			return
+		}
+
+		c.JSON(http.StatusAccepted, nil)
+	}
+}
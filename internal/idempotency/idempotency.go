@@ -0,0 +1,87 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+const (
+	// DEFAULT_TTL is how long a key is remembered when no TTL is configured.
+	DEFAULT_TTL = 24 * time.Hour
+
+	// DEFAULT_LOCK_TIMEOUT is how long a request may hold its key while it
+	// is processed when no lock timeout is configured. A request that took
+	// longer is taken for dead, e.g. its process crashed, and its key is
+	// handed to the next retry.
+	DEFAULT_LOCK_TIMEOUT = time.Minute
+
+	MAXIMUM_KEY_LENGTH = 255
+)
+
+var (
+	ErrInvalidKey        = errors.New("the idempotency key must have between 1 and 255 characters")
+	ErrKeyReused         = errors.New("the idempotency key was already used with a different request")
+	ErrRequestInProgress = errors.New("a request with the same idempotency key is still being processed")
+)
+
+// Record is a request identified by an idempotency key. Keys belong to the
+// actor that sent them, the ActorID being 0 for anonymous requests, so two
+// users never share one. While the request is being processed the record
+// only holds the request hash; once it finishes the response is stored so a
+// replay can return it unchanged.
+type Record struct {
+	ActorID     int64
+	Key         string
+	RequestHash string
+	StatusCode  int
+	ContentType string
+	Body        []byte
+	CreatedAt   time.Time
+	LockedUntil time.Time
+	ExpiresAt   time.Time
+}
+
+// Completed reports whether the response of the request was already stored.
+func (r *Record) Completed() bool {
+	return r.StatusCode != 0
+}
+
+// Hash identifies a request by its method, path and body, so the same key
+// sent with another payload can be told apart from a genuine retry.
+func Hash(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method))
+	h.Write([]byte{0})
+	h.Write([]byte(path))
+	h.Write([]byte{0})
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type Reading interface {
+	GetByKey(ctx context.Context, actorID int64, key string) (*Record, error)
+}
+
+type Writing interface {
+	// Reserve stores the record unless the key is already there and has
+	// neither expired nor, while in progress, outlived its lock, reporting
+	// whether the key now belongs to the caller.
+	Reserve(ctx context.Context, r *Record) (bool, error)
+	Complete(ctx context.Context, r *Record) error
+	Release(ctx context.Context, actorID int64, key string) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+type UseCase interface {
+	Begin(ctx context.Context, key, requestHash string) (*Record, error)
+	Complete(ctx context.Context, r *Record) error
+	Release(ctx context.Context, key string) error
+}
@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type IdempotencyKeyDTO struct {
+	bun.BaseModel `bun:"table:idempotency_keys"`
+
+	ActorID     int64     `bun:"actor_id,pk"`
+	Key         string    `bun:"key,pk"`
+	RequestHash string    `bun:"request_hash,notnull"`
+	StatusCode  int       `bun:"status_code,notnull"`
+	ContentType string    `bun:"content_type,notnull"`
+	Body        []byte    `bun:"body"`
+	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	LockedUntil time.Time `bun:"locked_until,notnull"`
+	ExpiresAt   time.Time `bun:"expires_at,notnull"`
+}
@@ -0,0 +1,104 @@
+package postgres
+
+import (
+	"context"
+
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency"
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency/postgres/mapping"
+	"github.com/uptrace/bun"
+)
+
+type idempotencyPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *idempotencyPostgresRepo {
+	return &idempotencyPostgresRepo{
+		db: db,
+	}
+}
+
+func (ir *idempotencyPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, ir.db)
+}
+
+func (ir *idempotencyPostgresRepo) GetByKey(ctx context.Context, actorID int64, key string) (*idempotency.Record, error) {
+	var idempotencyKeyDTO dto.IdempotencyKeyDTO
+
+	err := ir.conn(ctx).NewSelect().
+		Model(&idempotencyKeyDTO).
+		Where("actor_id = ?", actorID).
+		Where("key = ?", key).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToRecord(&idempotencyKeyDTO), nil
+}
+
+func (ir *idempotencyPostgresRepo) Reserve(ctx context.Context, r *idempotency.Record) (bool, error) {
+	idempotencyKeyDTO := mapping.MapRecordToDTO(r)
+
+	// An expired key is taken over as if it had never been used, and so is
+	// a key still in progress whose lock ran out, as its request is dead.
+	res, err := ir.conn(ctx).NewInsert().
+		Model(idempotencyKeyDTO).
+		On("CONFLICT (actor_id, key) DO UPDATE").
+		Set("request_hash = EXCLUDED.request_hash").
+		Set("status_code = EXCLUDED.status_code").
+		Set("content_type = EXCLUDED.content_type").
+		Set("body = EXCLUDED.body").
+		Set("created_at = EXCLUDED.created_at").
+		Set("locked_until = EXCLUDED.locked_until").
+		Set("expires_at = EXCLUDED.expires_at").
+		Where("?TableAlias.expires_at < current_timestamp OR (?TableAlias.status_code = 0 AND ?TableAlias.locked_until < current_timestamp)").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected == 1, nil
+}
+
+// Complete stores the response only while the key is still in progress for
+// the same request, so that a request slower than its lock never overwrites
+// the key another request took over.
+func (ir *idempotencyPostgresRepo) Complete(ctx context.Context, r *idempotency.Record) error {
+	idempotencyKeyDTO := mapping.MapRecordToDTO(r)
+
+	_, err := ir.conn(ctx).NewUpdate().
+		Model(idempotencyKeyDTO).
+		Column("status_code", "content_type", "body").
+		Where("actor_id = ?", idempotencyKeyDTO.ActorID).
+		Where("key = ?", idempotencyKeyDTO.Key).
+		Where("request_hash = ?", idempotencyKeyDTO.RequestHash).
+		Where("status_code = 0").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ir *idempotencyPostgresRepo) Release(ctx context.Context, actorID int64, key string) error {
+	_, err := ir.conn(ctx).NewDelete().
+		Model((*dto.IdempotencyKeyDTO)(nil)).
+		Where("actor_id = ?", actorID).
+		Where("key = ?", key).
+		Where("status_code = 0").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency"
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency/postgres/dto"
+)
+
+func MapRecordToDTO(record *idempotency.Record) *dto.IdempotencyKeyDTO {
+	return &dto.IdempotencyKeyDTO{
+		ActorID:     record.ActorID,
+		Key:         record.Key,
+		RequestHash: record.RequestHash,
+		StatusCode:  record.StatusCode,
+		ContentType: record.ContentType,
+		Body:        record.Body,
+		CreatedAt:   record.CreatedAt,
+		LockedUntil: record.LockedUntil,
+		ExpiresAt:   record.ExpiresAt,
+	}
+}
+
+func MapDTOToRecord(idempotencyKeyDTO *dto.IdempotencyKeyDTO) *idempotency.Record {
+	return &idempotency.Record{
+		ActorID:     idempotencyKeyDTO.ActorID,
+		Key:         idempotencyKeyDTO.Key,
+		RequestHash: idempotencyKeyDTO.RequestHash,
+		StatusCode:  idempotencyKeyDTO.StatusCode,
+		ContentType: idempotencyKeyDTO.ContentType,
+		Body:        idempotencyKeyDTO.Body,
+		CreatedAt:   idempotencyKeyDTO.CreatedAt,
+		LockedUntil: idempotencyKeyDTO.LockedUntil,
+		ExpiresAt:   idempotencyKeyDTO.ExpiresAt,
+	}
+}
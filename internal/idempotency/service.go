@@ -0,0 +1,130 @@
+package idempotency
+
+import (
+	"context"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+type Service struct {
+	repo        Repository
+	ttl         time.Duration
+	lockTimeout time.Duration
+	logger      *logging.Logging
+}
+
+// NewService builds the idempotency use case. A ttl or lockTimeout that is
+// not positive falls back to DEFAULT_TTL or DEFAULT_LOCK_TIMEOUT.
+func NewService(r Repository, ttl, lockTimeout time.Duration, l *logging.Logging) *Service {
+	if ttl <= 0 {
+		ttl = DEFAULT_TTL
+	}
+
+	if lockTimeout <= 0 {
+		lockTimeout = DEFAULT_LOCK_TIMEOUT
+	}
+
+	return &Service{
+		repo:        r,
+		ttl:         ttl,
+		lockTimeout: lockTimeout,
+		logger:      l,
+	}
+}
+
+// Begin claims the key of the actor in ctx for the request. It returns a nil
+// record when the request must be processed, or the stored record when it is
+// a replay of a request that already finished.
+func (s *Service) Begin(ctx context.Context, key, requestHash string) (*Record, error) {
+	s.logger.Debug("[IDEMPOTENCY] Begin - DEBUG: ", map[string]any{
+		"idempotencyKey": key,
+	})
+	if key == "" || len(key) > MAXIMUM_KEY_LENGTH {
+		return nil, ErrInvalidKey
+	}
+
+	actorID := actorIDFrom(ctx)
+	now := time.Now()
+	reserved, err := s.repo.Reserve(ctx, &Record{
+		ActorID:     actorID,
+		Key:         key,
+		RequestHash: requestHash,
+		LockedUntil: now.Add(s.lockTimeout),
+		ExpiresAt:   now.Add(s.ttl),
+	})
+	if err != nil {
+		s.logger.Error("[IDEMPOTENCY] Begin - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	if reserved {
+		return nil, nil
+	}
+
+	record, err := s.repo.GetByKey(ctx, actorID, key)
+	if err != nil {
+		s.logger.Error("[IDEMPOTENCY] Begin - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	if record.RequestHash != requestHash {
+		return nil, ErrKeyReused
+	}
+
+	if !record.Completed() {
+		return nil, ErrRequestInProgress
+	}
+
+	return record, nil
+}
+
+// Complete stores the response of the request holding the key of the actor
+// in ctx.
+func (s *Service) Complete(ctx context.Context, r *Record) error {
+	s.logger.Debug("[IDEMPOTENCY] Complete - DEBUG: ", map[string]any{
+		"idempotencyKey": r.Key,
+		"statusCode":     r.StatusCode,
+	})
+	r.ActorID = actorIDFrom(ctx)
+	err := s.repo.Complete(ctx, r)
+	if err != nil {
+		s.logger.Error("[IDEMPOTENCY] Complete - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+// Release gives up the key of the actor in ctx, so that it can be retried.
+func (s *Service) Release(ctx context.Context, key string) error {
+	s.logger.Debug("[IDEMPOTENCY] Release - DEBUG: ", map[string]any{
+		"idempotencyKey": key,
+	})
+	err := s.repo.Release(ctx, actorIDFrom(ctx), key)
+	if err != nil {
+		s.logger.Error("[IDEMPOTENCY] Release - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+// actorIDFrom returns the user performing the request, or 0 when it is
+// anonymous.
+func actorIDFrom(ctx context.Context) int64 {
+	if a, ok := actor.FromContext(ctx); ok {
+		return a.UserID
+	}
+
+	return 0
+}
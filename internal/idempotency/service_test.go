@@ -0,0 +1,254 @@
+package idempotency_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/idempotency"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	idempotency_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/idempotency"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked      = errors.New("some error")
+	mockedContext  = context.Background()
+	mockedHash     = idempotency.Hash("POST", "/v1/vehicles/", []byte(`{"plate":"ABC1D23"}`))
+	completedEntry = &idempotency.Record{
+		Key:         "key",
+		RequestHash: mockedHash,
+		StatusCode:  201,
+		ContentType: "application/json; charset=utf-8",
+		Body:        []byte(`{"id":1}`),
+	}
+)
+
+func TestHash(t *testing.T) {
+	body := []byte(`{"plate":"ABC1D23"}`)
+
+	assert.Equal(t, idempotency.Hash("POST", "/v1/vehicles/", body), idempotency.Hash("POST", "/v1/vehicles/", body))
+	assert.NotEqual(t, idempotency.Hash("POST", "/v1/vehicles/", body), idempotency.Hash("POST", "/v1/drivers/", body))
+	assert.NotEqual(t, idempotency.Hash("POST", "/v1/vehicles/", body), idempotency.Hash("POST", "/v1/vehicles/", []byte(`{}`)))
+}
+
+func TestService_Begin(t *testing.T) {
+	type serviceMocks struct {
+		repo   *idempotency_mocks.MockRepository
+		logger *logging.Logging
+	}
+
+	type args struct {
+		ctx         context.Context
+		key         string
+		requestHash string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        *idempotency.Record
+		wantErr     error
+	}{
+		{
+			name: "Dado uma chave nova quando o método Begin é chamado então a requisição deve ser processada",
+			args: args{
+				ctx:         mockedContext,
+				key:         "key",
+				requestHash: mockedHash,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Reserve(p.ctx, gomock.Any()).Return(true, nil)
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "Dado um usuário autenticado quando o método Begin é chamado então a chave é reservada para ele e travada por menos tempo que a validade",
+			args: args{
+				ctx:         actor.WithActor(mockedContext, &actor.Actor{UserID: 7}),
+				key:         "key",
+				requestHash: mockedHash,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Reserve(p.ctx, gomock.Cond(func(x any) bool {
+					r := x.(*idempotency.Record)
+					return r.ActorID == 7 && r.Key == p.key && r.LockedUntil.Before(r.ExpiresAt)
+				})).Return(true, nil)
+			},
+			want:    nil,
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma chave concluída por um usuário autenticado quando o método Begin é chamado então ela é procurada entre as chaves dele",
+			args: args{
+				ctx:         actor.WithActor(mockedContext, &actor.Actor{UserID: 7}),
+				key:         "key",
+				requestHash: mockedHash,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Reserve(p.ctx, gomock.Any()).Return(false, nil)
+				m.repo.EXPECT().GetByKey(p.ctx, int64(7), p.key).Return(completedEntry, nil)
+			},
+			want:    completedEntry,
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma chave já concluída com o mesmo corpo quando o método Begin é chamado então a resposta original é retornada",
+			args: args{
+				ctx:         mockedContext,
+				key:         "key",
+				requestHash: mockedHash,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Reserve(p.ctx, gomock.Any()).Return(false, nil)
+				m.repo.EXPECT().GetByKey(p.ctx, int64(0), p.key).Return(completedEntry, nil)
+			},
+			want:    completedEntry,
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma chave reutilizada com outro corpo quando o método Begin é chamado então um erro é retornado",
+			args: args{
+				ctx:         mockedContext,
+				key:         "key",
+				requestHash: idempotency.Hash("POST", "/v1/vehicles/", []byte(`{"plate":"XYZ9A87"}`)),
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Reserve(p.ctx, gomock.Any()).Return(false, nil)
+				m.repo.EXPECT().GetByKey(p.ctx, int64(0), p.key).Return(completedEntry, nil)
+			},
+			want:    nil,
+			wantErr: idempotency.ErrKeyReused,
+		},
+		{
+			name: "Dado uma chave ainda em processamento quando o método Begin é chamado então um erro é retornado",
+			args: args{
+				ctx:         mockedContext,
+				key:         "key",
+				requestHash: mockedHash,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Reserve(p.ctx, gomock.Any()).Return(false, nil)
+				m.repo.EXPECT().GetByKey(p.ctx, int64(0), p.key).Return(&idempotency.Record{Key: p.key, RequestHash: p.requestHash}, nil)
+			},
+			want:    nil,
+			wantErr: idempotency.ErrRequestInProgress,
+		},
+		{
+			name: "Dado uma chave longa demais quando o método Begin é chamado então um erro é retornado",
+			args: args{
+				ctx:         mockedContext,
+				key:         strings.Repeat("k", idempotency.MAXIMUM_KEY_LENGTH+1),
+				requestHash: mockedHash,
+			},
+			want:    nil,
+			wantErr: idempotency.ErrInvalidKey,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Begin é chamado então o erro é retornado",
+			args: args{
+				ctx:         mockedContext,
+				key:         "key",
+				requestHash: mockedHash,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Reserve(p.ctx, gomock.Any()).Return(false, errMocked)
+			},
+			want:    nil,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:   idempotency_mocks.NewMockRepository(ctrl),
+				logger: logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := idempotency.NewService(sm.repo, 0, 0, sm.logger)
+
+			got, err := s.Begin(test.args.ctx, test.args.key, test.args.requestHash)
+
+			assert.Equal(tt, test.want, got)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_Release(t *testing.T) {
+	type serviceMocks struct {
+		repo   *idempotency_mocks.MockRepository
+		logger *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		key string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado uma chave reservada quando o método Release é chamado então a chave é liberada",
+			args: args{
+				ctx: mockedContext,
+				key: "key",
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Release(p.ctx, int64(0), p.key).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Release é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				key: "key",
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Release(p.ctx, int64(0), p.key).Return(errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:   idempotency_mocks.NewMockRepository(ctrl),
+				logger: logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := idempotency.NewService(sm.repo, 0, 0, sm.logger)
+
+			err := s.Release(test.args.ctx, test.args.key)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+var (
+	ErrInvalidPatch   = errors.New("the merge patch must be a valid JSON object")
+	ErrImmutableField = errors.New("the field cannot be changed by a patch")
+)
+
+// Apply merges patch into document following RFC 7396 (JSON Merge Patch) and
+// returns the resulting JSON. A null member of the patch removes the member
+// from the document, an object is merged recursively and any other value
+// replaces the original one.
+func Apply(document, patch []byte) ([]byte, error) {
+	var target any
+	if len(document) > 0 {
+		if err := json.Unmarshal(document, &target); err != nil {
+			return nil, err
+		}
+	}
+
+	var p any
+	if err := json.Unmarshal(patch, &p); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(merge(target, p))
+}
+
+// Fields returns the sorted names of the top-level members of an object
+// patch, including the ones set to null.
+func Fields(patch []byte) ([]string, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &members); err != nil || members == nil {
+		return nil, ErrInvalidPatch
+	}
+
+	fields := make([]string, 0, len(members))
+	for field := range members {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	return fields, nil
+}
+
+// CheckImmutable returns an error for every patched field that belongs to
+// the immutable ones, instead of letting the write silently skip it.
+func CheckImmutable(fields []string, immutable ...string) error {
+	var errs []error
+	for _, field := range fields {
+		if slices.Contains(immutable, field) {
+			errs = append(errs, fmt.Errorf("%w: [%s]", ErrImmutableField, field))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func merge(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for name, value := range patchObject {
+		if value == nil {
+			delete(targetObject, name)
+			continue
+		}
+
+		targetObject[name] = merge(targetObject[name], value)
+	}
+
+	return targetObject
+}
@@ -0,0 +1,140 @@
+package mergepatch
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+)
+
+func TestApply(t *testing.T) {
+	// Examples taken from the appendix A of RFC 7396.
+	tests := []struct {
+		name     string
+		document string
+		patch    string
+		want     string
+	}{
+		{
+			name:     "Dado um membro existente quando o patch é aplicado então o valor é substituído",
+			document: `{"a":"b"}`,
+			patch:    `{"a":"c"}`,
+			want:     `{"a":"c"}`,
+		},
+		{
+			name:     "Dado um membro novo quando o patch é aplicado então o membro é adicionado",
+			document: `{"a":"b"}`,
+			patch:    `{"b":"c"}`,
+			want:     `{"a":"b","b":"c"}`,
+		},
+		{
+			name:     "Dado um membro nulo quando o patch é aplicado então o membro é removido",
+			document: `{"a":"b","b":"c"}`,
+			patch:    `{"a":null}`,
+			want:     `{"b":"c"}`,
+		},
+		{
+			name:     "Dado um objeto aninhado quando o patch é aplicado então os objetos são mesclados",
+			document: `{"a":{"b":"c"}}`,
+			patch:    `{"a":{"b":"d","c":null}}`,
+			want:     `{"a":{"b":"d"}}`,
+		},
+		{
+			name:     "Dado um array quando o patch é aplicado então o array é substituído por inteiro",
+			document: `{"a":[{"b":"c"}]}`,
+			patch:    `{"a":[1]}`,
+			want:     `{"a":[1]}`,
+		},
+		{
+			name:     "Dado um documento que não é objeto quando o patch é aplicado então o documento é substituído",
+			document: `["a","b"]`,
+			patch:    `{"a":"c"}`,
+			want:     `{"a":"c"}`,
+		},
+		{
+			name:     "Dado um patch nulo aninhado em membro inexistente quando o patch é aplicado então nada é criado",
+			document: `{"e":null}`,
+			patch:    `{"a":1}`,
+			want:     `{"a":1,"e":null}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			actualDocument, err := Apply([]byte(test.document), []byte(test.patch))
+
+			assert.Equal(tt, nil, err)
+
+			var actual, want any
+			assert.Equal(tt, nil, json.Unmarshal(actualDocument, &actual))
+			assert.Equal(tt, nil, json.Unmarshal([]byte(test.want), &want))
+			assert.Equal(tt, want, actual)
+		})
+	}
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		name    string
+		patch   string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:    "Dado um patch objeto quando os campos são lidos então os nomes são retornados em ordem",
+			patch:   `{"model":"Uno","complement":null}`,
+			want:    []string{"complement", "model"},
+			wantErr: false,
+		},
+		{
+			name:    "Dado um patch que não é objeto quando os campos são lidos então um erro é retornado",
+			patch:   `["model"]`,
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name:    "Dado um patch nulo quando os campos são lidos então um erro é retornado",
+			patch:   `null`,
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			actualFields, err := Fields([]byte(test.patch))
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, actualFields)
+		})
+	}
+}
+
+func TestCheckImmutable(t *testing.T) {
+	tests := []struct {
+		name    string
+		fields  []string
+		wantErr bool
+	}{
+		{
+			name:    "Dado apenas campos mutáveis quando a verificação é chamada então nenhum erro é retornado",
+			fields:  []string{"brand", "model"},
+			wantErr: false,
+		},
+		{
+			name:    "Dado um campo imutável quando a verificação é chamada então um erro é retornado",
+			fields:  []string{"brand", "plate"},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := CheckImmutable(test.fields, "id", "plate", "renavam")
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.wantErr, errors.Is(err, ErrImmutableField))
+		})
+	}
+}
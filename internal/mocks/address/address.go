@@ -70,6 +70,21 @@ func (mr *MockReadingMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockReading)(nil).GetByUserID), ctx, userID)
 }
 
+// ListByUserIDs mocks base method.
+func (m *MockReading) ListByUserIDs(ctx context.Context, userIDs []int64) (*[]address.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(*[]address.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserIDs indicates an expected call of ListByUserIDs.
+func (mr *MockReadingMockRecorder) ListByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserIDs", reflect.TypeOf((*MockReading)(nil).ListByUserIDs), ctx, userIDs)
+}
+
 // MockWriting is a mock of Writing interface.
 type MockWriting struct {
 	ctrl     *gomock.Controller
@@ -109,17 +124,31 @@ func (mr *MockWritingMockRecorder) Create(ctx, a any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockWriting) Delete(ctx context.Context, id int64) error {
+func (m *MockWriting) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockWritingMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockWritingMockRecorder) Delete(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, id, version)
+}
+
+// Patch mocks base method.
+func (m *MockWriting) Patch(ctx context.Context, a *address.Address, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, a, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockWritingMockRecorder) Patch(ctx, a, fields any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockWriting)(nil).Patch), ctx, a, fields)
 }
 
 // Update mocks base method.
@@ -175,17 +204,17 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, a any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+func (m *MockRepository) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id, version)
 }
 
 // GetByID mocks base method.
@@ -218,6 +247,35 @@ func (mr *MockRepositoryMockRecorder) GetByUserID(ctx, userID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockRepository)(nil).GetByUserID), ctx, userID)
 }
 
+// ListByUserIDs mocks base method.
+func (m *MockRepository) ListByUserIDs(ctx context.Context, userIDs []int64) (*[]address.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(*[]address.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserIDs indicates an expected call of ListByUserIDs.
+func (mr *MockRepositoryMockRecorder) ListByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserIDs", reflect.TypeOf((*MockRepository)(nil).ListByUserIDs), ctx, userIDs)
+}
+
+// Patch mocks base method.
+func (m *MockRepository) Patch(ctx context.Context, a *address.Address, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, a, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockRepositoryMockRecorder) Patch(ctx, a, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockRepository)(nil).Patch), ctx, a, fields)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, a *address.Address) error {
 	m.ctrl.T.Helper()
@@ -271,17 +329,17 @@ func (mr *MockUseCaseMockRecorder) Create(ctx, a any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockUseCase) Delete(ctx context.Context, id int64) error {
+func (m *MockUseCase) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockUseCaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, id, version)
 }
 
 // GetByID mocks base method.
@@ -314,6 +372,35 @@ func (mr *MockUseCaseMockRecorder) GetByUserID(ctx, userID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockUseCase)(nil).GetByUserID), ctx, userID)
 }
 
+// ListByUserIDs mocks base method.
+func (m *MockUseCase) ListByUserIDs(ctx context.Context, userIDs []int64) (*[]address.Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByUserIDs", ctx, userIDs)
+	ret0, _ := ret[0].(*[]address.Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByUserIDs indicates an expected call of ListByUserIDs.
+func (mr *MockUseCaseMockRecorder) ListByUserIDs(ctx, userIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByUserIDs", reflect.TypeOf((*MockUseCase)(nil).ListByUserIDs), ctx, userIDs)
+}
+
+// Patch mocks base method.
+func (m *MockUseCase) Patch(ctx context.Context, a *address.Address, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, a, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockUseCaseMockRecorder) Patch(ctx, a, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockUseCase)(nil).Patch), ctx, a, fields)
+}
+
 // Update mocks base method.
 func (m *MockUseCase) Update(ctx context.Context, a *address.Address) error {
 	m.ctrl.T.Helper()
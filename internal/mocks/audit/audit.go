@@ -0,0 +1,272 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/audit/audit.go
+//
+// Generated by this command:
+//
+//	mockgen -source=internal/audit/audit.go -destination=internal/mocks/audit/audit.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	audit "github.com/LucasMateus-eng/operations-service/internal/audit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockReading) List(ctx context.Context, specification *audit.EntrySpecification) (*[]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriting) Create(ctx context.Context, entries []*audit.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWritingMockRecorder) Create(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, entries)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, entries []*audit.Entry) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, entries)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, entries any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, entries)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context, specification *audit.EntrySpecification) (*[]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
+}
+
+// MockRecorder is a mock of Recorder interface.
+type MockRecorder struct {
+	ctrl     *gomock.Controller
+	recorder *MockRecorderMockRecorder
+}
+
+// MockRecorderMockRecorder is the mock recorder for MockRecorder.
+type MockRecorderMockRecorder struct {
+	mock *MockRecorder
+}
+
+// NewMockRecorder creates a new mock instance.
+func NewMockRecorder(ctrl *gomock.Controller) *MockRecorder {
+	mock := &MockRecorder{ctrl: ctrl}
+	mock.recorder = &MockRecorderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRecorder) EXPECT() *MockRecorderMockRecorder {
+	return m.recorder
+}
+
+// Record mocks base method.
+func (m *MockRecorder) Record(ctx context.Context, entries ...*audit.Entry) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range entries {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Record", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockRecorderMockRecorder) Record(ctx any, entries ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, entries...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockRecorder)(nil).Record), varargs...)
+}
+
+// Track mocks base method.
+func (m *MockRecorder) Track(ctx context.Context, write func(context.Context) ([]*audit.Entry, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", ctx, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockRecorderMockRecorder) Track(ctx, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockRecorder)(nil).Track), ctx, write)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// List mocks base method.
+func (m *MockUseCase) List(ctx context.Context, specification *audit.EntrySpecification) (*[]audit.Entry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]audit.Entry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
+}
+
+// Record mocks base method.
+func (m *MockUseCase) Record(ctx context.Context, entries ...*audit.Entry) error {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx}
+	for _, a := range entries {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Record", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Record indicates an expected call of Record.
+func (mr *MockUseCaseMockRecorder) Record(ctx any, entries ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx}, entries...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Record", reflect.TypeOf((*MockUseCase)(nil).Record), varargs...)
+}
+
+// Track mocks base method.
+func (m *MockUseCase) Track(ctx context.Context, write func(context.Context) ([]*audit.Entry, error)) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Track", ctx, write)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Track indicates an expected call of Track.
+func (mr *MockUseCaseMockRecorder) Track(ctx, write any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Track", reflect.TypeOf((*MockUseCase)(nil).Track), ctx, write)
+}
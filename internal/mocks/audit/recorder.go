@@ -0,0 +1,23 @@
+package mocks
+
+import (
+	context "context"
+
+	audit "github.com/LucasMateus-eng/operations-service/internal/audit"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// NewPassThroughRecorder returns a recorder that runs every tracked write as
+// is, without a transaction, and accepts any entry that is recorded.
+func NewPassThroughRecorder(ctrl *gomock.Controller) *MockRecorder {
+	recorder := NewMockRecorder(ctrl)
+	recorder.EXPECT().Track(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, write func(ctx context.Context) ([]*audit.Entry, error)) error {
+			_, err := write(ctx)
+			return err
+		},
+	).AnyTimes()
+	recorder.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return recorder
+}
@@ -0,0 +1,689 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: checklist/checklist.go
+//
+// Generated by this command:
+//
+//	mockgen -source=checklist/checklist.go -destination=internal/mocks/checklist/checklist.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	checklist "github.com/LucasMateus-eng/operations-service/checklist"
+	driver "github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	maintenance "github.com/LucasMateus-eng/operations-service/maintenance"
+	odometer "github.com/LucasMateus-eng/operations-service/odometer"
+	vehicle "github.com/LucasMateus-eng/operations-service/vehicle"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// FindTemplate mocks base method.
+func (m *MockReading) FindTemplate(ctx context.Context, category vehicle.Category, kind checklist.Kind) (*checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTemplate", ctx, category, kind)
+	ret0, _ := ret[0].(*checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTemplate indicates an expected call of FindTemplate.
+func (mr *MockReadingMockRecorder) FindTemplate(ctx, category, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTemplate", reflect.TypeOf((*MockReading)(nil).FindTemplate), ctx, category, kind)
+}
+
+// GetByID mocks base method.
+func (m *MockReading) GetByID(ctx context.Context, id int64) (*checklist.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*checklist.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReading)(nil).GetByID), ctx, id)
+}
+
+// GetTemplate mocks base method.
+func (m *MockReading) GetTemplate(ctx context.Context, id int64) (*checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, id)
+	ret0, _ := ret[0].(*checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockReadingMockRecorder) GetTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockReading)(nil).GetTemplate), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockReading) List(ctx context.Context, specification *checklist.ChecklistSpecification) (*[]checklist.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]checklist.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
+}
+
+// ListTemplates mocks base method.
+func (m *MockReading) ListTemplates(ctx context.Context, specification *checklist.TemplateSpecification) (*[]checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx, specification)
+	ret0, _ := ret[0].(*[]checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockReadingMockRecorder) ListTemplates(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockReading)(nil).ListTemplates), ctx, specification)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriting) Create(ctx context.Context, c *checklist.Checklist) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWritingMockRecorder) Create(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, c)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockWriting) CreateTemplate(ctx context.Context, t *checklist.Template) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockWritingMockRecorder) CreateTemplate(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockWriting)(nil).CreateTemplate), ctx, t)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockWriting) DeleteTemplate(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockWritingMockRecorder) DeleteTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockWriting)(nil).DeleteTemplate), ctx, id)
+}
+
+// UpdateTemplate mocks base method.
+func (m *MockWriting) UpdateTemplate(ctx context.Context, t *checklist.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTemplate indicates an expected call of UpdateTemplate.
+func (mr *MockWritingMockRecorder) UpdateTemplate(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockWriting)(nil).UpdateTemplate), ctx, t)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, c *checklist.Checklist) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, c)
+}
+
+// CreateTemplate mocks base method.
+func (m *MockRepository) CreateTemplate(ctx context.Context, t *checklist.Template) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockRepositoryMockRecorder) CreateTemplate(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockRepository)(nil).CreateTemplate), ctx, t)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockRepository) DeleteTemplate(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockRepositoryMockRecorder) DeleteTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockRepository)(nil).DeleteTemplate), ctx, id)
+}
+
+// FindTemplate mocks base method.
+func (m *MockRepository) FindTemplate(ctx context.Context, category vehicle.Category, kind checklist.Kind) (*checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTemplate", ctx, category, kind)
+	ret0, _ := ret[0].(*checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTemplate indicates an expected call of FindTemplate.
+func (mr *MockRepositoryMockRecorder) FindTemplate(ctx, category, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTemplate", reflect.TypeOf((*MockRepository)(nil).FindTemplate), ctx, category, kind)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*checklist.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*checklist.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
+}
+
+// GetTemplate mocks base method.
+func (m *MockRepository) GetTemplate(ctx context.Context, id int64) (*checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, id)
+	ret0, _ := ret[0].(*checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockRepositoryMockRecorder) GetTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockRepository)(nil).GetTemplate), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context, specification *checklist.ChecklistSpecification) (*[]checklist.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]checklist.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
+}
+
+// ListTemplates mocks base method.
+func (m *MockRepository) ListTemplates(ctx context.Context, specification *checklist.TemplateSpecification) (*[]checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx, specification)
+	ret0, _ := ret[0].(*[]checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockRepositoryMockRecorder) ListTemplates(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockRepository)(nil).ListTemplates), ctx, specification)
+}
+
+// UpdateTemplate mocks base method.
+func (m *MockRepository) UpdateTemplate(ctx context.Context, t *checklist.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTemplate indicates an expected call of UpdateTemplate.
+func (mr *MockRepositoryMockRecorder) UpdateTemplate(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockRepository)(nil).UpdateTemplate), ctx, t)
+}
+
+// MockAssignmentReading is a mock of AssignmentReading interface.
+type MockAssignmentReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentReadingMockRecorder
+}
+
+// MockAssignmentReadingMockRecorder is the mock recorder for MockAssignmentReading.
+type MockAssignmentReadingMockRecorder struct {
+	mock *MockAssignmentReading
+}
+
+// NewMockAssignmentReading creates a new mock instance.
+func NewMockAssignmentReading(ctrl *gomock.Controller) *MockAssignmentReading {
+	mock := &MockAssignmentReading{ctrl: ctrl}
+	mock.recorder = &MockAssignmentReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentReading) EXPECT() *MockAssignmentReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockAssignmentReading) GetByID(ctx context.Context, driverID, vehicleID int64) (*drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, driverID, vehicleID)
+	ret0, _ := ret[0].(*drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockAssignmentReadingMockRecorder) GetByID(ctx, driverID, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockAssignmentReading)(nil).GetByID), ctx, driverID, vehicleID)
+}
+
+// MockVehicleReading is a mock of VehicleReading interface.
+type MockVehicleReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockVehicleReadingMockRecorder
+}
+
+// MockVehicleReadingMockRecorder is the mock recorder for MockVehicleReading.
+type MockVehicleReadingMockRecorder struct {
+	mock *MockVehicleReading
+}
+
+// NewMockVehicleReading creates a new mock instance.
+func NewMockVehicleReading(ctrl *gomock.Controller) *MockVehicleReading {
+	mock := &MockVehicleReading{ctrl: ctrl}
+	mock.recorder = &MockVehicleReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVehicleReading) EXPECT() *MockVehicleReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockVehicleReading) GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockVehicleReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockVehicleReading)(nil).GetByID), ctx, id)
+}
+
+// MockDriverReading is a mock of DriverReading interface.
+type MockDriverReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriverReadingMockRecorder
+}
+
+// MockDriverReadingMockRecorder is the mock recorder for MockDriverReading.
+type MockDriverReadingMockRecorder struct {
+	mock *MockDriverReading
+}
+
+// NewMockDriverReading creates a new mock instance.
+func NewMockDriverReading(ctrl *gomock.Controller) *MockDriverReading {
+	mock := &MockDriverReading{ctrl: ctrl}
+	mock.recorder = &MockDriverReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriverReading) EXPECT() *MockDriverReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockDriverReading) GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userId)
+	ret0, _ := ret[0].(*driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockDriverReadingMockRecorder) GetByUserID(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockDriverReading)(nil).GetByUserID), ctx, userId)
+}
+
+// MockOdometerWriting is a mock of OdometerWriting interface.
+type MockOdometerWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockOdometerWritingMockRecorder
+}
+
+// MockOdometerWritingMockRecorder is the mock recorder for MockOdometerWriting.
+type MockOdometerWritingMockRecorder struct {
+	mock *MockOdometerWriting
+}
+
+// NewMockOdometerWriting creates a new mock instance.
+func NewMockOdometerWriting(ctrl *gomock.Controller) *MockOdometerWriting {
+	mock := &MockOdometerWriting{ctrl: ctrl}
+	mock.recorder = &MockOdometerWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOdometerWriting) EXPECT() *MockOdometerWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOdometerWriting) Create(ctx context.Context, r *odometer.Record) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOdometerWritingMockRecorder) Create(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOdometerWriting)(nil).Create), ctx, r)
+}
+
+// MockTicketWriting is a mock of TicketWriting interface.
+type MockTicketWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockTicketWritingMockRecorder
+}
+
+// MockTicketWritingMockRecorder is the mock recorder for MockTicketWriting.
+type MockTicketWritingMockRecorder struct {
+	mock *MockTicketWriting
+}
+
+// NewMockTicketWriting creates a new mock instance.
+func NewMockTicketWriting(ctrl *gomock.Controller) *MockTicketWriting {
+	mock := &MockTicketWriting{ctrl: ctrl}
+	mock.recorder = &MockTicketWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTicketWriting) EXPECT() *MockTicketWritingMockRecorder {
+	return m.recorder
+}
+
+// CreateTicket mocks base method.
+func (m *MockTicketWriting) CreateTicket(ctx context.Context, t *maintenance.Ticket) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockTicketWritingMockRecorder) CreateTicket(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockTicketWriting)(nil).CreateTicket), ctx, t)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CreateTemplate mocks base method.
+func (m *MockUseCase) CreateTemplate(ctx context.Context, t *checklist.Template) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplate", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplate indicates an expected call of CreateTemplate.
+func (mr *MockUseCaseMockRecorder) CreateTemplate(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockUseCase)(nil).CreateTemplate), ctx, t)
+}
+
+// DeleteTemplate mocks base method.
+func (m *MockUseCase) DeleteTemplate(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplate", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTemplate indicates an expected call of DeleteTemplate.
+func (mr *MockUseCaseMockRecorder) DeleteTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockUseCase)(nil).DeleteTemplate), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockUseCase) GetByID(ctx context.Context, id int64) (*checklist.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*checklist.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUseCase)(nil).GetByID), ctx, id)
+}
+
+// GetTemplate mocks base method.
+func (m *MockUseCase) GetTemplate(ctx context.Context, id int64) (*checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTemplate", ctx, id)
+	ret0, _ := ret[0].(*checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTemplate indicates an expected call of GetTemplate.
+func (mr *MockUseCaseMockRecorder) GetTemplate(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplate", reflect.TypeOf((*MockUseCase)(nil).GetTemplate), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockUseCase) List(ctx context.Context, specification *checklist.ChecklistSpecification) (*[]checklist.Checklist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]checklist.Checklist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
+}
+
+// ListTemplates mocks base method.
+func (m *MockUseCase) ListTemplates(ctx context.Context, specification *checklist.TemplateSpecification) (*[]checklist.Template, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplates", ctx, specification)
+	ret0, _ := ret[0].(*[]checklist.Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplates indicates an expected call of ListTemplates.
+func (mr *MockUseCaseMockRecorder) ListTemplates(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockUseCase)(nil).ListTemplates), ctx, specification)
+}
+
+// Submit mocks base method.
+func (m *MockUseCase) Submit(ctx context.Context, c *checklist.Checklist) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockUseCaseMockRecorder) Submit(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockUseCase)(nil).Submit), ctx, c)
+}
+
+// UpdateTemplate mocks base method.
+func (m *MockUseCase) UpdateTemplate(ctx context.Context, t *checklist.Template) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplate", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateTemplate indicates an expected call of UpdateTemplate.
+func (mr *MockUseCaseMockRecorder) UpdateTemplate(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplate", reflect.TypeOf((*MockUseCase)(nil).UpdateTemplate), ctx, t)
+}
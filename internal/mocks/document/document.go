@@ -0,0 +1,506 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: document/document.go
+//
+// Generated by this command:
+//
+//	mockgen -source=document/document.go -destination=internal/mocks/document/document.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	io "io"
+	reflect "reflect"
+	time "time"
+
+	document "github.com/LucasMateus-eng/operations-service/document"
+	driver "github.com/LucasMateus-eng/operations-service/driver"
+	vehicle "github.com/LucasMateus-eng/operations-service/vehicle"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockReading) GetByID(ctx context.Context, id int64) (*document.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*document.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReading)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockReading) List(ctx context.Context, specification *document.DocumentSpecification) (*[]document.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]document.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriting) Create(ctx context.Context, d *document.Document) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, d)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWritingMockRecorder) Create(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, d)
+}
+
+// Delete mocks base method.
+func (m *MockWriting) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockWritingMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, id)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, d *document.Document) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, d)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, d any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, d)
+}
+
+// Delete mocks base method.
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*document.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*document.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context, specification *document.DocumentSpecification) (*[]document.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]document.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
+}
+
+// MockStorage is a mock of Storage interface.
+type MockStorage struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageMockRecorder
+}
+
+// MockStorageMockRecorder is the mock recorder for MockStorage.
+type MockStorageMockRecorder struct {
+	mock *MockStorage
+}
+
+// NewMockStorage creates a new mock instance.
+func NewMockStorage(ctrl *gomock.Controller) *MockStorage {
+	mock := &MockStorage{ctrl: ctrl}
+	mock.recorder = &MockStorageMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorage) EXPECT() *MockStorageMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockStorageMockRecorder) Delete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockStorage)(nil).Delete), ctx, key)
+}
+
+// Get mocks base method.
+func (m *MockStorage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, key)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get.
+func (mr *MockStorageMockRecorder) Get(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockStorage)(nil).Get), ctx, key)
+}
+
+// Put mocks base method.
+func (m *MockStorage) Put(ctx context.Context, key string, content io.Reader, size int64, contentType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, key, content, size, contentType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockStorageMockRecorder) Put(ctx, key, content, size, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStorage)(nil).Put), ctx, key, content, size, contentType)
+}
+
+// SignURL mocks base method.
+func (m *MockStorage) SignURL(ctx context.Context, key string, expiry time.Duration) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignURL", ctx, key, expiry)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignURL indicates an expected call of SignURL.
+func (mr *MockStorageMockRecorder) SignURL(ctx, key, expiry any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignURL", reflect.TypeOf((*MockStorage)(nil).SignURL), ctx, key, expiry)
+}
+
+// MockDriverReading is a mock of DriverReading interface.
+type MockDriverReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriverReadingMockRecorder
+}
+
+// MockDriverReadingMockRecorder is the mock recorder for MockDriverReading.
+type MockDriverReadingMockRecorder struct {
+	mock *MockDriverReading
+}
+
+// NewMockDriverReading creates a new mock instance.
+func NewMockDriverReading(ctrl *gomock.Controller) *MockDriverReading {
+	mock := &MockDriverReading{ctrl: ctrl}
+	mock.recorder = &MockDriverReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriverReading) EXPECT() *MockDriverReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockDriverReading) GetByID(ctx context.Context, id int64) (*driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockDriverReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockDriverReading)(nil).GetByID), ctx, id)
+}
+
+// MockVehicleReading is a mock of VehicleReading interface.
+type MockVehicleReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockVehicleReadingMockRecorder
+}
+
+// MockVehicleReadingMockRecorder is the mock recorder for MockVehicleReading.
+type MockVehicleReadingMockRecorder struct {
+	mock *MockVehicleReading
+}
+
+// NewMockVehicleReading creates a new mock instance.
+func NewMockVehicleReading(ctrl *gomock.Controller) *MockVehicleReading {
+	mock := &MockVehicleReading{ctrl: ctrl}
+	mock.recorder = &MockVehicleReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVehicleReading) EXPECT() *MockVehicleReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockVehicleReading) GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockVehicleReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockVehicleReading)(nil).GetByID), ctx, id)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockUseCase) Delete(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockUseCaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, id)
+}
+
+// DiscardFiles mocks base method.
+func (m *MockUseCase) DiscardFiles(ctx context.Context, keys []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DiscardFiles", ctx, keys)
+}
+
+// DiscardFiles indicates an expected call of DiscardFiles.
+func (mr *MockUseCaseMockRecorder) DiscardFiles(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardFiles", reflect.TypeOf((*MockUseCase)(nil).DiscardFiles), ctx, keys)
+}
+
+// DriverFiles mocks base method.
+func (m *MockUseCase) DriverFiles(ctx context.Context, driverID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DriverFiles", ctx, driverID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DriverFiles indicates an expected call of DriverFiles.
+func (mr *MockUseCaseMockRecorder) DriverFiles(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DriverFiles", reflect.TypeOf((*MockUseCase)(nil).DriverFiles), ctx, driverID)
+}
+
+// GetByID mocks base method.
+func (m *MockUseCase) GetByID(ctx context.Context, id int64) (*document.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*document.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUseCase)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockUseCase) List(ctx context.Context, specification *document.DocumentSpecification) (*[]document.Document, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]document.Document)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
+}
+
+// SignURL mocks base method.
+func (m *MockUseCase) SignURL(ctx context.Context, id int64) (string, time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignURL", ctx, id)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(time.Time)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// SignURL indicates an expected call of SignURL.
+func (mr *MockUseCaseMockRecorder) SignURL(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignURL", reflect.TypeOf((*MockUseCase)(nil).SignURL), ctx, id)
+}
+
+// Upload mocks base method.
+func (m *MockUseCase) Upload(ctx context.Context, d *document.Document, content io.Reader) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upload", ctx, d, content)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Upload indicates an expected call of Upload.
+func (mr *MockUseCaseMockRecorder) Upload(ctx, d, content any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockUseCase)(nil).Upload), ctx, d, content)
+}
+
+// VehicleFiles mocks base method.
+func (m *MockUseCase) VehicleFiles(ctx context.Context, vehicleID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VehicleFiles", ctx, vehicleID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VehicleFiles indicates an expected call of VehicleFiles.
+func (mr *MockUseCaseMockRecorder) VehicleFiles(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VehicleFiles", reflect.TypeOf((*MockUseCase)(nil).VehicleFiles), ctx, vehicleID)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	driver "github.com/LucasMateus-eng/operations-service/driver"
 	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
@@ -87,6 +88,81 @@ func (mr *MockReadingMockRecorder) GetVehicleListByDriverID(ctx, specification a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicleListByDriverID", reflect.TypeOf((*MockReading)(nil).GetVehicleListByDriverID), ctx, specification)
 }
 
+// ListByDriverIDs mocks base method.
+func (m *MockReading) ListByDriverIDs(ctx context.Context, driverIDs []int64) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByDriverIDs", ctx, driverIDs)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByDriverIDs indicates an expected call of ListByDriverIDs.
+func (mr *MockReadingMockRecorder) ListByDriverIDs(ctx, driverIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDriverIDs", reflect.TypeOf((*MockReading)(nil).ListByDriverIDs), ctx, driverIDs)
+}
+
+// ListByVehicleIDAt mocks base method.
+func (m *MockReading) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDAt", ctx, vehicleID, at)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDAt indicates an expected call of ListByVehicleIDAt.
+func (mr *MockReadingMockRecorder) ListByVehicleIDAt(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDAt", reflect.TypeOf((*MockReading)(nil).ListByVehicleIDAt), ctx, vehicleID, at)
+}
+
+// ListByVehicleIDs mocks base method.
+func (m *MockReading) ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDs", ctx, vehicleIDs)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDs indicates an expected call of ListByVehicleIDs.
+func (mr *MockReadingMockRecorder) ListByVehicleIDs(ctx, vehicleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDs", reflect.TypeOf((*MockReading)(nil).ListByVehicleIDs), ctx, vehicleIDs)
+}
+
+// ListDriverIDsByVehicleID mocks base method.
+func (m *MockReading) ListDriverIDsByVehicleID(ctx context.Context, vehicleID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDriverIDsByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDriverIDsByVehicleID indicates an expected call of ListDriverIDsByVehicleID.
+func (mr *MockReadingMockRecorder) ListDriverIDsByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDriverIDsByVehicleID", reflect.TypeOf((*MockReading)(nil).ListDriverIDsByVehicleID), ctx, vehicleID)
+}
+
+// ListVehicleIDsByDriverID mocks base method.
+func (m *MockReading) ListVehicleIDsByDriverID(ctx context.Context, driverID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVehicleIDsByDriverID", ctx, driverID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVehicleIDsByDriverID indicates an expected call of ListVehicleIDsByDriverID.
+func (mr *MockReadingMockRecorder) ListVehicleIDsByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVehicleIDsByDriverID", reflect.TypeOf((*MockReading)(nil).ListVehicleIDsByDriverID), ctx, driverID)
+}
+
 // MockWriting is a mock of Writing interface.
 type MockWriting struct {
 	ctrl     *gomock.Controller
@@ -139,6 +215,62 @@ func (mr *MockWritingMockRecorder) Delete(ctx, driverID, vehicleID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, driverID, vehicleID)
 }
 
+// EndByDriverID mocks base method.
+func (m *MockWriting) EndByDriverID(ctx context.Context, driverID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndByDriverID", ctx, driverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndByDriverID indicates an expected call of EndByDriverID.
+func (mr *MockWritingMockRecorder) EndByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndByDriverID", reflect.TypeOf((*MockWriting)(nil).EndByDriverID), ctx, driverID)
+}
+
+// EndByVehicleID mocks base method.
+func (m *MockWriting) EndByVehicleID(ctx context.Context, vehicleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndByVehicleID indicates an expected call of EndByVehicleID.
+func (mr *MockWritingMockRecorder) EndByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndByVehicleID", reflect.TypeOf((*MockWriting)(nil).EndByVehicleID), ctx, vehicleID)
+}
+
+// PurgeByDriverID mocks base method.
+func (m *MockWriting) PurgeByDriverID(ctx context.Context, driverID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByDriverID", ctx, driverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByDriverID indicates an expected call of PurgeByDriverID.
+func (mr *MockWritingMockRecorder) PurgeByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByDriverID", reflect.TypeOf((*MockWriting)(nil).PurgeByDriverID), ctx, driverID)
+}
+
+// PurgeByVehicleID mocks base method.
+func (m *MockWriting) PurgeByVehicleID(ctx context.Context, vehicleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByVehicleID indicates an expected call of PurgeByVehicleID.
+func (mr *MockWritingMockRecorder) PurgeByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByVehicleID", reflect.TypeOf((*MockWriting)(nil).PurgeByVehicleID), ctx, vehicleID)
+}
+
 // MockRepository is a mock of Repository interface.
 type MockRepository struct {
 	ctrl     *gomock.Controller
@@ -191,6 +323,34 @@ func (mr *MockRepositoryMockRecorder) Delete(ctx, driverID, vehicleID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, driverID, vehicleID)
 }
 
+// EndByDriverID mocks base method.
+func (m *MockRepository) EndByDriverID(ctx context.Context, driverID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndByDriverID", ctx, driverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndByDriverID indicates an expected call of EndByDriverID.
+func (mr *MockRepositoryMockRecorder) EndByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndByDriverID", reflect.TypeOf((*MockRepository)(nil).EndByDriverID), ctx, driverID)
+}
+
+// EndByVehicleID mocks base method.
+func (m *MockRepository) EndByVehicleID(ctx context.Context, vehicleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndByVehicleID indicates an expected call of EndByVehicleID.
+func (mr *MockRepositoryMockRecorder) EndByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndByVehicleID", reflect.TypeOf((*MockRepository)(nil).EndByVehicleID), ctx, vehicleID)
+}
+
 // GetByID mocks base method.
 func (m *MockRepository) GetByID(ctx context.Context, driverID, vehicleID int64) (*drivervehicle.DriverVehicle, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +396,185 @@ func (mr *MockRepositoryMockRecorder) GetVehicleListByDriverID(ctx, specificatio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicleListByDriverID", reflect.TypeOf((*MockRepository)(nil).GetVehicleListByDriverID), ctx, specification)
 }
 
+// ListByDriverIDs mocks base method.
+func (m *MockRepository) ListByDriverIDs(ctx context.Context, driverIDs []int64) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByDriverIDs", ctx, driverIDs)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByDriverIDs indicates an expected call of ListByDriverIDs.
+func (mr *MockRepositoryMockRecorder) ListByDriverIDs(ctx, driverIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDriverIDs", reflect.TypeOf((*MockRepository)(nil).ListByDriverIDs), ctx, driverIDs)
+}
+
+// ListByVehicleIDAt mocks base method.
+func (m *MockRepository) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDAt", ctx, vehicleID, at)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDAt indicates an expected call of ListByVehicleIDAt.
+func (mr *MockRepositoryMockRecorder) ListByVehicleIDAt(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDAt", reflect.TypeOf((*MockRepository)(nil).ListByVehicleIDAt), ctx, vehicleID, at)
+}
+
+// ListByVehicleIDs mocks base method.
+func (m *MockRepository) ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDs", ctx, vehicleIDs)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDs indicates an expected call of ListByVehicleIDs.
+func (mr *MockRepositoryMockRecorder) ListByVehicleIDs(ctx, vehicleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDs", reflect.TypeOf((*MockRepository)(nil).ListByVehicleIDs), ctx, vehicleIDs)
+}
+
+// ListDriverIDsByVehicleID mocks base method.
+func (m *MockRepository) ListDriverIDsByVehicleID(ctx context.Context, vehicleID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDriverIDsByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDriverIDsByVehicleID indicates an expected call of ListDriverIDsByVehicleID.
+func (mr *MockRepositoryMockRecorder) ListDriverIDsByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDriverIDsByVehicleID", reflect.TypeOf((*MockRepository)(nil).ListDriverIDsByVehicleID), ctx, vehicleID)
+}
+
+// ListVehicleIDsByDriverID mocks base method.
+func (m *MockRepository) ListVehicleIDsByDriverID(ctx context.Context, driverID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVehicleIDsByDriverID", ctx, driverID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVehicleIDsByDriverID indicates an expected call of ListVehicleIDsByDriverID.
+func (mr *MockRepositoryMockRecorder) ListVehicleIDsByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVehicleIDsByDriverID", reflect.TypeOf((*MockRepository)(nil).ListVehicleIDsByDriverID), ctx, driverID)
+}
+
+// PurgeByDriverID mocks base method.
+func (m *MockRepository) PurgeByDriverID(ctx context.Context, driverID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByDriverID", ctx, driverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByDriverID indicates an expected call of PurgeByDriverID.
+func (mr *MockRepositoryMockRecorder) PurgeByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByDriverID", reflect.TypeOf((*MockRepository)(nil).PurgeByDriverID), ctx, driverID)
+}
+
+// PurgeByVehicleID mocks base method.
+func (m *MockRepository) PurgeByVehicleID(ctx context.Context, vehicleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByVehicleID indicates an expected call of PurgeByVehicleID.
+func (mr *MockRepositoryMockRecorder) PurgeByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByVehicleID", reflect.TypeOf((*MockRepository)(nil).PurgeByVehicleID), ctx, vehicleID)
+}
+
+// MockMaintenanceReading is a mock of MaintenanceReading interface.
+type MockMaintenanceReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockMaintenanceReadingMockRecorder
+}
+
+// MockMaintenanceReadingMockRecorder is the mock recorder for MockMaintenanceReading.
+type MockMaintenanceReadingMockRecorder struct {
+	mock *MockMaintenanceReading
+}
+
+// NewMockMaintenanceReading creates a new mock instance.
+func NewMockMaintenanceReading(ctrl *gomock.Controller) *MockMaintenanceReading {
+	mock := &MockMaintenanceReading{ctrl: ctrl}
+	mock.recorder = &MockMaintenanceReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMaintenanceReading) EXPECT() *MockMaintenanceReadingMockRecorder {
+	return m.recorder
+}
+
+// HasOverdueCritical mocks base method.
+func (m *MockMaintenanceReading) HasOverdueCritical(ctx context.Context, vehicleID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOverdueCritical", ctx, vehicleID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOverdueCritical indicates an expected call of HasOverdueCritical.
+func (mr *MockMaintenanceReadingMockRecorder) HasOverdueCritical(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOverdueCritical", reflect.TypeOf((*MockMaintenanceReading)(nil).HasOverdueCritical), ctx, vehicleID)
+}
+
+// MockInsuranceReading is a mock of InsuranceReading interface.
+type MockInsuranceReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockInsuranceReadingMockRecorder
+}
+
+// MockInsuranceReadingMockRecorder is the mock recorder for MockInsuranceReading.
+type MockInsuranceReadingMockRecorder struct {
+	mock *MockInsuranceReading
+}
+
+// NewMockInsuranceReading creates a new mock instance.
+func NewMockInsuranceReading(ctrl *gomock.Controller) *MockInsuranceReading {
+	mock := &MockInsuranceReading{ctrl: ctrl}
+	mock.recorder = &MockInsuranceReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInsuranceReading) EXPECT() *MockInsuranceReadingMockRecorder {
+	return m.recorder
+}
+
+// HasValidPolicy mocks base method.
+func (m *MockInsuranceReading) HasValidPolicy(ctx context.Context, vehicleID int64, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasValidPolicy", ctx, vehicleID, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasValidPolicy indicates an expected call of HasValidPolicy.
+func (mr *MockInsuranceReadingMockRecorder) HasValidPolicy(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasValidPolicy", reflect.TypeOf((*MockInsuranceReading)(nil).HasValidPolicy), ctx, vehicleID, at)
+}
+
 // MockUseCase is a mock of UseCase interface.
 type MockUseCase struct {
 	ctrl     *gomock.Controller
@@ -260,10 +599,10 @@ func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockUseCase) Create(ctx context.Context, dv *drivervehicle.DriverVehicle) (int64, error) {
+func (m *MockUseCase) Create(ctx context.Context, dv *drivervehicle.DriverVehicle) (*drivervehicle.DriverVehicle, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Create", ctx, dv)
-	ret0, _ := ret[0].(int64)
+	ret0, _ := ret[0].(*drivervehicle.DriverVehicle)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -332,3 +671,48 @@ func (mr *MockUseCaseMockRecorder) GetVehicleListByDriverID(ctx, specification a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVehicleListByDriverID", reflect.TypeOf((*MockUseCase)(nil).GetVehicleListByDriverID), ctx, specification)
 }
+
+// ListByDriverIDs mocks base method.
+func (m *MockUseCase) ListByDriverIDs(ctx context.Context, driverIDs []int64) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByDriverIDs", ctx, driverIDs)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByDriverIDs indicates an expected call of ListByDriverIDs.
+func (mr *MockUseCaseMockRecorder) ListByDriverIDs(ctx, driverIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByDriverIDs", reflect.TypeOf((*MockUseCase)(nil).ListByDriverIDs), ctx, driverIDs)
+}
+
+// ListByVehicleIDAt mocks base method.
+func (m *MockUseCase) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDAt", ctx, vehicleID, at)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDAt indicates an expected call of ListByVehicleIDAt.
+func (mr *MockUseCaseMockRecorder) ListByVehicleIDAt(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDAt", reflect.TypeOf((*MockUseCase)(nil).ListByVehicleIDAt), ctx, vehicleID, at)
+}
+
+// ListByVehicleIDs mocks base method.
+func (m *MockUseCase) ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDs", ctx, vehicleIDs)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDs indicates an expected call of ListByVehicleIDs.
+func (mr *MockUseCaseMockRecorder) ListByVehicleIDs(ctx, vehicleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDs", reflect.TypeOf((*MockUseCase)(nil).ListByVehicleIDs), ctx, vehicleIDs)
+}
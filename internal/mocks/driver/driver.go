@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 
 	driver "github.com/LucasMateus-eng/operations-service/driver"
+	user "github.com/LucasMateus-eng/operations-service/user"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -70,6 +71,21 @@ func (mr *MockReadingMockRecorder) GetByIDWithEagerLoading(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithEagerLoading", reflect.TypeOf((*MockReading)(nil).GetByIDWithEagerLoading), ctx, id)
 }
 
+// GetByLegalInformation mocks base method.
+func (m *MockReading) GetByLegalInformation(ctx context.Context, info driver.DriverLegalInformation) (*driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByLegalInformation", ctx, info)
+	ret0, _ := ret[0].(*driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByLegalInformation indicates an expected call of GetByLegalInformation.
+func (mr *MockReadingMockRecorder) GetByLegalInformation(ctx, info any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLegalInformation", reflect.TypeOf((*MockReading)(nil).GetByLegalInformation), ctx, info)
+}
+
 // GetByUserID mocks base method.
 func (m *MockReading) GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +116,20 @@ func (mr *MockReadingMockRecorder) GetByUserIDWithEagerLoading(ctx, userId any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDWithEagerLoading", reflect.TypeOf((*MockReading)(nil).GetByUserIDWithEagerLoading), ctx, userId)
 }
 
+// Iterate mocks base method.
+func (m *MockReading) Iterate(ctx context.Context, specification *driver.DriverSpecification, fn func(*driver.Driver) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, specification, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockReadingMockRecorder) Iterate(ctx, specification, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockReading)(nil).Iterate), ctx, specification, fn)
+}
+
 // List mocks base method.
 func (m *MockReading) List(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +145,36 @@ func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
 }
 
+// ListByIDs mocks base method.
+func (m *MockReading) ListByIDs(ctx context.Context, ids []int64) (*[]driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, ids)
+	ret0, _ := ret[0].(*[]driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockReadingMockRecorder) ListByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockReading)(nil).ListByIDs), ctx, ids)
+}
+
+// ListDeleted mocks base method.
+func (m *MockReading) ListDeleted(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, specification)
+	ret0, _ := ret[0].(*[]driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockReadingMockRecorder) ListDeleted(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockReading)(nil).ListDeleted), ctx, specification)
+}
+
 // ListWithEagerLoading mocks base method.
 func (m *MockReading) ListWithEagerLoading(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -168,18 +228,75 @@ func (mr *MockWritingMockRecorder) Create(ctx, d any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, d)
 }
 
+// CreateWithUser mocks base method.
+func (m *MockWriting) CreateWithUser(ctx context.Context, d *driver.Driver, u *user.User) (*driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithUser", ctx, d, u)
+	ret0, _ := ret[0].(*driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithUser indicates an expected call of CreateWithUser.
+func (mr *MockWritingMockRecorder) CreateWithUser(ctx, d, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithUser", reflect.TypeOf((*MockWriting)(nil).CreateWithUser), ctx, d, u)
+}
+
 // Delete mocks base method.
-func (m *MockWriting) Delete(ctx context.Context, id int64) error {
+func (m *MockWriting) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockWritingMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockWritingMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, id, version)
+}
+
+// Patch mocks base method.
+func (m *MockWriting) Patch(ctx context.Context, d *driver.Driver, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, d, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockWritingMockRecorder) Patch(ctx, d, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockWriting)(nil).Patch), ctx, d, fields)
+}
+
+// Purge mocks base method.
+func (m *MockWriting) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockWritingMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockWriting)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockWriting) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockWritingMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockWriting)(nil).Restore), ctx, id)
 }
 
 // Update mocks base method.
@@ -234,18 +351,33 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, d any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, d)
 }
 
+// CreateWithUser mocks base method.
+func (m *MockRepository) CreateWithUser(ctx context.Context, d *driver.Driver, u *user.User) (*driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithUser", ctx, d, u)
+	ret0, _ := ret[0].(*driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWithUser indicates an expected call of CreateWithUser.
+func (mr *MockRepositoryMockRecorder) CreateWithUser(ctx, d, u any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithUser", reflect.TypeOf((*MockRepository)(nil).CreateWithUser), ctx, d, u)
+}
+
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+func (m *MockRepository) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id, version)
 }
 
 // GetByID mocks base method.
@@ -278,6 +410,21 @@ func (mr *MockRepositoryMockRecorder) GetByIDWithEagerLoading(ctx, id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByIDWithEagerLoading", reflect.TypeOf((*MockRepository)(nil).GetByIDWithEagerLoading), ctx, id)
 }
 
+// GetByLegalInformation mocks base method.
+func (m *MockRepository) GetByLegalInformation(ctx context.Context, info driver.DriverLegalInformation) (*driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByLegalInformation", ctx, info)
+	ret0, _ := ret[0].(*driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByLegalInformation indicates an expected call of GetByLegalInformation.
+func (mr *MockRepositoryMockRecorder) GetByLegalInformation(ctx, info any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByLegalInformation", reflect.TypeOf((*MockRepository)(nil).GetByLegalInformation), ctx, info)
+}
+
 // GetByUserID mocks base method.
 func (m *MockRepository) GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -308,6 +455,20 @@ func (mr *MockRepositoryMockRecorder) GetByUserIDWithEagerLoading(ctx, userId an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDWithEagerLoading", reflect.TypeOf((*MockRepository)(nil).GetByUserIDWithEagerLoading), ctx, userId)
 }
 
+// Iterate mocks base method.
+func (m *MockRepository) Iterate(ctx context.Context, specification *driver.DriverSpecification, fn func(*driver.Driver) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, specification, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockRepositoryMockRecorder) Iterate(ctx, specification, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockRepository)(nil).Iterate), ctx, specification, fn)
+}
+
 // List mocks base method.
 func (m *MockRepository) List(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -323,6 +484,36 @@ func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
 }
 
+// ListByIDs mocks base method.
+func (m *MockRepository) ListByIDs(ctx context.Context, ids []int64) (*[]driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, ids)
+	ret0, _ := ret[0].(*[]driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockRepositoryMockRecorder) ListByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockRepository)(nil).ListByIDs), ctx, ids)
+}
+
+// ListDeleted mocks base method.
+func (m *MockRepository) ListDeleted(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, specification)
+	ret0, _ := ret[0].(*[]driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockRepositoryMockRecorder) ListDeleted(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepository)(nil).ListDeleted), ctx, specification)
+}
+
 // ListWithEagerLoading mocks base method.
 func (m *MockRepository) ListWithEagerLoading(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -338,6 +529,48 @@ func (mr *MockRepositoryMockRecorder) ListWithEagerLoading(ctx, specification an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithEagerLoading", reflect.TypeOf((*MockRepository)(nil).ListWithEagerLoading), ctx, specification)
 }
 
+// Patch mocks base method.
+func (m *MockRepository) Patch(ctx context.Context, d *driver.Driver, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, d, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockRepositoryMockRecorder) Patch(ctx, d, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockRepository)(nil).Patch), ctx, d, fields)
+}
+
+// Purge mocks base method.
+func (m *MockRepository) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockRepositoryMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockRepository)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockRepository) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, d *driver.Driver) error {
 	m.ctrl.T.Helper()
@@ -391,17 +624,31 @@ func (mr *MockUseCaseMockRecorder) Create(ctx, d any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockUseCase) Delete(ctx context.Context, id int64) error {
+func (m *MockUseCase) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockUseCaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, id, version)
+}
+
+// Export mocks base method.
+func (m *MockUseCase) Export(ctx context.Context, specification *driver.DriverSpecification, fn func(*driver.Driver) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, specification, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUseCaseMockRecorder) Export(ctx, specification, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUseCase)(nil).Export), ctx, specification, fn)
 }
 
 // GetByID mocks base method.
@@ -464,6 +711,21 @@ func (mr *MockUseCaseMockRecorder) GetByUserIDWithEagerLoading(ctx, userId any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserIDWithEagerLoading", reflect.TypeOf((*MockUseCase)(nil).GetByUserIDWithEagerLoading), ctx, userId)
 }
 
+// Import mocks base method.
+func (m *MockUseCase) Import(ctx context.Context, rows []driver.ImportRow) (*driver.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, rows)
+	ret0, _ := ret[0].(*driver.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockUseCaseMockRecorder) Import(ctx, rows any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockUseCase)(nil).Import), ctx, rows)
+}
+
 // List mocks base method.
 func (m *MockUseCase) List(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -479,6 +741,36 @@ func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
 }
 
+// ListByIDs mocks base method.
+func (m *MockUseCase) ListByIDs(ctx context.Context, ids []int64) (*[]driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, ids)
+	ret0, _ := ret[0].(*[]driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockUseCaseMockRecorder) ListByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockUseCase)(nil).ListByIDs), ctx, ids)
+}
+
+// ListDeleted mocks base method.
+func (m *MockUseCase) ListDeleted(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, specification)
+	ret0, _ := ret[0].(*[]driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockUseCaseMockRecorder) ListDeleted(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockUseCase)(nil).ListDeleted), ctx, specification)
+}
+
 // ListWithEagerLoading mocks base method.
 func (m *MockUseCase) ListWithEagerLoading(ctx context.Context, specification *driver.DriverSpecification) (*[]driver.Driver, error) {
 	m.ctrl.T.Helper()
@@ -494,6 +786,34 @@ func (mr *MockUseCaseMockRecorder) ListWithEagerLoading(ctx, specification any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWithEagerLoading", reflect.TypeOf((*MockUseCase)(nil).ListWithEagerLoading), ctx, specification)
 }
 
+// Patch mocks base method.
+func (m *MockUseCase) Patch(ctx context.Context, d *driver.Driver, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, d, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockUseCaseMockRecorder) Patch(ctx, d, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockUseCase)(nil).Patch), ctx, d, fields)
+}
+
+// Restore mocks base method.
+func (m *MockUseCase) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUseCaseMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUseCase)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockUseCase) Update(ctx context.Context, d *driver.Driver) error {
 	m.ctrl.T.Helper()
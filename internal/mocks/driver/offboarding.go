@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: driver/offboarding.go
+//
+// Generated by this command:
+//
+//	mockgen -source=driver/offboarding.go -destination=internal/mocks/driver/offboarding.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAssignmentReading is a mock of AssignmentReading interface.
+type MockAssignmentReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentReadingMockRecorder
+}
+
+// MockAssignmentReadingMockRecorder is the mock recorder for MockAssignmentReading.
+type MockAssignmentReadingMockRecorder struct {
+	mock *MockAssignmentReading
+}
+
+// NewMockAssignmentReading creates a new mock instance.
+func NewMockAssignmentReading(ctrl *gomock.Controller) *MockAssignmentReading {
+	mock := &MockAssignmentReading{ctrl: ctrl}
+	mock.recorder = &MockAssignmentReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentReading) EXPECT() *MockAssignmentReadingMockRecorder {
+	return m.recorder
+}
+
+// ListVehicleIDsByDriverID mocks base method.
+func (m *MockAssignmentReading) ListVehicleIDsByDriverID(ctx context.Context, driverID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVehicleIDsByDriverID", ctx, driverID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVehicleIDsByDriverID indicates an expected call of ListVehicleIDsByDriverID.
+func (mr *MockAssignmentReadingMockRecorder) ListVehicleIDsByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVehicleIDsByDriverID", reflect.TypeOf((*MockAssignmentReading)(nil).ListVehicleIDsByDriverID), ctx, driverID)
+}
+
+// MockAssignmentWriting is a mock of AssignmentWriting interface.
+type MockAssignmentWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentWritingMockRecorder
+}
+
+// MockAssignmentWritingMockRecorder is the mock recorder for MockAssignmentWriting.
+type MockAssignmentWritingMockRecorder struct {
+	mock *MockAssignmentWriting
+}
+
+// NewMockAssignmentWriting creates a new mock instance.
+func NewMockAssignmentWriting(ctrl *gomock.Controller) *MockAssignmentWriting {
+	mock := &MockAssignmentWriting{ctrl: ctrl}
+	mock.recorder = &MockAssignmentWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentWriting) EXPECT() *MockAssignmentWritingMockRecorder {
+	return m.recorder
+}
+
+// EndByDriverID mocks base method.
+func (m *MockAssignmentWriting) EndByDriverID(ctx context.Context, driverID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndByDriverID", ctx, driverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndByDriverID indicates an expected call of EndByDriverID.
+func (mr *MockAssignmentWritingMockRecorder) EndByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndByDriverID", reflect.TypeOf((*MockAssignmentWriting)(nil).EndByDriverID), ctx, driverID)
+}
+
+// PurgeByDriverID mocks base method.
+func (m *MockAssignmentWriting) PurgeByDriverID(ctx context.Context, driverID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByDriverID", ctx, driverID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByDriverID indicates an expected call of PurgeByDriverID.
+func (mr *MockAssignmentWritingMockRecorder) PurgeByDriverID(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByDriverID", reflect.TypeOf((*MockAssignmentWriting)(nil).PurgeByDriverID), ctx, driverID)
+}
+
+// MockDocumentPurging is a mock of DocumentPurging interface.
+type MockDocumentPurging struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentPurgingMockRecorder
+}
+
+// MockDocumentPurgingMockRecorder is the mock recorder for MockDocumentPurging.
+type MockDocumentPurgingMockRecorder struct {
+	mock *MockDocumentPurging
+}
+
+// NewMockDocumentPurging creates a new mock instance.
+func NewMockDocumentPurging(ctrl *gomock.Controller) *MockDocumentPurging {
+	mock := &MockDocumentPurging{ctrl: ctrl}
+	mock.recorder = &MockDocumentPurgingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentPurging) EXPECT() *MockDocumentPurgingMockRecorder {
+	return m.recorder
+}
+
+// DiscardFiles mocks base method.
+func (m *MockDocumentPurging) DiscardFiles(ctx context.Context, keys []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DiscardFiles", ctx, keys)
+}
+
+// DiscardFiles indicates an expected call of DiscardFiles.
+func (mr *MockDocumentPurgingMockRecorder) DiscardFiles(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardFiles", reflect.TypeOf((*MockDocumentPurging)(nil).DiscardFiles), ctx, keys)
+}
+
+// DriverFiles mocks base method.
+func (m *MockDocumentPurging) DriverFiles(ctx context.Context, driverID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DriverFiles", ctx, driverID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DriverFiles indicates an expected call of DriverFiles.
+func (mr *MockDocumentPurgingMockRecorder) DriverFiles(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DriverFiles", reflect.TypeOf((*MockDocumentPurging)(nil).DriverFiles), ctx, driverID)
+}
+
+// MockOffboardingUseCase is a mock of OffboardingUseCase interface.
+type MockOffboardingUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockOffboardingUseCaseMockRecorder
+}
+
+// MockOffboardingUseCaseMockRecorder is the mock recorder for MockOffboardingUseCase.
+type MockOffboardingUseCaseMockRecorder struct {
+	mock *MockOffboardingUseCase
+}
+
+// NewMockOffboardingUseCase creates a new mock instance.
+func NewMockOffboardingUseCase(ctrl *gomock.Controller) *MockOffboardingUseCase {
+	mock := &MockOffboardingUseCase{ctrl: ctrl}
+	mock.recorder = &MockOffboardingUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOffboardingUseCase) EXPECT() *MockOffboardingUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Offboard mocks base method.
+func (m *MockOffboardingUseCase) Offboard(ctx context.Context, id, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Offboard", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Offboard indicates an expected call of Offboard.
+func (mr *MockOffboardingUseCaseMockRecorder) Offboard(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Offboard", reflect.TypeOf((*MockOffboardingUseCase)(nil).Offboard), ctx, id, version)
+}
+
+// Purge mocks base method.
+func (m *MockOffboardingUseCase) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockOffboardingUseCaseMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockOffboardingUseCase)(nil).Purge), ctx, id)
+}
@@ -0,0 +1,472 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fine/fine.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fine/fine.go -destination=internal/mocks/fine/fine.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	fine "github.com/LucasMateus-eng/operations-service/fine"
+	vehicle "github.com/LucasMateus-eng/operations-service/vehicle"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockReading) GetByID(ctx context.Context, id int64) (*fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReading)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockReading) List(ctx context.Context, specification *fine.FineSpecification) (*[]fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
+}
+
+// ListStandings mocks base method.
+func (m *MockReading) ListStandings(ctx context.Context, since time.Time, minimumPoints int) (*[]fine.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStandings", ctx, since, minimumPoints)
+	ret0, _ := ret[0].(*[]fine.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStandings indicates an expected call of ListStandings.
+func (mr *MockReadingMockRecorder) ListStandings(ctx, since, minimumPoints any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStandings", reflect.TypeOf((*MockReading)(nil).ListStandings), ctx, since, minimumPoints)
+}
+
+// Standing mocks base method.
+func (m *MockReading) Standing(ctx context.Context, driverID int64, since time.Time) (*fine.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Standing", ctx, driverID, since)
+	ret0, _ := ret[0].(*fine.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Standing indicates an expected call of Standing.
+func (mr *MockReadingMockRecorder) Standing(ctx, driverID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Standing", reflect.TypeOf((*MockReading)(nil).Standing), ctx, driverID, since)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriting) Create(ctx context.Context, f *fine.Fine) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, f)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWritingMockRecorder) Create(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, f)
+}
+
+// Update mocks base method.
+func (m *MockWriting) Update(ctx context.Context, f *fine.Fine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWritingMockRecorder) Update(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWriting)(nil).Update), ctx, f)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, f *fine.Fine) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, f)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context, specification *fine.FineSpecification) (*[]fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
+}
+
+// ListStandings mocks base method.
+func (m *MockRepository) ListStandings(ctx context.Context, since time.Time, minimumPoints int) (*[]fine.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStandings", ctx, since, minimumPoints)
+	ret0, _ := ret[0].(*[]fine.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStandings indicates an expected call of ListStandings.
+func (mr *MockRepositoryMockRecorder) ListStandings(ctx, since, minimumPoints any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStandings", reflect.TypeOf((*MockRepository)(nil).ListStandings), ctx, since, minimumPoints)
+}
+
+// Standing mocks base method.
+func (m *MockRepository) Standing(ctx context.Context, driverID int64, since time.Time) (*fine.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Standing", ctx, driverID, since)
+	ret0, _ := ret[0].(*fine.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Standing indicates an expected call of Standing.
+func (mr *MockRepositoryMockRecorder) Standing(ctx, driverID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Standing", reflect.TypeOf((*MockRepository)(nil).Standing), ctx, driverID, since)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, f *fine.Fine) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, f)
+}
+
+// MockVehicleReading is a mock of VehicleReading interface.
+type MockVehicleReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockVehicleReadingMockRecorder
+}
+
+// MockVehicleReadingMockRecorder is the mock recorder for MockVehicleReading.
+type MockVehicleReadingMockRecorder struct {
+	mock *MockVehicleReading
+}
+
+// NewMockVehicleReading creates a new mock instance.
+func NewMockVehicleReading(ctrl *gomock.Controller) *MockVehicleReading {
+	mock := &MockVehicleReading{ctrl: ctrl}
+	mock.recorder = &MockVehicleReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVehicleReading) EXPECT() *MockVehicleReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByPlate mocks base method.
+func (m *MockVehicleReading) GetByPlate(ctx context.Context, plate string) (*vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByPlate", ctx, plate)
+	ret0, _ := ret[0].(*vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByPlate indicates an expected call of GetByPlate.
+func (mr *MockVehicleReadingMockRecorder) GetByPlate(ctx, plate any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByPlate", reflect.TypeOf((*MockVehicleReading)(nil).GetByPlate), ctx, plate)
+}
+
+// MockAssignmentReading is a mock of AssignmentReading interface.
+type MockAssignmentReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentReadingMockRecorder
+}
+
+// MockAssignmentReadingMockRecorder is the mock recorder for MockAssignmentReading.
+type MockAssignmentReadingMockRecorder struct {
+	mock *MockAssignmentReading
+}
+
+// NewMockAssignmentReading creates a new mock instance.
+func NewMockAssignmentReading(ctrl *gomock.Controller) *MockAssignmentReading {
+	mock := &MockAssignmentReading{ctrl: ctrl}
+	mock.recorder = &MockAssignmentReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentReading) EXPECT() *MockAssignmentReadingMockRecorder {
+	return m.recorder
+}
+
+// ListByVehicleIDAt mocks base method.
+func (m *MockAssignmentReading) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDAt", ctx, vehicleID, at)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDAt indicates an expected call of ListByVehicleIDAt.
+func (mr *MockAssignmentReadingMockRecorder) ListByVehicleIDAt(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDAt", reflect.TypeOf((*MockAssignmentReading)(nil).ListByVehicleIDAt), ctx, vehicleID, at)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUseCase) Create(ctx context.Context, f *fine.Fine) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, f)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUseCaseMockRecorder) Create(ctx, f any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), ctx, f)
+}
+
+// GetByID mocks base method.
+func (m *MockUseCase) GetByID(ctx context.Context, id int64) (*fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUseCase)(nil).GetByID), ctx, id)
+}
+
+// Indicate mocks base method.
+func (m *MockUseCase) Indicate(ctx context.Context, id, driverID int64) (*fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Indicate", ctx, id, driverID)
+	ret0, _ := ret[0].(*fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Indicate indicates an expected call of Indicate.
+func (mr *MockUseCaseMockRecorder) Indicate(ctx, id, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Indicate", reflect.TypeOf((*MockUseCase)(nil).Indicate), ctx, id, driverID)
+}
+
+// List mocks base method.
+func (m *MockUseCase) List(ctx context.Context, specification *fine.FineSpecification) (*[]fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
+}
+
+// ListAlerts mocks base method.
+func (m *MockUseCase) ListAlerts(ctx context.Context) (*[]fine.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlerts", ctx)
+	ret0, _ := ret[0].(*[]fine.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlerts indicates an expected call of ListAlerts.
+func (mr *MockUseCaseMockRecorder) ListAlerts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockUseCase)(nil).ListAlerts), ctx)
+}
+
+// Pay mocks base method.
+func (m *MockUseCase) Pay(ctx context.Context, id int64, paidAt time.Time) (*fine.Fine, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pay", ctx, id, paidAt)
+	ret0, _ := ret[0].(*fine.Fine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Pay indicates an expected call of Pay.
+func (mr *MockUseCaseMockRecorder) Pay(ctx, id, paidAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pay", reflect.TypeOf((*MockUseCase)(nil).Pay), ctx, id, paidAt)
+}
+
+// Standing mocks base method.
+func (m *MockUseCase) Standing(ctx context.Context, driverID int64) (*fine.Standing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Standing", ctx, driverID)
+	ret0, _ := ret[0].(*fine.Standing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Standing indicates an expected call of Standing.
+func (mr *MockUseCaseMockRecorder) Standing(ctx, driverID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Standing", reflect.TypeOf((*MockUseCase)(nil).Standing), ctx, driverID)
+}
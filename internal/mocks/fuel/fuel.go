@@ -0,0 +1,369 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: fuel/fuel.go
+//
+// Generated by this command:
+//
+//	mockgen -source=fuel/fuel.go -destination=internal/mocks/fuel/fuel.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	fuel "github.com/LucasMateus-eng/operations-service/fuel"
+	odometer "github.com/LucasMateus-eng/operations-service/odometer"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockReading) GetByID(ctx context.Context, id int64) (*fuel.Refuel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*fuel.Refuel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReading)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockReading) List(ctx context.Context, specification *fuel.RefuelSpecification) (*[]fuel.Refuel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]fuel.Refuel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
+}
+
+// ListSegments mocks base method.
+func (m *MockReading) ListSegments(ctx context.Context, specification *fuel.EfficiencySpecification) (*[]fuel.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSegments", ctx, specification)
+	ret0, _ := ret[0].(*[]fuel.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSegments indicates an expected call of ListSegments.
+func (mr *MockReadingMockRecorder) ListSegments(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegments", reflect.TypeOf((*MockReading)(nil).ListSegments), ctx, specification)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriting) Create(ctx context.Context, r *fuel.Refuel) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWritingMockRecorder) Create(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, r)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, r *fuel.Refuel) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, r)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*fuel.Refuel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*fuel.Refuel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context, specification *fuel.RefuelSpecification) (*[]fuel.Refuel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]fuel.Refuel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
+}
+
+// ListSegments mocks base method.
+func (m *MockRepository) ListSegments(ctx context.Context, specification *fuel.EfficiencySpecification) (*[]fuel.Segment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSegments", ctx, specification)
+	ret0, _ := ret[0].(*[]fuel.Segment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSegments indicates an expected call of ListSegments.
+func (mr *MockRepositoryMockRecorder) ListSegments(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSegments", reflect.TypeOf((*MockRepository)(nil).ListSegments), ctx, specification)
+}
+
+// MockAssignmentReading is a mock of AssignmentReading interface.
+type MockAssignmentReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentReadingMockRecorder
+}
+
+// MockAssignmentReadingMockRecorder is the mock recorder for MockAssignmentReading.
+type MockAssignmentReadingMockRecorder struct {
+	mock *MockAssignmentReading
+}
+
+// NewMockAssignmentReading creates a new mock instance.
+func NewMockAssignmentReading(ctrl *gomock.Controller) *MockAssignmentReading {
+	mock := &MockAssignmentReading{ctrl: ctrl}
+	mock.recorder = &MockAssignmentReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentReading) EXPECT() *MockAssignmentReadingMockRecorder {
+	return m.recorder
+}
+
+// ListByVehicleIDAt mocks base method.
+func (m *MockAssignmentReading) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDAt", ctx, vehicleID, at)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDAt indicates an expected call of ListByVehicleIDAt.
+func (mr *MockAssignmentReadingMockRecorder) ListByVehicleIDAt(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDAt", reflect.TypeOf((*MockAssignmentReading)(nil).ListByVehicleIDAt), ctx, vehicleID, at)
+}
+
+// MockOdometerWriting is a mock of OdometerWriting interface.
+type MockOdometerWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockOdometerWritingMockRecorder
+}
+
+// MockOdometerWritingMockRecorder is the mock recorder for MockOdometerWriting.
+type MockOdometerWritingMockRecorder struct {
+	mock *MockOdometerWriting
+}
+
+// NewMockOdometerWriting creates a new mock instance.
+func NewMockOdometerWriting(ctrl *gomock.Controller) *MockOdometerWriting {
+	mock := &MockOdometerWriting{ctrl: ctrl}
+	mock.recorder = &MockOdometerWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOdometerWriting) EXPECT() *MockOdometerWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockOdometerWriting) Create(ctx context.Context, r *odometer.Record) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockOdometerWritingMockRecorder) Create(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockOdometerWriting)(nil).Create), ctx, r)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockUseCase) Create(ctx context.Context, r *fuel.Refuel) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, r)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUseCaseMockRecorder) Create(ctx, r any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), ctx, r)
+}
+
+// Efficiency mocks base method.
+func (m *MockUseCase) Efficiency(ctx context.Context, specification *fuel.EfficiencySpecification) (*fuel.Efficiency, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Efficiency", ctx, specification)
+	ret0, _ := ret[0].(*fuel.Efficiency)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Efficiency indicates an expected call of Efficiency.
+func (mr *MockUseCaseMockRecorder) Efficiency(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Efficiency", reflect.TypeOf((*MockUseCase)(nil).Efficiency), ctx, specification)
+}
+
+// GetByID mocks base method.
+func (m *MockUseCase) GetByID(ctx context.Context, id int64) (*fuel.Refuel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*fuel.Refuel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUseCase)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockUseCase) List(ctx context.Context, specification *fuel.RefuelSpecification) (*[]fuel.Refuel, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]fuel.Refuel)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
+}
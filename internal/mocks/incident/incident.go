@@ -0,0 +1,390 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: incident/incident.go
+//
+// Generated by this command:
+//
+//	mockgen -source=incident/incident.go -destination=internal/mocks/incident/incident.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	incident "github.com/LucasMateus-eng/operations-service/incident"
+	vehicle "github.com/LucasMateus-eng/operations-service/vehicle"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockReading) GetByID(ctx context.Context, id int64) (*incident.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*incident.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReading)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockReading) List(ctx context.Context, specification *incident.IncidentSpecification) (*[]incident.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]incident.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriting) Create(ctx context.Context, i *incident.Incident) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, i)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWritingMockRecorder) Create(ctx, i any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, i)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, i *incident.Incident) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, i)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, i any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, i)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*incident.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*incident.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context, specification *incident.IncidentSpecification) (*[]incident.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]incident.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
+}
+
+// MockVehicleWriting is a mock of VehicleWriting interface.
+type MockVehicleWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockVehicleWritingMockRecorder
+}
+
+// MockVehicleWritingMockRecorder is the mock recorder for MockVehicleWriting.
+type MockVehicleWritingMockRecorder struct {
+	mock *MockVehicleWriting
+}
+
+// NewMockVehicleWriting creates a new mock instance.
+func NewMockVehicleWriting(ctrl *gomock.Controller) *MockVehicleWriting {
+	mock := &MockVehicleWriting{ctrl: ctrl}
+	mock.recorder = &MockVehicleWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVehicleWriting) EXPECT() *MockVehicleWritingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockVehicleWriting) GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockVehicleWritingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockVehicleWriting)(nil).GetByID), ctx, id)
+}
+
+// Patch mocks base method.
+func (m *MockVehicleWriting) Patch(ctx context.Context, v *vehicle.Vehicle, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, v, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockVehicleWritingMockRecorder) Patch(ctx, v, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockVehicleWriting)(nil).Patch), ctx, v, fields)
+}
+
+// MockAssignmentReading is a mock of AssignmentReading interface.
+type MockAssignmentReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentReadingMockRecorder
+}
+
+// MockAssignmentReadingMockRecorder is the mock recorder for MockAssignmentReading.
+type MockAssignmentReadingMockRecorder struct {
+	mock *MockAssignmentReading
+}
+
+// NewMockAssignmentReading creates a new mock instance.
+func NewMockAssignmentReading(ctrl *gomock.Controller) *MockAssignmentReading {
+	mock := &MockAssignmentReading{ctrl: ctrl}
+	mock.recorder = &MockAssignmentReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentReading) EXPECT() *MockAssignmentReadingMockRecorder {
+	return m.recorder
+}
+
+// ListByVehicleIDAt mocks base method.
+func (m *MockAssignmentReading) ListByVehicleIDAt(ctx context.Context, vehicleID int64, at time.Time) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDAt", ctx, vehicleID, at)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDAt indicates an expected call of ListByVehicleIDAt.
+func (mr *MockAssignmentReadingMockRecorder) ListByVehicleIDAt(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDAt", reflect.TypeOf((*MockAssignmentReading)(nil).ListByVehicleIDAt), ctx, vehicleID, at)
+}
+
+// ListByVehicleIDs mocks base method.
+func (m *MockAssignmentReading) ListByVehicleIDs(ctx context.Context, vehicleIDs []int64) (*[]drivervehicle.DriverVehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByVehicleIDs", ctx, vehicleIDs)
+	ret0, _ := ret[0].(*[]drivervehicle.DriverVehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByVehicleIDs indicates an expected call of ListByVehicleIDs.
+func (mr *MockAssignmentReadingMockRecorder) ListByVehicleIDs(ctx, vehicleIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByVehicleIDs", reflect.TypeOf((*MockAssignmentReading)(nil).ListByVehicleIDs), ctx, vehicleIDs)
+}
+
+// MockAssignmentWriting is a mock of AssignmentWriting interface.
+type MockAssignmentWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentWritingMockRecorder
+}
+
+// MockAssignmentWritingMockRecorder is the mock recorder for MockAssignmentWriting.
+type MockAssignmentWritingMockRecorder struct {
+	mock *MockAssignmentWriting
+}
+
+// NewMockAssignmentWriting creates a new mock instance.
+func NewMockAssignmentWriting(ctrl *gomock.Controller) *MockAssignmentWriting {
+	mock := &MockAssignmentWriting{ctrl: ctrl}
+	mock.recorder = &MockAssignmentWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentWriting) EXPECT() *MockAssignmentWritingMockRecorder {
+	return m.recorder
+}
+
+// EndByVehicleID mocks base method.
+func (m *MockAssignmentWriting) EndByVehicleID(ctx context.Context, vehicleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndByVehicleID indicates an expected call of EndByVehicleID.
+func (mr *MockAssignmentWritingMockRecorder) EndByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndByVehicleID", reflect.TypeOf((*MockAssignmentWriting)(nil).EndByVehicleID), ctx, vehicleID)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockUseCase) GetByID(ctx context.Context, id int64) (*incident.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*incident.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUseCase)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockUseCase) List(ctx context.Context, specification *incident.IncidentSpecification) (*[]incident.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]incident.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
+}
+
+// Report mocks base method.
+func (m *MockUseCase) Report(ctx context.Context, i *incident.Incident) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, i)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockUseCaseMockRecorder) Report(ctx, i any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockUseCase)(nil).Report), ctx, i)
+}
@@ -0,0 +1,619 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: insurance/insurance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=insurance/insurance.go -destination=internal/mocks/insurance/insurance.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	incident "github.com/LucasMateus-eng/operations-service/incident"
+	insurance "github.com/LucasMateus-eng/operations-service/insurance"
+	vehicle "github.com/LucasMateus-eng/operations-service/vehicle"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// GetClaim mocks base method.
+func (m *MockReading) GetClaim(ctx context.Context, id int64) (*insurance.Claim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClaim", ctx, id)
+	ret0, _ := ret[0].(*insurance.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClaim indicates an expected call of GetClaim.
+func (mr *MockReadingMockRecorder) GetClaim(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaim", reflect.TypeOf((*MockReading)(nil).GetClaim), ctx, id)
+}
+
+// GetPolicy mocks base method.
+func (m *MockReading) GetPolicy(ctx context.Context, id int64) (*insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, id)
+	ret0, _ := ret[0].(*insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockReadingMockRecorder) GetPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockReading)(nil).GetPolicy), ctx, id)
+}
+
+// HasValidPolicy mocks base method.
+func (m *MockReading) HasValidPolicy(ctx context.Context, vehicleID int64, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasValidPolicy", ctx, vehicleID, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasValidPolicy indicates an expected call of HasValidPolicy.
+func (mr *MockReadingMockRecorder) HasValidPolicy(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasValidPolicy", reflect.TypeOf((*MockReading)(nil).HasValidPolicy), ctx, vehicleID, at)
+}
+
+// ListClaims mocks base method.
+func (m *MockReading) ListClaims(ctx context.Context, specification *insurance.ClaimSpecification) (*[]insurance.Claim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClaims", ctx, specification)
+	ret0, _ := ret[0].(*[]insurance.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaims indicates an expected call of ListClaims.
+func (mr *MockReadingMockRecorder) ListClaims(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaims", reflect.TypeOf((*MockReading)(nil).ListClaims), ctx, specification)
+}
+
+// ListExpiring mocks base method.
+func (m *MockReading) ListExpiring(ctx context.Context, now, until time.Time) (*[]insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiring", ctx, now, until)
+	ret0, _ := ret[0].(*[]insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiring indicates an expected call of ListExpiring.
+func (mr *MockReadingMockRecorder) ListExpiring(ctx, now, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiring", reflect.TypeOf((*MockReading)(nil).ListExpiring), ctx, now, until)
+}
+
+// ListPolicies mocks base method.
+func (m *MockReading) ListPolicies(ctx context.Context, specification *insurance.PolicySpecification) (*[]insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", ctx, specification)
+	ret0, _ := ret[0].(*[]insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockReadingMockRecorder) ListPolicies(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockReading)(nil).ListPolicies), ctx, specification)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// CreateClaim mocks base method.
+func (m *MockWriting) CreateClaim(ctx context.Context, c *insurance.Claim) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClaim", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClaim indicates an expected call of CreateClaim.
+func (mr *MockWritingMockRecorder) CreateClaim(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClaim", reflect.TypeOf((*MockWriting)(nil).CreateClaim), ctx, c)
+}
+
+// CreatePolicy mocks base method.
+func (m *MockWriting) CreatePolicy(ctx context.Context, p *insurance.Policy) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockWritingMockRecorder) CreatePolicy(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockWriting)(nil).CreatePolicy), ctx, p)
+}
+
+// UpdateClaim mocks base method.
+func (m *MockWriting) UpdateClaim(ctx context.Context, c *insurance.Claim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClaim", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClaim indicates an expected call of UpdateClaim.
+func (mr *MockWritingMockRecorder) UpdateClaim(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClaim", reflect.TypeOf((*MockWriting)(nil).UpdateClaim), ctx, c)
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockWriting) UpdatePolicy(ctx context.Context, p *insurance.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockWritingMockRecorder) UpdatePolicy(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockWriting)(nil).UpdatePolicy), ctx, p)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateClaim mocks base method.
+func (m *MockRepository) CreateClaim(ctx context.Context, c *insurance.Claim) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateClaim", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateClaim indicates an expected call of CreateClaim.
+func (mr *MockRepositoryMockRecorder) CreateClaim(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateClaim", reflect.TypeOf((*MockRepository)(nil).CreateClaim), ctx, c)
+}
+
+// CreatePolicy mocks base method.
+func (m *MockRepository) CreatePolicy(ctx context.Context, p *insurance.Policy) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockRepositoryMockRecorder) CreatePolicy(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockRepository)(nil).CreatePolicy), ctx, p)
+}
+
+// GetClaim mocks base method.
+func (m *MockRepository) GetClaim(ctx context.Context, id int64) (*insurance.Claim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClaim", ctx, id)
+	ret0, _ := ret[0].(*insurance.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClaim indicates an expected call of GetClaim.
+func (mr *MockRepositoryMockRecorder) GetClaim(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaim", reflect.TypeOf((*MockRepository)(nil).GetClaim), ctx, id)
+}
+
+// GetPolicy mocks base method.
+func (m *MockRepository) GetPolicy(ctx context.Context, id int64) (*insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, id)
+	ret0, _ := ret[0].(*insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockRepositoryMockRecorder) GetPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockRepository)(nil).GetPolicy), ctx, id)
+}
+
+// HasValidPolicy mocks base method.
+func (m *MockRepository) HasValidPolicy(ctx context.Context, vehicleID int64, at time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasValidPolicy", ctx, vehicleID, at)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasValidPolicy indicates an expected call of HasValidPolicy.
+func (mr *MockRepositoryMockRecorder) HasValidPolicy(ctx, vehicleID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasValidPolicy", reflect.TypeOf((*MockRepository)(nil).HasValidPolicy), ctx, vehicleID, at)
+}
+
+// ListClaims mocks base method.
+func (m *MockRepository) ListClaims(ctx context.Context, specification *insurance.ClaimSpecification) (*[]insurance.Claim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClaims", ctx, specification)
+	ret0, _ := ret[0].(*[]insurance.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaims indicates an expected call of ListClaims.
+func (mr *MockRepositoryMockRecorder) ListClaims(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaims", reflect.TypeOf((*MockRepository)(nil).ListClaims), ctx, specification)
+}
+
+// ListExpiring mocks base method.
+func (m *MockRepository) ListExpiring(ctx context.Context, now, until time.Time) (*[]insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExpiring", ctx, now, until)
+	ret0, _ := ret[0].(*[]insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExpiring indicates an expected call of ListExpiring.
+func (mr *MockRepositoryMockRecorder) ListExpiring(ctx, now, until any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExpiring", reflect.TypeOf((*MockRepository)(nil).ListExpiring), ctx, now, until)
+}
+
+// ListPolicies mocks base method.
+func (m *MockRepository) ListPolicies(ctx context.Context, specification *insurance.PolicySpecification) (*[]insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", ctx, specification)
+	ret0, _ := ret[0].(*[]insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockRepositoryMockRecorder) ListPolicies(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockRepository)(nil).ListPolicies), ctx, specification)
+}
+
+// UpdateClaim mocks base method.
+func (m *MockRepository) UpdateClaim(ctx context.Context, c *insurance.Claim) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClaim", ctx, c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateClaim indicates an expected call of UpdateClaim.
+func (mr *MockRepositoryMockRecorder) UpdateClaim(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClaim", reflect.TypeOf((*MockRepository)(nil).UpdateClaim), ctx, c)
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockRepository) UpdatePolicy(ctx context.Context, p *insurance.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockRepositoryMockRecorder) UpdatePolicy(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockRepository)(nil).UpdatePolicy), ctx, p)
+}
+
+// MockVehicleReading is a mock of VehicleReading interface.
+type MockVehicleReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockVehicleReadingMockRecorder
+}
+
+// MockVehicleReadingMockRecorder is the mock recorder for MockVehicleReading.
+type MockVehicleReadingMockRecorder struct {
+	mock *MockVehicleReading
+}
+
+// NewMockVehicleReading creates a new mock instance.
+func NewMockVehicleReading(ctrl *gomock.Controller) *MockVehicleReading {
+	mock := &MockVehicleReading{ctrl: ctrl}
+	mock.recorder = &MockVehicleReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVehicleReading) EXPECT() *MockVehicleReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockVehicleReading) GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockVehicleReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockVehicleReading)(nil).GetByID), ctx, id)
+}
+
+// MockIncidentReading is a mock of IncidentReading interface.
+type MockIncidentReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockIncidentReadingMockRecorder
+}
+
+// MockIncidentReadingMockRecorder is the mock recorder for MockIncidentReading.
+type MockIncidentReadingMockRecorder struct {
+	mock *MockIncidentReading
+}
+
+// NewMockIncidentReading creates a new mock instance.
+func NewMockIncidentReading(ctrl *gomock.Controller) *MockIncidentReading {
+	mock := &MockIncidentReading{ctrl: ctrl}
+	mock.recorder = &MockIncidentReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIncidentReading) EXPECT() *MockIncidentReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockIncidentReading) GetByID(ctx context.Context, id int64) (*incident.Incident, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*incident.Incident)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockIncidentReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockIncidentReading)(nil).GetByID), ctx, id)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ChangeClaimStatus mocks base method.
+func (m *MockUseCase) ChangeClaimStatus(ctx context.Context, id int64, status insurance.ClaimStatus, number string) (*insurance.Claim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeClaimStatus", ctx, id, status, number)
+	ret0, _ := ret[0].(*insurance.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeClaimStatus indicates an expected call of ChangeClaimStatus.
+func (mr *MockUseCaseMockRecorder) ChangeClaimStatus(ctx, id, status, number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeClaimStatus", reflect.TypeOf((*MockUseCase)(nil).ChangeClaimStatus), ctx, id, status, number)
+}
+
+// CreatePolicy mocks base method.
+func (m *MockUseCase) CreatePolicy(ctx context.Context, p *insurance.Policy) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePolicy", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePolicy indicates an expected call of CreatePolicy.
+func (mr *MockUseCaseMockRecorder) CreatePolicy(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePolicy", reflect.TypeOf((*MockUseCase)(nil).CreatePolicy), ctx, p)
+}
+
+// GetClaim mocks base method.
+func (m *MockUseCase) GetClaim(ctx context.Context, id int64) (*insurance.Claim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetClaim", ctx, id)
+	ret0, _ := ret[0].(*insurance.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetClaim indicates an expected call of GetClaim.
+func (mr *MockUseCaseMockRecorder) GetClaim(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClaim", reflect.TypeOf((*MockUseCase)(nil).GetClaim), ctx, id)
+}
+
+// GetPolicy mocks base method.
+func (m *MockUseCase) GetPolicy(ctx context.Context, id int64) (*insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPolicy", ctx, id)
+	ret0, _ := ret[0].(*insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPolicy indicates an expected call of GetPolicy.
+func (mr *MockUseCaseMockRecorder) GetPolicy(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPolicy", reflect.TypeOf((*MockUseCase)(nil).GetPolicy), ctx, id)
+}
+
+// ListAlerts mocks base method.
+func (m *MockUseCase) ListAlerts(ctx context.Context) (*[]insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlerts", ctx)
+	ret0, _ := ret[0].(*[]insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlerts indicates an expected call of ListAlerts.
+func (mr *MockUseCaseMockRecorder) ListAlerts(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockUseCase)(nil).ListAlerts), ctx)
+}
+
+// ListClaims mocks base method.
+func (m *MockUseCase) ListClaims(ctx context.Context, specification *insurance.ClaimSpecification) (*[]insurance.Claim, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClaims", ctx, specification)
+	ret0, _ := ret[0].(*[]insurance.Claim)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClaims indicates an expected call of ListClaims.
+func (mr *MockUseCaseMockRecorder) ListClaims(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClaims", reflect.TypeOf((*MockUseCase)(nil).ListClaims), ctx, specification)
+}
+
+// ListPolicies mocks base method.
+func (m *MockUseCase) ListPolicies(ctx context.Context, specification *insurance.PolicySpecification) (*[]insurance.Policy, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPolicies", ctx, specification)
+	ret0, _ := ret[0].(*[]insurance.Policy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPolicies indicates an expected call of ListPolicies.
+func (mr *MockUseCaseMockRecorder) ListPolicies(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPolicies", reflect.TypeOf((*MockUseCase)(nil).ListPolicies), ctx, specification)
+}
+
+// OpenClaim mocks base method.
+func (m *MockUseCase) OpenClaim(ctx context.Context, c *insurance.Claim) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenClaim", ctx, c)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenClaim indicates an expected call of OpenClaim.
+func (mr *MockUseCaseMockRecorder) OpenClaim(ctx, c any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenClaim", reflect.TypeOf((*MockUseCase)(nil).OpenClaim), ctx, c)
+}
+
+// UpdatePolicy mocks base method.
+func (m *MockUseCase) UpdatePolicy(ctx context.Context, p *insurance.Policy) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePolicy", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePolicy indicates an expected call of UpdatePolicy.
+func (mr *MockUseCaseMockRecorder) UpdatePolicy(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePolicy", reflect.TypeOf((*MockUseCase)(nil).UpdatePolicy), ctx, p)
+}
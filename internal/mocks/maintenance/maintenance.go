@@ -0,0 +1,759 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: maintenance/maintenance.go
+//
+// Generated by this command:
+//
+//	mockgen -source=maintenance/maintenance.go -destination=internal/mocks/maintenance/maintenance.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	maintenance "github.com/LucasMateus-eng/operations-service/maintenance"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// GetOrder mocks base method.
+func (m *MockReading) GetOrder(ctx context.Context, id int64) (*maintenance.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockReadingMockRecorder) GetOrder(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockReading)(nil).GetOrder), ctx, id)
+}
+
+// GetPlan mocks base method.
+func (m *MockReading) GetPlan(ctx context.Context, id int64) (*maintenance.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlan", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlan indicates an expected call of GetPlan.
+func (mr *MockReadingMockRecorder) GetPlan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlan", reflect.TypeOf((*MockReading)(nil).GetPlan), ctx, id)
+}
+
+// GetTicket mocks base method.
+func (m *MockReading) GetTicket(ctx context.Context, id int64) (*maintenance.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicket", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicket indicates an expected call of GetTicket.
+func (mr *MockReadingMockRecorder) GetTicket(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*MockReading)(nil).GetTicket), ctx, id)
+}
+
+// ListOrders mocks base method.
+func (m *MockReading) ListOrders(ctx context.Context, specification *maintenance.OrderSpecification) (*[]maintenance.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrders", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockReadingMockRecorder) ListOrders(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockReading)(nil).ListOrders), ctx, specification)
+}
+
+// ListPlans mocks base method.
+func (m *MockReading) ListPlans(ctx context.Context, specification *maintenance.PlanSpecification) (*[]maintenance.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlans", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlans indicates an expected call of ListPlans.
+func (mr *MockReadingMockRecorder) ListPlans(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlans", reflect.TypeOf((*MockReading)(nil).ListPlans), ctx, specification)
+}
+
+// ListSchedules mocks base method.
+func (m *MockReading) ListSchedules(ctx context.Context, specification *maintenance.ScheduleSpecification) (*[]maintenance.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockReadingMockRecorder) ListSchedules(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockReading)(nil).ListSchedules), ctx, specification)
+}
+
+// ListTickets mocks base method.
+func (m *MockReading) ListTickets(ctx context.Context, specification *maintenance.TicketSpecification) (*[]maintenance.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTickets", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTickets indicates an expected call of ListTickets.
+func (mr *MockReadingMockRecorder) ListTickets(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTickets", reflect.TypeOf((*MockReading)(nil).ListTickets), ctx, specification)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// CloseTicket mocks base method.
+func (m *MockWriting) CloseTicket(ctx context.Context, t *maintenance.Ticket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseTicket", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseTicket indicates an expected call of CloseTicket.
+func (mr *MockWritingMockRecorder) CloseTicket(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseTicket", reflect.TypeOf((*MockWriting)(nil).CloseTicket), ctx, t)
+}
+
+// CreateOrder mocks base method.
+func (m *MockWriting) CreateOrder(ctx context.Context, o *maintenance.Order) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, o)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockWritingMockRecorder) CreateOrder(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockWriting)(nil).CreateOrder), ctx, o)
+}
+
+// CreatePlan mocks base method.
+func (m *MockWriting) CreatePlan(ctx context.Context, p *maintenance.Plan) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlan", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlan indicates an expected call of CreatePlan.
+func (mr *MockWritingMockRecorder) CreatePlan(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlan", reflect.TypeOf((*MockWriting)(nil).CreatePlan), ctx, p)
+}
+
+// CreateTicket mocks base method.
+func (m *MockWriting) CreateTicket(ctx context.Context, t *maintenance.Ticket) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockWritingMockRecorder) CreateTicket(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockWriting)(nil).CreateTicket), ctx, t)
+}
+
+// DeleteOrder mocks base method.
+func (m *MockWriting) DeleteOrder(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrder", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrder indicates an expected call of DeleteOrder.
+func (mr *MockWritingMockRecorder) DeleteOrder(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockWriting)(nil).DeleteOrder), ctx, id)
+}
+
+// DeletePlan mocks base method.
+func (m *MockWriting) DeletePlan(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlan", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlan indicates an expected call of DeletePlan.
+func (mr *MockWritingMockRecorder) DeletePlan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlan", reflect.TypeOf((*MockWriting)(nil).DeletePlan), ctx, id)
+}
+
+// UpdatePlan mocks base method.
+func (m *MockWriting) UpdatePlan(ctx context.Context, p *maintenance.Plan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlan", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlan indicates an expected call of UpdatePlan.
+func (mr *MockWritingMockRecorder) UpdatePlan(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlan", reflect.TypeOf((*MockWriting)(nil).UpdatePlan), ctx, p)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CloseTicket mocks base method.
+func (m *MockRepository) CloseTicket(ctx context.Context, t *maintenance.Ticket) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseTicket", ctx, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseTicket indicates an expected call of CloseTicket.
+func (mr *MockRepositoryMockRecorder) CloseTicket(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseTicket", reflect.TypeOf((*MockRepository)(nil).CloseTicket), ctx, t)
+}
+
+// CreateOrder mocks base method.
+func (m *MockRepository) CreateOrder(ctx context.Context, o *maintenance.Order) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, o)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockRepositoryMockRecorder) CreateOrder(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockRepository)(nil).CreateOrder), ctx, o)
+}
+
+// CreatePlan mocks base method.
+func (m *MockRepository) CreatePlan(ctx context.Context, p *maintenance.Plan) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlan", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlan indicates an expected call of CreatePlan.
+func (mr *MockRepositoryMockRecorder) CreatePlan(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlan", reflect.TypeOf((*MockRepository)(nil).CreatePlan), ctx, p)
+}
+
+// CreateTicket mocks base method.
+func (m *MockRepository) CreateTicket(ctx context.Context, t *maintenance.Ticket) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockRepositoryMockRecorder) CreateTicket(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockRepository)(nil).CreateTicket), ctx, t)
+}
+
+// DeleteOrder mocks base method.
+func (m *MockRepository) DeleteOrder(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrder", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrder indicates an expected call of DeleteOrder.
+func (mr *MockRepositoryMockRecorder) DeleteOrder(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockRepository)(nil).DeleteOrder), ctx, id)
+}
+
+// DeletePlan mocks base method.
+func (m *MockRepository) DeletePlan(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlan", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlan indicates an expected call of DeletePlan.
+func (mr *MockRepositoryMockRecorder) DeletePlan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlan", reflect.TypeOf((*MockRepository)(nil).DeletePlan), ctx, id)
+}
+
+// GetOrder mocks base method.
+func (m *MockRepository) GetOrder(ctx context.Context, id int64) (*maintenance.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockRepositoryMockRecorder) GetOrder(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockRepository)(nil).GetOrder), ctx, id)
+}
+
+// GetPlan mocks base method.
+func (m *MockRepository) GetPlan(ctx context.Context, id int64) (*maintenance.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlan", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlan indicates an expected call of GetPlan.
+func (mr *MockRepositoryMockRecorder) GetPlan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlan", reflect.TypeOf((*MockRepository)(nil).GetPlan), ctx, id)
+}
+
+// GetTicket mocks base method.
+func (m *MockRepository) GetTicket(ctx context.Context, id int64) (*maintenance.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicket", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicket indicates an expected call of GetTicket.
+func (mr *MockRepositoryMockRecorder) GetTicket(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*MockRepository)(nil).GetTicket), ctx, id)
+}
+
+// ListOrders mocks base method.
+func (m *MockRepository) ListOrders(ctx context.Context, specification *maintenance.OrderSpecification) (*[]maintenance.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrders", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockRepositoryMockRecorder) ListOrders(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockRepository)(nil).ListOrders), ctx, specification)
+}
+
+// ListPlans mocks base method.
+func (m *MockRepository) ListPlans(ctx context.Context, specification *maintenance.PlanSpecification) (*[]maintenance.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlans", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlans indicates an expected call of ListPlans.
+func (mr *MockRepositoryMockRecorder) ListPlans(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlans", reflect.TypeOf((*MockRepository)(nil).ListPlans), ctx, specification)
+}
+
+// ListSchedules mocks base method.
+func (m *MockRepository) ListSchedules(ctx context.Context, specification *maintenance.ScheduleSpecification) (*[]maintenance.Schedule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSchedules", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Schedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSchedules indicates an expected call of ListSchedules.
+func (mr *MockRepositoryMockRecorder) ListSchedules(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSchedules", reflect.TypeOf((*MockRepository)(nil).ListSchedules), ctx, specification)
+}
+
+// ListTickets mocks base method.
+func (m *MockRepository) ListTickets(ctx context.Context, specification *maintenance.TicketSpecification) (*[]maintenance.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTickets", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTickets indicates an expected call of ListTickets.
+func (mr *MockRepositoryMockRecorder) ListTickets(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTickets", reflect.TypeOf((*MockRepository)(nil).ListTickets), ctx, specification)
+}
+
+// UpdatePlan mocks base method.
+func (m *MockRepository) UpdatePlan(ctx context.Context, p *maintenance.Plan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlan", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlan indicates an expected call of UpdatePlan.
+func (mr *MockRepositoryMockRecorder) UpdatePlan(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlan", reflect.TypeOf((*MockRepository)(nil).UpdatePlan), ctx, p)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// CloseTicket mocks base method.
+func (m *MockUseCase) CloseTicket(ctx context.Context, id, orderID int64) (*maintenance.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseTicket", ctx, id, orderID)
+	ret0, _ := ret[0].(*maintenance.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseTicket indicates an expected call of CloseTicket.
+func (mr *MockUseCaseMockRecorder) CloseTicket(ctx, id, orderID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseTicket", reflect.TypeOf((*MockUseCase)(nil).CloseTicket), ctx, id, orderID)
+}
+
+// CreateOrder mocks base method.
+func (m *MockUseCase) CreateOrder(ctx context.Context, o *maintenance.Order) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrder", ctx, o)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrder indicates an expected call of CreateOrder.
+func (mr *MockUseCaseMockRecorder) CreateOrder(ctx, o any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrder", reflect.TypeOf((*MockUseCase)(nil).CreateOrder), ctx, o)
+}
+
+// CreatePlan mocks base method.
+func (m *MockUseCase) CreatePlan(ctx context.Context, p *maintenance.Plan) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlan", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlan indicates an expected call of CreatePlan.
+func (mr *MockUseCaseMockRecorder) CreatePlan(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlan", reflect.TypeOf((*MockUseCase)(nil).CreatePlan), ctx, p)
+}
+
+// CreateTicket mocks base method.
+func (m *MockUseCase) CreateTicket(ctx context.Context, t *maintenance.Ticket) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTicket", ctx, t)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTicket indicates an expected call of CreateTicket.
+func (mr *MockUseCaseMockRecorder) CreateTicket(ctx, t any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTicket", reflect.TypeOf((*MockUseCase)(nil).CreateTicket), ctx, t)
+}
+
+// DeleteOrder mocks base method.
+func (m *MockUseCase) DeleteOrder(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOrder", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteOrder indicates an expected call of DeleteOrder.
+func (mr *MockUseCaseMockRecorder) DeleteOrder(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOrder", reflect.TypeOf((*MockUseCase)(nil).DeleteOrder), ctx, id)
+}
+
+// DeletePlan mocks base method.
+func (m *MockUseCase) DeletePlan(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePlan", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePlan indicates an expected call of DeletePlan.
+func (mr *MockUseCaseMockRecorder) DeletePlan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePlan", reflect.TypeOf((*MockUseCase)(nil).DeletePlan), ctx, id)
+}
+
+// GetOrder mocks base method.
+func (m *MockUseCase) GetOrder(ctx context.Context, id int64) (*maintenance.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOrder", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOrder indicates an expected call of GetOrder.
+func (mr *MockUseCaseMockRecorder) GetOrder(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOrder", reflect.TypeOf((*MockUseCase)(nil).GetOrder), ctx, id)
+}
+
+// GetPlan mocks base method.
+func (m *MockUseCase) GetPlan(ctx context.Context, id int64) (*maintenance.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlan", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlan indicates an expected call of GetPlan.
+func (mr *MockUseCaseMockRecorder) GetPlan(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlan", reflect.TypeOf((*MockUseCase)(nil).GetPlan), ctx, id)
+}
+
+// GetTicket mocks base method.
+func (m *MockUseCase) GetTicket(ctx context.Context, id int64) (*maintenance.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTicket", ctx, id)
+	ret0, _ := ret[0].(*maintenance.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTicket indicates an expected call of GetTicket.
+func (mr *MockUseCaseMockRecorder) GetTicket(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTicket", reflect.TypeOf((*MockUseCase)(nil).GetTicket), ctx, id)
+}
+
+// HasOverdueCritical mocks base method.
+func (m *MockUseCase) HasOverdueCritical(ctx context.Context, vehicleID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HasOverdueCritical", ctx, vehicleID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// HasOverdueCritical indicates an expected call of HasOverdueCritical.
+func (mr *MockUseCaseMockRecorder) HasOverdueCritical(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HasOverdueCritical", reflect.TypeOf((*MockUseCase)(nil).HasOverdueCritical), ctx, vehicleID)
+}
+
+// ListDue mocks base method.
+func (m *MockUseCase) ListDue(ctx context.Context, vehicleID int64) (*[]maintenance.Due, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDue", ctx, vehicleID)
+	ret0, _ := ret[0].(*[]maintenance.Due)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDue indicates an expected call of ListDue.
+func (mr *MockUseCaseMockRecorder) ListDue(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDue", reflect.TypeOf((*MockUseCase)(nil).ListDue), ctx, vehicleID)
+}
+
+// ListOrders mocks base method.
+func (m *MockUseCase) ListOrders(ctx context.Context, specification *maintenance.OrderSpecification) (*[]maintenance.Order, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOrders", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Order)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOrders indicates an expected call of ListOrders.
+func (mr *MockUseCaseMockRecorder) ListOrders(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOrders", reflect.TypeOf((*MockUseCase)(nil).ListOrders), ctx, specification)
+}
+
+// ListOverdue mocks base method.
+func (m *MockUseCase) ListOverdue(ctx context.Context, specification *maintenance.OverdueSpecification) (*[]maintenance.Due, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOverdue", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Due)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOverdue indicates an expected call of ListOverdue.
+func (mr *MockUseCaseMockRecorder) ListOverdue(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOverdue", reflect.TypeOf((*MockUseCase)(nil).ListOverdue), ctx, specification)
+}
+
+// ListPlans mocks base method.
+func (m *MockUseCase) ListPlans(ctx context.Context, specification *maintenance.PlanSpecification) (*[]maintenance.Plan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPlans", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Plan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPlans indicates an expected call of ListPlans.
+func (mr *MockUseCaseMockRecorder) ListPlans(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPlans", reflect.TypeOf((*MockUseCase)(nil).ListPlans), ctx, specification)
+}
+
+// ListTickets mocks base method.
+func (m *MockUseCase) ListTickets(ctx context.Context, specification *maintenance.TicketSpecification) (*[]maintenance.Ticket, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTickets", ctx, specification)
+	ret0, _ := ret[0].(*[]maintenance.Ticket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTickets indicates an expected call of ListTickets.
+func (mr *MockUseCaseMockRecorder) ListTickets(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTickets", reflect.TypeOf((*MockUseCase)(nil).ListTickets), ctx, specification)
+}
+
+// UpdatePlan mocks base method.
+func (m *MockUseCase) UpdatePlan(ctx context.Context, p *maintenance.Plan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePlan", ctx, p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePlan indicates an expected call of UpdatePlan.
+func (mr *MockUseCaseMockRecorder) UpdatePlan(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePlan", reflect.TypeOf((*MockUseCase)(nil).UpdatePlan), ctx, p)
+}
@@ -0,0 +1,461 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: shift/shift.go
+//
+// Generated by this command:
+//
+//	mockgen -source=shift/shift.go -destination=internal/mocks/shift/shift.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	driver "github.com/LucasMateus-eng/operations-service/driver"
+	shift "github.com/LucasMateus-eng/operations-service/shift"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockReading is a mock of Reading interface.
+type MockReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadingMockRecorder
+}
+
+// MockReadingMockRecorder is the mock recorder for MockReading.
+type MockReadingMockRecorder struct {
+	mock *MockReading
+}
+
+// NewMockReading creates a new mock instance.
+func NewMockReading(ctrl *gomock.Controller) *MockReading {
+	mock := &MockReading{ctrl: ctrl}
+	mock.recorder = &MockReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReading) EXPECT() *MockReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByID mocks base method.
+func (m *MockReading) GetByID(ctx context.Context, id int64) (*shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockReadingMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockReading)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockReading) List(ctx context.Context, specification *shift.ShiftSpecification) (*[]shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
+}
+
+// ListWorked mocks base method.
+func (m *MockReading) ListWorked(ctx context.Context, driverID int64, from, to time.Time) (*[]shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorked", ctx, driverID, from, to)
+	ret0, _ := ret[0].(*[]shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorked indicates an expected call of ListWorked.
+func (mr *MockReadingMockRecorder) ListWorked(ctx, driverID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorked", reflect.TypeOf((*MockReading)(nil).ListWorked), ctx, driverID, from, to)
+}
+
+// MockWriting is a mock of Writing interface.
+type MockWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockWritingMockRecorder
+}
+
+// MockWritingMockRecorder is the mock recorder for MockWriting.
+type MockWritingMockRecorder struct {
+	mock *MockWriting
+}
+
+// NewMockWriting creates a new mock instance.
+func NewMockWriting(ctrl *gomock.Controller) *MockWriting {
+	mock := &MockWriting{ctrl: ctrl}
+	mock.recorder = &MockWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockWriting) EXPECT() *MockWritingMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockWriting) Create(ctx context.Context, s *shift.Shift) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockWritingMockRecorder) Create(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, s)
+}
+
+// EndPeriod mocks base method.
+func (m *MockWriting) EndPeriod(ctx context.Context, shiftID int64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndPeriod", ctx, shiftID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndPeriod indicates an expected call of EndPeriod.
+func (mr *MockWritingMockRecorder) EndPeriod(ctx, shiftID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndPeriod", reflect.TypeOf((*MockWriting)(nil).EndPeriod), ctx, shiftID, at)
+}
+
+// StartPeriod mocks base method.
+func (m *MockWriting) StartPeriod(ctx context.Context, p *shift.Period) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPeriod", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPeriod indicates an expected call of StartPeriod.
+func (mr *MockWritingMockRecorder) StartPeriod(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPeriod", reflect.TypeOf((*MockWriting)(nil).StartPeriod), ctx, p)
+}
+
+// Update mocks base method.
+func (m *MockWriting) Update(ctx context.Context, s *shift.Shift, from shift.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, s, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockWritingMockRecorder) Update(ctx, s, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWriting)(nil).Update), ctx, s, from)
+}
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockRepository) Create(ctx context.Context, s *shift.Shift) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockRepositoryMockRecorder) Create(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, s)
+}
+
+// EndPeriod mocks base method.
+func (m *MockRepository) EndPeriod(ctx context.Context, shiftID int64, at time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndPeriod", ctx, shiftID, at)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndPeriod indicates an expected call of EndPeriod.
+func (mr *MockRepositoryMockRecorder) EndPeriod(ctx, shiftID, at any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndPeriod", reflect.TypeOf((*MockRepository)(nil).EndPeriod), ctx, shiftID, at)
+}
+
+// GetByID mocks base method.
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockRepositoryMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockRepository)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockRepository) List(ctx context.Context, specification *shift.ShiftSpecification) (*[]shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
+}
+
+// ListWorked mocks base method.
+func (m *MockRepository) ListWorked(ctx context.Context, driverID int64, from, to time.Time) (*[]shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWorked", ctx, driverID, from, to)
+	ret0, _ := ret[0].(*[]shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWorked indicates an expected call of ListWorked.
+func (mr *MockRepositoryMockRecorder) ListWorked(ctx, driverID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWorked", reflect.TypeOf((*MockRepository)(nil).ListWorked), ctx, driverID, from, to)
+}
+
+// StartPeriod mocks base method.
+func (m *MockRepository) StartPeriod(ctx context.Context, p *shift.Period) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartPeriod", ctx, p)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartPeriod indicates an expected call of StartPeriod.
+func (mr *MockRepositoryMockRecorder) StartPeriod(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartPeriod", reflect.TypeOf((*MockRepository)(nil).StartPeriod), ctx, p)
+}
+
+// Update mocks base method.
+func (m *MockRepository) Update(ctx context.Context, s *shift.Shift, from shift.Status) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, s, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Update indicates an expected call of Update.
+func (mr *MockRepositoryMockRecorder) Update(ctx, s, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRepository)(nil).Update), ctx, s, from)
+}
+
+// MockDriverReading is a mock of DriverReading interface.
+type MockDriverReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockDriverReadingMockRecorder
+}
+
+// MockDriverReadingMockRecorder is the mock recorder for MockDriverReading.
+type MockDriverReadingMockRecorder struct {
+	mock *MockDriverReading
+}
+
+// NewMockDriverReading creates a new mock instance.
+func NewMockDriverReading(ctrl *gomock.Controller) *MockDriverReading {
+	mock := &MockDriverReading{ctrl: ctrl}
+	mock.recorder = &MockDriverReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDriverReading) EXPECT() *MockDriverReadingMockRecorder {
+	return m.recorder
+}
+
+// GetByUserID mocks base method.
+func (m *MockDriverReading) GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByUserID", ctx, userId)
+	ret0, _ := ret[0].(*driver.Driver)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByUserID indicates an expected call of GetByUserID.
+func (mr *MockDriverReadingMockRecorder) GetByUserID(ctx, userId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByUserID", reflect.TypeOf((*MockDriverReading)(nil).GetByUserID), ctx, userId)
+}
+
+// MockUseCase is a mock of UseCase interface.
+type MockUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUseCaseMockRecorder
+}
+
+// MockUseCaseMockRecorder is the mock recorder for MockUseCase.
+type MockUseCaseMockRecorder struct {
+	mock *MockUseCase
+}
+
+// NewMockUseCase creates a new mock instance.
+func NewMockUseCase(ctrl *gomock.Controller) *MockUseCase {
+	mock := &MockUseCase{ctrl: ctrl}
+	mock.recorder = &MockUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUseCase) EXPECT() *MockUseCaseMockRecorder {
+	return m.recorder
+}
+
+// ClockIn mocks base method.
+func (m *MockUseCase) ClockIn(ctx context.Context, id int64) (*shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockIn", ctx, id)
+	ret0, _ := ret[0].(*shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockIn indicates an expected call of ClockIn.
+func (mr *MockUseCaseMockRecorder) ClockIn(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockIn", reflect.TypeOf((*MockUseCase)(nil).ClockIn), ctx, id)
+}
+
+// ClockOut mocks base method.
+func (m *MockUseCase) ClockOut(ctx context.Context, id int64) (*shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClockOut", ctx, id)
+	ret0, _ := ret[0].(*shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClockOut indicates an expected call of ClockOut.
+func (mr *MockUseCaseMockRecorder) ClockOut(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClockOut", reflect.TypeOf((*MockUseCase)(nil).ClockOut), ctx, id)
+}
+
+// Create mocks base method.
+func (m *MockUseCase) Create(ctx context.Context, s *shift.Shift) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, s)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockUseCaseMockRecorder) Create(ctx, s any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUseCase)(nil).Create), ctx, s)
+}
+
+// GetByID mocks base method.
+func (m *MockUseCase) GetByID(ctx context.Context, id int64) (*shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockUseCaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUseCase)(nil).GetByID), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockUseCase) List(ctx context.Context, specification *shift.ShiftSpecification) (*[]shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, specification)
+	ret0, _ := ret[0].(*[]shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
+}
+
+// Report mocks base method.
+func (m *MockUseCase) Report(ctx context.Context, driverID int64, from, to time.Time) (*shift.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Report", ctx, driverID, from, to)
+	ret0, _ := ret[0].(*shift.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Report indicates an expected call of Report.
+func (mr *MockUseCaseMockRecorder) Report(ctx, driverID, from, to any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockUseCase)(nil).Report), ctx, driverID, from, to)
+}
+
+// Switch mocks base method.
+func (m *MockUseCase) Switch(ctx context.Context, id int64, activity shift.Activity) (*shift.Shift, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Switch", ctx, id, activity)
+	ret0, _ := ret[0].(*shift.Shift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Switch indicates an expected call of Switch.
+func (mr *MockUseCaseMockRecorder) Switch(ctx, id, activity any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Switch", reflect.TypeOf((*MockUseCase)(nil).Switch), ctx, id, activity)
+}
@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: vehicle/decommissioning.go
+//
+// Generated by this command:
+//
+//	mockgen -source=vehicle/decommissioning.go -destination=internal/mocks/vehicle/decommissioning.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockAssignmentReading is a mock of AssignmentReading interface.
+type MockAssignmentReading struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentReadingMockRecorder
+}
+
+// MockAssignmentReadingMockRecorder is the mock recorder for MockAssignmentReading.
+type MockAssignmentReadingMockRecorder struct {
+	mock *MockAssignmentReading
+}
+
+// NewMockAssignmentReading creates a new mock instance.
+func NewMockAssignmentReading(ctrl *gomock.Controller) *MockAssignmentReading {
+	mock := &MockAssignmentReading{ctrl: ctrl}
+	mock.recorder = &MockAssignmentReadingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentReading) EXPECT() *MockAssignmentReadingMockRecorder {
+	return m.recorder
+}
+
+// ListDriverIDsByVehicleID mocks base method.
+func (m *MockAssignmentReading) ListDriverIDsByVehicleID(ctx context.Context, vehicleID int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDriverIDsByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDriverIDsByVehicleID indicates an expected call of ListDriverIDsByVehicleID.
+func (mr *MockAssignmentReadingMockRecorder) ListDriverIDsByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDriverIDsByVehicleID", reflect.TypeOf((*MockAssignmentReading)(nil).ListDriverIDsByVehicleID), ctx, vehicleID)
+}
+
+// MockAssignmentWriting is a mock of AssignmentWriting interface.
+type MockAssignmentWriting struct {
+	ctrl     *gomock.Controller
+	recorder *MockAssignmentWritingMockRecorder
+}
+
+// MockAssignmentWritingMockRecorder is the mock recorder for MockAssignmentWriting.
+type MockAssignmentWritingMockRecorder struct {
+	mock *MockAssignmentWriting
+}
+
+// NewMockAssignmentWriting creates a new mock instance.
+func NewMockAssignmentWriting(ctrl *gomock.Controller) *MockAssignmentWriting {
+	mock := &MockAssignmentWriting{ctrl: ctrl}
+	mock.recorder = &MockAssignmentWritingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAssignmentWriting) EXPECT() *MockAssignmentWritingMockRecorder {
+	return m.recorder
+}
+
+// EndByVehicleID mocks base method.
+func (m *MockAssignmentWriting) EndByVehicleID(ctx context.Context, vehicleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndByVehicleID indicates an expected call of EndByVehicleID.
+func (mr *MockAssignmentWritingMockRecorder) EndByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndByVehicleID", reflect.TypeOf((*MockAssignmentWriting)(nil).EndByVehicleID), ctx, vehicleID)
+}
+
+// PurgeByVehicleID mocks base method.
+func (m *MockAssignmentWriting) PurgeByVehicleID(ctx context.Context, vehicleID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeByVehicleID", ctx, vehicleID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PurgeByVehicleID indicates an expected call of PurgeByVehicleID.
+func (mr *MockAssignmentWritingMockRecorder) PurgeByVehicleID(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeByVehicleID", reflect.TypeOf((*MockAssignmentWriting)(nil).PurgeByVehicleID), ctx, vehicleID)
+}
+
+// MockDocumentPurging is a mock of DocumentPurging interface.
+type MockDocumentPurging struct {
+	ctrl     *gomock.Controller
+	recorder *MockDocumentPurgingMockRecorder
+}
+
+// MockDocumentPurgingMockRecorder is the mock recorder for MockDocumentPurging.
+type MockDocumentPurgingMockRecorder struct {
+	mock *MockDocumentPurging
+}
+
+// NewMockDocumentPurging creates a new mock instance.
+func NewMockDocumentPurging(ctrl *gomock.Controller) *MockDocumentPurging {
+	mock := &MockDocumentPurging{ctrl: ctrl}
+	mock.recorder = &MockDocumentPurgingMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDocumentPurging) EXPECT() *MockDocumentPurgingMockRecorder {
+	return m.recorder
+}
+
+// DiscardFiles mocks base method.
+func (m *MockDocumentPurging) DiscardFiles(ctx context.Context, keys []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "DiscardFiles", ctx, keys)
+}
+
+// DiscardFiles indicates an expected call of DiscardFiles.
+func (mr *MockDocumentPurgingMockRecorder) DiscardFiles(ctx, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardFiles", reflect.TypeOf((*MockDocumentPurging)(nil).DiscardFiles), ctx, keys)
+}
+
+// VehicleFiles mocks base method.
+func (m *MockDocumentPurging) VehicleFiles(ctx context.Context, vehicleID int64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VehicleFiles", ctx, vehicleID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VehicleFiles indicates an expected call of VehicleFiles.
+func (mr *MockDocumentPurgingMockRecorder) VehicleFiles(ctx, vehicleID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VehicleFiles", reflect.TypeOf((*MockDocumentPurging)(nil).VehicleFiles), ctx, vehicleID)
+}
+
+// MockDecommissioningUseCase is a mock of DecommissioningUseCase interface.
+type MockDecommissioningUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockDecommissioningUseCaseMockRecorder
+}
+
+// MockDecommissioningUseCaseMockRecorder is the mock recorder for MockDecommissioningUseCase.
+type MockDecommissioningUseCaseMockRecorder struct {
+	mock *MockDecommissioningUseCase
+}
+
+// NewMockDecommissioningUseCase creates a new mock instance.
+func NewMockDecommissioningUseCase(ctrl *gomock.Controller) *MockDecommissioningUseCase {
+	mock := &MockDecommissioningUseCase{ctrl: ctrl}
+	mock.recorder = &MockDecommissioningUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDecommissioningUseCase) EXPECT() *MockDecommissioningUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Decommission mocks base method.
+func (m *MockDecommissioningUseCase) Decommission(ctx context.Context, id, version int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decommission", ctx, id, version)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Decommission indicates an expected call of Decommission.
+func (mr *MockDecommissioningUseCaseMockRecorder) Decommission(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decommission", reflect.TypeOf((*MockDecommissioningUseCase)(nil).Decommission), ctx, id, version)
+}
+
+// Purge mocks base method.
+func (m *MockDecommissioningUseCase) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockDecommissioningUseCaseMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockDecommissioningUseCase)(nil).Purge), ctx, id)
+}
@@ -85,6 +85,20 @@ func (mr *MockReadingMockRecorder) GetByRenavam(ctx, renavam any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRenavam", reflect.TypeOf((*MockReading)(nil).GetByRenavam), ctx, renavam)
 }
 
+// Iterate mocks base method.
+func (m *MockReading) Iterate(ctx context.Context, specification *vehicle.VehicleSpectification, fn func(*vehicle.Vehicle) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, specification, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockReadingMockRecorder) Iterate(ctx, specification, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockReading)(nil).Iterate), ctx, specification, fn)
+}
+
 // List mocks base method.
 func (m *MockReading) List(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +114,51 @@ func (mr *MockReadingMockRecorder) List(ctx, specification any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockReading)(nil).List), ctx, specification)
 }
 
+// ListByIDs mocks base method.
+func (m *MockReading) ListByIDs(ctx context.Context, ids []int64) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, ids)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockReadingMockRecorder) ListByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockReading)(nil).ListByIDs), ctx, ids)
+}
+
+// ListByPlatesOrRenavams mocks base method.
+func (m *MockReading) ListByPlatesOrRenavams(ctx context.Context, plates, renavams []string) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPlatesOrRenavams", ctx, plates, renavams)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPlatesOrRenavams indicates an expected call of ListByPlatesOrRenavams.
+func (mr *MockReadingMockRecorder) ListByPlatesOrRenavams(ctx, plates, renavams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPlatesOrRenavams", reflect.TypeOf((*MockReading)(nil).ListByPlatesOrRenavams), ctx, plates, renavams)
+}
+
+// ListDeleted mocks base method.
+func (m *MockReading) ListDeleted(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, specification)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockReadingMockRecorder) ListDeleted(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockReading)(nil).ListDeleted), ctx, specification)
+}
+
 // MockWriting is a mock of Writing interface.
 type MockWriting struct {
 	ctrl     *gomock.Controller
@@ -138,18 +197,75 @@ func (mr *MockWritingMockRecorder) Create(ctx, v any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockWriting)(nil).Create), ctx, v)
 }
 
+// CreateBatch mocks base method.
+func (m *MockWriting) CreateBatch(ctx context.Context, vs []vehicle.Vehicle) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, vs)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockWritingMockRecorder) CreateBatch(ctx, vs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockWriting)(nil).CreateBatch), ctx, vs)
+}
+
 // Delete mocks base method.
-func (m *MockWriting) Delete(ctx context.Context, id int64) error {
+func (m *MockWriting) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockWritingMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockWritingMockRecorder) Delete(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, id, version)
+}
+
+// Patch mocks base method.
+func (m *MockWriting) Patch(ctx context.Context, v *vehicle.Vehicle, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, v, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockWritingMockRecorder) Patch(ctx, v, fields any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockWriting)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockWriting)(nil).Patch), ctx, v, fields)
+}
+
+// Purge mocks base method.
+func (m *MockWriting) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockWritingMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockWriting)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockWriting) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockWritingMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockWriting)(nil).Restore), ctx, id)
 }
 
 // Update mocks base method.
@@ -204,18 +320,33 @@ func (mr *MockRepositoryMockRecorder) Create(ctx, v any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockRepository)(nil).Create), ctx, v)
 }
 
+// CreateBatch mocks base method.
+func (m *MockRepository) CreateBatch(ctx context.Context, vs []vehicle.Vehicle) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, vs)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockRepositoryMockRecorder) CreateBatch(ctx, vs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockRepository)(nil).CreateBatch), ctx, vs)
+}
+
 // Delete mocks base method.
-func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+func (m *MockRepository) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) Delete(ctx, id, version any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockRepository)(nil).Delete), ctx, id, version)
 }
 
 // GetByID mocks base method.
@@ -263,6 +394,20 @@ func (mr *MockRepositoryMockRecorder) GetByRenavam(ctx, renavam any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRenavam", reflect.TypeOf((*MockRepository)(nil).GetByRenavam), ctx, renavam)
 }
 
+// Iterate mocks base method.
+func (m *MockRepository) Iterate(ctx context.Context, specification *vehicle.VehicleSpectification, fn func(*vehicle.Vehicle) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Iterate", ctx, specification, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Iterate indicates an expected call of Iterate.
+func (mr *MockRepositoryMockRecorder) Iterate(ctx, specification, fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Iterate", reflect.TypeOf((*MockRepository)(nil).Iterate), ctx, specification, fn)
+}
+
 // List mocks base method.
 func (m *MockRepository) List(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
 	m.ctrl.T.Helper()
@@ -278,6 +423,93 @@ func (mr *MockRepositoryMockRecorder) List(ctx, specification any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRepository)(nil).List), ctx, specification)
 }
 
+// ListByIDs mocks base method.
+func (m *MockRepository) ListByIDs(ctx context.Context, ids []int64) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, ids)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockRepositoryMockRecorder) ListByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockRepository)(nil).ListByIDs), ctx, ids)
+}
+
+// ListByPlatesOrRenavams mocks base method.
+func (m *MockRepository) ListByPlatesOrRenavams(ctx context.Context, plates, renavams []string) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByPlatesOrRenavams", ctx, plates, renavams)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByPlatesOrRenavams indicates an expected call of ListByPlatesOrRenavams.
+func (mr *MockRepositoryMockRecorder) ListByPlatesOrRenavams(ctx, plates, renavams any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByPlatesOrRenavams", reflect.TypeOf((*MockRepository)(nil).ListByPlatesOrRenavams), ctx, plates, renavams)
+}
+
+// ListDeleted mocks base method.
+func (m *MockRepository) ListDeleted(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, specification)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockRepositoryMockRecorder) ListDeleted(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockRepository)(nil).ListDeleted), ctx, specification)
+}
+
+// Patch mocks base method.
+func (m *MockRepository) Patch(ctx context.Context, v *vehicle.Vehicle, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, v, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockRepositoryMockRecorder) Patch(ctx, v, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockRepository)(nil).Patch), ctx, v, fields)
+}
+
+// Purge mocks base method.
+func (m *MockRepository) Purge(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockRepositoryMockRecorder) Purge(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockRepository)(nil).Purge), ctx, id)
+}
+
+// Restore mocks base method.
+func (m *MockRepository) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockRepository) Update(ctx context.Context, v *vehicle.Vehicle) error {
 	m.ctrl.T.Helper()
@@ -331,17 +563,31 @@ func (mr *MockUseCaseMockRecorder) Create(ctx, v any) *gomock.Call {
 }
 
 // Delete mocks base method.
-func (m *MockUseCase) Delete(ctx context.Context, id int64) error {
+func (m *MockUseCase) Delete(ctx context.Context, id, version int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "Delete", ctx, id, version)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Delete indicates an expected call of Delete.
-func (mr *MockUseCaseMockRecorder) Delete(ctx, id any) *gomock.Call {
+func (mr *MockUseCaseMockRecorder) Delete(ctx, id, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, id, version)
+}
+
+// Export mocks base method.
+func (m *MockUseCase) Export(ctx context.Context, specification *vehicle.VehicleSpectification, fn func(*vehicle.Vehicle) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, specification, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Export indicates an expected call of Export.
+func (mr *MockUseCaseMockRecorder) Export(ctx, specification, fn any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUseCase)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockUseCase)(nil).Export), ctx, specification, fn)
 }
 
 // GetByID mocks base method.
@@ -389,6 +635,21 @@ func (mr *MockUseCaseMockRecorder) GetByRenavam(ctx, renavam any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByRenavam", reflect.TypeOf((*MockUseCase)(nil).GetByRenavam), ctx, renavam)
 }
 
+// Import mocks base method.
+func (m *MockUseCase) Import(ctx context.Context, rows []vehicle.ImportRow, dryRun bool) (*vehicle.ImportReport, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, rows, dryRun)
+	ret0, _ := ret[0].(*vehicle.ImportReport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import.
+func (mr *MockUseCaseMockRecorder) Import(ctx, rows, dryRun any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockUseCase)(nil).Import), ctx, rows, dryRun)
+}
+
 // List mocks base method.
 func (m *MockUseCase) List(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
 	m.ctrl.T.Helper()
@@ -404,6 +665,64 @@ func (mr *MockUseCaseMockRecorder) List(ctx, specification any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUseCase)(nil).List), ctx, specification)
 }
 
+// ListByIDs mocks base method.
+func (m *MockUseCase) ListByIDs(ctx context.Context, ids []int64) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListByIDs", ctx, ids)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListByIDs indicates an expected call of ListByIDs.
+func (mr *MockUseCaseMockRecorder) ListByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListByIDs", reflect.TypeOf((*MockUseCase)(nil).ListByIDs), ctx, ids)
+}
+
+// ListDeleted mocks base method.
+func (m *MockUseCase) ListDeleted(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, specification)
+	ret0, _ := ret[0].(*[]vehicle.Vehicle)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted.
+func (mr *MockUseCaseMockRecorder) ListDeleted(ctx, specification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockUseCase)(nil).ListDeleted), ctx, specification)
+}
+
+// Patch mocks base method.
+func (m *MockUseCase) Patch(ctx context.Context, v *vehicle.Vehicle, fields []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Patch", ctx, v, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Patch indicates an expected call of Patch.
+func (mr *MockUseCaseMockRecorder) Patch(ctx, v, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Patch", reflect.TypeOf((*MockUseCase)(nil).Patch), ctx, v, fields)
+}
+
+// Restore mocks base method.
+func (m *MockUseCase) Restore(ctx context.Context, id int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUseCaseMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUseCase)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockUseCase) Update(ctx context.Context, v *vehicle.Vehicle) error {
 	m.ctrl.T.Helper()
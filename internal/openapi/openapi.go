@@ -0,0 +1,301 @@
+package openapi
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const VERSION = "3.1.0"
+
+var pathParameterPattern = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem holds the operations of a path by their lower case method.
+type PathItem map[string]*Operation
+
+type Operation struct {
+	OperationID string                `json:"operationId,omitempty"`
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []Parameter           `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+type Response struct {
+	Description string               `json:"description"`
+	Headers     map[string]*Header   `json:"headers,omitempty"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Scheme      string `json:"scheme,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Route describes an operation with Go values: the path uses the gin
+// syntax, Query is a struct read through its form tags and the values of
+// the contents are read through their json tags, or their form tags for
+// multipart forms.
+type Route struct {
+	Method    string
+	Path      string
+	Summary   string
+	Tag       string
+	Query     any
+	Headers   []Parameter
+	Body      []Content
+	Responses map[int]Reply
+	Security  []string
+}
+
+type Content struct {
+	MediaType string
+	Value     any
+}
+
+type Reply struct {
+	Description string
+	Headers     map[string]*Header
+	Content     []Content
+}
+
+// Builder turns routes into a document, collecting every named struct and
+// enum it meets into the components of the document.
+type Builder struct {
+	document *Document
+	enums    map[reflect.Type][]string
+	names    map[reflect.Type]string
+}
+
+func NewBuilder(info Info) *Builder {
+	return &Builder{
+		document: &Document{
+			OpenAPI: VERSION,
+			Info:    info,
+			Paths:   map[string]PathItem{},
+			Components: Components{
+				Schemas:         map[string]*Schema{},
+				SecuritySchemes: map[string]*SecurityScheme{},
+			},
+		},
+		enums: map[reflect.Type][]string{},
+		names: map[reflect.Type]string{},
+	}
+}
+
+// Enum documents the type of sample, which is written in JSON as a string,
+// with the given values.
+func (b *Builder) Enum(sample any, values ...string) *Builder {
+	b.enums[reflect.TypeOf(sample)] = values
+	return b
+}
+
+func (b *Builder) SecurityScheme(name string, scheme *SecurityScheme) *Builder {
+	b.document.Components.SecuritySchemes[name] = scheme
+	return b
+}
+
+func (b *Builder) Add(routes ...Route) *Builder {
+	for _, route := range routes {
+		path := Path(route.Path)
+		if _, ok := b.document.Paths[path]; !ok {
+			b.document.Paths[path] = PathItem{}
+		}
+
+		b.document.Paths[path][strings.ToLower(route.Method)] = b.operation(route)
+	}
+
+	return b
+}
+
+func (b *Builder) Document() *Document {
+	return b.document
+}
+
+// Path converts a gin path to an OpenAPI one, e.g. /users/:id becomes
+// /users/{id}. Trailing slashes are removed, as gin redirects them.
+func Path(ginPath string) string {
+	path := pathParameterPattern.ReplaceAllString(ginPath, "{$1}")
+	if len(path) > 1 {
+		path = strings.TrimSuffix(path, "/")
+	}
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return path
+}
+
+func (b *Builder) operation(route Route) *Operation {
+	op := &Operation{
+		OperationID: operationID(route.Method, Path(route.Path)),
+		Summary:     route.Summary,
+		Responses:   map[string]*Response{},
+	}
+
+	if len(route.Tag) > 0 {
+		op.Tags = []string{route.Tag}
+	}
+
+	// Every path parameter of this API is the identifier of a resource.
+	for _, match := range pathParameterPattern.FindAllStringSubmatch(route.Path, -1) {
+		op.Parameters = append(op.Parameters, Parameter{
+			Name:     match[1],
+			In:       "path",
+			Required: true,
+			Schema:   &Schema{Type: "integer", Format: "int64"},
+		})
+	}
+
+	if route.Query != nil {
+		op.Parameters = append(op.Parameters, b.queryParameters(reflect.TypeOf(route.Query))...)
+	}
+
+	op.Parameters = append(op.Parameters, route.Headers...)
+
+	if len(route.Body) > 0 {
+		op.RequestBody = &RequestBody{Required: true, Content: b.content(route.Body)}
+	}
+
+	statuses := make([]int, 0, len(route.Responses))
+	for status := range route.Responses {
+		statuses = append(statuses, status)
+	}
+	sort.Ints(statuses)
+
+	for _, status := range statuses {
+		reply := route.Responses[status]
+		op.Responses[strconv.Itoa(status)] = &Response{
+			Description: reply.Description,
+			Headers:     reply.Headers,
+			Content:     b.content(reply.Content),
+		}
+	}
+
+	for _, scheme := range route.Security {
+		op.Security = append(op.Security, map[string][]string{scheme: {}})
+	}
+
+	return op
+}
+
+func (b *Builder) content(contents []Content) map[string]MediaType {
+	if len(contents) == 0 {
+		return nil
+	}
+
+	media := make(map[string]MediaType, len(contents))
+	for _, c := range contents {
+		var schema *Schema
+		if c.Value != nil {
+			tag := "json"
+			if strings.HasPrefix(c.MediaType, "multipart/") {
+				tag = "form"
+			}
+			schema = b.schema(reflect.TypeOf(c.Value), tag)
+		}
+
+		media[c.MediaType] = MediaType{Schema: schema}
+	}
+
+	return media
+}
+
+func (b *Builder) queryParameters(t reflect.Type) []Parameter {
+	object := b.object(indirect(t), "form")
+
+	required := map[string]bool{}
+	for _, name := range object.Required {
+		required[name] = true
+	}
+
+	names := make([]string, 0, len(object.Properties))
+	for name := range object.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parameters := make([]Parameter, 0, len(names))
+	for _, name := range names {
+		parameters = append(parameters, Parameter{
+			Name:     name,
+			In:       "query",
+			Required: required[name],
+			Schema:   object.Properties[name],
+		})
+	}
+
+	return parameters
+}
+
+// operationID names an operation after its method and path, e.g.
+// get_v1_users_id for GET /v1/users/{id}.
+func operationID(method, path string) string {
+	replacer := strings.NewReplacer("{", "", "}", "", "-", "_", "/", "_")
+	return strings.ToLower(method) + replacer.Replace(strings.TrimSuffix(path, "/"))
+}
+
+func (b *Builder) componentName(t reflect.Type) string {
+	if name, ok := b.names[t]; ok {
+		return name
+	}
+
+	name := t.Name()
+	for other := range b.names {
+		if b.names[other] == name {
+			pkg := t.PkgPath()[strings.LastIndex(t.PkgPath(), "/")+1:]
+			pkg = strings.ReplaceAll(pkg, "-", "")
+			name = fmt.Sprintf("%s%s", strings.ToUpper(pkg[:1])+pkg[1:], name)
+			break
+		}
+	}
+
+	b.names[t] = name
+	return name
+}
@@ -0,0 +1,104 @@
+package openapi_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/openapi"
+	"github.com/go-playground/assert/v2"
+)
+
+type color int
+
+type page struct {
+	Page     int `form:"page" binding:"required"`
+	PageSize int `form:"pageSize"`
+}
+
+type filter struct {
+	Name string `form:"name"`
+	page
+}
+
+type paint struct {
+	ID        int64     `json:"id"`
+	Color     color     `json:"color" binding:"required"`
+	Tags      []string  `json:"tags,omitempty"`
+	Internal  string    `json:"-"`
+	CreatedAt time.Time `json:"created_at"`
+	Next      *paint    `json:"next,omitempty"`
+}
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		name    string
+		ginPath string
+		want    string
+	}{
+		{
+			name:    "Dado um caminho com parâmetros quando o método Path é chamado então eles são convertidos",
+			ginPath: "/v1/drivers-vehicles/:driver_id/:vehicle_id",
+			want:    "/v1/drivers-vehicles/{driver_id}/{vehicle_id}",
+		},
+		{
+			name:    "Dado um caminho com barra final quando o método Path é chamado então ela é removida",
+			ginPath: "/v1/users/",
+			want:    "/v1/users",
+		},
+		{
+			name:    "Dado um caminho curinga quando o método Path é chamado então ele é convertido",
+			ginPath: "/swagger/*filepath",
+			want:    "/swagger/{filepath}",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, openapi.Path(test.ginPath))
+		})
+	}
+}
+
+func TestBuilder_Add(t *testing.T) {
+	document := openapi.NewBuilder(openapi.Info{Title: "Paints", Version: "1"}).
+		Enum(color(0), "RED", "BLUE").
+		Add(openapi.Route{
+			Method: http.MethodPut,
+			Path:   "/paints/:id",
+			Query:  filter{},
+			Body:   []openapi.Content{{MediaType: "application/json", Value: paint{}}},
+			Responses: map[int]openapi.Reply{
+				http.StatusOK: {Description: "The paints.", Content: []openapi.Content{{MediaType: "application/json", Value: []paint{}}}},
+			},
+		}).
+		Document()
+
+	op := document.Paths["/paints/{id}"]["put"]
+
+	t.Run("Dado uma rota quando ela é adicionada então os parâmetros de caminho e de consulta são descritos", func(tt *testing.T) {
+		names := []string{}
+		required := []bool{}
+		for _, parameter := range op.Parameters {
+			names = append(names, parameter.In+":"+parameter.Name)
+			required = append(required, parameter.Required)
+		}
+
+		assert.Equal(tt, "put_paints_id", op.OperationID)
+		assert.Equal(tt, []string{"path:id", "query:name", "query:page", "query:pageSize"}, names)
+		assert.Equal(tt, []bool{true, false, true, false}, required)
+	})
+
+	t.Run("Dado uma estrutura nomeada quando ela é descrita então ela vira um componente com seus enums", func(tt *testing.T) {
+		schema := document.Components.Schemas["paint"]
+
+		assert.Equal(tt, "#/components/schemas/paint", op.RequestBody.Content["application/json"].Schema.Ref)
+		assert.Equal(tt, "#/components/schemas/paint", op.Responses["200"].Content["application/json"].Schema.Items.Ref)
+		assert.Equal(tt, []string{"color"}, schema.Required)
+		assert.Equal(tt, "#/components/schemas/color", schema.Properties["color"].Ref)
+		assert.Equal(tt, []string{"RED", "BLUE"}, document.Components.Schemas["color"].Enum)
+		assert.Equal(tt, "date-time", schema.Properties["created_at"].Format)
+		assert.Equal(tt, "#/components/schemas/paint", schema.Properties["next"].Ref)
+		assert.Equal(tt, 5, len(schema.Properties))
+	})
+}
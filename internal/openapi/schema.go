@@ -0,0 +1,150 @@
+package openapi
+
+import (
+	"encoding/json"
+	"mime/multipart"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+	fileHeaderType = reflect.TypeOf(multipart.FileHeader{})
+)
+
+// Schema is the subset of JSON Schema used by the document. An empty schema
+// accepts any value.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+func indirect(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	return t
+}
+
+// schema describes t as read through the given struct tag. Named structs
+// read through their json tags and enums become components referenced by
+// the schema.
+func (b *Builder) schema(t reflect.Type, tag string) *Schema {
+	t = indirect(t)
+
+	if values, ok := b.enums[t]; ok {
+		return b.component(t, func() *Schema {
+			return &Schema{Type: "string", Enum: values}
+		})
+	}
+
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	case fileHeaderType:
+		return &Schema{Type: "string", Format: "binary"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Uint, reflect.Uint8, reflect.Uint16:
+		return &Schema{Type: "integer"}
+	case reflect.Int32, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: b.schema(t.Elem(), tag)}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: b.schema(t.Elem(), tag)}
+	case reflect.Struct:
+		if len(t.Name()) == 0 || tag != "json" {
+			return b.object(t, tag)
+		}
+		return b.component(t, func() *Schema {
+			return b.object(t, tag)
+		})
+	}
+
+	return &Schema{}
+}
+
+// component registers the schema built by describe under the name of t,
+// once, and returns a reference to it.
+func (b *Builder) component(t reflect.Type, describe func() *Schema) *Schema {
+	name := b.componentName(t)
+	if _, ok := b.document.Components.Schemas[name]; !ok {
+		// The placeholder stops the recursion of types that refer to
+		// themselves.
+		b.document.Components.Schemas[name] = &Schema{}
+		*b.document.Components.Schemas[name] = *describe()
+	}
+
+	return &Schema{Ref: "#/components/schemas/" + name}
+}
+
+// object describes the exported fields of a struct. Embedded structs
+// without a tag have their fields promoted, as encoding/json and gin do.
+func (b *Builder) object(t reflect.Type, tag string) *Schema {
+	object := &Schema{Type: "object", Properties: map[string]*Schema{}}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get(tag), ",")
+
+		if field.Anonymous && len(name) == 0 && indirect(field.Type).Kind() == reflect.Struct {
+			embedded := b.object(indirect(field.Type), tag)
+			for property, schema := range embedded.Properties {
+				object.Properties[property] = schema
+			}
+			object.Required = append(object.Required, embedded.Required...)
+			continue
+		}
+
+		if !field.IsExported() || name == "-" {
+			continue
+		}
+		if len(name) == 0 {
+			name = field.Name
+		}
+
+		object.Properties[name] = b.schema(field.Type, tag)
+		if required(field) {
+			object.Required = append(object.Required, name)
+		}
+	}
+
+	return object
+}
+
+func required(field reflect.StructField) bool {
+	for _, rule := range strings.Split(field.Tag.Get("binding"), ",") {
+		if rule == "required" {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,135 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Event types published to other systems. A type is named after the
+// aggregate it belongs to and what happened to it.
+const (
+	VEHICLE_CREATED                  = "vehicle.created"
+	VEHICLE_UPDATED                  = "vehicle.updated"
+	VEHICLE_LICENSING_STATUS_CHANGED = "vehicle.licensing_status_changed"
+	VEHICLE_DELETED                  = "vehicle.deleted"
+	DRIVER_CREATED                   = "driver.created"
+	DRIVER_UPDATED                   = "driver.updated"
+	DRIVER_DELETED                   = "driver.deleted"
+	DRIVER_POINTS_NEAR_SUSPENSION    = "driver.points_near_suspension"
+	DRIVER_VEHICLE_ASSIGNED          = "driver_vehicle.assigned"
+	DRIVER_VEHICLE_UNASSIGNED        = "driver_vehicle.unassigned"
+)
+
+// Aggregate types that emit events.
+const (
+	VEHICLE        = "vehicle"
+	DRIVER         = "driver"
+	DRIVER_VEHICLE = "driver_vehicle"
+)
+
+var EventTypes = []string{
+	VEHICLE_CREATED,
+	VEHICLE_UPDATED,
+	VEHICLE_LICENSING_STATUS_CHANGED,
+	VEHICLE_DELETED,
+	DRIVER_CREATED,
+	DRIVER_UPDATED,
+	DRIVER_DELETED,
+	DRIVER_POINTS_NEAR_SUSPENSION,
+	DRIVER_VEHICLE_ASSIGNED,
+	DRIVER_VEHICLE_UNASSIGNED,
+}
+
+// Event is a fact about an aggregate waiting in the outbox to be published.
+// Events of the same aggregate are published in the order they were emitted.
+type Event struct {
+	ID            int64
+	AggregateType string
+	AggregateID   string
+	Type          string
+	Payload       json.RawMessage
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+	OccurredAt    time.Time
+	PublishedAt   time.Time
+	ParkedAt      time.Time
+}
+
+// AssignmentPayload is the body of the driver_vehicle.assigned and
+// driver_vehicle.unassigned events.
+type AssignmentPayload struct {
+	DriverID  int64 `json:"driver_id"`
+	VehicleID int64 `json:"vehicle_id"`
+}
+
+// NewEvent encodes payload as the JSON body of an event of the aggregate
+// identified by aggregateType and id.
+func NewEvent(aggregateType string, id int64, eventType string, payload any) (*Event, error) {
+	return newEvent(aggregateType, strconv.FormatInt(id, 10), eventType, payload)
+}
+
+// NewAssignmentEvent is NewEvent for the assignment of a vehicle to a driver,
+// identified as "driverID:vehicleID".
+func NewAssignmentEvent(driverID, vehicleID int64, eventType string, payload any) (*Event, error) {
+	return newEvent(DRIVER_VEHICLE, strconv.FormatInt(driverID, 10)+":"+strconv.FormatInt(vehicleID, 10), eventType, payload)
+}
+
+// NewUnassignedEvent is the driver_vehicle.unassigned event of the
+// assignment of the vehicle to the driver.
+func NewUnassignedEvent(driverID, vehicleID int64) (*Event, error) {
+	return NewAssignmentEvent(driverID, vehicleID, DRIVER_VEHICLE_UNASSIGNED, AssignmentPayload{DriverID: driverID, VehicleID: vehicleID})
+}
+
+func newEvent(aggregateType, aggregateID, eventType string, payload any) (*Event, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Event{
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Type:          eventType,
+		Payload:       body,
+	}, nil
+}
+
+type Reading interface {
+	// ListPending returns up to limit unpublished events that are neither
+	// parked nor waiting for a retry, oldest first. Events behind an earlier
+	// one of their aggregate that is waiting for a retry are left out.
+	ListPending(ctx context.Context, limit int) (*[]Event, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, events []*Event) error
+	MarkPublished(ctx context.Context, id int64) error
+	// MarkFailed counts a failed attempt and holds the event until
+	// nextAttemptAt.
+	MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error
+	// Park counts a failed attempt and sets the event aside for good.
+	Park(ctx context.Context, id int64, reason string) error
+	// Lock takes a lock held until the transaction carried by ctx ends. It
+	// returns false when another relay already holds it.
+	Lock(ctx context.Context) (bool, error)
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// Emitter appends events to the outbox using the transaction carried by ctx,
+// so they are stored if and only if the change that caused them is.
+type Emitter interface {
+	Emit(ctx context.Context, events ...*Event) error
+}
+
+// Publisher delivers an event to other systems. It may be called more than
+// once for the same event, so consumers must be idempotent on Event.ID.
+type Publisher interface {
+	Publish(ctx context.Context, e *Event) error
+}
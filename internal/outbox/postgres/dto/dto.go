@@ -0,0 +1,24 @@
+package dto
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type EventDTO struct {
+	bun.BaseModel `bun:"table:outbox_events"`
+
+	ID            int64           `bun:"id,pk,autoincrement"`
+	AggregateType string          `bun:"aggregate_type,notnull"`
+	AggregateID   string          `bun:"aggregate_id,notnull"`
+	EventType     string          `bun:"event_type,notnull"`
+	Payload       json.RawMessage `bun:"payload,type:jsonb,notnull"`
+	Attempts      int             `bun:"attempts,notnull,default:0"`
+	LastError     string          `bun:"last_error,nullzero"`
+	NextAttemptAt time.Time       `bun:"next_attempt_at,nullzero,notnull,default:current_timestamp"`
+	OccurredAt    time.Time       `bun:"occurred_at,nullzero,notnull,default:current_timestamp"`
+	PublishedAt   time.Time       `bun:"published_at,nullzero"`
+	ParkedAt      time.Time       `bun:"parked_at,nullzero"`
+}
@@ -0,0 +1,38 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox/postgres/dto"
+)
+
+func MapEventToDTO(event *outbox.Event) *dto.EventDTO {
+	return &dto.EventDTO{
+		ID:            event.ID,
+		AggregateType: event.AggregateType,
+		AggregateID:   event.AggregateID,
+		EventType:     event.Type,
+		Payload:       event.Payload,
+		Attempts:      event.Attempts,
+		LastError:     event.LastError,
+		NextAttemptAt: event.NextAttemptAt,
+		OccurredAt:    event.OccurredAt,
+		PublishedAt:   event.PublishedAt,
+		ParkedAt:      event.ParkedAt,
+	}
+}
+
+func MapDTOToEvent(eventDTO *dto.EventDTO) *outbox.Event {
+	return &outbox.Event{
+		ID:            eventDTO.ID,
+		AggregateType: eventDTO.AggregateType,
+		AggregateID:   eventDTO.AggregateID,
+		Type:          eventDTO.EventType,
+		Payload:       eventDTO.Payload,
+		Attempts:      eventDTO.Attempts,
+		LastError:     eventDTO.LastError,
+		NextAttemptAt: eventDTO.NextAttemptAt,
+		OccurredAt:    eventDTO.OccurredAt,
+		PublishedAt:   eventDTO.PublishedAt,
+		ParkedAt:      eventDTO.ParkedAt,
+	}
+}
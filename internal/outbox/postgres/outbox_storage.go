@@ -0,0 +1,127 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox/postgres/mapping"
+	"github.com/uptrace/bun"
+)
+
+// relayLockKey identifies the advisory lock that keeps a single relay
+// publishing at a time, which per aggregate ordering relies on.
+const relayLockKey = 7_310_420_036
+
+type outboxPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *outboxPostgresRepo {
+	return &outboxPostgresRepo{
+		db: db,
+	}
+}
+
+func (or *outboxPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, or.db)
+}
+
+func (or *outboxPostgresRepo) ListPending(ctx context.Context, limit int) (*[]outbox.Event, error) {
+	var eventDTOs []dto.EventDTO
+
+	// An event waiting for a retry also holds back the later events of its
+	// aggregate, which keeps them in order. A parked event does not.
+	err := or.conn(ctx).NewSelect().
+		Model(&eventDTOs).
+		Where("?TableAlias.published_at IS NULL").
+		Where("?TableAlias.parked_at IS NULL").
+		Where("?TableAlias.next_attempt_at <= current_timestamp").
+		Where(`NOT EXISTS (
+			SELECT 1 FROM outbox_events AS earlier
+			WHERE earlier.aggregate_type = ?TableAlias.aggregate_type
+				AND earlier.aggregate_id = ?TableAlias.aggregate_id
+				AND earlier.id < ?TableAlias.id
+				AND earlier.published_at IS NULL
+				AND earlier.parked_at IS NULL
+				AND earlier.next_attempt_at > current_timestamp
+		)`).
+		Order("id ASC").
+		Limit(limit).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	events := make([]outbox.Event, 0, len(eventDTOs))
+	for _, dto := range eventDTOs {
+		events = append(events, *mapping.MapDTOToEvent(&dto))
+	}
+
+	return &events, nil
+}
+
+func (or *outboxPostgresRepo) Create(ctx context.Context, events []*outbox.Event) error {
+	eventDTOs := make([]*dto.EventDTO, len(events))
+	for i, event := range events {
+		eventDTOs[i] = mapping.MapEventToDTO(event)
+	}
+
+	_, err := or.conn(ctx).NewInsert().Model(&eventDTOs).Returning("id, occurred_at").Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	for i, eventDTO := range eventDTOs {
+		events[i].ID = eventDTO.ID
+		events[i].OccurredAt = eventDTO.OccurredAt
+	}
+
+	return nil
+}
+
+func (or *outboxPostgresRepo) MarkPublished(ctx context.Context, id int64) error {
+	_, err := or.conn(ctx).NewUpdate().
+		Model((*dto.EventDTO)(nil)).
+		Set("published_at = current_timestamp").
+		Set("attempts = attempts + 1").
+		Set("last_error = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (or *outboxPostgresRepo) MarkFailed(ctx context.Context, id int64, reason string, nextAttemptAt time.Time) error {
+	_, err := or.conn(ctx).NewUpdate().
+		Model((*dto.EventDTO)(nil)).
+		Set("attempts = attempts + 1").
+		Set("last_error = ?", reason).
+		Set("next_attempt_at = ?", nextAttemptAt).
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (or *outboxPostgresRepo) Park(ctx context.Context, id int64, reason string) error {
+	_, err := or.conn(ctx).NewUpdate().
+		Model((*dto.EventDTO)(nil)).
+		Set("attempts = attempts + 1").
+		Set("last_error = ?", reason).
+		Set("parked_at = current_timestamp").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (or *outboxPostgresRepo) Lock(ctx context.Context) (bool, error) {
+	var locked bool
+
+	err := or.conn(ctx).NewSelect().ColumnExpr("pg_try_advisory_xact_lock(?)", relayLockKey).Scan(ctx, &locked)
+	if err != nil {
+		return false, err
+	}
+
+	return locked, nil
+}
@@ -0,0 +1,32 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// logPublisher writes events to the application log. It is meant for
+// development, where no consumer is listening.
+type logPublisher struct {
+	logger *logging.Logging
+}
+
+func NewLog(l *logging.Logging) *logPublisher {
+	return &logPublisher{
+		logger: l,
+	}
+}
+
+func (lp *logPublisher) Publish(ctx context.Context, e *outbox.Event) error {
+	lp.logger.Info("[OUTBOX] Publish - INFO: ", map[string]any{
+		"eventID":       e.ID,
+		"type":          e.Type,
+		"aggregateType": e.AggregateType,
+		"aggregateID":   e.AggregateID,
+		"payload":       string(e.Payload),
+	})
+
+	return nil
+}
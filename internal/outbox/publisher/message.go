@@ -0,0 +1,31 @@
+package publisher
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// Message is the envelope every publisher sends. Consumers deduplicate
+// redeliveries by ID.
+type Message struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Payload       json.RawMessage `json:"payload"`
+}
+
+func Marshal(e *outbox.Event) ([]byte, error) {
+	return json.Marshal(Message{
+		ID:            strconv.FormatInt(e.ID, 10),
+		Type:          e.Type,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		OccurredAt:    e.OccurredAt,
+		Payload:       e.Payload,
+	})
+}
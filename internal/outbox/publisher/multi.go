@@ -0,0 +1,30 @@
+package publisher
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// multiPublisher publishes every event to each of its publishers, in order. An event
+// a publisher fails on is retried on all of them, so every publisher must
+// tolerate duplicates.
+type multiPublisher struct {
+	publishers []outbox.Publisher
+}
+
+func NewMulti(publishers ...outbox.Publisher) *multiPublisher {
+	return &multiPublisher{
+		publishers: publishers,
+	}
+}
+
+func (mp *multiPublisher) Publish(ctx context.Context, e *outbox.Event) error {
+	for _, p := range mp.publishers {
+		if err := p.Publish(ctx, e); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package publisher
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+const (
+	DEFAULT_STREAM         = "OPERATIONS"
+	DEFAULT_SUBJECT_PREFIX = "operations"
+)
+
+// JetStream is the part of a NATS JetStream client used by the publisher.
+// jetstream.JetStream implements it; tests use an in-memory stand-in.
+type JetStream interface {
+	PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error)
+}
+
+// jetStreamPublisher publishes every event on the subject
+// "<prefix>.<event type>", e.g. "operations.vehicle.created". The event ID is
+// sent as the message ID, so the stream drops redeliveries that arrive
+// within its duplicate window.
+type jetStreamPublisher struct {
+	js     JetStream
+	prefix string
+}
+
+func NewJetStream(js JetStream, prefix string) *jetStreamPublisher {
+	if len(prefix) == 0 {
+		prefix = DEFAULT_SUBJECT_PREFIX
+	}
+
+	return &jetStreamPublisher{
+		js:     js,
+		prefix: prefix,
+	}
+}
+
+func (jp *jetStreamPublisher) Publish(ctx context.Context, e *outbox.Event) error {
+	body, err := Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	msg := nats.NewMsg(jp.prefix + "." + e.Type)
+	msg.Data = body
+	msg.Header.Set("Event-Type", e.Type)
+	msg.Header.Set("Aggregate-Type", e.AggregateType)
+	msg.Header.Set("Aggregate-ID", e.AggregateID)
+	msg.Header.Set(jetstream.MsgIDHeader, strconv.FormatInt(e.ID, 10))
+
+	_, err = jp.js.PublishMsg(ctx, msg)
+	return err
+}
+
+// ConnectJetStream connects to the NATS server at url and makes sure the
+// stream receiving the events exists.
+func ConnectJetStream(ctx context.Context, url, prefix string) (jetstream.JetStream, *nats.Conn, error) {
+	if len(prefix) == 0 {
+		prefix = DEFAULT_SUBJECT_PREFIX
+	}
+
+	nc, err := nats.Connect(url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	js, err := jetstream.New(nc)
+	if err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+
+	_, err = js.CreateOrUpdateStream(ctx, jetstream.StreamConfig{
+		Name:     DEFAULT_STREAM,
+		Subjects: []string{prefix + ".>"},
+	})
+	if err != nil {
+		nc.Close()
+		return nil, nil, err
+	}
+
+	return js, nc, nil
+}
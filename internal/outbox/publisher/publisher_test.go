@@ -0,0 +1,113 @@
+package publisher_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox/publisher"
+	"github.com/go-playground/assert/v2"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	mockedEvent   = &outbox.Event{
+		ID:            42,
+		AggregateType: outbox.VEHICLE,
+		AggregateID:   "7",
+		Type:          outbox.VEHICLE_LICENSING_STATUS_CHANGED,
+		Payload:       json.RawMessage(`{"id":7,"status":"BLOCKED"}`),
+	}
+)
+
+// memoryJetStream is an in-memory stand-in for a JetStream server that
+// keeps the published messages and drops duplicated message IDs, as a
+// stream does within its duplicate window.
+type memoryJetStream struct {
+	messages []*nats.Msg
+	ids      map[string]bool
+	err      error
+}
+
+func (m *memoryJetStream) PublishMsg(ctx context.Context, msg *nats.Msg, opts ...jetstream.PublishOpt) (*jetstream.PubAck, error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+
+	id := msg.Header.Get(jetstream.MsgIDHeader)
+	if m.ids[id] {
+		return &jetstream.PubAck{Duplicate: true}, nil
+	}
+
+	m.ids[id] = true
+	m.messages = append(m.messages, msg)
+	return &jetstream.PubAck{Sequence: uint64(len(m.messages))}, nil
+}
+
+func TestJetStreamPublisher_Publish(t *testing.T) {
+	js := &memoryJetStream{ids: make(map[string]bool)}
+	p := publisher.NewJetStream(js, "")
+
+	// A redelivery of the same event is dropped by the stream.
+	assert.Equal(t, nil, p.Publish(mockedContext, mockedEvent))
+	assert.Equal(t, nil, p.Publish(mockedContext, mockedEvent))
+
+	assert.Equal(t, 1, len(js.messages))
+	assert.Equal(t, "operations.vehicle.licensing_status_changed", js.messages[0].Subject)
+	assert.Equal(t, "7", js.messages[0].Header.Get("Aggregate-ID"))
+
+	var message publisher.Message
+	assert.Equal(t, nil, json.Unmarshal(js.messages[0].Data, &message))
+	assert.Equal(t, "42", message.ID)
+	assert.Equal(t, outbox.VEHICLE_LICENSING_STATUS_CHANGED, message.Type)
+	assert.Equal(t, string(mockedEvent.Payload), string(message.Payload))
+
+	js.err = errMocked
+	assert.Equal(t, errMocked, p.Publish(mockedContext, mockedEvent))
+}
+
+func TestWebhookPublisher_Publish(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		wantErr bool
+	}{
+		{
+			name:    "Dado um webhook que aceita o evento quando o método Publish é chamado então nenhum erro é retornado",
+			status:  http.StatusNoContent,
+			wantErr: false,
+		},
+		{
+			name:    "Dado um webhook que falha quando o método Publish é chamado então um erro é retornado",
+			status:  http.StatusServiceUnavailable,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			var received publisher.Message
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(tt, "42", r.Header.Get("X-Event-ID"))
+				assert.Equal(tt, outbox.VEHICLE_LICENSING_STATUS_CHANGED, r.Header.Get("X-Event-Type"))
+				assert.Equal(tt, nil, json.NewDecoder(r.Body).Decode(&received))
+				w.WriteHeader(test.status)
+			}))
+			defer server.Close()
+
+			p := publisher.NewWebhook(server.URL, server.Client())
+
+			err := p.Publish(mockedContext, mockedEvent)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, "42", received.ID)
+		})
+	}
+}
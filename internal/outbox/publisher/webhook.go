@@ -0,0 +1,54 @@
+package publisher
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// webhookPublisher posts every event as JSON to a single URL. Any status
+// other than 2xx is a failure and the event is tried again.
+type webhookPublisher struct {
+	url    string
+	client *http.Client
+}
+
+func NewWebhook(url string, client *http.Client) *webhookPublisher {
+	return &webhookPublisher{
+		url:    url,
+		client: client,
+	}
+}
+
+func (wp *webhookPublisher) Publish(ctx context.Context, e *outbox.Event) error {
+	body, err := Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wp.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event-ID", strconv.FormatInt(e.ID, 10))
+	req.Header.Set("X-Event-Type", e.Type)
+
+	res, err := wp.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("the webhook answered the event [%d] with status %d", e.ID, res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/transaction"
+)
+
+const (
+	DEFAULT_MAXIMUM_ATTEMPTS = 10
+	DEFAULT_BATCH_SIZE       = 100
+	DEFAULT_INTERVAL         = time.Second
+
+	// The wait before a retry doubles from INITIAL_BACKOFF up to
+	// MAXIMUM_BACKOFF: 1s, 2s, 4s, ... 5m.
+	INITIAL_BACKOFF = time.Second
+	MAXIMUM_BACKOFF = 5 * time.Minute
+)
+
+// Backoff is the wait before the next try of an event that failed attempts
+// times.
+func Backoff(attempts int) time.Duration {
+	backoff := INITIAL_BACKOFF
+	for i := 1; i < attempts && backoff < MAXIMUM_BACKOFF; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, MAXIMUM_BACKOFF)
+}
+
+// Relay moves events from the outbox to a Publisher. An event is marked as
+// published only after the publisher accepted it, so delivery is at least
+// once. When an event fails, it is retried with an exponential backoff and
+// the later events of its aggregate wait for it, which keeps them in order.
+// After maximumAttempts the event is parked, releasing its aggregate, so
+// that an event that can never be published does not hold the outbox back.
+type Relay struct {
+	transactor      transaction.Transactor
+	repo            Repository
+	publisher       Publisher
+	maximumAttempts int
+	batchSize       int
+	logger          *logging.Logging
+}
+
+func NewRelay(t transaction.Transactor, r Repository, p Publisher, maximumAttempts, batchSize int, l *logging.Logging) *Relay {
+	if maximumAttempts <= 0 {
+		maximumAttempts = DEFAULT_MAXIMUM_ATTEMPTS
+	}
+
+	if batchSize <= 0 {
+		batchSize = DEFAULT_BATCH_SIZE
+	}
+
+	return &Relay{
+		transactor:      t,
+		repo:            r,
+		publisher:       p,
+		maximumAttempts: maximumAttempts,
+		batchSize:       batchSize,
+		logger:          l,
+	}
+}
+
+// Run relays the outbox every interval until ctx is cancelled.
+func (r *Relay) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DEFAULT_INTERVAL
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		published, err := r.RelayOnce(ctx)
+		if err != nil {
+			r.logger.Error("[OUTBOX] Run - ERROR: ", map[string]any{
+				"err": err.Error(),
+			})
+		}
+
+		// A full batch means more events are probably waiting.
+		if err == nil && published == r.batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RelayOnce publishes one batch of pending events and returns how many were
+// published. Only one relay works at a time; the others return 0.
+func (r *Relay) RelayOnce(ctx context.Context) (int, error) {
+	var published int
+
+	err := r.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		locked, err := r.repo.Lock(ctx)
+		if err != nil || !locked {
+			return err
+		}
+
+		events, err := r.repo.ListPending(ctx, r.batchSize)
+		if err != nil {
+			return err
+		}
+
+		held := make(map[string]bool)
+		for i := range *events {
+			e := &(*events)[i]
+
+			aggregate := e.AggregateType + ":" + e.AggregateID
+			if held[aggregate] {
+				continue
+			}
+
+			if err := r.publisher.Publish(ctx, e); err != nil {
+				held[aggregate] = true
+				if err := r.fail(ctx, e, err); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if err := r.repo.MarkPublished(ctx, e.ID); err != nil {
+				return err
+			}
+			published++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return published, nil
+}
+
+// fail records the failed attempt of the event, parking it once it has
+// used up its attempts.
+func (r *Relay) fail(ctx context.Context, e *Event, publishErr error) error {
+	e.Attempts++
+	parked := e.Attempts >= r.maximumAttempts
+
+	r.logger.Warn("[OUTBOX] RelayOnce - WARN: ", map[string]any{
+		"eventID":  e.ID,
+		"attempts": e.Attempts,
+		"parked":   parked,
+		"err":      publishErr.Error(),
+	})
+
+	if parked {
+		return r.repo.Park(ctx, e.ID, publishErr.Error())
+	}
+
+	return r.repo.MarkFailed(ctx, e.ID, publishErr.Error(), time.Now().Add(Backoff(e.Attempts)))
+}
@@ -0,0 +1,196 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
+	transaction_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/transaction"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+)
+
+func withinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{
+			name:     "Dado a primeira falha quando o método Backoff é chamado então a espera inicial é retornada",
+			attempts: 1,
+			want:     outbox.INITIAL_BACKOFF,
+		},
+		{
+			name:     "Dado a terceira falha quando o método Backoff é chamado então a espera é dobrada duas vezes",
+			attempts: 3,
+			want:     4 * outbox.INITIAL_BACKOFF,
+		},
+		{
+			name:     "Dado muitas falhas quando o método Backoff é chamado então a espera máxima é retornada",
+			attempts: 50,
+			want:     outbox.MAXIMUM_BACKOFF,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, outbox.Backoff(test.attempts))
+		})
+	}
+}
+
+func TestRelay_RelayOnce(t *testing.T) {
+	type relayMocks struct {
+		transactor *transaction_mocks.MockTransactor
+		repo       *outbox_mocks.MockRepository
+		publisher  *outbox_mocks.MockPublisher
+		logger     *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+	}
+
+	pendingEvents := &[]outbox.Event{
+		{ID: 1, AggregateType: outbox.VEHICLE, AggregateID: "1", Type: outbox.VEHICLE_CREATED},
+		{ID: 2, AggregateType: outbox.VEHICLE, AggregateID: "1", Type: outbox.VEHICLE_UPDATED},
+		{ID: 3, AggregateType: outbox.VEHICLE, AggregateID: "2", Type: outbox.VEHICLE_CREATED},
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m relayMocks)
+		want        int
+		wantErr     bool
+	}{
+		{
+			name: "Dado eventos pendentes quando o método RelayOnce é chamado então todos são publicados em ordem",
+			args: args{
+				ctx: mockedContext,
+			},
+			prepareMock: func(p args, m relayMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().Lock(p.ctx).Return(true, nil)
+				m.repo.EXPECT().ListPending(p.ctx, 10).Return(pendingEvents, nil)
+				gomock.InOrder(
+					m.publisher.EXPECT().Publish(p.ctx, &(*pendingEvents)[0]).Return(nil),
+					m.repo.EXPECT().MarkPublished(p.ctx, int64(1)).Return(nil),
+					m.publisher.EXPECT().Publish(p.ctx, &(*pendingEvents)[1]).Return(nil),
+					m.repo.EXPECT().MarkPublished(p.ctx, int64(2)).Return(nil),
+					m.publisher.EXPECT().Publish(p.ctx, &(*pendingEvents)[2]).Return(nil),
+					m.repo.EXPECT().MarkPublished(p.ctx, int64(3)).Return(nil),
+				)
+			},
+			want:    3,
+			wantErr: false,
+		},
+		{
+			name: "Dado uma falha ao publicar um evento quando o método RelayOnce é chamado então os eventos seguintes do mesmo agregado aguardam",
+			args: args{
+				ctx: mockedContext,
+			},
+			prepareMock: func(p args, m relayMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().Lock(p.ctx).Return(true, nil)
+				m.repo.EXPECT().ListPending(p.ctx, 10).Return(pendingEvents, nil)
+				gomock.InOrder(
+					m.publisher.EXPECT().Publish(p.ctx, gomock.Any()).Return(errMocked),
+					m.repo.EXPECT().MarkFailed(p.ctx, int64(1), errMocked.Error(), gomock.Cond(func(x any) bool {
+						return time.Until(x.(time.Time)) > 0
+					})).Return(nil),
+					m.publisher.EXPECT().Publish(p.ctx, &(*pendingEvents)[2]).Return(nil),
+					m.repo.EXPECT().MarkPublished(p.ctx, int64(3)).Return(nil),
+				)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Dado um evento que esgotou as tentativas quando o método RelayOnce é chamado então ele é estacionado",
+			args: args{
+				ctx: mockedContext,
+			},
+			prepareMock: func(p args, m relayMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().Lock(p.ctx).Return(true, nil)
+				m.repo.EXPECT().ListPending(p.ctx, 10).Return(&[]outbox.Event{
+					{ID: 1, AggregateType: outbox.VEHICLE, AggregateID: "1", Type: outbox.VEHICLE_CREATED, Attempts: 2},
+				}, nil)
+				gomock.InOrder(
+					m.publisher.EXPECT().Publish(p.ctx, gomock.Any()).Return(errMocked),
+					m.repo.EXPECT().Park(p.ctx, int64(1), errMocked.Error()).Return(nil),
+				)
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Dado outro relay em execução quando o método RelayOnce é chamado então nada é publicado",
+			args: args{
+				ctx: mockedContext,
+			},
+			prepareMock: func(p args, m relayMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().Lock(p.ctx).Return(false, nil)
+			},
+			want:    0,
+			wantErr: false,
+		},
+		{
+			name: "Dado um erro ao marcar um evento como publicado quando o método RelayOnce é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+			},
+			prepareMock: func(p args, m relayMocks) {
+				m.transactor.EXPECT().WithinTransaction(p.ctx, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().Lock(p.ctx).Return(true, nil)
+				m.repo.EXPECT().ListPending(p.ctx, 10).Return(pendingEvents, nil)
+				m.publisher.EXPECT().Publish(p.ctx, &(*pendingEvents)[0]).Return(nil)
+				m.repo.EXPECT().MarkPublished(p.ctx, int64(1)).Return(errMocked)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			rm := relayMocks{
+				transactor: transaction_mocks.NewMockTransactor(ctrl),
+				repo:       outbox_mocks.NewMockRepository(ctrl),
+				publisher:  outbox_mocks.NewMockPublisher(ctrl),
+				logger:     logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, rm)
+			}
+
+			r := outbox.NewRelay(rm.transactor, rm.repo, rm.publisher, 3, 10, rm.logger)
+
+			published, err := r.RelayOnce(test.args.ctx)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, published)
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package outbox
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+type Service struct {
+	repo   Repository
+	logger *logging.Logging
+}
+
+func NewService(r Repository, l *logging.Logging) *Service {
+	return &Service{
+		repo:   r,
+		logger: l,
+	}
+}
+
+func (s *Service) Emit(ctx context.Context, events ...*Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	err := s.repo.Create(ctx, events)
+	if err != nil {
+		s.logger.Error("[OUTBOX] Emit - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
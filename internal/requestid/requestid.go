@@ -0,0 +1,51 @@
+package requestid
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+const (
+	HEADER         = "X-Request-ID"
+	MAXIMUM_LENGTH = 128
+)
+
+type requestIDKey struct{}
+
+// New returns a random 128-bit request ID encoded as hex.
+func New() string {
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		panic(err)
+	}
+
+	return hex.EncodeToString(id)
+}
+
+// WithRequestID returns a copy of ctx carrying the request ID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// FromContext returns the request ID carried by ctx, or an empty string.
+func FromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// IsUsable reports whether an ID sent by a client can be kept: it must be
+// short and made only of printable ASCII characters.
+func IsUsable(id string) bool {
+	if len(id) == 0 || len(id) > MAXIMUM_LENGTH {
+		return false
+	}
+
+	for _, r := range id {
+		if r < '!' || r > '~' {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,98 @@
+package spreadsheet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Locale string
+
+const (
+	PT_BR Locale = "pt-BR"
+	EN_US Locale = "en-US"
+
+	BRAZILIAN_TIMEZONE = "America/Sao_Paulo"
+)
+
+// Formatter renders dates and numbers of exported cells following the
+// conventions of a locale: 31/12/2024 and 1.234,56 for pt-BR, ISO 8601 dates
+// and 1,234.56 for en-US.
+type Formatter struct {
+	locale   Locale
+	location *time.Location
+}
+
+func NewFormatter(locale string) (*Formatter, error) {
+	switch {
+	case len(strings.TrimSpace(locale)) == 0, strings.EqualFold(locale, string(PT_BR)):
+		location, err := time.LoadLocation(BRAZILIAN_TIMEZONE)
+		if err != nil {
+			location = time.FixedZone("BRT", -3*60*60)
+		}
+		return &Formatter{locale: PT_BR, location: location}, nil
+	case strings.EqualFold(locale, string(EN_US)):
+		return &Formatter{locale: EN_US, location: time.UTC}, nil
+	}
+
+	return nil, fmt.Errorf("the given locale [%s] is not supported, use %s or %s", locale, PT_BR, EN_US)
+}
+
+func (f *Formatter) Date(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	if f.locale == PT_BR {
+		return t.Format("02/01/2006")
+	}
+
+	return t.Format("2006-01-02")
+}
+
+func (f *Formatter) DateTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	if f.locale == PT_BR {
+		return t.In(f.location).Format("02/01/2006 15:04:05")
+	}
+
+	return t.In(f.location).Format(time.RFC3339)
+}
+
+func (f *Formatter) Integer(n int64) string {
+	return f.Decimal(float64(n), 0)
+}
+
+func (f *Formatter) Decimal(n float64, precision int) string {
+	thousandsSeparator, decimalSeparator := ",", "."
+	if f.locale == PT_BR {
+		thousandsSeparator, decimalSeparator = ".", ","
+	}
+
+	formatted := strconv.FormatFloat(n, 'f', precision, 64)
+
+	sign := ""
+	if strings.HasPrefix(formatted, "-") {
+		sign, formatted = "-", formatted[1:]
+	}
+
+	integerPart, fractionalPart, hasFraction := strings.Cut(formatted, ".")
+
+	var grouped strings.Builder
+	for i, digit := range integerPart {
+		if i > 0 && (len(integerPart)-i)%3 == 0 {
+			grouped.WriteString(thousandsSeparator)
+		}
+		grouped.WriteRune(digit)
+	}
+
+	if hasFraction {
+		return sign + grouped.String() + decimalSeparator + fractionalPart
+	}
+
+	return sign + grouped.String()
+}
@@ -0,0 +1,63 @@
+package spreadsheet
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+)
+
+func TestFormatter(t *testing.T) {
+	date := time.Date(2024, time.December, 31, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		locale       string
+		wantDate     string
+		wantDateTime string
+		wantInteger  string
+		wantDecimal  string
+		wantErr      bool
+	}{
+		{
+			name:         "Dado o locale pt-BR quando os valores são formatados então as convenções brasileiras são usadas",
+			locale:       "pt-BR",
+			wantDate:     "31/12/2024",
+			wantDateTime: "31/12/2024 12:04:05",
+			wantInteger:  "1.234.567",
+			wantDecimal:  "-1.234,56",
+			wantErr:      false,
+		},
+		{
+			name:         "Dado o locale en-US quando os valores são formatados então as datas seguem a ISO 8601",
+			locale:       "en-US",
+			wantDate:     "2024-12-31",
+			wantDateTime: "2024-12-31T15:04:05Z",
+			wantInteger:  "1,234,567",
+			wantDecimal:  "-1,234.56",
+			wantErr:      false,
+		},
+		{
+			name:    "Dado um locale não suportado quando o formatador é criado então um erro é retornado",
+			locale:  "fr-FR",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			formatter, err := NewFormatter(test.locale)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			if err != nil {
+				return
+			}
+
+			assert.Equal(tt, test.wantDate, formatter.Date(date))
+			assert.Equal(tt, test.wantDateTime, formatter.DateTime(date))
+			assert.Equal(tt, test.wantInteger, formatter.Integer(1234567))
+			assert.Equal(tt, test.wantDecimal, formatter.Decimal(-1234.561, 2))
+			assert.Equal(tt, "", formatter.Date(time.Time{}))
+		})
+	}
+}
@@ -0,0 +1,322 @@
+package spreadsheet
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xuri/excelize/v2"
+)
+
+type Format string
+
+const (
+	CSV   Format = "csv"
+	XLSX  Format = "xlsx"
+	JSONL Format = "jsonl"
+)
+
+var (
+	ErrUnsupportedFormat = errors.New("the given spreadsheet format is not supported, use csv, xlsx or jsonl")
+	ErrMissingHeader     = errors.New("the spreadsheet must have a header row")
+
+	quotedText       = regexp.MustCompile(`"[^"]*"`)
+	bracketedSection = regexp.MustCompile(`\[[^\]]*\]`)
+)
+
+// Record is a data row indexed by its normalized header. Line is the
+// 1-based line of the row in the original file, header included.
+type Record struct {
+	Line   int
+	Values map[string]string
+}
+
+func (r Record) Get(column string) string {
+	return strings.TrimSpace(r.Values[NormalizeHeader(column)])
+}
+
+func NormalizeHeader(header string) string {
+	return strings.ToLower(strings.TrimSpace(strings.TrimPrefix(header, "\ufeff")))
+}
+
+func GetFormat(name string) (Format, error) {
+	switch strings.ToLower(strings.TrimPrefix(filepath.Ext(name), ".")) {
+	case "csv":
+		return CSV, nil
+	case "xlsx":
+		return XLSX, nil
+	case "jsonl", "ndjson":
+		return JSONL, nil
+	}
+
+	switch Format(strings.ToLower(name)) {
+	case CSV, XLSX, JSONL:
+		return Format(strings.ToLower(name)), nil
+	}
+
+	return "", fmt.Errorf("%w: [%s]", ErrUnsupportedFormat, name)
+}
+
+func Read(format Format, r io.Reader) ([]Record, error) {
+	switch format {
+	case CSV:
+		return ReadCSV(r)
+	case XLSX:
+		return ReadXLSX(r)
+	case JSONL:
+		return ReadJSONL(r)
+	}
+
+	return nil, fmt.Errorf("%w: [%s]", ErrUnsupportedFormat, format)
+}
+
+// ReadCSV accepts both comma and semicolon separated files, the latter being
+// the default of spreadsheets saved with a pt-BR locale.
+func ReadCSV(r io.Reader) ([]Record, error) {
+	content, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := csv.NewReader(strings.NewReader(string(content)))
+	reader.Comma = detectSeparator(string(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows [][]string
+	var lines []int
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		line, _ := reader.FieldPos(0)
+		rows = append(rows, row)
+		lines = append(lines, line)
+	}
+
+	return toRecords(rows, lines)
+}
+
+// ReadXLSX reads the first sheet of the workbook. Cells are read without
+// their number format, so that dates typed as such, which the file keeps as
+// serial numbers, come out as 2006-01-02 instead of Excel's mm-dd-yy.
+func ReadXLSX(r io.Reader) ([]Record, error) {
+	file, err := excelize.OpenReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sheets := file.GetSheetList()
+	if len(sheets) == 0 {
+		return nil, ErrMissingHeader
+	}
+
+	rows, err := file.GetRows(sheets[0], excelize.Options{RawCellValue: true})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := formatDates(file, sheets[0], rows); err != nil {
+		return nil, err
+	}
+
+	lines := make([]int, len(rows))
+	for i := range rows {
+		lines[i] = i + 1
+	}
+
+	return toRecords(rows, lines)
+}
+
+// formatDates replaces the serial numbers of the cells styled as dates with
+// the dates themselves.
+func formatDates(file *excelize.File, sheet string, rows [][]string) error {
+	props, err := file.GetWorkbookProps()
+	if err != nil {
+		return err
+	}
+	date1904 := props.Date1904 != nil && *props.Date1904
+
+	dateStyles := map[int]bool{}
+	for i, row := range rows {
+		for j, value := range row {
+			serial, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+
+			cell, err := excelize.CoordinatesToCellName(j+1, i+1)
+			if err != nil {
+				return err
+			}
+
+			styleID, err := file.GetCellStyle(sheet, cell)
+			if err != nil {
+				return err
+			}
+
+			isDate, ok := dateStyles[styleID]
+			if !ok {
+				style, err := file.GetStyle(styleID)
+				if err != nil {
+					return err
+				}
+
+				isDate = isDateFormat(style)
+				dateStyles[styleID] = isDate
+			}
+
+			if !isDate {
+				continue
+			}
+
+			date, err := excelize.ExcelDateToTime(serial, date1904)
+			if err != nil {
+				return err
+			}
+
+			if date.Equal(date.Truncate(24 * time.Hour)) {
+				rows[i][j] = date.Format(time.DateOnly)
+			} else {
+				rows[i][j] = date.Format(time.RFC3339)
+			}
+		}
+	}
+
+	return nil
+}
+
+// isDateFormat tells the number formats that show a date: the builtin 14
+// to 17 and 22, and the custom ones with a day or a year, such as
+// dd/mm/yyyy. Quoted text and bracketed sections, as colors, are skipped.
+func isDateFormat(style *excelize.Style) bool {
+	if style.NumFmt >= 14 && style.NumFmt <= 17 || style.NumFmt == 22 {
+		return true
+	}
+
+	if style.CustomNumFmt == nil {
+		return false
+	}
+
+	format := strings.ToLower(*style.CustomNumFmt)
+	for _, pattern := range []*regexp.Regexp{quotedText, bracketedSection} {
+		format = pattern.ReplaceAllString(format, "")
+	}
+
+	return strings.ContainsAny(format, "dy")
+}
+
+// ReadJSONL reads one flat JSON object per line. Keys play the role of the
+// header and every value is kept in its textual form.
+func ReadJSONL(r io.Reader) ([]Record, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var records []Record
+	line := 0
+	for scanner.Scan() {
+		line++
+
+		content := strings.TrimSpace(scanner.Text())
+		if len(content) == 0 {
+			continue
+		}
+
+		var object map[string]any
+		if err := json.Unmarshal([]byte(content), &object); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+
+		values := make(map[string]string, len(object))
+		for key, value := range object {
+			switch v := value.(type) {
+			case nil:
+				values[NormalizeHeader(key)] = ""
+			case string:
+				values[NormalizeHeader(key)] = v
+			default:
+				encoded, err := json.Marshal(v)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", line, err)
+				}
+				values[NormalizeHeader(key)] = string(encoded)
+			}
+		}
+
+		records = append(records, Record{
+			Line:   line,
+			Values: values,
+		})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+func detectSeparator(content string) rune {
+	firstLine, _, _ := strings.Cut(content, "\n")
+	if strings.Count(firstLine, ";") > strings.Count(firstLine, ",") {
+		return ';'
+	}
+
+	return ','
+}
+
+func toRecords(rows [][]string, lines []int) ([]Record, error) {
+	if len(rows) == 0 {
+		return nil, ErrMissingHeader
+	}
+
+	headers := make([]string, len(rows[0]))
+	for i, header := range rows[0] {
+		headers[i] = NormalizeHeader(header)
+	}
+
+	records := make([]Record, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		if isBlank(row) {
+			continue
+		}
+
+		values := make(map[string]string, len(headers))
+		for j, header := range headers {
+			if j < len(row) {
+				values[header] = row[j]
+			}
+		}
+
+		records = append(records, Record{
+			Line:   lines[i+1],
+			Values: values,
+		})
+	}
+
+	return records, nil
+}
+
+func isBlank(row []string) bool {
+	for _, value := range row {
+		if len(strings.TrimSpace(value)) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
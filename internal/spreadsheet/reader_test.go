@@ -0,0 +1,142 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-playground/assert/v2"
+	"github.com/xuri/excelize/v2"
+)
+
+func TestReadCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		want    []Record
+		wantErr bool
+	}{
+		{
+			name:    "Dado um CSV separado por vírgulas quando a leitura é chamada então os registros são indexados pelo cabeçalho",
+			content: "Plate,Brand\nABC1D23,Fiat\n\nXYZ9876,Ford\n",
+			want: []Record{
+				{Line: 2, Values: map[string]string{"plate": "ABC1D23", "brand": "Fiat"}},
+				{Line: 4, Values: map[string]string{"plate": "XYZ9876", "brand": "Ford"}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Dado um CSV separado por ponto e vírgula quando a leitura é chamada então o separador é detectado",
+			content: "\ufeffplaca;marca\nABC1D23;Fiat\n",
+			want: []Record{
+				{Line: 2, Values: map[string]string{"placa": "ABC1D23", "marca": "Fiat"}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "Dado um CSV vazio quando a leitura é chamada então um erro é retornado",
+			content: "",
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			actualRecords, err := ReadCSV(strings.NewReader(test.content))
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, actualRecords)
+		})
+	}
+}
+
+func TestReadXLSX(t *testing.T) {
+	file := excelize.NewFile()
+	defer file.Close()
+
+	sheet := file.GetSheetName(0)
+	file.SetSheetRow(sheet, "A1", &[]any{"plate", "brand", "acquired_at", "odometer", "color"})
+	file.SetSheetRow(sheet, "A2", &[]any{"ABC1D23", "Fiat", time.Date(2023, 5, 17, 0, 0, 0, 0, time.UTC), 15200})
+	file.SetSheetRow(sheet, "A3", &[]any{"XYZ9876", "Ford", time.Date(2024, 1, 2, 8, 30, 0, 0, time.UTC), 800, "red"})
+
+	date, _ := file.NewStyle(&excelize.Style{NumFmt: 14})
+	file.SetCellStyle(sheet, "C2", "C2", date)
+
+	customDate := "dd/mm/yyyy hh:mm"
+	dateTime, _ := file.NewStyle(&excelize.Style{CustomNumFmt: &customDate})
+	file.SetCellStyle(sheet, "C3", "C3", dateTime)
+
+	colored := `[Red]"R$" 0`
+	money, _ := file.NewStyle(&excelize.Style{CustomNumFmt: &colored})
+	file.SetCellStyle(sheet, "D3", "D3", money)
+
+	var buffer bytes.Buffer
+	if err := file.Write(&buffer); err != nil {
+		t.Fatal(err)
+	}
+
+	actualRecords, err := ReadXLSX(&buffer)
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []Record{
+		{Line: 2, Values: map[string]string{"plate": "ABC1D23", "brand": "Fiat", "acquired_at": "2023-05-17", "odometer": "15200"}},
+		{Line: 3, Values: map[string]string{"plate": "XYZ9876", "brand": "Ford", "acquired_at": "2024-01-02T08:30:00Z", "odometer": "800", "color": "red"}},
+	}, actualRecords)
+}
+
+func TestReadJSONL(t *testing.T) {
+	content := `{"Name": "João", "number": 100, "complement": null}
+
+{"name": "Maria", "number": "20A"}
+`
+
+	actualRecords, err := ReadJSONL(strings.NewReader(content))
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []Record{
+		{Line: 1, Values: map[string]string{"name": "João", "number": "100", "complement": ""}},
+		{Line: 3, Values: map[string]string{"name": "Maria", "number": "20A"}},
+	}, actualRecords)
+
+	_, err = ReadJSONL(strings.NewReader("{not json}"))
+	assert.NotEqual(t, nil, err)
+}
+
+func TestGetFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		arg     string
+		want    Format
+		wantErr bool
+	}{
+		{
+			name:    "Dado um nome de arquivo .xlsx quando o formato é detectado então XLSX é retornado",
+			arg:     "frota.XLSX",
+			want:    XLSX,
+			wantErr: false,
+		},
+		{
+			name:    "Dado o nome do formato quando o formato é detectado então CSV é retornado",
+			arg:     "csv",
+			want:    CSV,
+			wantErr: false,
+		},
+		{
+			name:    "Dado um formato não suportado quando o formato é detectado então um erro é retornado",
+			arg:     "frota.ods",
+			want:    "",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			actualFormat, err := GetFormat(test.arg)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, actualFormat)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+
+	"github.com/xuri/excelize/v2"
+)
+
+const (
+	XLSX_SHEET_NAME = "Sheet1"
+)
+
+// Writer writes one row at a time, so that callers can stream large files
+// without holding every row in memory.
+type Writer interface {
+	Write(row []string) error
+	Flush() error
+}
+
+type csvWriter struct {
+	writer *csv.Writer
+}
+
+func (cw *csvWriter) Write(row []string) error {
+	return cw.writer.Write(row)
+}
+
+func (cw *csvWriter) Flush() error {
+	cw.writer.Flush()
+	return cw.writer.Error()
+}
+
+// xlsxWriter relies on the excelize stream writer, which spills rows to a
+// temporary file instead of keeping the whole sheet in memory.
+type xlsxWriter struct {
+	file   *excelize.File
+	stream *excelize.StreamWriter
+	output io.Writer
+	row    int
+}
+
+func (xw *xlsxWriter) Write(row []string) error {
+	xw.row++
+
+	cell, err := excelize.CoordinatesToCellName(1, xw.row)
+	if err != nil {
+		return err
+	}
+
+	values := make([]any, len(row))
+	for i, value := range row {
+		values[i] = value
+	}
+
+	return xw.stream.SetRow(cell, values)
+}
+
+func (xw *xlsxWriter) Flush() error {
+	defer xw.file.Close()
+
+	if err := xw.stream.Flush(); err != nil {
+		return err
+	}
+
+	return xw.file.Write(xw.output)
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	file := excelize.NewFile()
+
+	stream, err := file.NewStreamWriter(XLSX_SHEET_NAME)
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+
+	return &xlsxWriter{
+		file:   file,
+		stream: stream,
+		output: w,
+	}, nil
+}
+
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case CSV:
+		return &csvWriter{writer: csv.NewWriter(w)}, nil
+	case XLSX:
+		return newXLSXWriter(w)
+	}
+
+	return nil, fmt.Errorf("%w: [%s]", ErrUnsupportedFormat, format)
+}
+
+func ContentType(format Format) string {
+	switch format {
+	case CSV:
+		return "text/csv; charset=utf-8"
+	case XLSX:
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	case JSONL:
+		return "application/x-ndjson"
+	}
+
+	return "application/octet-stream"
+}
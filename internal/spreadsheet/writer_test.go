@@ -0,0 +1,66 @@
+package spreadsheet
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/go-playground/assert/v2"
+)
+
+func TestWriter(t *testing.T) {
+	rows := [][]string{
+		{"plate", "brand"},
+		{"ABC1D23", "Fiat"},
+		{"XYZ9876", "Ford"},
+	}
+
+	want := []Record{
+		{Line: 2, Values: map[string]string{"plate": "ABC1D23", "brand": "Fiat"}},
+		{Line: 3, Values: map[string]string{"plate": "XYZ9876", "brand": "Ford"}},
+	}
+
+	tests := []struct {
+		name    string
+		format  Format
+		wantErr bool
+	}{
+		{
+			name:    "Dado o formato CSV quando as linhas são escritas então o arquivo pode ser lido de volta",
+			format:  CSV,
+			wantErr: false,
+		},
+		{
+			name:    "Dado o formato XLSX quando as linhas são escritas então o arquivo pode ser lido de volta",
+			format:  XLSX,
+			wantErr: false,
+		},
+		{
+			name:    "Dado o formato JSONL quando o escritor é criado então um erro é retornado",
+			format:  JSONL,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			var buffer bytes.Buffer
+
+			writer, err := NewWriter(test.format, &buffer)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			if err != nil {
+				return
+			}
+
+			for _, row := range rows {
+				assert.Equal(tt, nil, writer.Write(row))
+			}
+			assert.Equal(tt, nil, writer.Flush())
+
+			actualRecords, err := Read(test.format, &buffer)
+
+			assert.Equal(tt, nil, err)
+			assert.Equal(tt, want, actualRecords)
+		})
+	}
+}
@@ -0,0 +1,10 @@
+package transaction
+
+import "context"
+
+// Transactor runs a unit of work atomically. The context handed to fn
+// carries the transaction, so every repository called with it takes part in
+// the same unit of work. Nested calls join the outer transaction.
+type Transactor interface {
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,19 @@
+package trash
+
+import "errors"
+
+var (
+	// ErrNotInTrash is returned when restoring or purging a resource that
+	// does not exist or was never soft deleted.
+	ErrNotInTrash = errors.New("the resource is not in the trash")
+
+	// ErrRestoreConflict is returned when a resource cannot be restored
+	// because an active one was created with the same unique values after it
+	// was deleted.
+	ErrRestoreConflict = errors.New("the resource cannot be restored because an active one already uses the same unique values")
+
+	// ErrPurgeConflict is returned when a resource cannot be purged because
+	// records that must be kept, such as fines or refuels, still refer to
+	// it.
+	ErrPurgeConflict = errors.New("the resource cannot be purged because records that must be kept still refer to it")
+)
@@ -0,0 +1,21 @@
+package validation
+
+// Messages flattens errors built with errors.Join into one message each, so
+// that they can be reported field by field.
+func Messages(errs ...error) []string {
+	var messages []string
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			messages = append(messages, Messages(joined.Unwrap()...)...)
+			continue
+		}
+
+		messages = append(messages, err.Error())
+	}
+
+	return messages
+}
@@ -0,0 +1,61 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox/publisher"
+)
+
+// Dispatcher is the outbox publisher of webhooks: it queues a delivery of
+// every event for each active subscription to its type. The deliveries are
+// stored in the relay transaction and sent later by the Sender.
+type Dispatcher struct {
+	repo   Repository
+	logger *logging.Logging
+}
+
+func NewDispatcher(r Repository, l *logging.Logging) *Dispatcher {
+	return &Dispatcher{
+		repo:   r,
+		logger: l,
+	}
+}
+
+func (d *Dispatcher) Publish(ctx context.Context, e *outbox.Event) error {
+	subscriptions, err := d.repo.ListByEventType(ctx, e.Type)
+	if err != nil {
+		return err
+	}
+
+	if len(*subscriptions) == 0 {
+		return nil
+	}
+
+	body, err := publisher.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	deliveries := make([]*Delivery, 0, len(*subscriptions))
+	for _, subscription := range *subscriptions {
+		deliveries = append(deliveries, &Delivery{
+			SubscriptionID: subscription.ID,
+			EventID:        e.ID,
+			EventType:      e.Type,
+			Body:           body,
+			Status:         PENDING,
+			NextAttemptAt:  now,
+		})
+	}
+
+	d.logger.Debug("[WEBHOOK] Publish - DEBUG: ", map[string]any{
+		"eventID":    e.ID,
+		"deliveries": len(deliveries),
+	})
+
+	return d.repo.CreateDeliveries(ctx, deliveries)
+}
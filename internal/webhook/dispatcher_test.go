@@ -0,0 +1,95 @@
+package webhook_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	webhook_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/webhook"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+func TestDispatcher_Publish(t *testing.T) {
+	type args struct {
+		ctx   context.Context
+		event *outbox.Event
+	}
+
+	event := &outbox.Event{
+		ID:            7,
+		AggregateType: outbox.DRIVER,
+		AggregateID:   "3",
+		Type:          outbox.DRIVER_CREATED,
+		Payload:       []byte(`{"id":3}`),
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m *webhook_mocks.MockRepository)
+		wantErr     bool
+	}{
+		{
+			name: "Dado assinaturas ativas ao tipo do evento quando o método Publish é chamado então uma entrega pendente é criada para cada uma",
+			args: args{
+				ctx:   mockedContext,
+				event: event,
+			},
+			prepareMock: func(p args, m *webhook_mocks.MockRepository) {
+				m.EXPECT().ListByEventType(p.ctx, outbox.DRIVER_CREATED).Return(&[]webhook.Subscription{{ID: 1}, {ID: 2}}, nil)
+				m.EXPECT().CreateDeliveries(p.ctx, gomock.Cond(func(x any) bool {
+					deliveries := x.([]*webhook.Delivery)
+					return len(deliveries) == 2 &&
+						deliveries[0].SubscriptionID == 1 && deliveries[1].SubscriptionID == 2 &&
+						deliveries[0].EventID == 7 && deliveries[0].Status == webhook.PENDING &&
+						len(deliveries[0].Body) > 0
+				})).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado nenhuma assinatura ao tipo do evento quando o método Publish é chamado então nenhuma entrega é criada",
+			args: args{
+				ctx:   mockedContext,
+				event: event,
+			},
+			prepareMock: func(p args, m *webhook_mocks.MockRepository) {
+				m.EXPECT().ListByEventType(p.ctx, outbox.DRIVER_CREATED).Return(&[]webhook.Subscription{}, nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado um erro ao buscar as assinaturas quando o método Publish é chamado então o erro é retornado",
+			args: args{
+				ctx:   mockedContext,
+				event: event,
+			},
+			prepareMock: func(p args, m *webhook_mocks.MockRepository) {
+				m.EXPECT().ListByEventType(p.ctx, outbox.DRIVER_CREATED).Return(nil, errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			repo := webhook_mocks.NewMockRepository(ctrl)
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, repo)
+			}
+
+			d := webhook.NewDispatcher(repo, logging.InitializerLogging(&config.Config{}))
+
+			err := d.Publish(test.args.ctx, test.args.event)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
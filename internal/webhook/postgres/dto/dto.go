@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type SubscriptionDTO struct {
+	bun.BaseModel `bun:"table:webhook_subscriptions"`
+
+	ID         int64     `bun:"id,pk,autoincrement"`
+	URL        string    `bun:"url,notnull"`
+	EventTypes []string  `bun:"event_types,array,notnull"`
+	Secret     string    `bun:"secret,notnull"`
+	Active     bool      `bun:"active,notnull"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt  time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type DeliveryDTO struct {
+	bun.BaseModel `bun:"table:webhook_deliveries"`
+
+	ID             int64     `bun:"id,pk,autoincrement"`
+	SubscriptionID int64     `bun:"subscription_id,notnull"`
+	EventID        int64     `bun:"event_id,notnull"`
+	EventType      string    `bun:"event_type,notnull"`
+	Body           []byte    `bun:"body,notnull"`
+	Status         string    `bun:"status,notnull"`
+	Attempts       int       `bun:"attempts,notnull"`
+	NextAttemptAt  time.Time `bun:"next_attempt_at,notnull"`
+	LastStatusCode int       `bun:"last_status_code,nullzero"`
+	LastError      string    `bun:"last_error,nullzero"`
+	DeliveredAt    time.Time `bun:"delivered_at,nullzero"`
+	CreatedAt      time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type AttemptDTO struct {
+	bun.BaseModel `bun:"table:webhook_delivery_attempts"`
+
+	ID             int64     `bun:"id,pk,autoincrement"`
+	DeliveryID     int64     `bun:"delivery_id,notnull"`
+	SubscriptionID int64     `bun:"subscription_id,notnull"`
+	Number         int       `bun:"number,notnull"`
+	StatusCode     int       `bun:"status_code,nullzero"`
+	Error          string    `bun:"error,nullzero"`
+	DurationMS     int64     `bun:"duration_ms,notnull"`
+	CreatedAt      time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,94 @@
+package mapping
+
+import (
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook/postgres/dto"
+)
+
+func MapSubscriptionToDTO(subscription *webhook.Subscription) *dto.SubscriptionDTO {
+	return &dto.SubscriptionDTO{
+		ID:         subscription.ID,
+		URL:        subscription.URL,
+		EventTypes: subscription.EventTypes,
+		Secret:     subscription.Secret,
+		Active:     subscription.Active,
+		CreatedAt:  subscription.CreatedAt,
+		UpdatedAt:  subscription.UpdatedAt,
+	}
+}
+
+func MapDTOToSubscription(subscriptionDTO *dto.SubscriptionDTO) *webhook.Subscription {
+	return &webhook.Subscription{
+		ID:         subscriptionDTO.ID,
+		URL:        subscriptionDTO.URL,
+		EventTypes: subscriptionDTO.EventTypes,
+		Secret:     subscriptionDTO.Secret,
+		Active:     subscriptionDTO.Active,
+		CreatedAt:  subscriptionDTO.CreatedAt,
+		UpdatedAt:  subscriptionDTO.UpdatedAt,
+	}
+}
+
+func MapDeliveryToDTO(delivery *webhook.Delivery) *dto.DeliveryDTO {
+	return &dto.DeliveryDTO{
+		ID:             delivery.ID,
+		SubscriptionID: delivery.SubscriptionID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Body:           delivery.Body,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		DeliveredAt:    delivery.DeliveredAt,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
+
+func MapDTOToDelivery(deliveryDTO *dto.DeliveryDTO) *webhook.Delivery {
+	return &webhook.Delivery{
+		ID:             deliveryDTO.ID,
+		SubscriptionID: deliveryDTO.SubscriptionID,
+		EventID:        deliveryDTO.EventID,
+		EventType:      deliveryDTO.EventType,
+		Body:           deliveryDTO.Body,
+		Status:         webhook.DeliveryStatus(deliveryDTO.Status),
+		Attempts:       deliveryDTO.Attempts,
+		NextAttemptAt:  deliveryDTO.NextAttemptAt,
+		LastStatusCode: deliveryDTO.LastStatusCode,
+		LastError:      deliveryDTO.LastError,
+		DeliveredAt:    deliveryDTO.DeliveredAt,
+		CreatedAt:      deliveryDTO.CreatedAt,
+		UpdatedAt:      deliveryDTO.UpdatedAt,
+	}
+}
+
+func MapAttemptToDTO(attempt *webhook.Attempt) *dto.AttemptDTO {
+	return &dto.AttemptDTO{
+		ID:             attempt.ID,
+		DeliveryID:     attempt.DeliveryID,
+		SubscriptionID: attempt.SubscriptionID,
+		Number:         attempt.Number,
+		StatusCode:     attempt.StatusCode,
+		Error:          attempt.Error,
+		DurationMS:     attempt.Duration.Milliseconds(),
+		CreatedAt:      attempt.CreatedAt,
+	}
+}
+
+func MapDTOToAttempt(attemptDTO *dto.AttemptDTO) *webhook.Attempt {
+	return &webhook.Attempt{
+		ID:             attemptDTO.ID,
+		DeliveryID:     attemptDTO.DeliveryID,
+		SubscriptionID: attemptDTO.SubscriptionID,
+		Number:         attemptDTO.Number,
+		StatusCode:     attemptDTO.StatusCode,
+		Error:          attemptDTO.Error,
+		Duration:       time.Duration(attemptDTO.DurationMS) * time.Millisecond,
+		CreatedAt:      attemptDTO.CreatedAt,
+	}
+}
@@ -0,0 +1,285 @@
+package postgres
+
+import (
+	"cmp"
+	"context"
+	"database/sql"
+	"slices"
+	"time"
+
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook/postgres/mapping"
+	"github.com/uptrace/bun"
+)
+
+type webhookPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *webhookPostgresRepo {
+	return &webhookPostgresRepo{
+		db: db,
+	}
+}
+
+func (wr *webhookPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, wr.db)
+}
+
+func (wr *webhookPostgresRepo) GetByID(ctx context.Context, id int64) (*webhook.Subscription, error) {
+	subscriptionDTO := new(dto.SubscriptionDTO)
+
+	err := wr.conn(ctx).NewSelect().Model(subscriptionDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToSubscription(subscriptionDTO), nil
+}
+
+func (wr *webhookPostgresRepo) List(ctx context.Context, specification *webhook.SubscriptionSpecification) (*[]webhook.Subscription, error) {
+	var subscriptionDTOs []dto.SubscriptionDTO
+
+	query := paginate(wr.conn(ctx).NewSelect().Model(&subscriptionDTOs).Order("id ASC"), specification.Page, specification.PageSize)
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]webhook.Subscription, 0, len(subscriptionDTOs))
+	for _, dto := range subscriptionDTOs {
+		subscriptions = append(subscriptions, *mapping.MapDTOToSubscription(&dto))
+	}
+
+	return &subscriptions, nil
+}
+
+func (wr *webhookPostgresRepo) ListByEventType(ctx context.Context, eventType string) (*[]webhook.Subscription, error) {
+	var subscriptionDTOs []dto.SubscriptionDTO
+
+	err := wr.conn(ctx).NewSelect().
+		Model(&subscriptionDTOs).
+		Where("active").
+		Where("? = ANY(event_types)", eventType).
+		Order("id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]webhook.Subscription, 0, len(subscriptionDTOs))
+	for _, dto := range subscriptionDTOs {
+		subscriptions = append(subscriptions, *mapping.MapDTOToSubscription(&dto))
+	}
+
+	return &subscriptions, nil
+}
+
+func (wr *webhookPostgresRepo) GetDelivery(ctx context.Context, id int64) (*webhook.Delivery, error) {
+	deliveryDTO := new(dto.DeliveryDTO)
+
+	err := wr.conn(ctx).NewSelect().Model(deliveryDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToDelivery(deliveryDTO), nil
+}
+
+// ListDeliveries returns the deliveries of a subscription, or of every
+// subscription when none is given, newest first.
+func (wr *webhookPostgresRepo) ListDeliveries(ctx context.Context, specification *webhook.DeliverySpecification) (*[]webhook.Delivery, error) {
+	var deliveryDTOs []dto.DeliveryDTO
+
+	query := wr.conn(ctx).NewSelect().Model(&deliveryDTOs).Order("id DESC")
+
+	if specification.SubscriptionID != 0 {
+		query = query.Where("subscription_id = ?", specification.SubscriptionID)
+	}
+
+	if len(specification.Status) > 0 {
+		query = query.Where("status = ?", specification.Status)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]webhook.Delivery, 0, len(deliveryDTOs))
+	for _, dto := range deliveryDTOs {
+		deliveries = append(deliveries, *mapping.MapDTOToDelivery(&dto))
+	}
+
+	return &deliveries, nil
+}
+
+func (wr *webhookPostgresRepo) ClaimDue(ctx context.Context, limit int, until time.Time) (*[]webhook.Delivery, error) {
+	var deliveryDTOs []dto.DeliveryDTO
+
+	due := wr.conn(ctx).NewSelect().
+		Model((*dto.DeliveryDTO)(nil)).
+		Column("id").
+		Where("status = ?", webhook.PENDING).
+		Where("next_attempt_at <= current_timestamp").
+		Order("next_attempt_at ASC", "id ASC").
+		Limit(limit).
+		For("UPDATE SKIP LOCKED")
+
+	err := wr.conn(ctx).NewUpdate().
+		Model((*dto.DeliveryDTO)(nil)).
+		Set("next_attempt_at = ?", until).
+		Where("id IN (?)", due).
+		Returning("*").
+		Scan(ctx, &deliveryDTOs)
+	if err != nil {
+		return nil, err
+	}
+
+	// RETURNING does not keep the order of the subquery.
+	slices.SortFunc(deliveryDTOs, func(a, b dto.DeliveryDTO) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+
+	deliveries := make([]webhook.Delivery, 0, len(deliveryDTOs))
+	for _, dto := range deliveryDTOs {
+		deliveries = append(deliveries, *mapping.MapDTOToDelivery(&dto))
+	}
+
+	return &deliveries, nil
+}
+
+// ListAttempts returns the log of the attempts of a subscription, newest
+// first, optionally restricted to one delivery.
+func (wr *webhookPostgresRepo) ListAttempts(ctx context.Context, specification *webhook.AttemptSpecification) (*[]webhook.Attempt, error) {
+	var attemptDTOs []dto.AttemptDTO
+
+	query := wr.conn(ctx).NewSelect().
+		Model(&attemptDTOs).
+		Where("subscription_id = ?", specification.SubscriptionID).
+		Order("id DESC")
+
+	if specification.DeliveryID != 0 {
+		query = query.Where("delivery_id = ?", specification.DeliveryID)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts := make([]webhook.Attempt, 0, len(attemptDTOs))
+	for _, dto := range attemptDTOs {
+		attempts = append(attempts, *mapping.MapDTOToAttempt(&dto))
+	}
+
+	return &attempts, nil
+}
+
+func (wr *webhookPostgresRepo) Create(ctx context.Context, s *webhook.Subscription) (int64, error) {
+	subscriptionDTO := mapping.MapSubscriptionToDTO(s)
+
+	_, err := wr.conn(ctx).NewInsert().Model(subscriptionDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return subscriptionDTO.ID, nil
+}
+
+func (wr *webhookPostgresRepo) Update(ctx context.Context, s *webhook.Subscription) error {
+	subscriptionDTO := mapping.MapSubscriptionToDTO(s)
+
+	query := wr.conn(ctx).NewUpdate().
+		Model(subscriptionDTO).
+		Column("url", "event_types", "active", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK()
+
+	if len(s.Secret) > 0 {
+		query = query.Column("secret")
+	}
+
+	res, err := query.Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (wr *webhookPostgresRepo) Delete(ctx context.Context, id int64) error {
+	res, err := wr.conn(ctx).NewDelete().Model((*dto.SubscriptionDTO)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (wr *webhookPostgresRepo) CreateDeliveries(ctx context.Context, deliveries []*webhook.Delivery) error {
+	deliveryDTOs := make([]*dto.DeliveryDTO, len(deliveries))
+	for i, delivery := range deliveries {
+		deliveryDTOs[i] = mapping.MapDeliveryToDTO(delivery)
+	}
+
+	_, err := wr.conn(ctx).NewInsert().
+		Model(&deliveryDTOs).
+		On("CONFLICT (subscription_id, event_id) DO NOTHING").
+		Exec(ctx)
+	return err
+}
+
+func (wr *webhookPostgresRepo) UpdateDelivery(ctx context.Context, d *webhook.Delivery) error {
+	deliveryDTO := mapping.MapDeliveryToDTO(d)
+
+	res, err := wr.conn(ctx).NewUpdate().
+		Model(deliveryDTO).
+		Column("status", "attempts", "next_attempt_at", "last_status_code", "last_error", "delivered_at", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (wr *webhookPostgresRepo) CreateAttempt(ctx context.Context, a *webhook.Attempt) error {
+	attemptDTO := mapping.MapAttemptToDTO(a)
+
+	_, err := wr.conn(ctx).NewInsert().Model(attemptDTO).Returning("id, created_at").Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	a.ID = attemptDTO.ID
+	a.CreatedAt = attemptDTO.CreatedAt
+
+	return nil
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
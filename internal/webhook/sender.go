@@ -0,0 +1,234 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/transaction"
+)
+
+const (
+	DEFAULT_MAXIMUM_ATTEMPTS = 10
+	DEFAULT_BATCH_SIZE       = 50
+	DEFAULT_INTERVAL         = 5 * time.Second
+	DEFAULT_TIMEOUT          = 10 * time.Second
+
+	// The wait before a retry doubles from INITIAL_BACKOFF up to
+	// MAXIMUM_BACKOFF: 30s, 1m, 2m, ... 1h.
+	INITIAL_BACKOFF = 30 * time.Second
+	MAXIMUM_BACKOFF = time.Hour
+)
+
+// Backoff is the wait before the next try of a delivery that failed
+// attempts times.
+func Backoff(attempts int) time.Duration {
+	backoff := INITIAL_BACKOFF
+	for i := 1; i < attempts && backoff < MAXIMUM_BACKOFF; i++ {
+		backoff *= 2
+	}
+
+	return min(backoff, MAXIMUM_BACKOFF)
+}
+
+// Sender posts the due deliveries to their subscriptions. A delivery that
+// keeps failing is retried with an exponential backoff and, after
+// maximumAttempts, moved to the dead letters, from where it can only be
+// redelivered by hand. Several senders can run at once: each claims the
+// deliveries it is sending for as long as a batch may take, and saves the
+// outcome of each delivery as soon as it is known, so that a slow subscriber
+// never holds a transaction open nor delays the outcome of the others.
+type Sender struct {
+	transactor      transaction.Transactor
+	repo            Repository
+	client          *http.Client
+	maximumAttempts int
+	batchSize       int
+	logger          *logging.Logging
+}
+
+func NewSender(t transaction.Transactor, r Repository, client *http.Client, maximumAttempts, batchSize int, l *logging.Logging) *Sender {
+	if maximumAttempts <= 0 {
+		maximumAttempts = DEFAULT_MAXIMUM_ATTEMPTS
+	}
+
+	if batchSize <= 0 {
+		batchSize = DEFAULT_BATCH_SIZE
+	}
+
+	return &Sender{
+		transactor:      t,
+		repo:            r,
+		client:          client,
+		maximumAttempts: maximumAttempts,
+		batchSize:       batchSize,
+		logger:          l,
+	}
+}
+
+// Run sends the due deliveries every interval until ctx is cancelled.
+func (s *Sender) Run(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		interval = DEFAULT_INTERVAL
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		sent, err := s.SendOnce(ctx)
+		if err != nil {
+			s.logger.Error("[WEBHOOK] Run - ERROR: ", map[string]any{
+				"err": err.Error(),
+			})
+		}
+
+		if err == nil && sent == s.batchSize && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// SendOnce tries one batch of due deliveries and returns how many were
+// tried.
+func (s *Sender) SendOnce(ctx context.Context) (int, error) {
+	deliveries, err := s.repo.ClaimDue(ctx, s.batchSize, time.Now().Add(s.claimTimeout()))
+	if err != nil {
+		return 0, err
+	}
+
+	var sent int
+	subscriptions := make(map[int64]*Subscription)
+	for i := range *deliveries {
+		d := &(*deliveries)[i]
+
+		subscription, ok := subscriptions[d.SubscriptionID]
+		if !ok {
+			subscription, err = s.repo.GetByID(ctx, d.SubscriptionID)
+			if err != nil {
+				s.logger.Warn("[WEBHOOK] SendOnce - WARN: ", map[string]any{
+					"deliveryID":     d.ID,
+					"subscriptionID": d.SubscriptionID,
+					"err":            err.Error(),
+				})
+				subscription = nil
+			}
+			subscriptions[d.SubscriptionID] = subscription
+		}
+
+		// A subscription deleted since the batch was claimed takes its
+		// deliveries with it, and one that could not be read leaves them to
+		// be claimed again once the claim is over. Either way the rest of the
+		// batch goes on.
+		if subscription == nil {
+			continue
+		}
+
+		if err := s.send(ctx, subscription, d); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// claimTimeout is how long the deliveries of a batch are kept from the other
+// senders: long enough for every post of the batch to time out. Those of a
+// sender that died are tried again once it is over.
+func (s *Sender) claimTimeout() time.Duration {
+	timeout := s.client.Timeout
+	if timeout <= 0 {
+		timeout = DEFAULT_TIMEOUT
+	}
+
+	return time.Duration(s.batchSize) * timeout
+}
+
+// send posts the delivery and then saves the attempt and the outcome in a
+// transaction of their own.
+func (s *Sender) send(ctx context.Context, subscription *Subscription, d *Delivery) error {
+	if !subscription.Active {
+		d.Status = DEAD
+		d.LastError = ErrInactiveSubscription.Error()
+		return s.repo.UpdateDelivery(ctx, d)
+	}
+
+	start := time.Now()
+	statusCode, err := s.post(ctx, subscription, d)
+	d.Attempts++
+
+	attempt := &Attempt{
+		DeliveryID:     d.ID,
+		SubscriptionID: d.SubscriptionID,
+		Number:         d.Attempts,
+		StatusCode:     statusCode,
+		Duration:       time.Since(start),
+	}
+	if err != nil {
+		attempt.Error = err.Error()
+	}
+
+	d.LastStatusCode = statusCode
+	d.LastError = attempt.Error
+
+	switch {
+	case err == nil:
+		d.Status = DELIVERED
+		d.DeliveredAt = time.Now()
+	case d.Attempts >= s.maximumAttempts:
+		d.Status = DEAD
+		s.logger.Warn("[WEBHOOK] send - WARN: ", map[string]any{
+			"deliveryID": d.ID,
+			"attempts":   d.Attempts,
+			"err":        err.Error(),
+		})
+	default:
+		d.NextAttemptAt = time.Now().Add(Backoff(d.Attempts))
+	}
+
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.repo.CreateAttempt(ctx, attempt); err != nil {
+			return err
+		}
+
+		return s.repo.UpdateDelivery(ctx, d)
+	})
+}
+
+// post sends the delivery and returns the status code answered, if any.
+func (s *Sender) post(ctx context.Context, subscription *Subscription, d *Delivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.URL, bytes.NewReader(d.Body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DELIVERY_HEADER, strconv.FormatInt(d.ID, 10))
+	req.Header.Set(EVENT_ID_HEADER, strconv.FormatInt(d.EventID, 10))
+	req.Header.Set(EVENT_TYPE_HEADER, d.EventType)
+	req.Header.Set(SIGNATURE_HEADER, Sign(subscription.Secret, time.Now(), d.Body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return res.StatusCode, fmt.Errorf("the subscriber answered with status %d", res.StatusCode)
+	}
+
+	return res.StatusCode, nil
+}
@@ -0,0 +1,229 @@
+package webhook_test
+
+import (
+	"context"
+	"database/sql"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	transaction_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/transaction"
+	webhook_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/webhook"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+func withinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		want     time.Duration
+	}{
+		{
+			name:     "Dado a primeira falha quando o método Backoff é chamado então a espera inicial é retornada",
+			attempts: 1,
+			want:     webhook.INITIAL_BACKOFF,
+		},
+		{
+			name:     "Dado a terceira falha quando o método Backoff é chamado então a espera é dobrada duas vezes",
+			attempts: 3,
+			want:     4 * webhook.INITIAL_BACKOFF,
+		},
+		{
+			name:     "Dado muitas falhas quando o método Backoff é chamado então a espera máxima é retornada",
+			attempts: 50,
+			want:     webhook.MAXIMUM_BACKOFF,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, webhook.Backoff(test.attempts))
+		})
+	}
+}
+
+func TestSender_SendOnce(t *testing.T) {
+	type senderMocks struct {
+		transactor *transaction_mocks.MockTransactor
+		repo       *webhook_mocks.MockRepository
+	}
+
+	const secret = "whsec_secret"
+	body := []byte(`{"id":7,"type":"driver.created"}`)
+
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		if r.URL.Path == "/fail" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	subscription := func(path string, active bool) *webhook.Subscription {
+		return &webhook.Subscription{
+			ID:         1,
+			URL:        server.URL + path,
+			EventTypes: []string{outbox.DRIVER_CREATED},
+			Secret:     secret,
+			Active:     active,
+		}
+	}
+
+	due := func(attempts int) *[]webhook.Delivery {
+		return &[]webhook.Delivery{{
+			ID:             10,
+			SubscriptionID: 1,
+			EventID:        7,
+			EventType:      outbox.DRIVER_CREATED,
+			Body:           body,
+			Status:         webhook.PENDING,
+			Attempts:       attempts,
+		}}
+	}
+
+	tests := []struct {
+		name        string
+		prepareMock func(m senderMocks)
+		want        int
+		wantErr     bool
+	}{
+		{
+			name: "Dado um assinante que responde 2xx quando o método SendOnce é chamado então a entrega é reservada e depois marcada como entregue na sua própria transação",
+			prepareMock: func(m senderMocks) {
+				m.repo.EXPECT().ClaimDue(mockedContext, 5, gomock.Cond(func(x any) bool {
+					return x.(time.Time).After(time.Now())
+				})).Return(due(0), nil)
+				m.transactor.EXPECT().WithinTransaction(mockedContext, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(mockedContext, int64(1)).Return(subscription("/ok", true), nil)
+				m.repo.EXPECT().CreateAttempt(mockedContext, gomock.Cond(func(x any) bool {
+					a := x.(*webhook.Attempt)
+					return a.Number == 1 && a.StatusCode == http.StatusNoContent && len(a.Error) == 0
+				})).Return(nil)
+				m.repo.EXPECT().UpdateDelivery(mockedContext, gomock.Cond(func(x any) bool {
+					d := x.(*webhook.Delivery)
+					return d.Status == webhook.DELIVERED && d.Attempts == 1 && !d.DeliveredAt.IsZero()
+				})).Return(nil)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Dado um assinante que falha quando o método SendOnce é chamado então a entrega é reagendada com backoff",
+			prepareMock: func(m senderMocks) {
+				m.repo.EXPECT().ClaimDue(mockedContext, 5, gomock.Any()).Return(due(1), nil)
+				m.transactor.EXPECT().WithinTransaction(mockedContext, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(mockedContext, int64(1)).Return(subscription("/fail", true), nil)
+				m.repo.EXPECT().CreateAttempt(mockedContext, gomock.Cond(func(x any) bool {
+					a := x.(*webhook.Attempt)
+					return a.Number == 2 && a.StatusCode == http.StatusServiceUnavailable && len(a.Error) > 0
+				})).Return(nil)
+				m.repo.EXPECT().UpdateDelivery(mockedContext, gomock.Cond(func(x any) bool {
+					d := x.(*webhook.Delivery)
+					return d.Status == webhook.PENDING && d.Attempts == 2 &&
+						d.NextAttemptAt.After(time.Now().Add(webhook.Backoff(2)-time.Minute))
+				})).Return(nil)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Dado a última tentativa falhando quando o método SendOnce é chamado então a entrega vai para as cartas mortas",
+			prepareMock: func(m senderMocks) {
+				m.repo.EXPECT().ClaimDue(mockedContext, 5, gomock.Any()).Return(due(2), nil)
+				m.transactor.EXPECT().WithinTransaction(mockedContext, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(mockedContext, int64(1)).Return(subscription("/fail", true), nil)
+				m.repo.EXPECT().CreateAttempt(mockedContext, gomock.Any()).Return(nil)
+				m.repo.EXPECT().UpdateDelivery(mockedContext, gomock.Cond(func(x any) bool {
+					d := x.(*webhook.Delivery)
+					return d.Status == webhook.DEAD && d.Attempts == 3
+				})).Return(nil)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Dado uma assinatura inativa quando o método SendOnce é chamado então a entrega vai para as cartas mortas sem ser enviada",
+			prepareMock: func(m senderMocks) {
+				m.repo.EXPECT().ClaimDue(mockedContext, 5, gomock.Any()).Return(due(0), nil)
+				m.repo.EXPECT().GetByID(mockedContext, int64(1)).Return(subscription("/ok", false), nil)
+				m.repo.EXPECT().UpdateDelivery(mockedContext, gomock.Cond(func(x any) bool {
+					d := x.(*webhook.Delivery)
+					return d.Status == webhook.DEAD && d.Attempts == 0
+				})).Return(nil)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Dado uma assinatura excluída durante o lote quando o método SendOnce é chamado então as suas entregas são puladas e as demais enviadas",
+			prepareMock: func(m senderMocks) {
+				m.repo.EXPECT().ClaimDue(mockedContext, 5, gomock.Any()).Return(&[]webhook.Delivery{
+					{ID: 11, SubscriptionID: 2, EventID: 7, EventType: outbox.DRIVER_CREATED, Body: body, Status: webhook.PENDING},
+					{ID: 12, SubscriptionID: 2, EventID: 7, EventType: outbox.DRIVER_CREATED, Body: body, Status: webhook.PENDING},
+					(*due(0))[0],
+				}, nil)
+				m.repo.EXPECT().GetByID(mockedContext, int64(2)).Return(nil, sql.ErrNoRows)
+				m.transactor.EXPECT().WithinTransaction(mockedContext, gomock.Any()).DoAndReturn(withinTransaction)
+				m.repo.EXPECT().GetByID(mockedContext, int64(1)).Return(subscription("/ok", true), nil)
+				m.repo.EXPECT().CreateAttempt(mockedContext, gomock.Any()).Return(nil)
+				m.repo.EXPECT().UpdateDelivery(mockedContext, gomock.Cond(func(x any) bool {
+					d := x.(*webhook.Delivery)
+					return d.ID == 10 && d.Status == webhook.DELIVERED
+				})).Return(nil)
+			},
+			want:    1,
+			wantErr: false,
+		},
+		{
+			name: "Dado um erro ao buscar as entregas quando o método SendOnce é chamado então o erro é retornado",
+			prepareMock: func(m senderMocks) {
+				m.repo.EXPECT().ClaimDue(mockedContext, 5, gomock.Any()).Return(nil, errMocked)
+			},
+			want:    0,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := senderMocks{
+				transactor: transaction_mocks.NewMockTransactor(ctrl),
+				repo:       webhook_mocks.NewMockRepository(ctrl),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(sm)
+			}
+
+			received = nil
+			s := webhook.NewSender(sm.transactor, sm.repo, server.Client(), 3, 5, logging.InitializerLogging(&config.Config{}))
+
+			sent, err := s.SendOnce(mockedContext)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, sent)
+
+			if received != nil {
+				assert.Equal(tt, "10", received.Get(webhook.DELIVERY_HEADER))
+				assert.Equal(tt, outbox.DRIVER_CREATED, received.Get(webhook.EVENT_TYPE_HEADER))
+				assert.Equal(tt, true, webhook.Verify(secret, received.Get(webhook.SIGNATURE_HEADER), body, time.Minute, time.Now()))
+			}
+		})
+	}
+}
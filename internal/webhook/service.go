@@ -0,0 +1,183 @@
+package webhook
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+type Service struct {
+	repo   Repository
+	logger *logging.Logging
+}
+
+func NewService(r Repository, l *logging.Logging) *Service {
+	return &Service{
+		repo:   r,
+		logger: l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Subscription, error) {
+	s.logger.Debug("[WEBHOOK] GetByID - DEBUG: ", map[string]any{
+		"subscriptionID": id,
+	})
+	subscription, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return subscription, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *SubscriptionSpecification) (*[]Subscription, error) {
+	s.logger.Debug("[WEBHOOK] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	subscriptions, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+// Create stores the subscription, generating its secret when none is given.
+func (s *Service) Create(ctx context.Context, subscription *Subscription) (int64, error) {
+	s.logger.Debug("[WEBHOOK] Create - DEBUG: ", map[string]any{
+		"url":        subscription.URL,
+		"eventTypes": subscription.EventTypes,
+	})
+	if err := subscription.Validate(); err != nil {
+		return 0, err
+	}
+
+	if len(subscription.Secret) == 0 {
+		secret, err := NewSecret()
+		if err != nil {
+			return 0, err
+		}
+		subscription.Secret = secret
+	}
+
+	subscriptionID, err := s.repo.Create(ctx, subscription)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] Create - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return subscriptionID, nil
+}
+
+// Update replaces the subscription. The secret is kept when none is given.
+func (s *Service) Update(ctx context.Context, subscription *Subscription) error {
+	s.logger.Debug("[WEBHOOK] Update - DEBUG: ", map[string]any{
+		"subscriptionID": subscription.ID,
+		"url":            subscription.URL,
+		"eventTypes":     subscription.EventTypes,
+	})
+	if err := subscription.Validate(); err != nil {
+		return err
+	}
+
+	err := s.repo.Update(ctx, subscription)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] Update - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, id int64) error {
+	s.logger.Debug("[WEBHOOK] Delete - DEBUG: ", map[string]any{
+		"subscriptionID": id,
+	})
+	err := s.repo.Delete(ctx, id)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] Delete - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ListDeliveries(ctx context.Context, specification *DeliverySpecification) (*[]Delivery, error) {
+	s.logger.Debug("[WEBHOOK] ListDeliveries - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	deliveries, err := s.repo.ListDeliveries(ctx, specification)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] ListDeliveries - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (s *Service) ListAttempts(ctx context.Context, specification *AttemptSpecification) (*[]Attempt, error) {
+	s.logger.Debug("[WEBHOOK] ListAttempts - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	attempts, err := s.repo.ListAttempts(ctx, specification)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] ListAttempts - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return attempts, nil
+}
+
+// Redeliver schedules a delivery that was delivered or given up on to be
+// sent again right away, with a fresh budget of attempts.
+func (s *Service) Redeliver(ctx context.Context, subscriptionID, deliveryID int64) error {
+	s.logger.Debug("[WEBHOOK] Redeliver - DEBUG: ", map[string]any{
+		"subscriptionID": subscriptionID,
+		"deliveryID":     deliveryID,
+	})
+	delivery, err := s.repo.GetDelivery(ctx, deliveryID)
+	if err == nil && delivery.SubscriptionID != subscriptionID {
+		err = sql.ErrNoRows
+	}
+	if err == nil && delivery.Status == PENDING {
+		err = ErrDeliveryPending
+	}
+	if err != nil {
+		s.logger.Error("[WEBHOOK] Redeliver - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	delivery.Status = PENDING
+	delivery.Attempts = 0
+	delivery.NextAttemptAt = time.Now()
+
+	err = s.repo.UpdateDelivery(ctx, delivery)
+	if err != nil {
+		s.logger.Error("[WEBHOOK] Redeliver - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,238 @@
+package webhook_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	webhook_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/webhook"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+)
+
+type serviceMocks struct {
+	repo   *webhook_mocks.MockRepository
+	logger *logging.Logging
+}
+
+func TestService_Create(t *testing.T) {
+	type args struct {
+		ctx          context.Context
+		subscription *webhook.Subscription
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        int64
+		wantErr     error
+	}{
+		{
+			name: "Dado uma assinatura sem segredo quando o método Create é chamado então um segredo é gerado",
+			args: args{
+				ctx: mockedContext,
+				subscription: &webhook.Subscription{
+					URL:        "https://partner.example.com/hooks",
+					EventTypes: []string{outbox.DRIVER_CREATED, outbox.VEHICLE_LICENSING_STATUS_CHANGED},
+					Active:     true,
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Create(p.ctx, gomock.Cond(func(x any) bool {
+					return strings.HasPrefix(x.(*webhook.Subscription).Secret, webhook.SECRET_PREFIX)
+				})).Return(int64(1), nil)
+			},
+			want:    1,
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma url relativa quando o método Create é chamado então a assinatura é recusada",
+			args: args{
+				ctx: mockedContext,
+				subscription: &webhook.Subscription{
+					URL:        "/hooks",
+					EventTypes: []string{outbox.DRIVER_CREATED},
+				},
+			},
+			want:    0,
+			wantErr: webhook.ErrInvalidURL,
+		},
+		{
+			name: "Dado nenhum tipo de evento quando o método Create é chamado então a assinatura é recusada",
+			args: args{
+				ctx: mockedContext,
+				subscription: &webhook.Subscription{
+					URL: "https://partner.example.com/hooks",
+				},
+			},
+			want:    0,
+			wantErr: webhook.ErrEmptyEventTypes,
+		},
+		{
+			name: "Dado um tipo de evento desconhecido quando o método Create é chamado então a assinatura é recusada",
+			args: args{
+				ctx: mockedContext,
+				subscription: &webhook.Subscription{
+					URL:        "https://partner.example.com/hooks",
+					EventTypes: []string{"vehicle.painted"},
+				},
+			},
+			want:    0,
+			wantErr: webhook.ErrUnknownEventType,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Create é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				subscription: &webhook.Subscription{
+					URL:        "https://partner.example.com/hooks",
+					EventTypes: []string{outbox.DRIVER_CREATED},
+					Secret:     "whsec_given",
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Create(p.ctx, p.subscription).Return(int64(0), errMocked)
+			},
+			want:    0,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:   webhook_mocks.NewMockRepository(ctrl),
+				logger: logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := webhook.NewService(sm.repo, sm.logger)
+
+			got, err := s.Create(test.args.ctx, test.args.subscription)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			assert.Equal(tt, test.want, got)
+		})
+	}
+}
+
+func TestService_Redeliver(t *testing.T) {
+	type args struct {
+		ctx            context.Context
+		subscriptionID int64
+		deliveryID     int64
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado uma entrega morta quando o método Redeliver é chamado então ela volta a ficar pendente",
+			args: args{
+				ctx:            mockedContext,
+				subscriptionID: 1,
+				deliveryID:     10,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetDelivery(p.ctx, p.deliveryID).Return(&webhook.Delivery{
+					ID:             p.deliveryID,
+					SubscriptionID: p.subscriptionID,
+					Status:         webhook.DEAD,
+					Attempts:       10,
+				}, nil)
+				m.repo.EXPECT().UpdateDelivery(p.ctx, gomock.Cond(func(x any) bool {
+					d := x.(*webhook.Delivery)
+					return d.Status == webhook.PENDING && d.Attempts == 0 && !d.NextAttemptAt.IsZero()
+				})).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma entrega pendente quando o método Redeliver é chamado então ErrDeliveryPending é retornado",
+			args: args{
+				ctx:            mockedContext,
+				subscriptionID: 1,
+				deliveryID:     10,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetDelivery(p.ctx, p.deliveryID).Return(&webhook.Delivery{
+					ID:             p.deliveryID,
+					SubscriptionID: p.subscriptionID,
+					Status:         webhook.PENDING,
+				}, nil)
+			},
+			wantErr: webhook.ErrDeliveryPending,
+		},
+		{
+			name: "Dado uma entrega de outra assinatura quando o método Redeliver é chamado então sql.ErrNoRows é retornado",
+			args: args{
+				ctx:            mockedContext,
+				subscriptionID: 1,
+				deliveryID:     10,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetDelivery(p.ctx, p.deliveryID).Return(&webhook.Delivery{
+					ID:             p.deliveryID,
+					SubscriptionID: 2,
+					Status:         webhook.DEAD,
+				}, nil)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name: "Dado uma entrega inexistente quando o método Redeliver é chamado então o erro é retornado",
+			args: args{
+				ctx:            mockedContext,
+				subscriptionID: 1,
+				deliveryID:     10,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetDelivery(p.ctx, p.deliveryID).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:   webhook_mocks.NewMockRepository(ctrl),
+				logger: logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := webhook.NewService(sm.repo, sm.logger)
+
+			err := s.Redeliver(test.args.ctx, test.args.subscriptionID, test.args.deliveryID)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	SIGNATURE_HEADER  = "X-Webhook-Signature"
+	DELIVERY_HEADER   = "X-Webhook-Delivery"
+	EVENT_ID_HEADER   = "X-Event-ID"
+	EVENT_TYPE_HEADER = "X-Event-Type"
+
+	SECRET_PREFIX = "whsec_"
+	secretLength  = 32
+)
+
+// Sign returns the value of the signature header of body sent at
+// timestamp: "t=<unix seconds>,v1=<hex HMAC-SHA256 of "<unix seconds>.<body>">".
+// Signing the timestamp lets receivers reject replayed deliveries.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+	return "t=" + t + ",v1=" + hex.EncodeToString(signature(secret, t, body))
+}
+
+// Verify checks a signature header made by Sign and rejects it when it is
+// older than tolerance. It is what a receiver is expected to do.
+func Verify(secret, header string, body []byte, tolerance time.Duration, now time.Time) bool {
+	var t, v1 string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			t = value
+		case "v1":
+			v1 = value
+		}
+	}
+
+	seconds, err := strconv.ParseInt(t, 10, 64)
+	if err != nil || now.Sub(time.Unix(seconds, 0)) > tolerance {
+		return false
+	}
+
+	expected, err := hex.DecodeString(v1)
+	if err != nil {
+		return false
+	}
+
+	return hmac.Equal(expected, signature(secret, t, body))
+}
+
+// NewSecret generates a random signing secret.
+func NewSecret() (string, error) {
+	b := make([]byte, secretLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return SECRET_PREFIX + hex.EncodeToString(b), nil
+}
+
+func signature(secret, timestamp string, body []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return mac.Sum(nil)
+}
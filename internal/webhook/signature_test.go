@@ -0,0 +1,86 @@
+package webhook_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/webhook"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":1,"type":"driver.created"}`)
+	signedAt := time.Unix(1_700_000_000, 0)
+	header := webhook.Sign("whsec_secret", signedAt, body)
+
+	tests := []struct {
+		name   string
+		secret string
+		header string
+		body   []byte
+		now    time.Time
+		want   bool
+	}{
+		{
+			name:   "Dado uma assinatura válida quando o método Verify é chamado então ela é aceita",
+			secret: "whsec_secret",
+			header: header,
+			body:   body,
+			now:    signedAt.Add(time.Minute),
+			want:   true,
+		},
+		{
+			name:   "Dado um segredo diferente quando o método Verify é chamado então a assinatura é rejeitada",
+			secret: "whsec_other",
+			header: header,
+			body:   body,
+			now:    signedAt.Add(time.Minute),
+			want:   false,
+		},
+		{
+			name:   "Dado um corpo alterado quando o método Verify é chamado então a assinatura é rejeitada",
+			secret: "whsec_secret",
+			header: header,
+			body:   []byte(`{"id":2,"type":"driver.created"}`),
+			now:    signedAt.Add(time.Minute),
+			want:   false,
+		},
+		{
+			name:   "Dado uma assinatura fora da tolerância quando o método Verify é chamado então ela é rejeitada",
+			secret: "whsec_secret",
+			header: header,
+			body:   body,
+			now:    signedAt.Add(10 * time.Minute),
+			want:   false,
+		},
+		{
+			name:   "Dado um cabeçalho malformado quando o método Verify é chamado então a assinatura é rejeitada",
+			secret: "whsec_secret",
+			header: "v1=abc",
+			body:   body,
+			now:    signedAt,
+			want:   false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			got := webhook.Verify(test.secret, test.header, test.body, 5*time.Minute, test.now)
+
+			assert.Equal(tt, test.want, got)
+		})
+	}
+}
+
+func TestNewSecret(t *testing.T) {
+	first, err := webhook.NewSecret()
+	assert.Equal(t, nil, err)
+
+	second, err := webhook.NewSecret()
+	assert.Equal(t, nil, err)
+
+	assert.Equal(t, true, strings.HasPrefix(first, webhook.SECRET_PREFIX))
+	assert.Equal(t, len(webhook.SECRET_PREFIX)+64, len(first))
+	assert.NotEqual(t, first, second)
+}
@@ -0,0 +1,159 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+type DeliveryStatus string
+
+const (
+	PENDING   DeliveryStatus = "PENDING"
+	DELIVERED DeliveryStatus = "DELIVERED"
+	DEAD      DeliveryStatus = "DEAD"
+)
+
+var (
+	deliveryStatuses = []DeliveryStatus{PENDING, DELIVERED, DEAD}
+
+	ErrInvalidURL           = errors.New("the webhook url must be an absolute http or https url")
+	ErrEmptyEventTypes      = errors.New("the webhook must subscribe to at least one event type")
+	ErrUnknownEventType     = errors.New("the event type is not one that can be subscribed to")
+	ErrInvalidStatus        = errors.New("the delivery status must be one of PENDING, DELIVERED or DEAD")
+	ErrDeliveryPending      = errors.New("the delivery is still pending and cannot be redelivered")
+	ErrInactiveSubscription = errors.New("the webhook subscription is inactive")
+)
+
+func GetDeliveryStatus(name string) (DeliveryStatus, error) {
+	status := DeliveryStatus(name)
+	if !slices.Contains(deliveryStatuses, status) {
+		return "", ErrInvalidStatus
+	}
+
+	return status, nil
+}
+
+// Subscription asks for the events of the given types to be posted to URL,
+// signed with Secret.
+type Subscription struct {
+	ID         int64
+	URL        string
+	EventTypes []string
+	Secret     string
+	Active     bool
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+}
+
+func (s *Subscription) Validate() error {
+	u, err := url.Parse(s.URL)
+	if err != nil || !u.IsAbs() || (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return ErrInvalidURL
+	}
+
+	if len(s.EventTypes) == 0 {
+		return ErrEmptyEventTypes
+	}
+
+	for _, eventType := range s.EventTypes {
+		if !slices.Contains(outbox.EventTypes, eventType) {
+			return fmt.Errorf("%w: [%s] is not one of %v", ErrUnknownEventType, eventType, outbox.EventTypes)
+		}
+	}
+
+	return nil
+}
+
+// Delivery is one event to be posted to one subscription. Body is the exact
+// payload that is signed and sent on every attempt.
+type Delivery struct {
+	ID             int64
+	SubscriptionID int64
+	EventID        int64
+	EventType      string
+	Body           []byte
+	Status         DeliveryStatus
+	Attempts       int
+	NextAttemptAt  time.Time
+	LastStatusCode int
+	LastError      string
+	DeliveredAt    time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Attempt is the log of one try to post a delivery.
+type Attempt struct {
+	ID             int64
+	DeliveryID     int64
+	SubscriptionID int64
+	Number         int
+	StatusCode     int
+	Error          string
+	Duration       time.Duration
+	CreatedAt      time.Time
+}
+
+type SubscriptionSpecification struct {
+	Page, PageSize int
+}
+
+type DeliverySpecification struct {
+	SubscriptionID int64
+	Status         DeliveryStatus
+	Page, PageSize int
+}
+
+type AttemptSpecification struct {
+	SubscriptionID int64
+	DeliveryID     int64
+	Page, PageSize int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Subscription, error)
+	List(ctx context.Context, specification *SubscriptionSpecification) (*[]Subscription, error)
+	// ListByEventType returns the active subscriptions to eventType.
+	ListByEventType(ctx context.Context, eventType string) (*[]Subscription, error)
+	GetDelivery(ctx context.Context, id int64) (*Delivery, error)
+	ListDeliveries(ctx context.Context, specification *DeliverySpecification) (*[]Delivery, error)
+	ListAttempts(ctx context.Context, specification *AttemptSpecification) (*[]Attempt, error)
+}
+
+type Writing interface {
+	// ClaimDue takes up to limit pending deliveries whose next attempt is
+	// due, those due the longest first, and puts their next attempt off
+	// until until, so that no other sender takes them in the meantime.
+	// Deliveries being claimed by another sender are skipped.
+	ClaimDue(ctx context.Context, limit int, until time.Time) (*[]Delivery, error)
+	Create(ctx context.Context, s *Subscription) (int64, error)
+	Update(ctx context.Context, s *Subscription) error
+	Delete(ctx context.Context, id int64) error
+	// CreateDeliveries ignores a delivery of an event already delivered to
+	// the same subscription, so an event relayed twice is posted once.
+	CreateDeliveries(ctx context.Context, deliveries []*Delivery) error
+	UpdateDelivery(ctx context.Context, d *Delivery) error
+	CreateAttempt(ctx context.Context, a *Attempt) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Subscription, error)
+	List(ctx context.Context, specification *SubscriptionSpecification) (*[]Subscription, error)
+	Create(ctx context.Context, s *Subscription) (int64, error)
+	Update(ctx context.Context, s *Subscription) error
+	Delete(ctx context.Context, id int64) error
+	ListDeliveries(ctx context.Context, specification *DeliverySpecification) (*[]Delivery, error)
+	ListAttempts(ctx context.Context, specification *AttemptSpecification) (*[]Attempt, error)
+	Redeliver(ctx context.Context, subscriptionID, deliveryID int64) error
+}
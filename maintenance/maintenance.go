@@ -0,0 +1,245 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+type OrderType string
+
+const (
+	PREVENTIVE OrderType = "PREVENTIVE"
+	CORRECTIVE OrderType = "CORRECTIVE"
+)
+
+type TicketStatus string
+
+const (
+	OPEN   TicketStatus = "OPEN"
+	CLOSED TicketStatus = "CLOSED"
+)
+
+var (
+	orderTypes     = []OrderType{PREVENTIVE, CORRECTIVE}
+	ticketStatuses = []TicketStatus{OPEN, CLOSED}
+
+	ErrInvalidOrderType    = errors.New("the service order type must be one of PREVENTIVE or CORRECTIVE")
+	ErrInvalidTicketStatus = errors.New("the maintenance ticket status must be one of OPEN or CLOSED")
+	ErrTicketClosed        = errors.New("the maintenance ticket is already closed")
+	ErrTicketOrderMismatch = errors.New("the service order that closes a maintenance ticket must be of the same vehicle")
+)
+
+func GetOrderType(name string) (OrderType, error) {
+	orderType := OrderType(name)
+	if !slices.Contains(orderTypes, orderType) {
+		return "", ErrInvalidOrderType
+	}
+
+	return orderType, nil
+}
+
+func GetTicketStatus(name string) (TicketStatus, error) {
+	status := TicketStatus(name)
+	if !slices.Contains(ticketStatuses, status) {
+		return "", ErrInvalidTicketStatus
+	}
+
+	return status, nil
+}
+
+// Part is a part replaced in a service order. Costs are in cents.
+type Part struct {
+	Name     string
+	Quantity int
+	UnitCost int64
+}
+
+// Order is a service order performed on a vehicle by the workshop or a
+// supplier. A preventive order may fulfill a plan, which then becomes due
+// again an interval after it. Cost is in cents.
+type Order struct {
+	ID          int64
+	VehicleID   int64
+	PlanID      int64
+	Type        OrderType
+	PerformedAt time.Time
+	Odometer    int64
+	Cost        int64
+	Supplier    string
+	Parts       []Part
+	Notes       string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Ticket is a repair a vehicle needs. It is opened when a problem is found,
+// by a checklist when ChecklistID is set, and closed once fixed, by a
+// service order when OrderID is set.
+type Ticket struct {
+	ID          int64
+	VehicleID   int64
+	ChecklistID int64
+	Description string
+	Status      TicketStatus
+	OrderID     int64
+	ClosedAt    time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Plan is a preventive maintenance due every IntervalKm kilometers or every
+// IntervalDays days, whichever comes first, on the vehicles of a brand and
+// model. A vehicle that is overdue on a critical plan cannot be assigned.
+type Plan struct {
+	ID           int64
+	Name         string
+	Brand        string
+	Model        string
+	IntervalKm   int64
+	IntervalDays int
+	Critical     bool
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Schedule is what is known of a plan on a vehicle: when and at which
+// odometer it was last performed, the current odometer of the vehicle and,
+// for a plan never performed, StartOdometer, the odometer of the vehicle
+// when the plan started to apply to it.
+type Schedule struct {
+	VehicleID       int64
+	VehicleSince    time.Time
+	Plan            Plan
+	LastPerformedAt time.Time
+	LastOdometer    int64
+	StartOdometer   int64
+	Odometer        int64
+}
+
+// Due is when a plan is next due on a vehicle. DueAt is zero for plans
+// without a time interval and DueOdometer for plans without a distance one.
+type Due struct {
+	VehicleID       int64
+	Plan            Plan
+	LastPerformedAt time.Time
+	LastOdometer    int64
+	Odometer        int64
+	DueAt           time.Time
+	DueOdometer     int64
+	Overdue         bool
+}
+
+// Due counts the intervals of the plan from its last order on the vehicle
+// or, when it was never performed, from the day the plan started to apply to
+// the vehicle, the later of the vehicle joining the fleet and the plan being
+// created, and the odometer of then. A vehicle that joins the fleet at a high
+// mileage is thus not overdue on every plan right away.
+func (s *Schedule) Due(now time.Time) Due {
+	since, from := s.VehicleSince, s.StartOdometer
+	if s.Plan.CreatedAt.After(since) {
+		since = s.Plan.CreatedAt
+	}
+
+	if !s.LastPerformedAt.IsZero() {
+		since, from = s.LastPerformedAt, s.LastOdometer
+	}
+
+	due := Due{
+		VehicleID:       s.VehicleID,
+		Plan:            s.Plan,
+		LastPerformedAt: s.LastPerformedAt,
+		LastOdometer:    s.LastOdometer,
+		Odometer:        s.Odometer,
+	}
+
+	if s.Plan.IntervalDays > 0 {
+		due.DueAt = since.AddDate(0, 0, s.Plan.IntervalDays)
+		due.Overdue = !now.Before(due.DueAt)
+	}
+
+	if s.Plan.IntervalKm > 0 {
+		due.DueOdometer = from + s.Plan.IntervalKm
+		due.Overdue = due.Overdue || s.Odometer >= due.DueOdometer
+	}
+
+	return due
+}
+
+type OrderSpecification struct {
+	VehicleID      int64
+	Page, PageSize int
+}
+
+type TicketSpecification struct {
+	VehicleID      int64
+	ChecklistID    int64
+	Status         TicketStatus
+	Page, PageSize int
+}
+
+type PlanSpecification struct {
+	Brand, Model   string
+	Page, PageSize int
+}
+
+// ScheduleSpecification restricts the schedules to a vehicle, when VehicleID
+// is set, and to the critical plans, when Critical is set.
+type ScheduleSpecification struct {
+	VehicleID int64
+	Critical  bool
+}
+
+type OverdueSpecification struct {
+	Critical       bool
+	Page, PageSize int
+}
+
+type Reading interface {
+	GetOrder(ctx context.Context, id int64) (*Order, error)
+	ListOrders(ctx context.Context, specification *OrderSpecification) (*[]Order, error)
+	GetPlan(ctx context.Context, id int64) (*Plan, error)
+	ListPlans(ctx context.Context, specification *PlanSpecification) (*[]Plan, error)
+	// ListSchedules returns a schedule for every plan of the brand and model
+	// of every live vehicle, ordered by vehicle and plan.
+	ListSchedules(ctx context.Context, specification *ScheduleSpecification) (*[]Schedule, error)
+	GetTicket(ctx context.Context, id int64) (*Ticket, error)
+	ListTickets(ctx context.Context, specification *TicketSpecification) (*[]Ticket, error)
+}
+
+type Writing interface {
+	CreateOrder(ctx context.Context, o *Order) (int64, error)
+	DeleteOrder(ctx context.Context, id int64) error
+	CreatePlan(ctx context.Context, p *Plan) (int64, error)
+	UpdatePlan(ctx context.Context, p *Plan) error
+	DeletePlan(ctx context.Context, id int64) error
+	CreateTicket(ctx context.Context, t *Ticket) (int64, error)
+	// CloseTicket saves the closing of the ticket, provided it is still
+	// open. It fails with ErrTicketClosed otherwise.
+	CloseTicket(ctx context.Context, t *Ticket) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+type UseCase interface {
+	GetOrder(ctx context.Context, id int64) (*Order, error)
+	ListOrders(ctx context.Context, specification *OrderSpecification) (*[]Order, error)
+	CreateOrder(ctx context.Context, o *Order) (int64, error)
+	DeleteOrder(ctx context.Context, id int64) error
+	GetPlan(ctx context.Context, id int64) (*Plan, error)
+	ListPlans(ctx context.Context, specification *PlanSpecification) (*[]Plan, error)
+	CreatePlan(ctx context.Context, p *Plan) (int64, error)
+	UpdatePlan(ctx context.Context, p *Plan) error
+	DeletePlan(ctx context.Context, id int64) error
+	ListDue(ctx context.Context, vehicleID int64) (*[]Due, error)
+	ListOverdue(ctx context.Context, specification *OverdueSpecification) (*[]Due, error)
+	HasOverdueCritical(ctx context.Context, vehicleID int64) (bool, error)
+	GetTicket(ctx context.Context, id int64) (*Ticket, error)
+	ListTickets(ctx context.Context, specification *TicketSpecification) (*[]Ticket, error)
+	CreateTicket(ctx context.Context, t *Ticket) (int64, error)
+	CloseTicket(ctx context.Context, id, orderID int64) (*Ticket, error)
+}
@@ -0,0 +1,116 @@
+package maintenance_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestSchedule_Due(t *testing.T) {
+	now := time.Date(2024, time.June, 1, 0, 0, 0, 0, time.UTC)
+	since := now.AddDate(0, 0, -100)
+
+	tests := []struct {
+		name     string
+		schedule maintenance.Schedule
+		want     maintenance.Due
+	}{
+		{
+			name: "Dado um plano nunca realizado quando o vencimento é calculado então os intervalos contam desde a entrada do veículo",
+			schedule: maintenance.Schedule{
+				VehicleID:    1,
+				VehicleSince: since,
+				Plan:         maintenance.Plan{ID: 1, IntervalKm: 10000, IntervalDays: 180},
+				Odometer:     4000,
+			},
+			want: maintenance.Due{
+				VehicleID:   1,
+				Plan:        maintenance.Plan{ID: 1, IntervalKm: 10000, IntervalDays: 180},
+				Odometer:    4000,
+				DueAt:       since.AddDate(0, 0, 180),
+				DueOdometer: 10000,
+				Overdue:     false,
+			},
+		},
+		{
+			name: "Dado um veículo que entrou na frota com alta quilometragem quando o vencimento de um plano nunca realizado é calculado então a distância conta desde a primeira leitura",
+			schedule: maintenance.Schedule{
+				VehicleID:     1,
+				VehicleSince:  since,
+				Plan:          maintenance.Plan{ID: 1, IntervalKm: 10000, IntervalDays: 180, Critical: true},
+				StartOdometer: 120000,
+				Odometer:      125000,
+			},
+			want: maintenance.Due{
+				VehicleID:   1,
+				Plan:        maintenance.Plan{ID: 1, IntervalKm: 10000, IntervalDays: 180, Critical: true},
+				Odometer:    125000,
+				DueAt:       since.AddDate(0, 0, 180),
+				DueOdometer: 130000,
+				Overdue:     false,
+			},
+		},
+		{
+			name: "Dado um plano criado depois da entrada do veículo quando o vencimento é calculado então os intervalos contam desde a criação do plano",
+			schedule: maintenance.Schedule{
+				VehicleID:     1,
+				VehicleSince:  since,
+				Plan:          maintenance.Plan{ID: 1, IntervalDays: 90, CreatedAt: now.AddDate(0, 0, -10)},
+				StartOdometer: 80000,
+				Odometer:      81000,
+			},
+			want: maintenance.Due{
+				VehicleID: 1,
+				Plan:      maintenance.Plan{ID: 1, IntervalDays: 90, CreatedAt: now.AddDate(0, 0, -10)},
+				Odometer:  81000,
+				DueAt:     now.AddDate(0, 0, 80),
+				Overdue:   false,
+			},
+		},
+		{
+			name: "Dado um plano realizado quando o hodômetro passa do intervalo então o plano está vencido",
+			schedule: maintenance.Schedule{
+				VehicleID:       1,
+				VehicleSince:    since,
+				Plan:            maintenance.Plan{ID: 1, IntervalKm: 10000, IntervalDays: 180},
+				LastPerformedAt: now.AddDate(0, 0, -10),
+				LastOdometer:    20000,
+				Odometer:        30000,
+			},
+			want: maintenance.Due{
+				VehicleID:       1,
+				Plan:            maintenance.Plan{ID: 1, IntervalKm: 10000, IntervalDays: 180},
+				LastPerformedAt: now.AddDate(0, 0, -10),
+				LastOdometer:    20000,
+				Odometer:        30000,
+				DueAt:           now.AddDate(0, 0, 170),
+				DueOdometer:     30000,
+				Overdue:         true,
+			},
+		},
+		{
+			name: "Dado um plano só por tempo quando o prazo passou então o plano está vencido",
+			schedule: maintenance.Schedule{
+				VehicleID:    1,
+				VehicleSince: since,
+				Plan:         maintenance.Plan{ID: 1, IntervalDays: 90},
+				Odometer:     1000,
+			},
+			want: maintenance.Due{
+				VehicleID: 1,
+				Plan:      maintenance.Plan{ID: 1, IntervalDays: 90},
+				Odometer:  1000,
+				DueAt:     since.AddDate(0, 0, 90),
+				Overdue:   true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, test.schedule.Due(now))
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type PartDTO struct {
+	Name     string `json:"name"`
+	Quantity int    `json:"quantity"`
+	UnitCost int64  `json:"unit_cost"`
+}
+
+type OrderDTO struct {
+	bun.BaseModel `bun:"table:maintenance_orders"`
+
+	ID          int64     `bun:"id,pk,autoincrement"`
+	VehicleID   int64     `bun:"vehicle_id,notnull"`
+	PlanID      int64     `bun:"plan_id,nullzero"`
+	Type        string    `bun:"type,notnull"`
+	PerformedAt time.Time `bun:"performed_at,notnull"`
+	Odometer    int64     `bun:"odometer,notnull"`
+	Cost        int64     `bun:"cost,notnull"`
+	Supplier    string    `bun:"supplier,nullzero"`
+	Parts       []PartDTO `bun:"parts,type:jsonb,notnull"`
+	Notes       string    `bun:"notes,nullzero"`
+	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type TicketDTO struct {
+	bun.BaseModel `bun:"table:maintenance_tickets"`
+
+	ID          int64     `bun:"id,pk,autoincrement"`
+	VehicleID   int64     `bun:"vehicle_id,notnull"`
+	ChecklistID int64     `bun:"checklist_id,nullzero"`
+	Description string    `bun:"description,notnull"`
+	Status      string    `bun:"status,notnull"`
+	OrderID     int64     `bun:"order_id,nullzero"`
+	ClosedAt    time.Time `bun:"closed_at,nullzero"`
+	CreatedAt   time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt   time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type PlanDTO struct {
+	bun.BaseModel `bun:"table:maintenance_plans"`
+
+	ID           int64     `bun:"id,pk,autoincrement"`
+	Name         string    `bun:"name,notnull"`
+	Brand        string    `bun:"brand,notnull"`
+	Model        string    `bun:"model,notnull"`
+	IntervalKm   int64     `bun:"interval_km,notnull"`
+	IntervalDays int       `bun:"interval_days,notnull"`
+	Critical     bool      `bun:"critical,notnull"`
+	CreatedAt    time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt    time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+// ScheduleDTO is a row of the schedules query, a plan joined to a vehicle
+// of its brand and model, whose columns are prefixed with plan_.
+type ScheduleDTO struct {
+	VehicleID       int64     `bun:"vehicle_id"`
+	VehicleSince    time.Time `bun:"vehicle_since"`
+	Plan            PlanDTO   `bun:"embed:plan_"`
+	LastPerformedAt time.Time `bun:"last_performed_at,nullzero"`
+	LastOdometer    int64     `bun:"last_odometer,nullzero"`
+	StartOdometer   int64     `bun:"start_odometer"`
+	Odometer        int64     `bun:"odometer"`
+}
@@ -0,0 +1,321 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/LucasMateus-eng/operations-service/maintenance/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/maintenance/postgres/mapping"
+	"github.com/uptrace/bun"
+)
+
+type maintenancePostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *maintenancePostgresRepo {
+	return &maintenancePostgresRepo{
+		db: db,
+	}
+}
+
+func (mr *maintenancePostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, mr.db)
+}
+
+func (mr *maintenancePostgresRepo) GetOrder(ctx context.Context, id int64) (*maintenance.Order, error) {
+	orderDTO := new(dto.OrderDTO)
+
+	err := mr.conn(ctx).NewSelect().Model(orderDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToOrder(orderDTO), nil
+}
+
+// ListOrders returns the orders of a vehicle, or of the whole fleet when
+// none is given, the latest first.
+func (mr *maintenancePostgresRepo) ListOrders(ctx context.Context, specification *maintenance.OrderSpecification) (*[]maintenance.Order, error) {
+	var orderDTOs []dto.OrderDTO
+
+	query := mr.conn(ctx).NewSelect().Model(&orderDTOs).Order("performed_at DESC", "id DESC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]maintenance.Order, 0, len(orderDTOs))
+	for _, dto := range orderDTOs {
+		orders = append(orders, *mapping.MapDTOToOrder(&dto))
+	}
+
+	return &orders, nil
+}
+
+func (mr *maintenancePostgresRepo) GetPlan(ctx context.Context, id int64) (*maintenance.Plan, error) {
+	planDTO := new(dto.PlanDTO)
+
+	err := mr.conn(ctx).NewSelect().Model(planDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToPlan(planDTO), nil
+}
+
+func (mr *maintenancePostgresRepo) ListPlans(ctx context.Context, specification *maintenance.PlanSpecification) (*[]maintenance.Plan, error) {
+	var planDTOs []dto.PlanDTO
+
+	query := mr.conn(ctx).NewSelect().Model(&planDTOs).Order("brand ASC", "model ASC", "id ASC")
+
+	if len(specification.Brand) > 0 {
+		query = query.Where("lower(brand) = lower(?)", specification.Brand)
+	}
+
+	if len(specification.Model) > 0 {
+		query = query.Where("lower(model) = lower(?)", specification.Model)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]maintenance.Plan, 0, len(planDTOs))
+	for _, dto := range planDTOs {
+		plans = append(plans, *mapping.MapDTOToPlan(&dto))
+	}
+
+	return &plans, nil
+}
+
+// ListSchedules joins every live vehicle to the plans of its brand and
+// model, along with the last order of each plan on the vehicle and its
+// current odometer: its latest odometer record or, when it has none, the
+// highest odometer among its orders.
+func (mr *maintenancePostgresRepo) ListSchedules(ctx context.Context, specification *maintenance.ScheduleSpecification) (*[]maintenance.Schedule, error) {
+	var scheduleDTOs []dto.ScheduleDTO
+
+	query := mr.conn(ctx).NewSelect().
+		TableExpr("vehicles AS v").
+		ColumnExpr("v.id AS vehicle_id, v.created_at AS vehicle_since").
+		ColumnExpr("p.id AS plan_id, p.name AS plan_name, p.brand AS plan_brand, p.model AS plan_model").
+		ColumnExpr("p.interval_km AS plan_interval_km, p.interval_days AS plan_interval_days, p.critical AS plan_critical").
+		ColumnExpr("p.created_at AS plan_created_at, p.updated_at AS plan_updated_at").
+		ColumnExpr("last.performed_at AS last_performed_at, last.odometer AS last_odometer").
+		ColumnExpr("COALESCE(start.odometer, 0) AS start_odometer").
+		ColumnExpr("COALESCE(reading.odometer, 0) AS odometer").
+		Join("JOIN maintenance_plans AS p ON lower(p.brand) = lower(v.brand) AND lower(p.model) = lower(v.model)").
+		Join(`LEFT JOIN LATERAL (
+			SELECT o.performed_at, o.odometer FROM maintenance_orders AS o
+			WHERE o.vehicle_id = v.id AND o.plan_id = p.id
+			ORDER BY o.performed_at DESC, o.id DESC LIMIT 1
+		) AS last ON true`).
+		// The odometer when the plan started to apply to the vehicle is the
+		// last reading up to then or, failing that, the first one after.
+		Join(`LEFT JOIN LATERAL (
+			SELECT COALESCE(
+				(SELECT r.km FROM odometer_records AS r WHERE r.vehicle_id = v.id AND r.read_at <= greatest(v.created_at, p.created_at) ORDER BY r.read_at DESC, r.id DESC LIMIT 1),
+				(SELECT r.km FROM odometer_records AS r WHERE r.vehicle_id = v.id ORDER BY r.read_at ASC, r.id ASC LIMIT 1)
+			) AS odometer
+		) AS start ON true`).
+		Join(`LEFT JOIN LATERAL (
+			SELECT COALESCE(
+				(SELECT r.km FROM odometer_records AS r WHERE r.vehicle_id = v.id ORDER BY r.read_at DESC, r.id DESC LIMIT 1),
+				(SELECT max(o.odometer) FROM maintenance_orders AS o WHERE o.vehicle_id = v.id)
+			) AS odometer
+		) AS reading ON true`).
+		Where("v.deleted_at = ?", time.Time{}).
+		OrderExpr("v.id ASC, p.id ASC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("v.id = ?", specification.VehicleID)
+	}
+
+	if specification.Critical {
+		query = query.Where("p.critical")
+	}
+
+	err := query.Scan(ctx, &scheduleDTOs)
+	if err != nil {
+		return nil, err
+	}
+
+	schedules := make([]maintenance.Schedule, 0, len(scheduleDTOs))
+	for _, dto := range scheduleDTOs {
+		schedules = append(schedules, *mapping.MapDTOToSchedule(&dto))
+	}
+
+	return &schedules, nil
+}
+
+func (mr *maintenancePostgresRepo) CreateOrder(ctx context.Context, o *maintenance.Order) (int64, error) {
+	orderDTO := mapping.MapOrderToDTO(o)
+
+	_, err := mr.conn(ctx).NewInsert().Model(orderDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return orderDTO.ID, nil
+}
+
+func (mr *maintenancePostgresRepo) DeleteOrder(ctx context.Context, id int64) error {
+	res, err := mr.conn(ctx).NewDelete().Model((*dto.OrderDTO)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (mr *maintenancePostgresRepo) CreatePlan(ctx context.Context, p *maintenance.Plan) (int64, error) {
+	planDTO := mapping.MapPlanToDTO(p)
+
+	_, err := mr.conn(ctx).NewInsert().Model(planDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return planDTO.ID, nil
+}
+
+func (mr *maintenancePostgresRepo) UpdatePlan(ctx context.Context, p *maintenance.Plan) error {
+	planDTO := mapping.MapPlanToDTO(p)
+
+	res, err := mr.conn(ctx).NewUpdate().
+		Model(planDTO).
+		Column("name", "brand", "model", "interval_km", "interval_days", "critical", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (mr *maintenancePostgresRepo) DeletePlan(ctx context.Context, id int64) error {
+	res, err := mr.conn(ctx).NewDelete().Model((*dto.PlanDTO)(nil)).Where("id = ?", id).Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkAffected(res)
+}
+
+func (mr *maintenancePostgresRepo) GetTicket(ctx context.Context, id int64) (*maintenance.Ticket, error) {
+	ticketDTO := new(dto.TicketDTO)
+
+	err := mr.conn(ctx).NewSelect().Model(ticketDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToTicket(ticketDTO), nil
+}
+
+// ListTickets returns the tickets, the latest first.
+func (mr *maintenancePostgresRepo) ListTickets(ctx context.Context, specification *maintenance.TicketSpecification) (*[]maintenance.Ticket, error) {
+	var ticketDTOs []dto.TicketDTO
+
+	query := mr.conn(ctx).NewSelect().Model(&ticketDTOs).Order("created_at DESC", "id DESC")
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if specification.ChecklistID != 0 {
+		query = query.Where("checklist_id = ?", specification.ChecklistID)
+	}
+
+	if len(specification.Status) > 0 {
+		query = query.Where("status = ?", specification.Status)
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tickets := make([]maintenance.Ticket, 0, len(ticketDTOs))
+	for _, dto := range ticketDTOs {
+		tickets = append(tickets, *mapping.MapDTOToTicket(&dto))
+	}
+
+	return &tickets, nil
+}
+
+func (mr *maintenancePostgresRepo) CreateTicket(ctx context.Context, t *maintenance.Ticket) (int64, error) {
+	ticketDTO := mapping.MapTicketToDTO(t)
+
+	_, err := mr.conn(ctx).NewInsert().Model(ticketDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return ticketDTO.ID, nil
+}
+
+func (mr *maintenancePostgresRepo) CloseTicket(ctx context.Context, t *maintenance.Ticket) error {
+	ticketDTO := mapping.MapTicketToDTO(t)
+
+	res, err := mr.conn(ctx).NewUpdate().
+		Model(ticketDTO).
+		Column("status", "order_id", "closed_at", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Where("status = ?", maintenance.OPEN).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return checkClosed(res)
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
+
+func checkAffected(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// checkClosed tells a ticket closed meanwhile, which the update of a still
+// open ticket leaves untouched, apart from other errors.
+func checkClosed(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return maintenance.ErrTicketClosed
+	}
+
+	return nil
+}
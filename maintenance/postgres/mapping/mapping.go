@@ -0,0 +1,126 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/LucasMateus-eng/operations-service/maintenance/postgres/dto"
+)
+
+func MapOrderToDTO(order *maintenance.Order) *dto.OrderDTO {
+	parts := make([]dto.PartDTO, 0, len(order.Parts))
+	for _, part := range order.Parts {
+		parts = append(parts, dto.PartDTO{
+			Name:     part.Name,
+			Quantity: part.Quantity,
+			UnitCost: part.UnitCost,
+		})
+	}
+
+	return &dto.OrderDTO{
+		ID:          order.ID,
+		VehicleID:   order.VehicleID,
+		PlanID:      order.PlanID,
+		Type:        string(order.Type),
+		PerformedAt: order.PerformedAt,
+		Odometer:    order.Odometer,
+		Cost:        order.Cost,
+		Supplier:    order.Supplier,
+		Parts:       parts,
+		Notes:       order.Notes,
+		CreatedAt:   order.CreatedAt,
+		UpdatedAt:   order.UpdatedAt,
+	}
+}
+
+func MapDTOToOrder(orderDTO *dto.OrderDTO) *maintenance.Order {
+	parts := make([]maintenance.Part, 0, len(orderDTO.Parts))
+	for _, part := range orderDTO.Parts {
+		parts = append(parts, maintenance.Part{
+			Name:     part.Name,
+			Quantity: part.Quantity,
+			UnitCost: part.UnitCost,
+		})
+	}
+
+	return &maintenance.Order{
+		ID:          orderDTO.ID,
+		VehicleID:   orderDTO.VehicleID,
+		PlanID:      orderDTO.PlanID,
+		Type:        maintenance.OrderType(orderDTO.Type),
+		PerformedAt: orderDTO.PerformedAt,
+		Odometer:    orderDTO.Odometer,
+		Cost:        orderDTO.Cost,
+		Supplier:    orderDTO.Supplier,
+		Parts:       parts,
+		Notes:       orderDTO.Notes,
+		CreatedAt:   orderDTO.CreatedAt,
+		UpdatedAt:   orderDTO.UpdatedAt,
+	}
+}
+
+func MapTicketToDTO(ticket *maintenance.Ticket) *dto.TicketDTO {
+	return &dto.TicketDTO{
+		ID:          ticket.ID,
+		VehicleID:   ticket.VehicleID,
+		ChecklistID: ticket.ChecklistID,
+		Description: ticket.Description,
+		Status:      string(ticket.Status),
+		OrderID:     ticket.OrderID,
+		ClosedAt:    ticket.ClosedAt,
+		CreatedAt:   ticket.CreatedAt,
+		UpdatedAt:   ticket.UpdatedAt,
+	}
+}
+
+func MapDTOToTicket(ticketDTO *dto.TicketDTO) *maintenance.Ticket {
+	return &maintenance.Ticket{
+		ID:          ticketDTO.ID,
+		VehicleID:   ticketDTO.VehicleID,
+		ChecklistID: ticketDTO.ChecklistID,
+		Description: ticketDTO.Description,
+		Status:      maintenance.TicketStatus(ticketDTO.Status),
+		OrderID:     ticketDTO.OrderID,
+		ClosedAt:    ticketDTO.ClosedAt,
+		CreatedAt:   ticketDTO.CreatedAt,
+		UpdatedAt:   ticketDTO.UpdatedAt,
+	}
+}
+
+func MapPlanToDTO(plan *maintenance.Plan) *dto.PlanDTO {
+	return &dto.PlanDTO{
+		ID:           plan.ID,
+		Name:         plan.Name,
+		Brand:        plan.Brand,
+		Model:        plan.Model,
+		IntervalKm:   plan.IntervalKm,
+		IntervalDays: plan.IntervalDays,
+		Critical:     plan.Critical,
+		CreatedAt:    plan.CreatedAt,
+		UpdatedAt:    plan.UpdatedAt,
+	}
+}
+
+func MapDTOToPlan(planDTO *dto.PlanDTO) *maintenance.Plan {
+	return &maintenance.Plan{
+		ID:           planDTO.ID,
+		Name:         planDTO.Name,
+		Brand:        planDTO.Brand,
+		Model:        planDTO.Model,
+		IntervalKm:   planDTO.IntervalKm,
+		IntervalDays: planDTO.IntervalDays,
+		Critical:     planDTO.Critical,
+		CreatedAt:    planDTO.CreatedAt,
+		UpdatedAt:    planDTO.UpdatedAt,
+	}
+}
+
+func MapDTOToSchedule(scheduleDTO *dto.ScheduleDTO) *maintenance.Schedule {
+	return &maintenance.Schedule{
+		VehicleID:       scheduleDTO.VehicleID,
+		VehicleSince:    scheduleDTO.VehicleSince,
+		Plan:            *MapDTOToPlan(&scheduleDTO.Plan),
+		LastPerformedAt: scheduleDTO.LastPerformedAt,
+		LastOdometer:    scheduleDTO.LastOdometer,
+		StartOdometer:   scheduleDTO.StartOdometer,
+		Odometer:        scheduleDTO.Odometer,
+	}
+}
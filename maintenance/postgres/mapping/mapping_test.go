@@ -0,0 +1,102 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	maintenance_dto "github.com/LucasMateus-eng/operations-service/maintenance/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapOrderToDTO(t *testing.T) {
+	order := &maintenance.Order{
+		ID:          1,
+		VehicleID:   2,
+		PlanID:      3,
+		Type:        maintenance.PREVENTIVE,
+		PerformedAt: mockedTime,
+		Odometer:    10000,
+		Cost:        35000,
+		Supplier:    "Oficina Central",
+		Parts:       []maintenance.Part{{Name: "Filtro de óleo", Quantity: 1, UnitCost: 5000}},
+		Notes:       "Troca de óleo",
+		CreatedAt:   mockedTime,
+		UpdatedAt:   mockedTime,
+	}
+
+	expectedDTO := &maintenance_dto.OrderDTO{
+		ID:          1,
+		VehicleID:   2,
+		PlanID:      3,
+		Type:        "PREVENTIVE",
+		PerformedAt: mockedTime,
+		Odometer:    10000,
+		Cost:        35000,
+		Supplier:    "Oficina Central",
+		Parts:       []maintenance_dto.PartDTO{{Name: "Filtro de óleo", Quantity: 1, UnitCost: 5000}},
+		Notes:       "Troca de óleo",
+		CreatedAt:   mockedTime,
+		UpdatedAt:   mockedTime,
+	}
+
+	actualDTO := MapOrderToDTO(order)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, order, MapDTOToOrder(actualDTO))
+}
+
+func TestMapPlanToDTO(t *testing.T) {
+	plan := &maintenance.Plan{
+		ID:           1,
+		Name:         "Troca de óleo",
+		Brand:        "Toyota",
+		Model:        "Corolla",
+		IntervalKm:   10000,
+		IntervalDays: 180,
+		Critical:     true,
+		CreatedAt:    mockedTime,
+		UpdatedAt:    mockedTime,
+	}
+
+	expectedDTO := &maintenance_dto.PlanDTO{
+		ID:           1,
+		Name:         "Troca de óleo",
+		Brand:        "Toyota",
+		Model:        "Corolla",
+		IntervalKm:   10000,
+		IntervalDays: 180,
+		Critical:     true,
+		CreatedAt:    mockedTime,
+		UpdatedAt:    mockedTime,
+	}
+
+	actualDTO := MapPlanToDTO(plan)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, plan, MapDTOToPlan(actualDTO))
+}
+
+func TestMapDTOToSchedule(t *testing.T) {
+	scheduleDTO := &maintenance_dto.ScheduleDTO{
+		VehicleID:       1,
+		VehicleSince:    mockedTime,
+		Plan:            maintenance_dto.PlanDTO{ID: 2, Name: "Revisão", IntervalKm: 10000},
+		LastPerformedAt: mockedTime,
+		LastOdometer:    5000,
+		Odometer:        12000,
+	}
+
+	expectedSchedule := &maintenance.Schedule{
+		VehicleID:       1,
+		VehicleSince:    mockedTime,
+		Plan:            maintenance.Plan{ID: 2, Name: "Revisão", IntervalKm: 10000},
+		LastPerformedAt: mockedTime,
+		LastOdometer:    5000,
+		Odometer:        12000,
+	}
+
+	assert.Equal(t, expectedSchedule, MapDTOToSchedule(scheduleDTO))
+}
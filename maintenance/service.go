@@ -0,0 +1,421 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+type Service struct {
+	repo    Repository
+	auditor audit.Recorder
+	logger  *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, l *logging.Logging) *Service {
+	return &Service{
+		repo:    r,
+		auditor: au,
+		logger:  l,
+	}
+}
+
+func (s *Service) GetOrder(ctx context.Context, id int64) (*Order, error) {
+	s.logger.Debug("[MAINTENANCE] GetOrder - DEBUG: ", map[string]any{
+		"orderID": id,
+	})
+	order, err := s.repo.GetOrder(ctx, id)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] GetOrder - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *Service) ListOrders(ctx context.Context, specification *OrderSpecification) (*[]Order, error) {
+	s.logger.Debug("[MAINTENANCE] ListOrders - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	orders, err := s.repo.ListOrders(ctx, specification)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] ListOrders - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return orders, nil
+}
+
+func (s *Service) CreateOrder(ctx context.Context, o *Order) (int64, error) {
+	s.logger.Debug("[MAINTENANCE] CreateOrder - DEBUG: ", map[string]any{
+		"order": o,
+	})
+	if err := o.Validate(); err != nil {
+		return 0, err
+	}
+
+	var orderID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		orderID, err = s.repo.CreateOrder(ctx, o)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.MAINTENANCE_ORDER, orderID, audit.CREATE, nil, o)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] CreateOrder - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return orderID, nil
+}
+
+func (s *Service) DeleteOrder(ctx context.Context, id int64) error {
+	s.logger.Debug("[MAINTENANCE] DeleteOrder - DEBUG: ", map[string]any{
+		"orderID": id,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetOrder(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.DeleteOrder(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.MAINTENANCE_ORDER, id, audit.DELETE, before, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] DeleteOrder - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetPlan(ctx context.Context, id int64) (*Plan, error) {
+	s.logger.Debug("[MAINTENANCE] GetPlan - DEBUG: ", map[string]any{
+		"planID": id,
+	})
+	plan, err := s.repo.GetPlan(ctx, id)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] GetPlan - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return plan, nil
+}
+
+func (s *Service) ListPlans(ctx context.Context, specification *PlanSpecification) (*[]Plan, error) {
+	s.logger.Debug("[MAINTENANCE] ListPlans - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	plans, err := s.repo.ListPlans(ctx, specification)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] ListPlans - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+func (s *Service) CreatePlan(ctx context.Context, p *Plan) (int64, error) {
+	s.logger.Debug("[MAINTENANCE] CreatePlan - DEBUG: ", map[string]any{
+		"plan": p,
+	})
+	if err := p.Validate(); err != nil {
+		return 0, err
+	}
+
+	var planID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		planID, err = s.repo.CreatePlan(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.MAINTENANCE_PLAN, planID, audit.CREATE, nil, p)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] CreatePlan - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return planID, nil
+}
+
+func (s *Service) UpdatePlan(ctx context.Context, p *Plan) error {
+	s.logger.Debug("[MAINTENANCE] UpdatePlan - DEBUG: ", map[string]any{
+		"plan": p,
+	})
+	if err := p.Validate(); err != nil {
+		return err
+	}
+
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetPlan(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.UpdatePlan(ctx, p); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetPlan(ctx, p.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.MAINTENANCE_PLAN, p.ID, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] UpdatePlan - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+// DeletePlan keeps the orders that fulfilled the plan, which no longer
+// point to it.
+func (s *Service) DeletePlan(ctx context.Context, id int64) error {
+	s.logger.Debug("[MAINTENANCE] DeletePlan - DEBUG: ", map[string]any{
+		"planID": id,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetPlan(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.DeletePlan(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.MAINTENANCE_PLAN, id, audit.DELETE, before, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] DeletePlan - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetTicket(ctx context.Context, id int64) (*Ticket, error) {
+	s.logger.Debug("[MAINTENANCE] GetTicket - DEBUG: ", map[string]any{
+		"ticketID": id,
+	})
+	ticket, err := s.repo.GetTicket(ctx, id)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] GetTicket - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return ticket, nil
+}
+
+func (s *Service) ListTickets(ctx context.Context, specification *TicketSpecification) (*[]Ticket, error) {
+	s.logger.Debug("[MAINTENANCE] ListTickets - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	tickets, err := s.repo.ListTickets(ctx, specification)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] ListTickets - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return tickets, nil
+}
+
+// CreateTicket opens the ticket.
+func (s *Service) CreateTicket(ctx context.Context, t *Ticket) (int64, error) {
+	s.logger.Debug("[MAINTENANCE] CreateTicket - DEBUG: ", map[string]any{
+		"ticket": t,
+	})
+	if err := t.Validate(); err != nil {
+		return 0, err
+	}
+
+	t.Status = OPEN
+	t.OrderID = 0
+	t.ClosedAt = time.Time{}
+
+	var ticketID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		ticketID, err = s.repo.CreateTicket(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.MAINTENANCE_TICKET, ticketID, audit.CREATE, nil, t)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] CreateTicket - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return ticketID, nil
+}
+
+// CloseTicket closes the open ticket, by the service order orderID of the
+// same vehicle when it is not zero.
+func (s *Service) CloseTicket(ctx context.Context, id, orderID int64) (*Ticket, error) {
+	s.logger.Debug("[MAINTENANCE] CloseTicket - DEBUG: ", map[string]any{
+		"ticketID": id,
+		"orderID":  orderID,
+	})
+
+	var after *Ticket
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetTicket(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if before.Status == CLOSED {
+			return nil, fmt.Errorf("%w: [%d]", ErrTicketClosed, id)
+		}
+
+		if orderID != 0 {
+			order, err := s.repo.GetOrder(ctx, orderID)
+			if err != nil {
+				return nil, err
+			}
+
+			if order.VehicleID != before.VehicleID {
+				return nil, fmt.Errorf("%w: order [%d] of vehicle [%d]", ErrTicketOrderMismatch, orderID, order.VehicleID)
+			}
+		}
+
+		after = new(Ticket)
+		*after = *before
+		after.Status = CLOSED
+		after.OrderID = orderID
+		after.ClosedAt = time.Now()
+
+		if err := s.repo.CloseTicket(ctx, after); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.MAINTENANCE_TICKET, id, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] CloseTicket - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// ListDue returns when each plan of the model of the vehicle is next due.
+func (s *Service) ListDue(ctx context.Context, vehicleID int64) (*[]Due, error) {
+	s.logger.Debug("[MAINTENANCE] ListDue - DEBUG: ", map[string]any{
+		"vehicleID": vehicleID,
+	})
+	dues, err := s.dues(ctx, &ScheduleSpecification{VehicleID: vehicleID}, false)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] ListDue - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return &dues, nil
+}
+
+// ListOverdue returns the plans that are overdue on some vehicle, by
+// vehicle. The schedules of the whole fleet are computed before the page is
+// taken, as whether a plan is overdue is not stored.
+func (s *Service) ListOverdue(ctx context.Context, specification *OverdueSpecification) (*[]Due, error) {
+	s.logger.Debug("[MAINTENANCE] ListOverdue - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	dues, err := s.dues(ctx, &ScheduleSpecification{Critical: specification.Critical}, true)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] ListOverdue - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		start := min((specification.Page-1)*specification.PageSize, len(dues))
+		end := min(start+specification.PageSize, len(dues))
+		dues = dues[start:end]
+	}
+
+	return &dues, nil
+}
+
+// HasOverdueCritical tells whether the vehicle is overdue on a critical
+// plan, in which case it cannot be assigned to a driver.
+func (s *Service) HasOverdueCritical(ctx context.Context, vehicleID int64) (bool, error) {
+	s.logger.Debug("[MAINTENANCE] HasOverdueCritical - DEBUG: ", map[string]any{
+		"vehicleID": vehicleID,
+	})
+	dues, err := s.dues(ctx, &ScheduleSpecification{VehicleID: vehicleID, Critical: true}, true)
+	if err != nil {
+		s.logger.Error("[MAINTENANCE] HasOverdueCritical - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return false, err
+	}
+
+	return len(dues) > 0, nil
+}
+
+func (s *Service) dues(ctx context.Context, specification *ScheduleSpecification, overdueOnly bool) ([]Due, error) {
+	schedules, err := s.repo.ListSchedules(ctx, specification)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	dues := make([]Due, 0, len(*schedules))
+	for _, schedule := range *schedules {
+		due := schedule.Due(now)
+		if overdueOnly && !due.Overdue {
+			continue
+		}
+
+		dues = append(dues, due)
+	}
+
+	return dues, nil
+}
@@ -0,0 +1,286 @@
+package maintenance_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	maintenance_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/maintenance"
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	expectedPlan  = &maintenance.Plan{Name: "Troca de óleo", Brand: "Toyota", Model: "Corolla", IntervalKm: 10000, Critical: true}
+)
+
+// schedules gives a vehicle that joined the fleet today and is 15000 km
+// ahead of the last order of its plans.
+func schedules(plans ...maintenance.Plan) *[]maintenance.Schedule {
+	schedules := make([]maintenance.Schedule, 0, len(plans))
+	for _, plan := range plans {
+		schedules = append(schedules, maintenance.Schedule{
+			VehicleID:       1,
+			VehicleSince:    time.Now(),
+			Plan:            plan,
+			LastPerformedAt: time.Now(),
+			Odometer:        15000,
+		})
+	}
+
+	return &schedules
+}
+
+func TestService_CreatePlan(t *testing.T) {
+	type args struct {
+		ctx  context.Context
+		plan *maintenance.Plan
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, repo *maintenance_mocks.MockRepository)
+		want        int64
+		wantErr     error
+	}{
+		{
+			name: "Dado um plano válido quando o método CreatePlan é chamado então o plano é criado",
+			args: args{ctx: mockedContext, plan: expectedPlan},
+			prepareMock: func(p args, repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().CreatePlan(p.ctx, p.plan).Return(int64(1), nil)
+			},
+			want: 1,
+		},
+		{
+			name:    "Dado um plano sem intervalo quando o método CreatePlan é chamado então o plano não é criado",
+			args:    args{ctx: mockedContext, plan: &maintenance.Plan{Name: "Revisão", Brand: "Fiat", Model: "Uno"}},
+			want:    0,
+			wantErr: maintenance.ErrInvalidPlan,
+		},
+		{
+			name: "Dado um erro do repositório quando o método CreatePlan é chamado então o erro é retornado",
+			args: args{ctx: mockedContext, plan: expectedPlan},
+			prepareMock: func(p args, repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().CreatePlan(p.ctx, p.plan).Return(int64(0), errMocked)
+			},
+			want:    0,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			repo := maintenance_mocks.NewMockRepository(ctrl)
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, repo)
+			}
+
+			s := maintenance.NewService(repo, audit_mocks.NewPassThroughRecorder(ctrl), logging.InitializerLogging(&config.Config{}))
+
+			actualID, err := s.CreatePlan(test.args.ctx, test.args.plan)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_ListOverdue(t *testing.T) {
+	type args struct {
+		ctx           context.Context
+		specification *maintenance.OverdueSpecification
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, repo *maintenance_mocks.MockRepository)
+		want        []int64
+		wantErr     bool
+	}{
+		{
+			name: "Dado planos vencidos e em dia quando o método ListOverdue é chamado então só os vencidos são retornados",
+			args: args{ctx: mockedContext, specification: &maintenance.OverdueSpecification{}},
+			prepareMock: func(p args, repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().ListSchedules(p.ctx, &maintenance.ScheduleSpecification{}).Return(schedules(
+					maintenance.Plan{ID: 1, IntervalKm: 10000},
+					maintenance.Plan{ID: 2, IntervalKm: 20000},
+					maintenance.Plan{ID: 3, IntervalKm: 5000},
+				), nil)
+			},
+			want: []int64{1, 3},
+		},
+		{
+			name: "Dado uma página quando o método ListOverdue é chamado então a página dos vencidos é retornada",
+			args: args{ctx: mockedContext, specification: &maintenance.OverdueSpecification{Critical: true, Page: 2, PageSize: 1}},
+			prepareMock: func(p args, repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().ListSchedules(p.ctx, &maintenance.ScheduleSpecification{Critical: true}).Return(schedules(
+					maintenance.Plan{ID: 1, IntervalKm: 10000},
+					maintenance.Plan{ID: 2, IntervalKm: 20000},
+					maintenance.Plan{ID: 3, IntervalKm: 5000},
+				), nil)
+			},
+			want: []int64{3},
+		},
+		{
+			name: "Dado um erro do repositório quando o método ListOverdue é chamado então o erro é retornado",
+			args: args{ctx: mockedContext, specification: &maintenance.OverdueSpecification{}},
+			prepareMock: func(p args, repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().ListSchedules(p.ctx, &maintenance.ScheduleSpecification{}).Return(nil, errMocked)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			repo := maintenance_mocks.NewMockRepository(ctrl)
+			test.prepareMock(test.args, repo)
+
+			s := maintenance.NewService(repo, audit_mocks.NewPassThroughRecorder(ctrl), logging.InitializerLogging(&config.Config{}))
+
+			dues, err := s.ListOverdue(test.args.ctx, test.args.specification)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+
+			var actualPlanIDs []int64
+			if dues != nil {
+				for _, due := range *dues {
+					actualPlanIDs = append(actualPlanIDs, due.Plan.ID)
+				}
+			}
+			assert.Equal(tt, test.want, actualPlanIDs)
+		})
+	}
+}
+
+func TestService_HasOverdueCritical(t *testing.T) {
+	tests := []struct {
+		name      string
+		schedules *[]maintenance.Schedule
+		err       error
+		want      bool
+		wantErr   bool
+	}{
+		{
+			name:      "Dado um veículo com plano crítico vencido quando o método HasOverdueCritical é chamado então verdadeiro é retornado",
+			schedules: schedules(maintenance.Plan{ID: 1, IntervalKm: 10000, Critical: true}),
+			want:      true,
+		},
+		{
+			name:      "Dado um veículo com os planos críticos em dia quando o método HasOverdueCritical é chamado então falso é retornado",
+			schedules: schedules(maintenance.Plan{ID: 1, IntervalKm: 20000, Critical: true}),
+			want:      false,
+		},
+		{
+			name:    "Dado um erro do repositório quando o método HasOverdueCritical é chamado então o erro é retornado",
+			err:     errMocked,
+			want:    false,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			repo := maintenance_mocks.NewMockRepository(ctrl)
+			repo.EXPECT().ListSchedules(mockedContext, &maintenance.ScheduleSpecification{VehicleID: 1, Critical: true}).Return(test.schedules, test.err)
+
+			s := maintenance.NewService(repo, audit_mocks.NewPassThroughRecorder(ctrl), logging.InitializerLogging(&config.Config{}))
+
+			overdue, err := s.HasOverdueCritical(mockedContext, 1)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, overdue)
+		})
+	}
+}
+
+func TestService_CloseTicket(t *testing.T) {
+	openTicket := func() *maintenance.Ticket {
+		return &maintenance.Ticket{ID: 3, VehicleID: 1, Description: "Pneus: careca", Status: maintenance.OPEN}
+	}
+
+	tests := []struct {
+		name        string
+		orderID     int64
+		prepareMock func(repo *maintenance_mocks.MockRepository)
+		wantErr     error
+	}{
+		{
+			name:    "Dado um chamado aberto e uma ordem do mesmo veículo quando o método CloseTicket é chamado então o chamado é fechado pela ordem",
+			orderID: 7,
+			prepareMock: func(repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().GetTicket(mockedContext, int64(3)).Return(openTicket(), nil)
+				repo.EXPECT().GetOrder(mockedContext, int64(7)).Return(&maintenance.Order{ID: 7, VehicleID: 1}, nil)
+				repo.EXPECT().CloseTicket(mockedContext, gomock.Any()).DoAndReturn(
+					func(_ context.Context, ticket *maintenance.Ticket) error {
+						assert.Equal(t, maintenance.CLOSED, ticket.Status)
+						assert.Equal(t, int64(7), ticket.OrderID)
+						assert.Equal(t, false, ticket.ClosedAt.IsZero())
+						return nil
+					},
+				)
+			},
+		},
+		{
+			name: "Dado um chamado aberto sem ordem quando o método CloseTicket é chamado então o chamado é fechado",
+			prepareMock: func(repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().GetTicket(mockedContext, int64(3)).Return(openTicket(), nil)
+				repo.EXPECT().CloseTicket(mockedContext, gomock.Any()).Return(nil)
+			},
+		},
+		{
+			name:    "Dado uma ordem de outro veículo quando o método CloseTicket é chamado então o chamado não é fechado",
+			orderID: 7,
+			prepareMock: func(repo *maintenance_mocks.MockRepository) {
+				repo.EXPECT().GetTicket(mockedContext, int64(3)).Return(openTicket(), nil)
+				repo.EXPECT().GetOrder(mockedContext, int64(7)).Return(&maintenance.Order{ID: 7, VehicleID: 2}, nil)
+			},
+			wantErr: maintenance.ErrTicketOrderMismatch,
+		},
+		{
+			name: "Dado um chamado já fechado quando o método CloseTicket é chamado então um erro é retornado",
+			prepareMock: func(repo *maintenance_mocks.MockRepository) {
+				closed := openTicket()
+				closed.Status = maintenance.CLOSED
+				repo.EXPECT().GetTicket(mockedContext, int64(3)).Return(closed, nil)
+			},
+			wantErr: maintenance.ErrTicketClosed,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			repo := maintenance_mocks.NewMockRepository(ctrl)
+			test.prepareMock(repo)
+
+			s := maintenance.NewService(repo, audit_mocks.NewPassThroughRecorder(ctrl), logging.InitializerLogging(&config.Config{}))
+
+			ticket, err := s.CloseTicket(mockedContext, 3, test.orderID)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			assert.Equal(tt, err == nil, ticket != nil)
+		})
+	}
+}
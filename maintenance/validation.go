@@ -0,0 +1,118 @@
+package maintenance
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidPlan   = errors.New("the given maintenance plan is invalid")
+	ErrInvalidOrder  = errors.New("the given service order is invalid")
+	ErrInvalidTicket = errors.New("the given maintenance ticket is invalid")
+
+	ErrEmptyPlanName         = errors.New("the maintenance plan name cannot be empty")
+	ErrEmptyPlanBrand        = errors.New("the maintenance plan brand cannot be empty")
+	ErrEmptyPlanModel        = errors.New("the maintenance plan model cannot be empty")
+	ErrMissingInterval       = errors.New("the maintenance plan must have an interval in kilometers, in days or both")
+	ErrNegativeInterval      = errors.New("the maintenance plan intervals cannot be negative")
+	ErrMissingVehicle        = errors.New("the service order must belong to a vehicle")
+	ErrInvalidPerformedAt    = errors.New("the service order date cannot be empty or in the future")
+	ErrNegativeOdometer      = errors.New("the service order odometer cannot be negative")
+	ErrNegativeCost          = errors.New("the service order cost cannot be negative")
+	ErrPlanOnCorrectiveOrder = errors.New("only preventive service orders can fulfill a maintenance plan")
+	ErrInvalidPart           = errors.New("every part must have a name, a positive quantity and a cost that is not negative")
+	ErrMissingTicketVehicle  = errors.New("the maintenance ticket must belong to a vehicle")
+	ErrEmptyTicketDesc       = errors.New("the maintenance ticket description cannot be empty")
+)
+
+// Validate returns every rule broken by the plan joined in a single error.
+func (p *Plan) Validate() error {
+	var errs []error
+
+	if len(strings.TrimSpace(p.Name)) == 0 {
+		errs = append(errs, ErrEmptyPlanName)
+	}
+
+	if len(strings.TrimSpace(p.Brand)) == 0 {
+		errs = append(errs, ErrEmptyPlanBrand)
+	}
+
+	if len(strings.TrimSpace(p.Model)) == 0 {
+		errs = append(errs, ErrEmptyPlanModel)
+	}
+
+	switch {
+	case p.IntervalKm < 0 || p.IntervalDays < 0:
+		errs = append(errs, ErrNegativeInterval)
+	case p.IntervalKm == 0 && p.IntervalDays == 0:
+		errs = append(errs, ErrMissingInterval)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidPlan, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Validate returns every rule broken by the order joined in a single error.
+func (o *Order) Validate() error {
+	var errs []error
+
+	if o.VehicleID <= 0 {
+		errs = append(errs, ErrMissingVehicle)
+	}
+
+	if _, err := GetOrderType(string(o.Type)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if o.PerformedAt.IsZero() || o.PerformedAt.After(time.Now()) {
+		errs = append(errs, ErrInvalidPerformedAt)
+	}
+
+	if o.Odometer < 0 {
+		errs = append(errs, ErrNegativeOdometer)
+	}
+
+	if o.Cost < 0 {
+		errs = append(errs, ErrNegativeCost)
+	}
+
+	if o.PlanID != 0 && o.Type != PREVENTIVE {
+		errs = append(errs, ErrPlanOnCorrectiveOrder)
+	}
+
+	for _, part := range o.Parts {
+		if len(strings.TrimSpace(part.Name)) == 0 || part.Quantity <= 0 || part.UnitCost < 0 {
+			errs = append(errs, fmt.Errorf("%w: [%s]", ErrInvalidPart, part.Name))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidOrder, errors.Join(errs...))
+	}
+
+	return nil
+}
+
+// Validate returns every rule broken by the ticket joined in a single error.
+func (t *Ticket) Validate() error {
+	var errs []error
+
+	if t.VehicleID <= 0 {
+		errs = append(errs, ErrMissingTicketVehicle)
+	}
+
+	if len(strings.TrimSpace(t.Description)) == 0 {
+		errs = append(errs, ErrEmptyTicketDesc)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidTicket, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package maintenance_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/maintenance"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestPlan_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		plan     maintenance.Plan
+		wantErrs []error
+	}{
+		{
+			name: "Dado um plano válido quando a validação é chamada então nenhum erro é retornado",
+			plan: maintenance.Plan{Name: "Troca de óleo", Brand: "Toyota", Model: "Corolla", IntervalKm: 10000},
+		},
+		{
+			name:     "Dado um plano vazio quando a validação é chamada então todas as regras quebradas são retornadas",
+			plan:     maintenance.Plan{},
+			wantErrs: []error{maintenance.ErrInvalidPlan, maintenance.ErrEmptyPlanName, maintenance.ErrEmptyPlanBrand, maintenance.ErrEmptyPlanModel, maintenance.ErrMissingInterval},
+		},
+		{
+			name:     "Dado um plano com intervalo negativo quando a validação é chamada então um erro é retornado",
+			plan:     maintenance.Plan{Name: "Revisão", Brand: "Fiat", Model: "Uno", IntervalKm: 10000, IntervalDays: -1},
+			wantErrs: []error{maintenance.ErrInvalidPlan, maintenance.ErrNegativeInterval},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.plan.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
+
+func TestOrder_Validate(t *testing.T) {
+	performedAt := time.Now().AddDate(0, 0, -1)
+
+	tests := []struct {
+		name     string
+		order    maintenance.Order
+		wantErrs []error
+	}{
+		{
+			name: "Dado uma ordem preventiva válida quando a validação é chamada então nenhum erro é retornado",
+			order: maintenance.Order{
+				VehicleID:   1,
+				PlanID:      1,
+				Type:        maintenance.PREVENTIVE,
+				PerformedAt: performedAt,
+				Odometer:    10000,
+				Cost:        35000,
+				Parts:       []maintenance.Part{{Name: "Filtro de óleo", Quantity: 1, UnitCost: 5000}},
+			},
+		},
+		{
+			name: "Dado uma ordem corretiva com plano quando a validação é chamada então um erro é retornado",
+			order: maintenance.Order{
+				VehicleID:   1,
+				PlanID:      1,
+				Type:        maintenance.CORRECTIVE,
+				PerformedAt: performedAt,
+			},
+			wantErrs: []error{maintenance.ErrInvalidOrder, maintenance.ErrPlanOnCorrectiveOrder},
+		},
+		{
+			name: "Dado uma ordem futura com peça inválida quando a validação é chamada então todas as regras quebradas são retornadas",
+			order: maintenance.Order{
+				Type:        "OTHER",
+				PerformedAt: time.Now().AddDate(0, 0, 1),
+				Odometer:    -1,
+				Cost:        -1,
+				Parts:       []maintenance.Part{{Name: "Pneu"}},
+			},
+			wantErrs: []error{
+				maintenance.ErrInvalidOrder, maintenance.ErrMissingVehicle, maintenance.ErrInvalidOrderType, maintenance.ErrInvalidPerformedAt,
+				maintenance.ErrNegativeOdometer, maintenance.ErrNegativeCost, maintenance.ErrInvalidPart,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.order.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
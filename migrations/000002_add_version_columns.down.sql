@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE "drivers" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "vehicles" DROP COLUMN IF EXISTS "version";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "version";
+
+COMMIT;
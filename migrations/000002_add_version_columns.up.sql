@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE "drivers" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE "vehicles" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+
+ALTER TABLE "users" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "adresses" DROP COLUMN IF EXISTS "version";
+
+COMMIT;
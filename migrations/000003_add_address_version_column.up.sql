@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE "adresses" ADD COLUMN IF NOT EXISTS "version" bigint NOT NULL DEFAULT 1;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "idempotency_keys" (
+  "key" varchar(255) PRIMARY KEY,
+  "request_hash" char(64) NOT NULL,
+  "status_code" integer NOT NULL DEFAULT 0,
+  "content_type" varchar NOT NULL DEFAULT '',
+  "body" bytea,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "expires_at" timestamptz NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS "idempotency_keys_expires_at_idx" ON "idempotency_keys" ("expires_at");
+
+COMMIT;
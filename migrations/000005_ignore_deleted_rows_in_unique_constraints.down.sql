@@ -0,0 +1,27 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "drivers_deleted_at_index";
+DROP INDEX IF EXISTS "vehicles_deleted_at_index";
+DROP INDEX IF EXISTS "users_deleted_at_index";
+
+DROP INDEX IF EXISTS "drivers_rg_active_key";
+DROP INDEX IF EXISTS "drivers_cpf_active_key";
+DROP INDEX IF EXISTS "drivers_driver_license_active_key";
+DROP INDEX IF EXISTS "drivers_user_id_active_key";
+DROP INDEX IF EXISTS "vehicles_plate_active_key";
+DROP INDEX IF EXISTS "vehicles_renavam_active_key";
+DROP INDEX IF EXISTS "users_username_active_key";
+
+-- Fails when a deleted row shares a unique value with an active one; purge
+-- those rows before rolling back.
+ALTER TABLE "drivers" ADD CONSTRAINT "drivers_rg_key" UNIQUE ("rg");
+ALTER TABLE "drivers" ADD CONSTRAINT "drivers_cpf_key" UNIQUE ("cpf");
+ALTER TABLE "drivers" ADD CONSTRAINT "drivers_driver_license_key" UNIQUE ("driver_license");
+ALTER TABLE "drivers" ADD CONSTRAINT "drivers_user_id_key" UNIQUE ("user_id");
+
+ALTER TABLE "vehicles" ADD CONSTRAINT "vehicles_plate_key" UNIQUE ("plate");
+ALTER TABLE "vehicles" ADD CONSTRAINT "vehicles_renavam_key" UNIQUE ("renavam");
+
+ALTER TABLE "users" ADD CONSTRAINT "users_username_key" UNIQUE ("username");
+
+COMMIT;
@@ -0,0 +1,27 @@
+BEGIN;
+
+ALTER TABLE "drivers" DROP CONSTRAINT IF EXISTS "drivers_rg_key";
+ALTER TABLE "drivers" DROP CONSTRAINT IF EXISTS "drivers_cpf_key";
+ALTER TABLE "drivers" DROP CONSTRAINT IF EXISTS "drivers_driver_license_key";
+ALTER TABLE "drivers" DROP CONSTRAINT IF EXISTS "drivers_user_id_key";
+
+ALTER TABLE "vehicles" DROP CONSTRAINT IF EXISTS "vehicles_plate_key";
+ALTER TABLE "vehicles" DROP CONSTRAINT IF EXISTS "vehicles_renavam_key";
+
+ALTER TABLE "users" DROP CONSTRAINT IF EXISTS "users_username_key";
+
+CREATE UNIQUE INDEX IF NOT EXISTS "drivers_rg_active_key" ON "drivers" ("rg") WHERE "deleted_at" = '0001-01-01 00:00:00+00';
+CREATE UNIQUE INDEX IF NOT EXISTS "drivers_cpf_active_key" ON "drivers" ("cpf") WHERE "deleted_at" = '0001-01-01 00:00:00+00';
+CREATE UNIQUE INDEX IF NOT EXISTS "drivers_driver_license_active_key" ON "drivers" ("driver_license") WHERE "deleted_at" = '0001-01-01 00:00:00+00';
+CREATE UNIQUE INDEX IF NOT EXISTS "drivers_user_id_active_key" ON "drivers" ("user_id") WHERE "deleted_at" = '0001-01-01 00:00:00+00';
+
+CREATE UNIQUE INDEX IF NOT EXISTS "vehicles_plate_active_key" ON "vehicles" ("plate") WHERE "deleted_at" = '0001-01-01 00:00:00+00';
+CREATE UNIQUE INDEX IF NOT EXISTS "vehicles_renavam_active_key" ON "vehicles" ("renavam") WHERE "deleted_at" = '0001-01-01 00:00:00+00';
+
+CREATE UNIQUE INDEX IF NOT EXISTS "users_username_active_key" ON "users" ("username") WHERE "deleted_at" = '0001-01-01 00:00:00+00';
+
+CREATE INDEX IF NOT EXISTS "drivers_deleted_at_index" ON "drivers" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "vehicles_deleted_at_index" ON "vehicles" ("deleted_at");
+CREATE INDEX IF NOT EXISTS "users_deleted_at_index" ON "users" ("deleted_at");
+
+COMMIT;
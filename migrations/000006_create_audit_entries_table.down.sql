@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "audit_entries";
+
+DROP FUNCTION IF EXISTS "audit_entries_append_only"();
+
+COMMIT;
@@ -0,0 +1,32 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "audit_entries" (
+  "id" bigserial PRIMARY KEY,
+  "entity_type" text NOT NULL,
+  "entity_id" text NOT NULL,
+  "operation" text NOT NULL,
+  "actor_id" bigint,
+  "actor_username" text,
+  "request_id" text,
+  "changes" jsonb NOT NULL DEFAULT '[]',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS "audit_entries_entity_index" ON "audit_entries" ("entity_type", "entity_id", "created_at");
+
+CREATE INDEX IF NOT EXISTS "audit_entries_request_id_index" ON "audit_entries" ("request_id");
+
+-- The audit log is append-only: entries can never be changed or removed.
+CREATE OR REPLACE FUNCTION "audit_entries_append_only"() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit entries are append-only';
+END
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS "audit_entries_append_only" ON "audit_entries";
+
+CREATE TRIGGER "audit_entries_append_only"
+  BEFORE UPDATE OR DELETE ON "audit_entries"
+  FOR EACH ROW EXECUTE FUNCTION "audit_entries_append_only"();
+
+COMMIT;
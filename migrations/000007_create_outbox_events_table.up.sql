@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "outbox_events" (
+  "id" bigserial PRIMARY KEY,
+  "aggregate_type" text NOT NULL,
+  "aggregate_id" text NOT NULL,
+  "event_type" text NOT NULL,
+  "payload" jsonb NOT NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "last_error" text,
+  "occurred_at" timestamptz NOT NULL DEFAULT (now()),
+  "published_at" timestamptz
+);
+
+CREATE INDEX IF NOT EXISTS "outbox_events_pending_index" ON "outbox_events" ("id") WHERE "published_at" IS NULL;
+
+CREATE INDEX IF NOT EXISTS "outbox_events_aggregate_index" ON "outbox_events" ("aggregate_type", "aggregate_id", "id");
+
+COMMIT;
@@ -0,0 +1,47 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "webhook_subscriptions" (
+  "id" bigserial PRIMARY KEY,
+  "url" text NOT NULL,
+  "event_types" text[] NOT NULL,
+  "secret" text NOT NULL,
+  "active" boolean NOT NULL DEFAULT true,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE IF NOT EXISTS "webhook_deliveries" (
+  "id" bigserial PRIMARY KEY,
+  "subscription_id" bigint NOT NULL REFERENCES "webhook_subscriptions" ("id") ON DELETE CASCADE,
+  "event_id" bigint NOT NULL,
+  "event_type" text NOT NULL,
+  "body" bytea NOT NULL,
+  "status" text NOT NULL,
+  "attempts" integer NOT NULL DEFAULT 0,
+  "next_attempt_at" timestamptz NOT NULL DEFAULT (now()),
+  "last_status_code" integer,
+  "last_error" text,
+  "delivered_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  UNIQUE ("subscription_id", "event_id")
+);
+
+CREATE INDEX IF NOT EXISTS "webhook_deliveries_pending_index" ON "webhook_deliveries" ("next_attempt_at") WHERE "status" = 'PENDING';
+
+CREATE INDEX IF NOT EXISTS "webhook_deliveries_status_index" ON "webhook_deliveries" ("subscription_id", "status", "id");
+
+CREATE TABLE IF NOT EXISTS "webhook_delivery_attempts" (
+  "id" bigserial PRIMARY KEY,
+  "delivery_id" bigint NOT NULL REFERENCES "webhook_deliveries" ("id") ON DELETE CASCADE,
+  "subscription_id" bigint NOT NULL REFERENCES "webhook_subscriptions" ("id") ON DELETE CASCADE,
+  "number" integer NOT NULL,
+  "status_code" integer,
+  "error" text,
+  "duration_ms" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS "webhook_delivery_attempts_subscription_index" ON "webhook_delivery_attempts" ("subscription_id", "id");
+
+COMMIT;
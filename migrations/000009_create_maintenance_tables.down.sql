@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "maintenance_orders";
+
+DROP TABLE IF EXISTS "maintenance_plans";
+
+COMMIT;
@@ -0,0 +1,36 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "maintenance_plans" (
+  "id" bigserial PRIMARY KEY,
+  "name" text NOT NULL,
+  "brand" text NOT NULL,
+  "model" text NOT NULL,
+  "interval_km" bigint NOT NULL DEFAULT 0,
+  "interval_days" integer NOT NULL DEFAULT 0,
+  "critical" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("interval_km" > 0 OR "interval_days" > 0)
+);
+
+-- Plans are matched to vehicles by brand and model, ignoring the case.
+CREATE INDEX IF NOT EXISTS "maintenance_plans_model_index" ON "maintenance_plans" (lower("brand"), lower("model"));
+
+CREATE TABLE IF NOT EXISTS "maintenance_orders" (
+  "id" bigserial PRIMARY KEY,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "plan_id" bigint REFERENCES "maintenance_plans" ("id") ON DELETE SET NULL,
+  "type" text NOT NULL,
+  "performed_at" timestamptz NOT NULL,
+  "odometer" bigint NOT NULL DEFAULT 0,
+  "cost" bigint NOT NULL DEFAULT 0,
+  "supplier" text,
+  "parts" jsonb NOT NULL DEFAULT '[]',
+  "notes" text,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS "maintenance_orders_vehicle_index" ON "maintenance_orders" ("vehicle_id", "plan_id", "performed_at");
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "odometer_records" (
+  "id" bigserial PRIMARY KEY,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "read_at" timestamptz NOT NULL,
+  "km" bigint NOT NULL CHECK ("km" >= 0),
+  "source" text NOT NULL,
+  "correction" boolean NOT NULL DEFAULT false,
+  "reason" text,
+  "anomaly" boolean NOT NULL DEFAULT false,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK (NOT "correction" OR "reason" IS NOT NULL)
+);
+
+-- The current odometer of a vehicle is its latest record.
+CREATE INDEX IF NOT EXISTS "odometer_records_vehicle_index" ON "odometer_records" ("vehicle_id", "read_at" DESC, "id" DESC);
+
+COMMIT;
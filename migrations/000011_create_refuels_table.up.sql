@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "refuels" (
+  "id" bigserial PRIMARY KEY,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "driver_id" bigint NOT NULL REFERENCES "drivers" ("id") ON DELETE CASCADE,
+  "fueled_at" timestamptz NOT NULL,
+  "litres" double precision NOT NULL CHECK ("litres" > 0),
+  "fuel_type" text NOT NULL,
+  "price" bigint NOT NULL DEFAULT 0,
+  "station" text,
+  "odometer" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+-- Segments are measured between consecutive refuels of a vehicle.
+CREATE INDEX IF NOT EXISTS "refuels_vehicle_index" ON "refuels" ("vehicle_id", "fueled_at", "id");
+
+CREATE INDEX IF NOT EXISTS "refuels_driver_index" ON "refuels" ("driver_id", "fueled_at");
+
+COMMIT;
@@ -0,0 +1,26 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "fines" (
+  "id" bigserial PRIMARY KEY,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "plate" text NOT NULL,
+  "driver_id" bigint REFERENCES "drivers" ("id") ON DELETE SET NULL,
+  "committed_at" timestamptz NOT NULL,
+  "location" text,
+  "article" text NOT NULL,
+  "points" smallint NOT NULL CHECK ("points" IN (3, 4, 5, 7)),
+  "amount" bigint NOT NULL DEFAULT 0 CHECK ("amount" >= 0),
+  "payment_due_at" timestamptz,
+  "indication_due_at" timestamptz,
+  "paid_at" timestamptz,
+  "indicated_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS "fines_vehicle_index" ON "fines" ("vehicle_id", "committed_at");
+
+-- Points are summed by driver over the last twelve months.
+CREATE INDEX IF NOT EXISTS "fines_driver_index" ON "fines" ("driver_id", "committed_at");
+
+COMMIT;
@@ -0,0 +1,44 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "trips" (
+  "id" bigserial PRIMARY KEY,
+  "driver_id" bigint NOT NULL REFERENCES "drivers" ("id") ON DELETE CASCADE,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "origin_locality" text NOT NULL,
+  "origin_number" text NOT NULL,
+  "origin_complement" text,
+  "origin_neighborhood" text NOT NULL,
+  "origin_city" text NOT NULL,
+  "origin_state" text NOT NULL,
+  "origin_cep" text NOT NULL,
+  "origin_country" text NOT NULL,
+  "destination_locality" text NOT NULL,
+  "destination_number" text NOT NULL,
+  "destination_complement" text,
+  "destination_neighborhood" text NOT NULL,
+  "destination_city" text NOT NULL,
+  "destination_state" text NOT NULL,
+  "destination_cep" text NOT NULL,
+  "destination_country" text NOT NULL,
+  "planned_start_at" timestamptz NOT NULL,
+  "planned_end_at" timestamptz,
+  "started_at" timestamptz,
+  "ended_at" timestamptz,
+  "start_odometer" bigint,
+  "end_odometer" bigint,
+  "status" text NOT NULL DEFAULT 'SCHEDULED',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("end_odometer" IS NULL OR "end_odometer" >= "start_odometer")
+);
+
+CREATE INDEX IF NOT EXISTS "trips_driver_index" ON "trips" ("driver_id", "planned_start_at");
+
+CREATE INDEX IF NOT EXISTS "trips_vehicle_index" ON "trips" ("vehicle_id", "planned_start_at");
+
+-- A driver and a vehicle are on at most one trip at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS "trips_driver_in_progress_index" ON "trips" ("driver_id") WHERE "status" = 'IN_PROGRESS';
+
+CREATE UNIQUE INDEX IF NOT EXISTS "trips_vehicle_in_progress_index" ON "trips" ("vehicle_id") WHERE "status" = 'IN_PROGRESS';
+
+COMMIT;
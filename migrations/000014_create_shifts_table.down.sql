@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "shift_periods";
+
+DROP TABLE IF EXISTS "shifts";
+
+COMMIT;
@@ -0,0 +1,37 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "shifts" (
+  "id" bigserial PRIMARY KEY,
+  "driver_id" bigint NOT NULL REFERENCES "drivers" ("id") ON DELETE CASCADE,
+  "planned_start_at" timestamptz NOT NULL,
+  "planned_end_at" timestamptz NOT NULL,
+  "clock_in_at" timestamptz,
+  "clock_out_at" timestamptz,
+  "status" text NOT NULL DEFAULT 'SCHEDULED',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("planned_end_at" > "planned_start_at")
+);
+
+CREATE INDEX IF NOT EXISTS "shifts_driver_index" ON "shifts" ("driver_id", "planned_start_at");
+
+CREATE INDEX IF NOT EXISTS "shifts_driver_clock_in_index" ON "shifts" ("driver_id", "clock_in_at");
+
+-- A driver is clocked in to at most one shift at a time.
+CREATE UNIQUE INDEX IF NOT EXISTS "shifts_driver_open_index" ON "shifts" ("driver_id") WHERE "status" = 'OPEN';
+
+CREATE TABLE IF NOT EXISTS "shift_periods" (
+  "id" bigserial PRIMARY KEY,
+  "shift_id" bigint NOT NULL REFERENCES "shifts" ("id") ON DELETE CASCADE,
+  "activity" text NOT NULL,
+  "started_at" timestamptz NOT NULL,
+  "ended_at" timestamptz,
+  CHECK ("ended_at" IS NULL OR "ended_at" >= "started_at")
+);
+
+CREATE INDEX IF NOT EXISTS "shift_periods_shift_index" ON "shift_periods" ("shift_id", "started_at");
+
+-- A shift has at most one current activity.
+CREATE UNIQUE INDEX IF NOT EXISTS "shift_periods_current_index" ON "shift_periods" ("shift_id") WHERE "ended_at" IS NULL;
+
+COMMIT;
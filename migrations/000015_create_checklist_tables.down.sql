@@ -0,0 +1,11 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "maintenance_tickets";
+
+DROP TABLE IF EXISTS "checklists";
+
+DROP TABLE IF EXISTS "checklist_templates";
+
+ALTER TABLE "vehicles" DROP COLUMN IF EXISTS "category";
+
+COMMIT;
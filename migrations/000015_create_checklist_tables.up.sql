@@ -0,0 +1,55 @@
+BEGIN;
+
+ALTER TABLE "vehicles" ADD COLUMN IF NOT EXISTS "category" text;
+
+-- The default template of a kind has an empty category, so that it takes
+-- part in the unique index.
+CREATE TABLE IF NOT EXISTS "checklist_templates" (
+  "id" bigserial PRIMARY KEY,
+  "name" text NOT NULL,
+  "category" text NOT NULL DEFAULT '',
+  "kind" text NOT NULL,
+  "items" jsonb NOT NULL DEFAULT '[]',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "checklist_templates_category_kind_index" ON "checklist_templates" ("category", "kind");
+
+CREATE TABLE IF NOT EXISTS "checklists" (
+  "id" bigserial PRIMARY KEY,
+  "kind" text NOT NULL,
+  "template_id" bigint REFERENCES "checklist_templates" ("id") ON DELETE SET NULL,
+  "driver_id" bigint NOT NULL REFERENCES "drivers" ("id") ON DELETE CASCADE,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "odometer" bigint NOT NULL,
+  "fuel_level" integer NOT NULL,
+  "answers" jsonb NOT NULL DEFAULT '[]',
+  "photos" jsonb NOT NULL DEFAULT '[]',
+  "notes" text,
+  "submitted_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK ("fuel_level" BETWEEN 0 AND 100)
+);
+
+CREATE INDEX IF NOT EXISTS "checklists_vehicle_index" ON "checklists" ("vehicle_id", "submitted_at");
+
+CREATE INDEX IF NOT EXISTS "checklists_driver_index" ON "checklists" ("driver_id", "submitted_at");
+
+CREATE TABLE IF NOT EXISTS "maintenance_tickets" (
+  "id" bigserial PRIMARY KEY,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "checklist_id" bigint REFERENCES "checklists" ("id") ON DELETE SET NULL,
+  "description" text NOT NULL,
+  "status" text NOT NULL DEFAULT 'OPEN',
+  "order_id" bigint REFERENCES "maintenance_orders" ("id") ON DELETE SET NULL,
+  "closed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS "maintenance_tickets_vehicle_index" ON "maintenance_tickets" ("vehicle_id", "status");
+
+CREATE INDEX IF NOT EXISTS "maintenance_tickets_checklist_index" ON "maintenance_tickets" ("checklist_id");
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "incidents" (
+  "id" bigserial PRIMARY KEY,
+  "type" text NOT NULL CHECK ("type" IN ('ACCIDENT', 'BREAKDOWN', 'THEFT', 'ROBBERY')),
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "driver_id" bigint REFERENCES "drivers" ("id") ON DELETE SET NULL,
+  "occurred_at" timestamptz NOT NULL,
+  "location" text NOT NULL,
+  "description" text NOT NULL,
+  "police_report" text,
+  "third_parties" jsonb NOT NULL DEFAULT '[]',
+  "cost_estimates" jsonb NOT NULL DEFAULT '[]',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX IF NOT EXISTS "incidents_vehicle_index" ON "incidents" ("vehicle_id", "occurred_at");
+
+CREATE INDEX IF NOT EXISTS "incidents_driver_index" ON "incidents" ("driver_id", "occurred_at");
+
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS "insurance_claims";
+
+DROP TABLE IF EXISTS "insurance_policies";
+
+COMMIT;
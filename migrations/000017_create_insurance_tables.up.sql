@@ -0,0 +1,40 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "insurance_policies" (
+  "id" bigserial PRIMARY KEY,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "insurer" text NOT NULL,
+  "number" text NOT NULL,
+  "coverage" text NOT NULL CHECK ("coverage" IN ('COMPREHENSIVE', 'THIRD_PARTY', 'FIRE_AND_THEFT')),
+  "starts_at" timestamptz NOT NULL,
+  "ends_at" timestamptz NOT NULL CHECK ("ends_at" > "starts_at"),
+  "premium" bigint NOT NULL DEFAULT 0 CHECK ("premium" >= 0),
+  "deductible" bigint NOT NULL DEFAULT 0 CHECK ("deductible" >= 0),
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "insurance_policies_number_index" ON "insurance_policies" ("insurer", "number");
+
+-- Vehicles are insured when any of their policies is in force.
+CREATE INDEX IF NOT EXISTS "insurance_policies_vehicle_index" ON "insurance_policies" ("vehicle_id", "ends_at");
+
+CREATE TABLE IF NOT EXISTS "insurance_claims" (
+  "id" bigserial PRIMARY KEY,
+  "policy_id" bigint NOT NULL REFERENCES "insurance_policies" ("id") ON DELETE CASCADE,
+  "incident_id" bigint NOT NULL REFERENCES "incidents" ("id") ON DELETE CASCADE,
+  "vehicle_id" bigint NOT NULL REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "number" text,
+  "status" text NOT NULL CHECK ("status" IN ('OPEN', 'UNDER_REVIEW', 'APPROVED', 'DENIED', 'PAID')),
+  "amount" bigint NOT NULL DEFAULT 0 CHECK ("amount" >= 0),
+  "notes" text,
+  "closed_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS "insurance_claims_incident_index" ON "insurance_claims" ("incident_id", "policy_id");
+
+CREATE INDEX IF NOT EXISTS "insurance_claims_vehicle_index" ON "insurance_claims" ("vehicle_id", "created_at");
+
+COMMIT;
@@ -0,0 +1,30 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS "documents" (
+  "id" bigserial PRIMARY KEY,
+  "owner_type" text NOT NULL CHECK ("owner_type" IN ('DRIVER', 'VEHICLE')),
+  "driver_id" bigint REFERENCES "drivers" ("id") ON DELETE CASCADE,
+  "vehicle_id" bigint REFERENCES "vehicles" ("id") ON DELETE CASCADE,
+  "type" text NOT NULL CHECK ("type" IN ('CNH', 'TOXICOLOGICAL_EXAM', 'MEDICAL_EXAM', 'CRLV')),
+  "name" text NOT NULL,
+  "content_type" text NOT NULL,
+  "size" bigint NOT NULL CHECK ("size" > 0),
+  "checksum" text NOT NULL,
+  "storage_key" text NOT NULL UNIQUE,
+  "valid_from" timestamptz,
+  "valid_until" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  CHECK (
+    ("owner_type" = 'DRIVER' AND "driver_id" IS NOT NULL AND "vehicle_id" IS NULL) OR
+    ("owner_type" = 'VEHICLE' AND "vehicle_id" IS NOT NULL AND "driver_id" IS NULL)
+  )
+);
+
+CREATE INDEX IF NOT EXISTS "documents_driver_index" ON "documents" ("driver_id", "valid_until");
+
+CREATE INDEX IF NOT EXISTS "documents_vehicle_index" ON "documents" ("vehicle_id", "valid_until");
+
+CREATE INDEX IF NOT EXISTS "documents_valid_until_index" ON "documents" ("valid_until");
+
+COMMIT;
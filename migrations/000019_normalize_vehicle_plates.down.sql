@@ -0,0 +1,6 @@
+BEGIN;
+
+-- The hyphens removed from the plates cannot be told apart from the plates
+-- sent without them, so the plates are left in their canonical form.
+
+COMMIT;
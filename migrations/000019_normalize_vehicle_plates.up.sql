@@ -0,0 +1,28 @@
+BEGIN;
+
+-- Plates are kept in upper case and without the hyphen of the old pattern,
+-- so that ABC-1234 and abc1234 find the same vehicle. A plate whose
+-- canonical form is shared with another active vehicle, either already in
+-- that form or about to be put in it, is left as is, to be merged by hand,
+-- instead of breaking "vehicles_plate_active_key".
+WITH "canonical" AS (
+  SELECT "id", count(*) OVER (
+    PARTITION BY regexp_replace(upper(btrim("plate")), '^([A-Z]{3})-([0-9]{4})$', '\1\2')
+  ) AS "holders"
+  FROM "vehicles"
+  WHERE "deleted_at" = '0001-01-01 00:00:00+00'
+)
+UPDATE "vehicles" AS "v"
+SET "plate" = regexp_replace(upper(btrim("v"."plate")), '^([A-Z]{3})-([0-9]{4})$', '\1\2')
+WHERE "v"."plate" <> regexp_replace(upper(btrim("v"."plate")), '^([A-Z]{3})-([0-9]{4})$', '\1\2')
+  AND NOT EXISTS (
+    SELECT 1 FROM "canonical" AS "c"
+    WHERE "c"."id" = "v"."id"
+      AND "c"."holders" > 1
+  );
+
+UPDATE "fines"
+SET "plate" = regexp_replace(upper(btrim("plate")), '^([A-Z]{3})-([0-9]{4})$', '\1\2')
+WHERE "plate" <> regexp_replace(upper(btrim("plate")), '^([A-Z]{3})-([0-9]{4})$', '\1\2');
+
+COMMIT;
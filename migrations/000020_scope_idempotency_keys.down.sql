@@ -0,0 +1,16 @@
+BEGIN;
+
+-- The same key may have been used by several users, so only the latest of
+-- them is kept.
+DELETE FROM "idempotency_keys" AS "k"
+USING "idempotency_keys" AS "newer"
+WHERE "newer"."key" = "k"."key"
+  AND ("newer"."created_at", "newer"."actor_id") > ("k"."created_at", "k"."actor_id");
+
+ALTER TABLE "idempotency_keys" DROP CONSTRAINT IF EXISTS "idempotency_keys_pkey";
+ALTER TABLE "idempotency_keys" ADD PRIMARY KEY ("key");
+
+ALTER TABLE "idempotency_keys" DROP COLUMN IF EXISTS "locked_until";
+ALTER TABLE "idempotency_keys" DROP COLUMN IF EXISTS "actor_id";
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- Keys belong to the user that sent them, 0 standing for anonymous requests,
+-- and a key in progress is only held until "locked_until".
+ALTER TABLE "idempotency_keys" ADD COLUMN IF NOT EXISTS "actor_id" bigint NOT NULL DEFAULT 0;
+ALTER TABLE "idempotency_keys" ADD COLUMN IF NOT EXISTS "locked_until" timestamptz NOT NULL DEFAULT (now());
+
+ALTER TABLE "idempotency_keys" DROP CONSTRAINT IF EXISTS "idempotency_keys_pkey";
+ALTER TABLE "idempotency_keys" ADD PRIMARY KEY ("actor_id", "key");
+
+COMMIT;
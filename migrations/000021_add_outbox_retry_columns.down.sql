@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP INDEX IF EXISTS "outbox_events_pending_index";
+CREATE INDEX IF NOT EXISTS "outbox_events_pending_index" ON "outbox_events" ("id") WHERE "published_at" IS NULL;
+
+ALTER TABLE "outbox_events" DROP COLUMN IF EXISTS "parked_at";
+ALTER TABLE "outbox_events" DROP COLUMN IF EXISTS "next_attempt_at";
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+-- A failed event waits until "next_attempt_at" to be retried and, once it
+-- has used up its attempts, is parked so that it no longer holds the relay.
+ALTER TABLE "outbox_events" ADD COLUMN IF NOT EXISTS "next_attempt_at" timestamptz NOT NULL DEFAULT (now());
+ALTER TABLE "outbox_events" ADD COLUMN IF NOT EXISTS "parked_at" timestamptz;
+
+DROP INDEX IF EXISTS "outbox_events_pending_index";
+CREATE INDEX IF NOT EXISTS "outbox_events_pending_index" ON "outbox_events" ("id") WHERE "published_at" IS NULL AND "parked_at" IS NULL;
+
+COMMIT;
@@ -0,0 +1,36 @@
+BEGIN;
+
+ALTER TABLE "refuels" DROP CONSTRAINT IF EXISTS "refuels_vehicle_id_fkey";
+ALTER TABLE "refuels" ADD CONSTRAINT "refuels_vehicle_id_fkey" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE CASCADE;
+ALTER TABLE "refuels" DROP CONSTRAINT IF EXISTS "refuels_driver_id_fkey";
+ALTER TABLE "refuels" ADD CONSTRAINT "refuels_driver_id_fkey" FOREIGN KEY ("driver_id") REFERENCES "drivers" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "fines" DROP CONSTRAINT IF EXISTS "fines_vehicle_id_fkey";
+ALTER TABLE "fines" ADD CONSTRAINT "fines_vehicle_id_fkey" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE CASCADE;
+ALTER TABLE "fines" DROP CONSTRAINT IF EXISTS "fines_driver_id_fkey";
+ALTER TABLE "fines" ADD CONSTRAINT "fines_driver_id_fkey" FOREIGN KEY ("driver_id") REFERENCES "drivers" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "trips" DROP CONSTRAINT IF EXISTS "trips_driver_id_fkey";
+ALTER TABLE "trips" ADD CONSTRAINT "trips_driver_id_fkey" FOREIGN KEY ("driver_id") REFERENCES "drivers" ("id") ON DELETE CASCADE;
+ALTER TABLE "trips" DROP CONSTRAINT IF EXISTS "trips_vehicle_id_fkey";
+ALTER TABLE "trips" ADD CONSTRAINT "trips_vehicle_id_fkey" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "shifts" DROP CONSTRAINT IF EXISTS "shifts_driver_id_fkey";
+ALTER TABLE "shifts" ADD CONSTRAINT "shifts_driver_id_fkey" FOREIGN KEY ("driver_id") REFERENCES "drivers" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "incidents" DROP CONSTRAINT IF EXISTS "incidents_vehicle_id_fkey";
+ALTER TABLE "incidents" ADD CONSTRAINT "incidents_vehicle_id_fkey" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE CASCADE;
+ALTER TABLE "incidents" DROP CONSTRAINT IF EXISTS "incidents_driver_id_fkey";
+ALTER TABLE "incidents" ADD CONSTRAINT "incidents_driver_id_fkey" FOREIGN KEY ("driver_id") REFERENCES "drivers" ("id") ON DELETE SET NULL;
+
+ALTER TABLE "insurance_policies" DROP CONSTRAINT IF EXISTS "insurance_policies_vehicle_id_fkey";
+ALTER TABLE "insurance_policies" ADD CONSTRAINT "insurance_policies_vehicle_id_fkey" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "insurance_claims" DROP CONSTRAINT IF EXISTS "insurance_claims_policy_id_fkey";
+ALTER TABLE "insurance_claims" ADD CONSTRAINT "insurance_claims_policy_id_fkey" FOREIGN KEY ("policy_id") REFERENCES "insurance_policies" ("id") ON DELETE CASCADE;
+ALTER TABLE "insurance_claims" DROP CONSTRAINT IF EXISTS "insurance_claims_incident_id_fkey";
+ALTER TABLE "insurance_claims" ADD CONSTRAINT "insurance_claims_incident_id_fkey" FOREIGN KEY ("incident_id") REFERENCES "incidents" ("id") ON DELETE CASCADE;
+ALTER TABLE "insurance_claims" DROP CONSTRAINT IF EXISTS "insurance_claims_vehicle_id_fkey";
+ALTER TABLE "insurance_claims" ADD CONSTRAINT "insurance_claims_vehicle_id_fkey" FOREIGN KEY ("vehicle_id") REFERENCES "vehicles" ("id") ON DELETE CASCADE;
+
+COMMIT;
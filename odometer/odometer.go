@@ -0,0 +1,98 @@
+package odometer
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+)
+
+type Source string
+
+const (
+	MANUAL    Source = "MANUAL"
+	TELEMETRY Source = "TELEMETRY"
+	FUEL      Source = "FUEL"
+	TRIP      Source = "TRIP"
+	CHECKLIST Source = "CHECKLIST"
+)
+
+// DEFAULT_MAXIMUM_DAILY_KM is the most a vehicle is expected to drive in a
+// day. Records further apart than that are flagged as anomalies.
+const DEFAULT_MAXIMUM_DAILY_KM = 1500
+
+var (
+	sources = []Source{MANUAL, TELEMETRY, FUEL, TRIP, CHECKLIST}
+
+	ErrInvalidSource     = errors.New("the odometer source must be one of MANUAL, TELEMETRY, FUEL, TRIP or CHECKLIST")
+	ErrDecreasingReading = errors.New("the odometer cannot be lower than an earlier reading or higher than a later one, unless the record is a correction")
+)
+
+func GetSource(name string) (Source, error) {
+	source := Source(name)
+	if !slices.Contains(sources, source) {
+		return "", ErrInvalidSource
+	}
+
+	return source, nil
+}
+
+// Record is a reading of the odometer of a vehicle. The readings of a
+// vehicle never decrease over time, except through a correction, which
+// must state its reason and becomes the baseline of the later readings.
+// Anomaly flags a reading further from the previous one than the vehicle
+// could have driven in between.
+type Record struct {
+	ID         int64
+	VehicleID  int64
+	ReadAt     time.Time
+	Km         int64
+	Source     Source
+	Correction bool
+	Reason     string
+	Anomaly    bool
+	CreatedAt  time.Time
+}
+
+// Plausible tells whether the vehicle could have driven from the previous
+// record to this one, counting at least a day between them.
+func (r *Record) Plausible(previous *Record, maximumDailyKm int64) bool {
+	days := int64(r.ReadAt.Sub(previous.ReadAt).Hours()/24) + 1
+
+	return r.Km-previous.Km <= days*maximumDailyKm
+}
+
+// RecordSpecification lists the records of a vehicle, the latest first, and
+// only the anomalies when Anomaly is set.
+type RecordSpecification struct {
+	VehicleID      int64
+	Anomaly        bool
+	Page, PageSize int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Record, error)
+	List(ctx context.Context, specification *RecordSpecification) (*[]Record, error)
+	// Neighbours returns the records of the vehicle right before and right
+	// after the given moment, nil when there is none.
+	Neighbours(ctx context.Context, vehicleID int64, readAt time.Time) (*Record, *Record, error)
+}
+
+type Writing interface {
+	// LockVehicle serializes the writes on the records of a live vehicle
+	// until the end of the transaction, and fails with sql.ErrNoRows when
+	// there is no such vehicle.
+	LockVehicle(ctx context.Context, vehicleID int64) error
+	Create(ctx context.Context, r *Record) (int64, error)
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Record, error)
+	List(ctx context.Context, specification *RecordSpecification) (*[]Record, error)
+	Create(ctx context.Context, r *Record) (int64, error)
+}
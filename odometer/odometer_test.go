@@ -0,0 +1,41 @@
+package odometer_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestRecord_Plausible(t *testing.T) {
+	previous := &odometer.Record{ReadAt: time.Date(2024, time.June, 1, 8, 0, 0, 0, time.UTC), Km: 10000}
+
+	tests := []struct {
+		name   string
+		record odometer.Record
+		want   bool
+	}{
+		{
+			name:   "Dado uma leitura no mesmo dia dentro do limite diário quando a plausibilidade é verificada então a leitura é plausível",
+			record: odometer.Record{ReadAt: previous.ReadAt.Add(2 * time.Hour), Km: 10500},
+			want:   true,
+		},
+		{
+			name:   "Dado uma leitura no mesmo dia acima do limite diário quando a plausibilidade é verificada então a leitura não é plausível",
+			record: odometer.Record{ReadAt: previous.ReadAt.Add(2 * time.Hour), Km: 12000},
+			want:   false,
+		},
+		{
+			name:   "Dado uma leitura dias depois dentro do limite do período quando a plausibilidade é verificada então a leitura é plausível",
+			record: odometer.Record{ReadAt: previous.ReadAt.AddDate(0, 0, 2), Km: 14000},
+			want:   true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, test.record.Plausible(previous, 1500))
+		})
+	}
+}
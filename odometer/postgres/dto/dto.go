@@ -0,0 +1,21 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type RecordDTO struct {
+	bun.BaseModel `bun:"table:odometer_records"`
+
+	ID         int64     `bun:"id,pk,autoincrement"`
+	VehicleID  int64     `bun:"vehicle_id,notnull"`
+	ReadAt     time.Time `bun:"read_at,notnull"`
+	Km         int64     `bun:"km,notnull"`
+	Source     string    `bun:"source,notnull"`
+	Correction bool      `bun:"correction,notnull"`
+	Reason     string    `bun:"reason,nullzero"`
+	Anomaly    bool      `bun:"anomaly,notnull"`
+	CreatedAt  time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+}
@@ -0,0 +1,34 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/odometer/postgres/dto"
+)
+
+func MapRecordToDTO(record *odometer.Record) *dto.RecordDTO {
+	return &dto.RecordDTO{
+		ID:         record.ID,
+		VehicleID:  record.VehicleID,
+		ReadAt:     record.ReadAt,
+		Km:         record.Km,
+		Source:     string(record.Source),
+		Correction: record.Correction,
+		Reason:     record.Reason,
+		Anomaly:    record.Anomaly,
+		CreatedAt:  record.CreatedAt,
+	}
+}
+
+func MapDTOToRecord(recordDTO *dto.RecordDTO) *odometer.Record {
+	return &odometer.Record{
+		ID:         recordDTO.ID,
+		VehicleID:  recordDTO.VehicleID,
+		ReadAt:     recordDTO.ReadAt,
+		Km:         recordDTO.Km,
+		Source:     odometer.Source(recordDTO.Source),
+		Correction: recordDTO.Correction,
+		Reason:     recordDTO.Reason,
+		Anomaly:    recordDTO.Anomaly,
+		CreatedAt:  recordDTO.CreatedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	odometer_dto "github.com/LucasMateus-eng/operations-service/odometer/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapRecordToDTO(t *testing.T) {
+	record := &odometer.Record{
+		ID:         1,
+		VehicleID:  2,
+		ReadAt:     mockedTime,
+		Km:         10000,
+		Source:     odometer.MANUAL,
+		Correction: true,
+		Reason:     "Hodômetro trocado",
+		Anomaly:    false,
+		CreatedAt:  mockedTime,
+	}
+
+	expectedDTO := &odometer_dto.RecordDTO{
+		ID:         1,
+		VehicleID:  2,
+		ReadAt:     mockedTime,
+		Km:         10000,
+		Source:     "MANUAL",
+		Correction: true,
+		Reason:     "Hodômetro trocado",
+		Anomaly:    false,
+		CreatedAt:  mockedTime,
+	}
+
+	actualDTO := MapRecordToDTO(record)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, record, MapDTOToRecord(actualDTO))
+}
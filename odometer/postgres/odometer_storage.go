@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/odometer/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/odometer/postgres/mapping"
+	"github.com/uptrace/bun"
+)
+
+type odometerPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *odometerPostgresRepo {
+	return &odometerPostgresRepo{
+		db: db,
+	}
+}
+
+func (or *odometerPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, or.db)
+}
+
+func (or *odometerPostgresRepo) GetByID(ctx context.Context, id int64) (*odometer.Record, error) {
+	recordDTO := new(dto.RecordDTO)
+
+	err := or.conn(ctx).NewSelect().Model(recordDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToRecord(recordDTO), nil
+}
+
+func (or *odometerPostgresRepo) List(ctx context.Context, specification *odometer.RecordSpecification) (*[]odometer.Record, error) {
+	var recordDTOs []dto.RecordDTO
+
+	query := or.conn(ctx).NewSelect().Model(&recordDTOs).
+		Where("vehicle_id = ?", specification.VehicleID).
+		Order("read_at DESC", "id DESC")
+
+	if specification.Anomaly {
+		query = query.Where("anomaly")
+	}
+
+	err := paginate(query, specification.Page, specification.PageSize).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]odometer.Record, 0, len(recordDTOs))
+	for _, dto := range recordDTOs {
+		records = append(records, *mapping.MapDTOToRecord(&dto))
+	}
+
+	return &records, nil
+}
+
+// Neighbours breaks the ties between records read at the same moment by
+// their creation, so the one being created comes after them.
+func (or *odometerPostgresRepo) Neighbours(ctx context.Context, vehicleID int64, readAt time.Time) (*odometer.Record, *odometer.Record, error) {
+	previous, err := or.neighbour(ctx, vehicleID, "read_at <= ?", readAt, "read_at DESC", "id DESC")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	next, err := or.neighbour(ctx, vehicleID, "read_at > ?", readAt, "read_at ASC", "id ASC")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return previous, next, nil
+}
+
+func (or *odometerPostgresRepo) neighbour(ctx context.Context, vehicleID int64, where string, readAt time.Time, orders ...string) (*odometer.Record, error) {
+	recordDTO := new(dto.RecordDTO)
+
+	err := or.conn(ctx).NewSelect().Model(recordDTO).
+		Where("vehicle_id = ?", vehicleID).
+		Where(where, readAt).
+		Order(orders...).
+		Limit(1).
+		Scan(ctx)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToRecord(recordDTO), nil
+}
+
+func (or *odometerPostgresRepo) LockVehicle(ctx context.Context, vehicleID int64) error {
+	var id int64
+
+	return or.conn(ctx).NewSelect().
+		TableExpr("vehicles").
+		Column("id").
+		Where("id = ?", vehicleID).
+		Where("deleted_at = ?", time.Time{}).
+		For("NO KEY UPDATE").
+		Scan(ctx, &id)
+}
+
+func (or *odometerPostgresRepo) Create(ctx context.Context, r *odometer.Record) (int64, error) {
+	recordDTO := mapping.MapRecordToDTO(r)
+
+	_, err := or.conn(ctx).NewInsert().Model(recordDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return recordDTO.ID, nil
+}
+
+func paginate(query *bun.SelectQuery, page, pageSize int) *bun.SelectQuery {
+	if page > 0 && pageSize > 0 {
+		query = query.Offset((page - 1) * pageSize).Limit(pageSize)
+	}
+
+	return query
+}
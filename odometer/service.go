@@ -0,0 +1,115 @@
+package odometer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+)
+
+type Service struct {
+	repo           Repository
+	auditor        audit.Recorder
+	maximumDailyKm int64
+	logger         *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, maximumDailyKm int64, l *logging.Logging) *Service {
+	if maximumDailyKm <= 0 {
+		maximumDailyKm = DEFAULT_MAXIMUM_DAILY_KM
+	}
+
+	return &Service{
+		repo:           r,
+		auditor:        au,
+		maximumDailyKm: maximumDailyKm,
+		logger:         l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Record, error) {
+	s.logger.Debug("[ODOMETER] GetByID - DEBUG: ", map[string]any{
+		"recordID": id,
+	})
+	record, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[ODOMETER] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *RecordSpecification) (*[]Record, error) {
+	s.logger.Debug("[ODOMETER] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	records, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[ODOMETER] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// Create records a reading between the readings of the vehicle taken
+// before and after it. Only a correction may break their order, and it is
+// never flagged as an anomaly.
+func (s *Service) Create(ctx context.Context, r *Record) (int64, error) {
+	s.logger.Debug("[ODOMETER] Create - DEBUG: ", map[string]any{
+		"record": r,
+	})
+	if err := r.Validate(); err != nil {
+		return 0, err
+	}
+
+	var recordID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.repo.LockVehicle(ctx, r.VehicleID); err != nil {
+			return nil, err
+		}
+
+		previous, next, err := s.repo.Neighbours(ctx, r.VehicleID, r.ReadAt)
+		if err != nil {
+			return nil, err
+		}
+
+		r.Anomaly = false
+		if !r.Correction {
+			if (previous != nil && r.Km < previous.Km) || (next != nil && r.Km > next.Km) {
+				return nil, fmt.Errorf("%w: [%d]", ErrDecreasingReading, r.Km)
+			}
+
+			r.Anomaly = previous != nil && !r.Plausible(previous, s.maximumDailyKm)
+		}
+
+		recordID, err = s.repo.Create(ctx, r)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.ODOMETER_RECORD, recordID, audit.CREATE, nil, r)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[ODOMETER] Create - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	if r.Anomaly {
+		s.logger.Warn("[ODOMETER] Create - WARN: ", map[string]any{
+			"recordID":  recordID,
+			"vehicleID": r.VehicleID,
+			"km":        r.Km,
+		})
+	}
+
+	return recordID, nil
+}
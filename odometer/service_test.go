@@ -0,0 +1,133 @@
+package odometer_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	odometer_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/odometer"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	readAt        = time.Now().Add(-time.Hour)
+	previous      = &odometer.Record{ID: 1, VehicleID: 1, ReadAt: readAt.Add(-time.Hour), Km: 10000, Source: odometer.TELEMETRY}
+	next          = &odometer.Record{ID: 2, VehicleID: 1, ReadAt: readAt.Add(30 * time.Minute), Km: 10100, Source: odometer.TELEMETRY}
+)
+
+func TestService_Create(t *testing.T) {
+	type args struct {
+		ctx    context.Context
+		record *odometer.Record
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, repo *odometer_mocks.MockRepository)
+		want        int64
+		wantAnomaly bool
+		wantErr     error
+	}{
+		{
+			name: "Dado uma leitura entre as vizinhas quando o método Create é chamado então a leitura é criada",
+			args: args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 10050, Source: odometer.MANUAL}},
+			prepareMock: func(p args, repo *odometer_mocks.MockRepository) {
+				repo.EXPECT().LockVehicle(p.ctx, int64(1)).Return(nil)
+				repo.EXPECT().Neighbours(p.ctx, int64(1), readAt).Return(previous, next, nil)
+				repo.EXPECT().Create(p.ctx, p.record).Return(int64(3), nil)
+			},
+			want: 3,
+		},
+		{
+			name: "Dado uma leitura menor que a anterior quando o método Create é chamado então a leitura não é criada",
+			args: args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 9000, Source: odometer.MANUAL}},
+			prepareMock: func(p args, repo *odometer_mocks.MockRepository) {
+				repo.EXPECT().LockVehicle(p.ctx, int64(1)).Return(nil)
+				repo.EXPECT().Neighbours(p.ctx, int64(1), readAt).Return(previous, nil, nil)
+			},
+			wantErr: odometer.ErrDecreasingReading,
+		},
+		{
+			name: "Dado uma leitura maior que a seguinte quando o método Create é chamado então a leitura não é criada",
+			args: args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 10200, Source: odometer.MANUAL}},
+			prepareMock: func(p args, repo *odometer_mocks.MockRepository) {
+				repo.EXPECT().LockVehicle(p.ctx, int64(1)).Return(nil)
+				repo.EXPECT().Neighbours(p.ctx, int64(1), readAt).Return(previous, next, nil)
+			},
+			wantErr: odometer.ErrDecreasingReading,
+		},
+		{
+			name: "Dado uma correção menor que a anterior quando o método Create é chamado então a correção é criada",
+			args: args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 9000, Source: odometer.MANUAL, Correction: true, Reason: "Telemetria com defeito"}},
+			prepareMock: func(p args, repo *odometer_mocks.MockRepository) {
+				repo.EXPECT().LockVehicle(p.ctx, int64(1)).Return(nil)
+				repo.EXPECT().Neighbours(p.ctx, int64(1), readAt).Return(previous, nil, nil)
+				repo.EXPECT().Create(p.ctx, p.record).Return(int64(3), nil)
+			},
+			want: 3,
+		},
+		{
+			name: "Dado um salto implausível quando o método Create é chamado então a leitura é criada como anomalia",
+			args: args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 50000, Source: odometer.TELEMETRY}},
+			prepareMock: func(p args, repo *odometer_mocks.MockRepository) {
+				repo.EXPECT().LockVehicle(p.ctx, int64(1)).Return(nil)
+				repo.EXPECT().Neighbours(p.ctx, int64(1), readAt).Return(previous, nil, nil)
+				repo.EXPECT().Create(p.ctx, p.record).Return(int64(3), nil)
+			},
+			want:        3,
+			wantAnomaly: true,
+		},
+		{
+			name: "Dado um veículo inexistente quando o método Create é chamado então o erro é retornado",
+			args: args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 10050, Source: odometer.MANUAL}},
+			prepareMock: func(p args, repo *odometer_mocks.MockRepository) {
+				repo.EXPECT().LockVehicle(p.ctx, int64(1)).Return(sql.ErrNoRows)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+		{
+			name:    "Dado uma leitura inválida quando o método Create é chamado então a leitura não é criada",
+			args:    args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 10050, Source: "GPS"}},
+			wantErr: odometer.ErrInvalidRecord,
+		},
+		{
+			name: "Dado um erro do repositório quando o método Create é chamado então o erro é retornado",
+			args: args{ctx: mockedContext, record: &odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 10050, Source: odometer.MANUAL}},
+			prepareMock: func(p args, repo *odometer_mocks.MockRepository) {
+				repo.EXPECT().LockVehicle(p.ctx, int64(1)).Return(nil)
+				repo.EXPECT().Neighbours(p.ctx, int64(1), readAt).Return(nil, nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			repo := odometer_mocks.NewMockRepository(ctrl)
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, repo)
+			}
+
+			s := odometer.NewService(repo, audit_mocks.NewPassThroughRecorder(ctrl), 0, logging.InitializerLogging(&config.Config{}))
+
+			actualID, err := s.Create(test.args.ctx, test.args.record)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, test.wantAnomaly, test.args.record.Anomaly)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package odometer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var (
+	ErrInvalidRecord = errors.New("the given odometer record is invalid")
+
+	ErrMissingVehicle = errors.New("the odometer record must belong to a vehicle")
+	ErrInvalidReadAt  = errors.New("the odometer reading date cannot be empty or in the future")
+	ErrNegativeKm     = errors.New("the odometer reading cannot be negative")
+	ErrMissingReason  = errors.New("a correction must state its reason")
+)
+
+// Validate returns every rule broken by the record joined in a single error.
+func (r *Record) Validate() error {
+	var errs []error
+
+	if r.VehicleID <= 0 {
+		errs = append(errs, ErrMissingVehicle)
+	}
+
+	if r.ReadAt.IsZero() || r.ReadAt.After(time.Now()) {
+		errs = append(errs, ErrInvalidReadAt)
+	}
+
+	if r.Km < 0 {
+		errs = append(errs, ErrNegativeKm)
+	}
+
+	if _, err := GetSource(string(r.Source)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if r.Correction && len(strings.TrimSpace(r.Reason)) == 0 {
+		errs = append(errs, ErrMissingReason)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidRecord, errors.Join(errs...))
+	}
+
+	return nil
+}
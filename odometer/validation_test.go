@@ -0,0 +1,48 @@
+package odometer_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestRecord_Validate(t *testing.T) {
+	readAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name     string
+		record   odometer.Record
+		wantErrs []error
+	}{
+		{
+			name:   "Dado uma leitura válida quando a validação é chamada então nenhum erro é retornado",
+			record: odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 1000, Source: odometer.TELEMETRY},
+		},
+		{
+			name:     "Dado uma correção sem motivo quando a validação é chamada então um erro é retornado",
+			record:   odometer.Record{VehicleID: 1, ReadAt: readAt, Km: 900, Source: odometer.MANUAL, Correction: true},
+			wantErrs: []error{odometer.ErrInvalidRecord, odometer.ErrMissingReason},
+		},
+		{
+			name:   "Dado uma leitura vazia no futuro quando a validação é chamada então todas as regras quebradas são retornadas",
+			record: odometer.Record{ReadAt: time.Now().Add(time.Hour), Km: -1, Source: "GPS"},
+			wantErrs: []error{
+				odometer.ErrInvalidRecord, odometer.ErrMissingVehicle, odometer.ErrInvalidReadAt, odometer.ErrNegativeKm, odometer.ErrInvalidSource,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.record.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
@@ -0,0 +1,5 @@
+// Package proto holds the protobuf definitions of the gRPC API. The Go code
+// generated from them lives next to each definition.
+package proto
+
+//go:generate protoc -I . --go_out=.. --go_opt=module=github.com/LucasMateus-eng/operations-service --go-grpc_out=.. --go-grpc_opt=module=github.com/LucasMateus-eng/operations-service operations/v1/address.proto operations/v1/driver.proto operations/v1/driver_vehicle.proto operations/v1/user.proto operations/v1/vehicle.proto
@@ -0,0 +1,516 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: operations/v1/driver.proto
+
+package operationsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DriverService_GetDriver_FullMethodName          = "/operations.v1.DriverService/GetDriver"
+	DriverService_GetDriverByUserID_FullMethodName  = "/operations.v1.DriverService/GetDriverByUserID"
+	DriverService_ListDrivers_FullMethodName        = "/operations.v1.DriverService/ListDrivers"
+	DriverService_ExportDrivers_FullMethodName      = "/operations.v1.DriverService/ExportDrivers"
+	DriverService_CreateDriver_FullMethodName       = "/operations.v1.DriverService/CreateDriver"
+	DriverService_UpdateDriver_FullMethodName       = "/operations.v1.DriverService/UpdateDriver"
+	DriverService_PatchDriver_FullMethodName        = "/operations.v1.DriverService/PatchDriver"
+	DriverService_DeleteDriver_FullMethodName       = "/operations.v1.DriverService/DeleteDriver"
+	DriverService_ListDeletedDrivers_FullMethodName = "/operations.v1.DriverService/ListDeletedDrivers"
+	DriverService_RestoreDriver_FullMethodName      = "/operations.v1.DriverService/RestoreDriver"
+	DriverService_PurgeDriver_FullMethodName        = "/operations.v1.DriverService/PurgeDriver"
+)
+
+// DriverServiceClient is the client API for DriverService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DriverServiceClient interface {
+	GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*Driver, error)
+	GetDriverByUserID(ctx context.Context, in *GetDriverByUserIDRequest, opts ...grpc.CallOption) (*Driver, error)
+	ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error)
+	// ExportDrivers streams every driver of the requested page, or every
+	// driver when no page is given, with its address.
+	ExportDrivers(ctx context.Context, in *ExportDriversRequest, opts ...grpc.CallOption) (DriverService_ExportDriversClient, error)
+	CreateDriver(ctx context.Context, in *CreateDriverRequest, opts ...grpc.CallOption) (*CreateDriverResponse, error)
+	UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error)
+	// PatchDriver writes only the fields in update_mask.
+	PatchDriver(ctx context.Context, in *PatchDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error)
+	DeleteDriver(ctx context.Context, in *DeleteDriverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDeletedDrivers(ctx context.Context, in *ListDeletedDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error)
+	RestoreDriver(ctx context.Context, in *RestoreDriverRequest, opts ...grpc.CallOption) (*Driver, error)
+	// PurgeDriver is restricted to administrators.
+	PurgeDriver(ctx context.Context, in *PurgeDriverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type driverServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDriverServiceClient(cc grpc.ClientConnInterface) DriverServiceClient {
+	return &driverServiceClient{cc}
+}
+
+func (c *driverServiceClient) GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*Driver, error) {
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, DriverService_GetDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriverByUserID(ctx context.Context, in *GetDriverByUserIDRequest, opts ...grpc.CallOption) (*Driver, error) {
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, DriverService_GetDriverByUserID_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error) {
+	out := new(ListDriversResponse)
+	err := c.cc.Invoke(ctx, DriverService_ListDrivers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ExportDrivers(ctx context.Context, in *ExportDriversRequest, opts ...grpc.CallOption) (DriverService_ExportDriversClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DriverService_ServiceDesc.Streams[0], DriverService_ExportDrivers_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &driverServiceExportDriversClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DriverService_ExportDriversClient interface {
+	Recv() (*Driver, error)
+	grpc.ClientStream
+}
+
+type driverServiceExportDriversClient struct {
+	grpc.ClientStream
+}
+
+func (x *driverServiceExportDriversClient) Recv() (*Driver, error) {
+	m := new(Driver)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *driverServiceClient) CreateDriver(ctx context.Context, in *CreateDriverRequest, opts ...grpc.CallOption) (*CreateDriverResponse, error) {
+	out := new(CreateDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_CreateDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error) {
+	out := new(UpdateDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_UpdateDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) PatchDriver(ctx context.Context, in *PatchDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error) {
+	out := new(UpdateDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_PatchDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) DeleteDriver(ctx context.Context, in *DeleteDriverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DriverService_DeleteDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListDeletedDrivers(ctx context.Context, in *ListDeletedDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error) {
+	out := new(ListDriversResponse)
+	err := c.cc.Invoke(ctx, DriverService_ListDeletedDrivers_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) RestoreDriver(ctx context.Context, in *RestoreDriverRequest, opts ...grpc.CallOption) (*Driver, error) {
+	out := new(Driver)
+	err := c.cc.Invoke(ctx, DriverService_RestoreDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) PurgeDriver(ctx context.Context, in *PurgeDriverRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DriverService_PurgeDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DriverServiceServer is the server API for DriverService service.
+// All implementations must embed UnimplementedDriverServiceServer
+// for forward compatibility
+type DriverServiceServer interface {
+	GetDriver(context.Context, *GetDriverRequest) (*Driver, error)
+	GetDriverByUserID(context.Context, *GetDriverByUserIDRequest) (*Driver, error)
+	ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error)
+	// ExportDrivers streams every driver of the requested page, or every
+	// driver when no page is given, with its address.
+	ExportDrivers(*ExportDriversRequest, DriverService_ExportDriversServer) error
+	CreateDriver(context.Context, *CreateDriverRequest) (*CreateDriverResponse, error)
+	UpdateDriver(context.Context, *UpdateDriverRequest) (*UpdateDriverResponse, error)
+	// PatchDriver writes only the fields in update_mask.
+	PatchDriver(context.Context, *PatchDriverRequest) (*UpdateDriverResponse, error)
+	DeleteDriver(context.Context, *DeleteDriverRequest) (*emptypb.Empty, error)
+	ListDeletedDrivers(context.Context, *ListDeletedDriversRequest) (*ListDriversResponse, error)
+	RestoreDriver(context.Context, *RestoreDriverRequest) (*Driver, error)
+	// PurgeDriver is restricted to administrators.
+	PurgeDriver(context.Context, *PurgeDriverRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedDriverServiceServer()
+}
+
+// UnimplementedDriverServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDriverServiceServer struct {
+}
+
+func (UnimplementedDriverServiceServer) GetDriver(context.Context, *GetDriverRequest) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriverByUserID(context.Context, *GetDriverByUserIDRequest) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverByUserID not implemented")
+}
+func (UnimplementedDriverServiceServer) ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) ExportDrivers(*ExportDriversRequest, DriverService_ExportDriversServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) CreateDriver(context.Context, *CreateDriverRequest) (*CreateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) UpdateDriver(context.Context, *UpdateDriverRequest) (*UpdateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) PatchDriver(context.Context, *PatchDriverRequest) (*UpdateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) DeleteDriver(context.Context, *DeleteDriverRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ListDeletedDrivers(context.Context, *ListDeletedDriversRequest) (*ListDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) RestoreDriver(context.Context, *RestoreDriverRequest) (*Driver, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) PurgeDriver(context.Context, *PurgeDriverRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) mustEmbedUnimplementedDriverServiceServer() {}
+
+// UnsafeDriverServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DriverServiceServer will
+// result in compilation errors.
+type UnsafeDriverServiceServer interface {
+	mustEmbedUnimplementedDriverServiceServer()
+}
+
+func RegisterDriverServiceServer(s grpc.ServiceRegistrar, srv DriverServiceServer) {
+	s.RegisterService(&DriverService_ServiceDesc, srv)
+}
+
+func _DriverService_GetDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriver(ctx, req.(*GetDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriverByUserID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverByUserIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverByUserID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriverByUserID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverByUserID(ctx, req.(*GetDriverByUserIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ListDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDrivers(ctx, req.(*ListDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ExportDrivers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDriversRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DriverServiceServer).ExportDrivers(m, &driverServiceExportDriversServer{stream})
+}
+
+type DriverService_ExportDriversServer interface {
+	Send(*Driver) error
+	grpc.ServerStream
+}
+
+type driverServiceExportDriversServer struct {
+	grpc.ServerStream
+}
+
+func (x *driverServiceExportDriversServer) Send(m *Driver) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _DriverService_CreateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).CreateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_CreateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).CreateDriver(ctx, req.(*CreateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UpdateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UpdateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, req.(*UpdateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_PatchDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).PatchDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_PatchDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).PatchDriver(ctx, req.(*PatchDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_DeleteDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).DeleteDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_DeleteDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).DeleteDriver(ctx, req.(*DeleteDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListDeletedDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDeletedDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ListDeletedDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDeletedDrivers(ctx, req.(*ListDeletedDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_RestoreDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).RestoreDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_RestoreDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).RestoreDriver(ctx, req.(*RestoreDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_PurgeDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).PurgeDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_PurgeDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).PurgeDriver(ctx, req.(*PurgeDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DriverService_ServiceDesc is the grpc.ServiceDesc for DriverService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DriverService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "operations.v1.DriverService",
+	HandlerType: (*DriverServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDriver",
+			Handler:    _DriverService_GetDriver_Handler,
+		},
+		{
+			MethodName: "GetDriverByUserID",
+			Handler:    _DriverService_GetDriverByUserID_Handler,
+		},
+		{
+			MethodName: "ListDrivers",
+			Handler:    _DriverService_ListDrivers_Handler,
+		},
+		{
+			MethodName: "CreateDriver",
+			Handler:    _DriverService_CreateDriver_Handler,
+		},
+		{
+			MethodName: "UpdateDriver",
+			Handler:    _DriverService_UpdateDriver_Handler,
+		},
+		{
+			MethodName: "PatchDriver",
+			Handler:    _DriverService_PatchDriver_Handler,
+		},
+		{
+			MethodName: "DeleteDriver",
+			Handler:    _DriverService_DeleteDriver_Handler,
+		},
+		{
+			MethodName: "ListDeletedDrivers",
+			Handler:    _DriverService_ListDeletedDrivers_Handler,
+		},
+		{
+			MethodName: "RestoreDriver",
+			Handler:    _DriverService_RestoreDriver_Handler,
+		},
+		{
+			MethodName: "PurgeDriver",
+			Handler:    _DriverService_PurgeDriver_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportDrivers",
+			Handler:       _DriverService_ExportDrivers_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "operations/v1/driver.proto",
+}
@@ -0,0 +1,258 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: operations/v1/driver_vehicle.proto
+
+package operationsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	DriverVehicleService_GetDriverVehicle_FullMethodName     = "/operations.v1.DriverVehicleService/GetDriverVehicle"
+	DriverVehicleService_ListVehiclesByDriver_FullMethodName = "/operations.v1.DriverVehicleService/ListVehiclesByDriver"
+	DriverVehicleService_ListDriversByVehicle_FullMethodName = "/operations.v1.DriverVehicleService/ListDriversByVehicle"
+	DriverVehicleService_AssignVehicle_FullMethodName        = "/operations.v1.DriverVehicleService/AssignVehicle"
+	DriverVehicleService_UnassignVehicle_FullMethodName      = "/operations.v1.DriverVehicleService/UnassignVehicle"
+)
+
+// DriverVehicleServiceClient is the client API for DriverVehicleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type DriverVehicleServiceClient interface {
+	GetDriverVehicle(ctx context.Context, in *GetDriverVehicleRequest, opts ...grpc.CallOption) (*DriverVehicle, error)
+	ListVehiclesByDriver(ctx context.Context, in *ListVehiclesByDriverRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error)
+	ListDriversByVehicle(ctx context.Context, in *ListDriversByVehicleRequest, opts ...grpc.CallOption) (*ListDriversResponse, error)
+	AssignVehicle(ctx context.Context, in *AssignVehicleRequest, opts ...grpc.CallOption) (*DriverVehicle, error)
+	UnassignVehicle(ctx context.Context, in *UnassignVehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type driverVehicleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDriverVehicleServiceClient(cc grpc.ClientConnInterface) DriverVehicleServiceClient {
+	return &driverVehicleServiceClient{cc}
+}
+
+func (c *driverVehicleServiceClient) GetDriverVehicle(ctx context.Context, in *GetDriverVehicleRequest, opts ...grpc.CallOption) (*DriverVehicle, error) {
+	out := new(DriverVehicle)
+	err := c.cc.Invoke(ctx, DriverVehicleService_GetDriverVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverVehicleServiceClient) ListVehiclesByDriver(ctx context.Context, in *ListVehiclesByDriverRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error) {
+	out := new(ListVehiclesResponse)
+	err := c.cc.Invoke(ctx, DriverVehicleService_ListVehiclesByDriver_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverVehicleServiceClient) ListDriversByVehicle(ctx context.Context, in *ListDriversByVehicleRequest, opts ...grpc.CallOption) (*ListDriversResponse, error) {
+	out := new(ListDriversResponse)
+	err := c.cc.Invoke(ctx, DriverVehicleService_ListDriversByVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverVehicleServiceClient) AssignVehicle(ctx context.Context, in *AssignVehicleRequest, opts ...grpc.CallOption) (*DriverVehicle, error) {
+	out := new(DriverVehicle)
+	err := c.cc.Invoke(ctx, DriverVehicleService_AssignVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverVehicleServiceClient) UnassignVehicle(ctx context.Context, in *UnassignVehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DriverVehicleService_UnassignVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DriverVehicleServiceServer is the server API for DriverVehicleService service.
+// All implementations must embed UnimplementedDriverVehicleServiceServer
+// for forward compatibility
+type DriverVehicleServiceServer interface {
+	GetDriverVehicle(context.Context, *GetDriverVehicleRequest) (*DriverVehicle, error)
+	ListVehiclesByDriver(context.Context, *ListVehiclesByDriverRequest) (*ListVehiclesResponse, error)
+	ListDriversByVehicle(context.Context, *ListDriversByVehicleRequest) (*ListDriversResponse, error)
+	AssignVehicle(context.Context, *AssignVehicleRequest) (*DriverVehicle, error)
+	UnassignVehicle(context.Context, *UnassignVehicleRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedDriverVehicleServiceServer()
+}
+
+// UnimplementedDriverVehicleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedDriverVehicleServiceServer struct {
+}
+
+func (UnimplementedDriverVehicleServiceServer) GetDriverVehicle(context.Context, *GetDriverVehicleRequest) (*DriverVehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverVehicle not implemented")
+}
+func (UnimplementedDriverVehicleServiceServer) ListVehiclesByDriver(context.Context, *ListVehiclesByDriverRequest) (*ListVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVehiclesByDriver not implemented")
+}
+func (UnimplementedDriverVehicleServiceServer) ListDriversByVehicle(context.Context, *ListDriversByVehicleRequest) (*ListDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDriversByVehicle not implemented")
+}
+func (UnimplementedDriverVehicleServiceServer) AssignVehicle(context.Context, *AssignVehicleRequest) (*DriverVehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignVehicle not implemented")
+}
+func (UnimplementedDriverVehicleServiceServer) UnassignVehicle(context.Context, *UnassignVehicleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnassignVehicle not implemented")
+}
+func (UnimplementedDriverVehicleServiceServer) mustEmbedUnimplementedDriverVehicleServiceServer() {}
+
+// UnsafeDriverVehicleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DriverVehicleServiceServer will
+// result in compilation errors.
+type UnsafeDriverVehicleServiceServer interface {
+	mustEmbedUnimplementedDriverVehicleServiceServer()
+}
+
+func RegisterDriverVehicleServiceServer(s grpc.ServiceRegistrar, srv DriverVehicleServiceServer) {
+	s.RegisterService(&DriverVehicleService_ServiceDesc, srv)
+}
+
+func _DriverVehicleService_GetDriverVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverVehicleServiceServer).GetDriverVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverVehicleService_GetDriverVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverVehicleServiceServer).GetDriverVehicle(ctx, req.(*GetDriverVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverVehicleService_ListVehiclesByDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVehiclesByDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverVehicleServiceServer).ListVehiclesByDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverVehicleService_ListVehiclesByDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverVehicleServiceServer).ListVehiclesByDriver(ctx, req.(*ListVehiclesByDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverVehicleService_ListDriversByVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriversByVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverVehicleServiceServer).ListDriversByVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverVehicleService_ListDriversByVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverVehicleServiceServer).ListDriversByVehicle(ctx, req.(*ListDriversByVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverVehicleService_AssignVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverVehicleServiceServer).AssignVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverVehicleService_AssignVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverVehicleServiceServer).AssignVehicle(ctx, req.(*AssignVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverVehicleService_UnassignVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnassignVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverVehicleServiceServer).UnassignVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverVehicleService_UnassignVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverVehicleServiceServer).UnassignVehicle(ctx, req.(*UnassignVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DriverVehicleService_ServiceDesc is the grpc.ServiceDesc for DriverVehicleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DriverVehicleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "operations.v1.DriverVehicleService",
+	HandlerType: (*DriverVehicleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDriverVehicle",
+			Handler:    _DriverVehicleService_GetDriverVehicle_Handler,
+		},
+		{
+			MethodName: "ListVehiclesByDriver",
+			Handler:    _DriverVehicleService_ListVehiclesByDriver_Handler,
+		},
+		{
+			MethodName: "ListDriversByVehicle",
+			Handler:    _DriverVehicleService_ListDriversByVehicle_Handler,
+		},
+		{
+			MethodName: "AssignVehicle",
+			Handler:    _DriverVehicleService_AssignVehicle_Handler,
+		},
+		{
+			MethodName: "UnassignVehicle",
+			Handler:    _DriverVehicleService_UnassignVehicle_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "operations/v1/driver_vehicle.proto",
+}
@@ -0,0 +1,553 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v4.25.3
+// source: operations/v1/vehicle.proto
+
+package operationsv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	VehicleService_GetVehicle_FullMethodName          = "/operations.v1.VehicleService/GetVehicle"
+	VehicleService_GetVehicleByPlate_FullMethodName   = "/operations.v1.VehicleService/GetVehicleByPlate"
+	VehicleService_GetVehicleByRenavam_FullMethodName = "/operations.v1.VehicleService/GetVehicleByRenavam"
+	VehicleService_ListVehicles_FullMethodName        = "/operations.v1.VehicleService/ListVehicles"
+	VehicleService_ExportVehicles_FullMethodName      = "/operations.v1.VehicleService/ExportVehicles"
+	VehicleService_CreateVehicle_FullMethodName       = "/operations.v1.VehicleService/CreateVehicle"
+	VehicleService_UpdateVehicle_FullMethodName       = "/operations.v1.VehicleService/UpdateVehicle"
+	VehicleService_PatchVehicle_FullMethodName        = "/operations.v1.VehicleService/PatchVehicle"
+	VehicleService_DeleteVehicle_FullMethodName       = "/operations.v1.VehicleService/DeleteVehicle"
+	VehicleService_ListDeletedVehicles_FullMethodName = "/operations.v1.VehicleService/ListDeletedVehicles"
+	VehicleService_RestoreVehicle_FullMethodName      = "/operations.v1.VehicleService/RestoreVehicle"
+	VehicleService_PurgeVehicle_FullMethodName        = "/operations.v1.VehicleService/PurgeVehicle"
+)
+
+// VehicleServiceClient is the client API for VehicleService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type VehicleServiceClient interface {
+	GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	GetVehicleByPlate(ctx context.Context, in *GetVehicleByPlateRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	GetVehicleByRenavam(ctx context.Context, in *GetVehicleByRenavamRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error)
+	// ExportVehicles streams every vehicle matching the request, without
+	// loading them all in memory.
+	ExportVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (VehicleService_ExportVehiclesClient, error)
+	CreateVehicle(ctx context.Context, in *CreateVehicleRequest, opts ...grpc.CallOption) (*CreateVehicleResponse, error)
+	UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error)
+	// PatchVehicle writes only the fields in update_mask.
+	PatchVehicle(ctx context.Context, in *PatchVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error)
+	DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDeletedVehicles(ctx context.Context, in *ListDeletedVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error)
+	RestoreVehicle(ctx context.Context, in *RestoreVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error)
+	// PurgeVehicle is restricted to administrators.
+	PurgeVehicle(ctx context.Context, in *PurgeVehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type vehicleServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewVehicleServiceClient(cc grpc.ClientConnInterface) VehicleServiceClient {
+	return &vehicleServiceClient{cc}
+}
+
+func (c *vehicleServiceClient) GetVehicle(ctx context.Context, in *GetVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_GetVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) GetVehicleByPlate(ctx context.Context, in *GetVehicleByPlateRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_GetVehicleByPlate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) GetVehicleByRenavam(ctx context.Context, in *GetVehicleByRenavamRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_GetVehicleByRenavam_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ListVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error) {
+	out := new(ListVehiclesResponse)
+	err := c.cc.Invoke(ctx, VehicleService_ListVehicles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ExportVehicles(ctx context.Context, in *ListVehiclesRequest, opts ...grpc.CallOption) (VehicleService_ExportVehiclesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &VehicleService_ServiceDesc.Streams[0], VehicleService_ExportVehicles_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &vehicleServiceExportVehiclesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type VehicleService_ExportVehiclesClient interface {
+	Recv() (*Vehicle, error)
+	grpc.ClientStream
+}
+
+type vehicleServiceExportVehiclesClient struct {
+	grpc.ClientStream
+}
+
+func (x *vehicleServiceExportVehiclesClient) Recv() (*Vehicle, error) {
+	m := new(Vehicle)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *vehicleServiceClient) CreateVehicle(ctx context.Context, in *CreateVehicleRequest, opts ...grpc.CallOption) (*CreateVehicleResponse, error) {
+	out := new(CreateVehicleResponse)
+	err := c.cc.Invoke(ctx, VehicleService_CreateVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) UpdateVehicle(ctx context.Context, in *UpdateVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error) {
+	out := new(UpdateVehicleResponse)
+	err := c.cc.Invoke(ctx, VehicleService_UpdateVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) PatchVehicle(ctx context.Context, in *PatchVehicleRequest, opts ...grpc.CallOption) (*UpdateVehicleResponse, error) {
+	out := new(UpdateVehicleResponse)
+	err := c.cc.Invoke(ctx, VehicleService_PatchVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) DeleteVehicle(ctx context.Context, in *DeleteVehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VehicleService_DeleteVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) ListDeletedVehicles(ctx context.Context, in *ListDeletedVehiclesRequest, opts ...grpc.CallOption) (*ListVehiclesResponse, error) {
+	out := new(ListVehiclesResponse)
+	err := c.cc.Invoke(ctx, VehicleService_ListDeletedVehicles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) RestoreVehicle(ctx context.Context, in *RestoreVehicleRequest, opts ...grpc.CallOption) (*Vehicle, error) {
+	out := new(Vehicle)
+	err := c.cc.Invoke(ctx, VehicleService_RestoreVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *vehicleServiceClient) PurgeVehicle(ctx context.Context, in *PurgeVehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, VehicleService_PurgeVehicle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// VehicleServiceServer is the server API for VehicleService service.
+// All implementations must embed UnimplementedVehicleServiceServer
+// for forward compatibility
+type VehicleServiceServer interface {
+	GetVehicle(context.Context, *GetVehicleRequest) (*Vehicle, error)
+	GetVehicleByPlate(context.Context, *GetVehicleByPlateRequest) (*Vehicle, error)
+	GetVehicleByRenavam(context.Context, *GetVehicleByRenavamRequest) (*Vehicle, error)
+	ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error)
+	// ExportVehicles streams every vehicle matching the request, without
+	// loading them all in memory.
+	ExportVehicles(*ListVehiclesRequest, VehicleService_ExportVehiclesServer) error
+	CreateVehicle(context.Context, *CreateVehicleRequest) (*CreateVehicleResponse, error)
+	UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error)
+	// PatchVehicle writes only the fields in update_mask.
+	PatchVehicle(context.Context, *PatchVehicleRequest) (*UpdateVehicleResponse, error)
+	DeleteVehicle(context.Context, *DeleteVehicleRequest) (*emptypb.Empty, error)
+	ListDeletedVehicles(context.Context, *ListDeletedVehiclesRequest) (*ListVehiclesResponse, error)
+	RestoreVehicle(context.Context, *RestoreVehicleRequest) (*Vehicle, error)
+	// PurgeVehicle is restricted to administrators.
+	PurgeVehicle(context.Context, *PurgeVehicleRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedVehicleServiceServer()
+}
+
+// UnimplementedVehicleServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedVehicleServiceServer struct {
+}
+
+func (UnimplementedVehicleServiceServer) GetVehicle(context.Context, *GetVehicleRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) GetVehicleByPlate(context.Context, *GetVehicleByPlateRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicleByPlate not implemented")
+}
+func (UnimplementedVehicleServiceServer) GetVehicleByRenavam(context.Context, *GetVehicleByRenavamRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicleByRenavam not implemented")
+}
+func (UnimplementedVehicleServiceServer) ListVehicles(context.Context, *ListVehiclesRequest) (*ListVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) ExportVehicles(*ListVehiclesRequest, VehicleService_ExportVehiclesServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) CreateVehicle(context.Context, *CreateVehicleRequest) (*CreateVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) UpdateVehicle(context.Context, *UpdateVehicleRequest) (*UpdateVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) PatchVehicle(context.Context, *PatchVehicleRequest) (*UpdateVehicleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PatchVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) DeleteVehicle(context.Context, *DeleteVehicleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) ListDeletedVehicles(context.Context, *ListDeletedVehiclesRequest) (*ListVehiclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeletedVehicles not implemented")
+}
+func (UnimplementedVehicleServiceServer) RestoreVehicle(context.Context, *RestoreVehicleRequest) (*Vehicle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) PurgeVehicle(context.Context, *PurgeVehicleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurgeVehicle not implemented")
+}
+func (UnimplementedVehicleServiceServer) mustEmbedUnimplementedVehicleServiceServer() {}
+
+// UnsafeVehicleServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to VehicleServiceServer will
+// result in compilation errors.
+type UnsafeVehicleServiceServer interface {
+	mustEmbedUnimplementedVehicleServiceServer()
+}
+
+func RegisterVehicleServiceServer(s grpc.ServiceRegistrar, srv VehicleServiceServer) {
+	s.RegisterService(&VehicleService_ServiceDesc, srv)
+}
+
+func _VehicleService_GetVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_GetVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetVehicle(ctx, req.(*GetVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_GetVehicleByPlate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVehicleByPlateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetVehicleByPlate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_GetVehicleByPlate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetVehicleByPlate(ctx, req.(*GetVehicleByPlateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_GetVehicleByRenavam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVehicleByRenavamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).GetVehicleByRenavam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_GetVehicleByRenavam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).GetVehicleByRenavam(ctx, req.(*GetVehicleByRenavamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ListVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_ListVehicles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListVehicles(ctx, req.(*ListVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ExportVehicles_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListVehiclesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(VehicleServiceServer).ExportVehicles(m, &vehicleServiceExportVehiclesServer{stream})
+}
+
+type VehicleService_ExportVehiclesServer interface {
+	Send(*Vehicle) error
+	grpc.ServerStream
+}
+
+type vehicleServiceExportVehiclesServer struct {
+	grpc.ServerStream
+}
+
+func (x *vehicleServiceExportVehiclesServer) Send(m *Vehicle) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _VehicleService_CreateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).CreateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_CreateVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).CreateVehicle(ctx, req.(*CreateVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_UpdateVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).UpdateVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_UpdateVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).UpdateVehicle(ctx, req.(*UpdateVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_PatchVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PatchVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).PatchVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_PatchVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).PatchVehicle(ctx, req.(*PatchVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_DeleteVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).DeleteVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_DeleteVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).DeleteVehicle(ctx, req.(*DeleteVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_ListDeletedVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeletedVehiclesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).ListDeletedVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_ListDeletedVehicles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).ListDeletedVehicles(ctx, req.(*ListDeletedVehiclesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_RestoreVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).RestoreVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_RestoreVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).RestoreVehicle(ctx, req.(*RestoreVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _VehicleService_PurgeVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(VehicleServiceServer).PurgeVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: VehicleService_PurgeVehicle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(VehicleServiceServer).PurgeVehicle(ctx, req.(*PurgeVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// VehicleService_ServiceDesc is the grpc.ServiceDesc for VehicleService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var VehicleService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "operations.v1.VehicleService",
+	HandlerType: (*VehicleServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVehicle",
+			Handler:    _VehicleService_GetVehicle_Handler,
+		},
+		{
+			MethodName: "GetVehicleByPlate",
+			Handler:    _VehicleService_GetVehicleByPlate_Handler,
+		},
+		{
+			MethodName: "GetVehicleByRenavam",
+			Handler:    _VehicleService_GetVehicleByRenavam_Handler,
+		},
+		{
+			MethodName: "ListVehicles",
+			Handler:    _VehicleService_ListVehicles_Handler,
+		},
+		{
+			MethodName: "CreateVehicle",
+			Handler:    _VehicleService_CreateVehicle_Handler,
+		},
+		{
+			MethodName: "UpdateVehicle",
+			Handler:    _VehicleService_UpdateVehicle_Handler,
+		},
+		{
+			MethodName: "PatchVehicle",
+			Handler:    _VehicleService_PatchVehicle_Handler,
+		},
+		{
+			MethodName: "DeleteVehicle",
+			Handler:    _VehicleService_DeleteVehicle_Handler,
+		},
+		{
+			MethodName: "ListDeletedVehicles",
+			Handler:    _VehicleService_ListDeletedVehicles_Handler,
+		},
+		{
+			MethodName: "RestoreVehicle",
+			Handler:    _VehicleService_RestoreVehicle_Handler,
+		},
+		{
+			MethodName: "PurgeVehicle",
+			Handler:    _VehicleService_PurgeVehicle_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportVehicles",
+			Handler:       _VehicleService_ExportVehicles_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "operations/v1/vehicle.proto",
+}
@@ -0,0 +1,199 @@
+package shift
+
+import "time"
+
+// Limits of the working hours of professional drivers set by Lei 13.103,
+// in the CLT (art. 235-C) and in the CTB (art. 67-C).
+const (
+	// MAXIMUM_CONTINUOUS_DRIVING is the longest a driver may drive before
+	// resting MINIMUM_DRIVING_BREAK, which may be split in shorter rests.
+	MAXIMUM_CONTINUOUS_DRIVING = 5*time.Hour + 30*time.Minute
+	MINIMUM_DRIVING_BREAK      = 30 * time.Minute
+	// MINIMUM_REST_BETWEEN_JOURNEYS is the rest between a clock out and the
+	// next clock in. Splitting it, which the law allows under conditions,
+	// is not considered.
+	MINIMUM_REST_BETWEEN_JOURNEYS = 11 * time.Hour
+	// MAXIMUM_JOURNEY is the 8 hours of a journey plus the 2 hours of
+	// overtime, not counting the rests.
+	MAXIMUM_JOURNEY = 10 * time.Hour
+
+	// REPORT_LOOKBACK is how long before a report the shift that came before
+	// its first one is looked for, to check the rest between them.
+	REPORT_LOOKBACK = 24 * time.Hour
+	// DEFAULT_REPORT_PERIOD is the period of a report without a start.
+	DEFAULT_REPORT_PERIOD = 7 * 24 * time.Hour
+)
+
+type Rule string
+
+const (
+	CONTINUOUS_DRIVING    Rule = "CONTINUOUS_DRIVING"
+	REST_BETWEEN_JOURNEYS Rule = "REST_BETWEEN_JOURNEYS"
+	JOURNEY_LENGTH        Rule = "JOURNEY_LENGTH"
+)
+
+// Violation is a limit broken during a shift. At is when it was broken,
+// Actual how far the shift went and Limit the limit itself.
+type Violation struct {
+	Rule    Rule
+	ShiftID int64
+	At      time.Time
+	Actual  time.Duration
+	Limit   time.Duration
+}
+
+// Report sums up the shifts of a driver clocked in over a period, along
+// with the limits they broke.
+type Report struct {
+	DriverID   int64
+	From, To   time.Time
+	Shifts     int
+	Journey    time.Duration
+	Driving    time.Duration
+	Rest       time.Duration
+	Violations []Violation
+}
+
+// rule checks a shift against a limit, given the shift worked before it,
+// which is nil for the first one.
+type rule func(previous, s *Shift, now time.Time) []Violation
+
+var rules = []rule{continuousDriving, restBetweenJourneys, journeyLength}
+
+// Evaluate checks every rule on the worked shifts of a driver, sorted by
+// their clock in, with the open one evaluated up to now.
+func Evaluate(shifts []Shift, now time.Time) []Violation {
+	violations := []Violation{}
+
+	var previous *Shift
+	for i := range shifts {
+		for _, r := range rules {
+			violations = append(violations, r(previous, &shifts[i], now)...)
+		}
+
+		previous = &shifts[i]
+	}
+
+	return violations
+}
+
+// NewReport sums up the worked shifts clocked in from from, sorted by their
+// clock in. Earlier shifts only take part in the rules of the next ones.
+func NewReport(driverID int64, from, to time.Time, shifts []Shift, now time.Time) *Report {
+	report := &Report{DriverID: driverID, From: from, To: to, Violations: []Violation{}}
+
+	reported := make(map[int64]bool, len(shifts))
+	for _, s := range shifts {
+		if s.ClockInAt.Before(from) {
+			continue
+		}
+
+		reported[s.ID] = true
+		report.Shifts++
+		report.Journey += s.Journey(now)
+		report.Driving += s.Time(DRIVING, now)
+		report.Rest += s.Time(REST, now)
+	}
+
+	for _, v := range Evaluate(shifts, now) {
+		if reported[v.ShiftID] {
+			report.Violations = append(report.Violations, v)
+		}
+	}
+
+	return report
+}
+
+// continuousDriving adds up the driving until the rests in between reach
+// MINIMUM_DRIVING_BREAK, flagging each stretch that goes past the limit.
+func continuousDriving(_, s *Shift, now time.Time) []Violation {
+	var violations []Violation
+	var driving, rest time.Duration
+	flagged := -1
+
+	for _, p := range s.Periods {
+		switch p.Activity {
+		case REST:
+			rest += p.Duration(now)
+			if rest >= MINIMUM_DRIVING_BREAK {
+				if flagged >= 0 {
+					violations[flagged].Actual = driving
+				}
+
+				driving, rest, flagged = 0, 0, -1
+			}
+		case DRIVING:
+			d := p.Duration(now)
+			if flagged < 0 && driving+d > MAXIMUM_CONTINUOUS_DRIVING {
+				violations = append(violations, Violation{
+					Rule:    CONTINUOUS_DRIVING,
+					ShiftID: s.ID,
+					At:      p.StartedAt.Add(MAXIMUM_CONTINUOUS_DRIVING - driving),
+					Limit:   MAXIMUM_CONTINUOUS_DRIVING,
+				})
+				flagged = len(violations) - 1
+			}
+
+			driving += d
+		}
+	}
+
+	if flagged >= 0 {
+		violations[flagged].Actual = driving
+	}
+
+	return violations
+}
+
+func restBetweenJourneys(previous, s *Shift, _ time.Time) []Violation {
+	if previous == nil || previous.ClockOutAt.IsZero() {
+		return nil
+	}
+
+	rest := s.ClockInAt.Sub(previous.ClockOutAt)
+	if rest >= MINIMUM_REST_BETWEEN_JOURNEYS {
+		return nil
+	}
+
+	return []Violation{{
+		Rule:    REST_BETWEEN_JOURNEYS,
+		ShiftID: s.ID,
+		At:      s.ClockInAt,
+		Actual:  rest,
+		Limit:   MINIMUM_REST_BETWEEN_JOURNEYS,
+	}}
+}
+
+// journeyLength flags the shift whose time clocked in, less its rests, goes
+// past MAXIMUM_JOURNEY.
+func journeyLength(_, s *Shift, now time.Time) []Violation {
+	worked := s.Journey(now) - s.Time(REST, now)
+	if worked <= MAXIMUM_JOURNEY {
+		return nil
+	}
+
+	// The limit is reached once the time worked between the rests adds up
+	// to it.
+	at, left := s.ClockInAt, MAXIMUM_JOURNEY
+	for _, p := range s.Periods {
+		if p.Activity != REST {
+			continue
+		}
+
+		if before := p.StartedAt.Sub(at); before < left {
+			left -= before
+			at = p.StartedAt.Add(p.Duration(now))
+			continue
+		}
+
+		break
+	}
+
+	return []Violation{{
+		Rule:    JOURNEY_LENGTH,
+		ShiftID: s.ID,
+		At:      at.Add(left),
+		Actual:  worked,
+		Limit:   MAXIMUM_JOURNEY,
+	}}
+}
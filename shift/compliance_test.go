@@ -0,0 +1,125 @@
+package shift_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/shift"
+	"github.com/go-playground/assert/v2"
+)
+
+var clockInAt = time.Date(2026, 10, 5, 6, 0, 0, 0, time.UTC)
+
+// worked builds a closed shift clocked in at start whose periods follow one
+// another, each lasting its duration.
+func worked(id int64, start time.Time, periods ...any) shift.Shift {
+	s := shift.Shift{ID: id, DriverID: 1, ClockInAt: start, Status: shift.CLOSED}
+
+	at := start
+	for i := 0; i < len(periods); i += 2 {
+		d := periods[i+1].(time.Duration)
+		s.Periods = append(s.Periods, shift.Period{ShiftID: id, Activity: periods[i].(shift.Activity), StartedAt: at, EndedAt: at.Add(d)})
+		at = at.Add(d)
+	}
+	s.ClockOutAt = at
+
+	return s
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		name   string
+		shifts []shift.Shift
+		want   []shift.Violation
+	}{
+		{
+			name:   "Dado uma jornada dentro dos limites quando as regras são avaliadas então nenhuma violação é retornada",
+			shifts: []shift.Shift{worked(1, clockInAt, shift.DRIVING, 4*time.Hour, shift.REST, time.Hour, shift.DRIVING, 4*time.Hour)},
+			want:   []shift.Violation{},
+		},
+		{
+			name:   "Dado um descanso fracionado de trinta minutos quando as regras são avaliadas então a direção contínua é reiniciada",
+			shifts: []shift.Shift{worked(1, clockInAt, shift.DRIVING, 3*time.Hour, shift.REST, 15*time.Minute, shift.DRIVING, 2*time.Hour, shift.REST, 15*time.Minute, shift.DRIVING, 3*time.Hour)},
+			want:   []shift.Violation{},
+		},
+		{
+			name:   "Dado mais de cinco horas e meia ao volante com um descanso curto quando as regras são avaliadas então a direção contínua é violada",
+			shifts: []shift.Shift{worked(1, clockInAt, shift.DRIVING, 5*time.Hour, shift.REST, 10*time.Minute, shift.DRIVING, time.Hour)},
+			want: []shift.Violation{{
+				Rule:    shift.CONTINUOUS_DRIVING,
+				ShiftID: 1,
+				At:      clockInAt.Add(5*time.Hour + 40*time.Minute),
+				Actual:  6 * time.Hour,
+				Limit:   shift.MAXIMUM_CONTINUOUS_DRIVING,
+			}},
+		},
+		{
+			name: "Dado menos de onze horas entre as jornadas quando as regras são avaliadas então o descanso entre jornadas é violado",
+			shifts: []shift.Shift{
+				worked(1, clockInAt, shift.DRIVING, 4*time.Hour),
+				worked(2, clockInAt.Add(13*time.Hour), shift.DRIVING, 4*time.Hour),
+			},
+			want: []shift.Violation{{
+				Rule:    shift.REST_BETWEEN_JOURNEYS,
+				ShiftID: 2,
+				At:      clockInAt.Add(13 * time.Hour),
+				Actual:  9 * time.Hour,
+				Limit:   shift.MINIMUM_REST_BETWEEN_JOURNEYS,
+			}},
+		},
+		{
+			name:   "Dado mais de dez horas trabalhadas fora os descansos quando as regras são avaliadas então a jornada é violada",
+			shifts: []shift.Shift{worked(1, clockInAt, shift.WORK, 4*time.Hour, shift.REST, time.Hour, shift.DRIVING, 5*time.Hour, shift.WORK, 2*time.Hour)},
+			want: []shift.Violation{{
+				Rule:    shift.JOURNEY_LENGTH,
+				ShiftID: 1,
+				At:      clockInAt.Add(11 * time.Hour),
+				Actual:  11 * time.Hour,
+				Limit:   shift.MAXIMUM_JOURNEY,
+			}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, shift.Evaluate(test.shifts, clockInAt.Add(48*time.Hour)))
+		})
+	}
+}
+
+func TestEvaluate_OpenShift(t *testing.T) {
+	open := shift.Shift{
+		ID:        1,
+		ClockInAt: clockInAt,
+		Status:    shift.OPEN,
+		Periods:   []shift.Period{{Activity: shift.DRIVING, StartedAt: clockInAt}},
+	}
+
+	violations := shift.Evaluate([]shift.Shift{open}, clockInAt.Add(6*time.Hour))
+
+	assert.Equal(t, 1, len(violations))
+	assert.Equal(t, shift.CONTINUOUS_DRIVING, violations[0].Rule)
+	assert.Equal(t, 6*time.Hour, violations[0].Actual)
+}
+
+func TestNewReport(t *testing.T) {
+	from := clockInAt.Add(12 * time.Hour)
+	shifts := []shift.Shift{
+		worked(1, clockInAt, shift.DRIVING, 4*time.Hour, shift.REST, time.Hour, shift.DRIVING, 4*time.Hour),
+		worked(2, clockInAt.Add(18*time.Hour), shift.DRIVING, 3*time.Hour, shift.REST, 30*time.Minute, shift.WORK, time.Hour),
+	}
+
+	report := shift.NewReport(1, from, from.Add(24*time.Hour), shifts, from.Add(48*time.Hour))
+
+	assert.Equal(t, 1, report.Shifts)
+	assert.Equal(t, 4*time.Hour+30*time.Minute, report.Journey)
+	assert.Equal(t, 3*time.Hour, report.Driving)
+	assert.Equal(t, 30*time.Minute, report.Rest)
+	assert.Equal(t, []shift.Violation{{
+		Rule:    shift.REST_BETWEEN_JOURNEYS,
+		ShiftID: 2,
+		At:      clockInAt.Add(18 * time.Hour),
+		Actual:  9 * time.Hour,
+		Limit:   shift.MINIMUM_REST_BETWEEN_JOURNEYS,
+	}}, report.Violations)
+}
@@ -0,0 +1,32 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+type ShiftDTO struct {
+	bun.BaseModel `bun:"table:shifts"`
+
+	ID             int64       `bun:"id,pk,autoincrement"`
+	DriverID       int64       `bun:"driver_id,notnull"`
+	PlannedStartAt time.Time   `bun:"planned_start_at,notnull"`
+	PlannedEndAt   time.Time   `bun:"planned_end_at,notnull"`
+	ClockInAt      time.Time   `bun:"clock_in_at,nullzero"`
+	ClockOutAt     time.Time   `bun:"clock_out_at,nullzero"`
+	Status         string      `bun:"status,notnull"`
+	Periods        []PeriodDTO `bun:"rel:has-many,join:id=shift_id"`
+	CreatedAt      time.Time   `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time   `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
+
+type PeriodDTO struct {
+	bun.BaseModel `bun:"table:shift_periods"`
+
+	ID        int64     `bun:"id,pk,autoincrement"`
+	ShiftID   int64     `bun:"shift_id,notnull"`
+	Activity  string    `bun:"activity,notnull"`
+	StartedAt time.Time `bun:"started_at,notnull"`
+	EndedAt   time.Time `bun:"ended_at,nullzero"`
+}
@@ -0,0 +1,66 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/shift"
+	"github.com/LucasMateus-eng/operations-service/shift/postgres/dto"
+)
+
+func MapShiftToDTO(s *shift.Shift) *dto.ShiftDTO {
+	periodDTOs := make([]dto.PeriodDTO, 0, len(s.Periods))
+	for _, p := range s.Periods {
+		periodDTOs = append(periodDTOs, *MapPeriodToDTO(&p))
+	}
+
+	return &dto.ShiftDTO{
+		ID:             s.ID,
+		DriverID:       s.DriverID,
+		PlannedStartAt: s.PlannedStartAt,
+		PlannedEndAt:   s.PlannedEndAt,
+		ClockInAt:      s.ClockInAt,
+		ClockOutAt:     s.ClockOutAt,
+		Status:         string(s.Status),
+		Periods:        periodDTOs,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+}
+
+func MapDTOToShift(shiftDTO *dto.ShiftDTO) *shift.Shift {
+	periods := make([]shift.Period, 0, len(shiftDTO.Periods))
+	for _, p := range shiftDTO.Periods {
+		periods = append(periods, *MapDTOToPeriod(&p))
+	}
+
+	return &shift.Shift{
+		ID:             shiftDTO.ID,
+		DriverID:       shiftDTO.DriverID,
+		PlannedStartAt: shiftDTO.PlannedStartAt,
+		PlannedEndAt:   shiftDTO.PlannedEndAt,
+		ClockInAt:      shiftDTO.ClockInAt,
+		ClockOutAt:     shiftDTO.ClockOutAt,
+		Status:         shift.Status(shiftDTO.Status),
+		Periods:        periods,
+		CreatedAt:      shiftDTO.CreatedAt,
+		UpdatedAt:      shiftDTO.UpdatedAt,
+	}
+}
+
+func MapPeriodToDTO(p *shift.Period) *dto.PeriodDTO {
+	return &dto.PeriodDTO{
+		ID:        p.ID,
+		ShiftID:   p.ShiftID,
+		Activity:  string(p.Activity),
+		StartedAt: p.StartedAt,
+		EndedAt:   p.EndedAt,
+	}
+}
+
+func MapDTOToPeriod(periodDTO *dto.PeriodDTO) *shift.Period {
+	return &shift.Period{
+		ID:        periodDTO.ID,
+		ShiftID:   periodDTO.ShiftID,
+		Activity:  shift.Activity(periodDTO.Activity),
+		StartedAt: periodDTO.StartedAt,
+		EndedAt:   periodDTO.EndedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/shift"
+	shift_dto "github.com/LucasMateus-eng/operations-service/shift/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapShiftToDTO(t *testing.T) {
+	s := &shift.Shift{
+		ID:             1,
+		DriverID:       2,
+		PlannedStartAt: mockedTime,
+		PlannedEndAt:   mockedTime,
+		ClockInAt:      mockedTime,
+		ClockOutAt:     mockedTime,
+		Status:         shift.CLOSED,
+		Periods: []shift.Period{
+			{ID: 3, ShiftID: 1, Activity: shift.DRIVING, StartedAt: mockedTime, EndedAt: mockedTime},
+			{ID: 4, ShiftID: 1, Activity: shift.REST, StartedAt: mockedTime, EndedAt: mockedTime},
+		},
+		CreatedAt: mockedTime,
+		UpdatedAt: mockedTime,
+	}
+
+	expectedDTO := &shift_dto.ShiftDTO{
+		ID:             1,
+		DriverID:       2,
+		PlannedStartAt: mockedTime,
+		PlannedEndAt:   mockedTime,
+		ClockInAt:      mockedTime,
+		ClockOutAt:     mockedTime,
+		Status:         "CLOSED",
+		Periods: []shift_dto.PeriodDTO{
+			{ID: 3, ShiftID: 1, Activity: "DRIVING", StartedAt: mockedTime, EndedAt: mockedTime},
+			{ID: 4, ShiftID: 1, Activity: "REST", StartedAt: mockedTime, EndedAt: mockedTime},
+		},
+		CreatedAt: mockedTime,
+		UpdatedAt: mockedTime,
+	}
+
+	actualDTO := MapShiftToDTO(s)
+	assert.Equal(t, expectedDTO, actualDTO)
+	assert.Equal(t, s, MapDTOToShift(actualDTO))
+}
@@ -0,0 +1,187 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/shift"
+	"github.com/LucasMateus-eng/operations-service/shift/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/shift/postgres/mapping"
+	"github.com/uptrace/bun"
+)
+
+type shiftPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *shiftPostgresRepo {
+	return &shiftPostgresRepo{
+		db: db,
+	}
+}
+
+func (sr *shiftPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, sr.db)
+}
+
+// periods loads the periods of the shifts in the order they happened.
+func periods(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.Order("started_at ASC", "id ASC")
+}
+
+func (sr *shiftPostgresRepo) GetByID(ctx context.Context, id int64) (*shift.Shift, error) {
+	shiftDTO := new(dto.ShiftDTO)
+
+	err := sr.conn(ctx).NewSelect().
+		Model(shiftDTO).
+		Relation("Periods", periods).
+		Where("shift_dto.id = ?", id).
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToShift(shiftDTO), nil
+}
+
+func (sr *shiftPostgresRepo) List(ctx context.Context, specification *shift.ShiftSpecification) (*[]shift.Shift, error) {
+	var shiftDTOs []dto.ShiftDTO
+
+	query := sr.conn(ctx).NewSelect().
+		Model(&shiftDTOs).
+		Relation("Periods", periods).
+		Order("planned_start_at DESC", "id DESC")
+
+	if specification.DriverID != 0 {
+		query = query.Where("driver_id = ?", specification.DriverID)
+	}
+
+	if specification.Status != "" {
+		query = query.Where("status = ?", specification.Status)
+	}
+
+	if !specification.From.IsZero() {
+		query = query.Where("planned_start_at >= ?", specification.From)
+	}
+
+	if !specification.To.IsZero() {
+		query = query.Where("planned_start_at < ?", specification.To)
+	}
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		query = query.Offset((specification.Page - 1) * specification.PageSize).Limit(specification.PageSize)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapShifts(shiftDTOs), nil
+}
+
+func (sr *shiftPostgresRepo) ListWorked(ctx context.Context, driverID int64, from, to time.Time) (*[]shift.Shift, error) {
+	var shiftDTOs []dto.ShiftDTO
+
+	err := sr.conn(ctx).NewSelect().
+		Model(&shiftDTOs).
+		Relation("Periods", periods).
+		Where("driver_id = ?", driverID).
+		Where("clock_in_at >= ?", from).
+		Where("clock_in_at < ?", to).
+		Order("clock_in_at ASC", "id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapShifts(shiftDTOs), nil
+}
+
+func (sr *shiftPostgresRepo) Create(ctx context.Context, s *shift.Shift) (int64, error) {
+	shiftDTO := mapping.MapShiftToDTO(s)
+
+	_, err := sr.conn(ctx).NewInsert().Model(shiftDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return shiftDTO.ID, nil
+}
+
+// Update relies on the partial unique index of the open shifts to keep a
+// driver from being clocked in to two shifts at once.
+func (sr *shiftPostgresRepo) Update(ctx context.Context, s *shift.Shift, from shift.Status) error {
+	shiftDTO := mapping.MapShiftToDTO(s)
+
+	res, err := sr.conn(ctx).NewUpdate().
+		Model(shiftDTO).
+		Column("status", "clock_in_at", "clock_out_at", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Where("status = ?", from).
+		Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return shift.ErrShiftOpen
+		}
+
+		return err
+	}
+
+	return checkMoved(res)
+}
+
+// StartPeriod relies on the partial unique index of the current periods to
+// keep a shift from having two activities at once.
+func (sr *shiftPostgresRepo) StartPeriod(ctx context.Context, p *shift.Period) (int64, error) {
+	periodDTO := mapping.MapPeriodToDTO(p)
+
+	_, err := sr.conn(ctx).NewInsert().Model(periodDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return 0, shift.ErrActivityChanged
+		}
+
+		return 0, err
+	}
+
+	return periodDTO.ID, nil
+}
+
+func (sr *shiftPostgresRepo) EndPeriod(ctx context.Context, shiftID int64, at time.Time) error {
+	_, err := sr.conn(ctx).NewUpdate().
+		Model((*dto.PeriodDTO)(nil)).
+		Set("ended_at = ?", at).
+		Where("shift_id = ?", shiftID).
+		Where("ended_at IS NULL").
+		Exec(ctx)
+
+	return err
+}
+
+func mapShifts(shiftDTOs []dto.ShiftDTO) *[]shift.Shift {
+	shifts := make([]shift.Shift, 0, len(shiftDTOs))
+	for _, dto := range shiftDTOs {
+		shifts = append(shifts, *mapping.MapDTOToShift(&dto))
+	}
+
+	return &shifts
+}
+
+// checkMoved tells a shift that moved to another status since it was read
+// apart from a successful update.
+func checkMoved(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return shift.ErrInvalidTransition
+	}
+
+	return nil
+}
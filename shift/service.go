@@ -0,0 +1,309 @@
+package shift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/user"
+)
+
+type Service struct {
+	repo    Repository
+	auditor audit.Recorder
+	drivers DriverReading
+	logger  *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, dr DriverReading, l *logging.Logging) *Service {
+	return &Service{
+		repo:    r,
+		auditor: au,
+		drivers: dr,
+		logger:  l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Shift, error) {
+	s.logger.Debug("[SHIFT] GetByID - DEBUG: ", map[string]any{
+		"shiftID": id,
+	})
+	shift, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[SHIFT] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *ShiftSpecification) (*[]Shift, error) {
+	s.logger.Debug("[SHIFT] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	shifts, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[SHIFT] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return shifts, nil
+}
+
+// Create schedules the shift.
+func (s *Service) Create(ctx context.Context, sh *Shift) (int64, error) {
+	s.logger.Debug("[SHIFT] Create - DEBUG: ", map[string]any{
+		"shift": sh,
+	})
+	if err := sh.Validate(); err != nil {
+		return 0, err
+	}
+
+	sh.Status = SCHEDULED
+
+	var shiftID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		shiftID, err = s.repo.Create(ctx, sh)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.SHIFT, shiftID, audit.CREATE, nil, sh)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[SHIFT] Create - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return shiftID, nil
+}
+
+// ClockIn opens the shift. A driver is clocked in to one shift at a time.
+func (s *Service) ClockIn(ctx context.Context, id int64) (*Shift, error) {
+	s.logger.Debug("[SHIFT] ClockIn - DEBUG: ", map[string]any{
+		"shiftID": id,
+	})
+
+	shift, err := s.move(ctx, id, OPEN, func(ctx context.Context, sh *Shift) error {
+		sh.ClockInAt = time.Now()
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("[SHIFT] ClockIn - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// ClockOut closes the shift, ending its current activity.
+func (s *Service) ClockOut(ctx context.Context, id int64) (*Shift, error) {
+	s.logger.Debug("[SHIFT] ClockOut - DEBUG: ", map[string]any{
+		"shiftID": id,
+	})
+
+	shift, err := s.move(ctx, id, CLOSED, func(ctx context.Context, sh *Shift) error {
+		sh.ClockOutAt = time.Now()
+		endPeriod(sh, sh.ClockOutAt)
+
+		return s.repo.EndPeriod(ctx, sh.ID, sh.ClockOutAt)
+	})
+	if err != nil {
+		s.logger.Error("[SHIFT] ClockOut - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return shift, nil
+}
+
+// Switch ends the current activity of an open shift and starts activity.
+// Switching to the current activity changes nothing.
+func (s *Service) Switch(ctx context.Context, id int64, activity Activity) (*Shift, error) {
+	s.logger.Debug("[SHIFT] Switch - DEBUG: ", map[string]any{
+		"shiftID":  id,
+		"activity": activity,
+	})
+
+	var after *Shift
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.authorize(ctx, before); err != nil {
+			return nil, err
+		}
+
+		if before.Status != OPEN {
+			return nil, fmt.Errorf("%w: [%d] is [%s]", ErrShiftNotOpen, id, before.Status)
+		}
+
+		after = before
+		if current := currentPeriod(before); current != nil && current.Activity == activity {
+			return nil, nil
+		}
+
+		after = copyShift(before)
+		now := time.Now()
+		endPeriod(after, now)
+
+		if err := s.repo.EndPeriod(ctx, id, now); err != nil {
+			return nil, err
+		}
+
+		period := Period{ShiftID: id, Activity: activity, StartedAt: now}
+		period.ID, err = s.repo.StartPeriod(ctx, &period)
+		if err != nil {
+			return nil, err
+		}
+
+		after.Periods = append(after.Periods, period)
+
+		return []*audit.Entry{audit.NewEntry(audit.SHIFT, id, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[SHIFT] Switch - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// Report sums up the shifts of the driver clocked in between from and to,
+// flagging the limits of the working hours they broke. A zero to is now and
+// a zero from is DEFAULT_REPORT_PERIOD before to.
+func (s *Service) Report(ctx context.Context, driverID int64, from, to time.Time) (*Report, error) {
+	s.logger.Debug("[SHIFT] Report - DEBUG: ", map[string]any{
+		"driverID": driverID,
+		"from":     from,
+		"to":       to,
+	})
+
+	now := time.Now()
+	if to.IsZero() {
+		to = now
+	}
+
+	if from.IsZero() {
+		from = to.Add(-DEFAULT_REPORT_PERIOD)
+	}
+
+	if !from.Before(to) {
+		return nil, fmt.Errorf("%w: from [%s] to [%s]", ErrInvalidPeriod, from, to)
+	}
+
+	shifts, err := s.repo.ListWorked(ctx, driverID, from.Add(-REPORT_LOOKBACK), to)
+	if err != nil {
+		s.logger.Error("[SHIFT] Report - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return NewReport(driverID, from, to, *shifts, now), nil
+}
+
+// move takes the shift to status next, letting change fill in what else
+// changes with it, once the driver is allowed to and the transition is
+// valid.
+func (s *Service) move(ctx context.Context, id int64, next Status, change func(ctx context.Context, sh *Shift) error) (*Shift, error) {
+	var after *Shift
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.authorize(ctx, before); err != nil {
+			return nil, err
+		}
+
+		if err := before.Status.Transition(next); err != nil {
+			return nil, err
+		}
+
+		after = copyShift(before)
+		after.Status = next
+
+		if err := change(ctx, after); err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Update(ctx, after, before.Status); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.SHIFT, id, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// authorize lets only the driver of the shift clock in, clock out and
+// change its activity.
+func (s *Service) authorize(ctx context.Context, sh *Shift) error {
+	a, ok := actor.FromContext(ctx)
+	if !ok || a.Role != user.DRIVER.String() {
+		return fmt.Errorf("%w: [%d]", ErrNotShiftDriver, sh.ID)
+	}
+
+	d, err := s.drivers.GetByUserID(ctx, a.UserID)
+	if err != nil {
+		return err
+	}
+
+	if d == nil || d.ID != sh.DriverID {
+		return fmt.Errorf("%w: [%d]", ErrNotShiftDriver, sh.ID)
+	}
+
+	return nil
+}
+
+// copyShift copies the shift along with its periods, so the copy can change
+// without changing the original.
+func copyShift(sh *Shift) *Shift {
+	c := new(Shift)
+	*c = *sh
+	c.Periods = append([]Period(nil), sh.Periods...)
+
+	return c
+}
+
+func currentPeriod(sh *Shift) *Period {
+	if len(sh.Periods) == 0 {
+		return nil
+	}
+
+	last := &sh.Periods[len(sh.Periods)-1]
+	if !last.EndedAt.IsZero() {
+		return nil
+	}
+
+	return last
+}
+
+func endPeriod(sh *Shift, at time.Time) {
+	if current := currentPeriod(sh); current != nil {
+		current.EndedAt = at
+	}
+}
@@ -0,0 +1,524 @@
+package shift_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	shift_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/shift"
+	"github.com/LucasMateus-eng/operations-service/shift"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	driverContext = actor.WithActor(mockedContext, &actor.Actor{UserID: 10, Role: user.DRIVER.String()})
+)
+
+func TestService_Create(t *testing.T) {
+	type serviceMocks struct {
+		repo    *shift_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		drivers *shift_mocks.MockDriverReading
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		sh  *shift.Shift
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        int64
+		wantErr     error
+	}{
+		{
+			name: "Dado um turno válido quando o método Create é chamado então o turno é agendado",
+			args: args{
+				ctx: mockedContext,
+				sh:  &shift.Shift{DriverID: 1, PlannedStartAt: clockInAt, PlannedEndAt: clockInAt.Add(8 * time.Hour)},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Create(p.ctx, p.sh).Return(int64(5), nil)
+			},
+			want:    5,
+			wantErr: nil,
+		},
+		{
+			name: "Dado um turno inválido quando o método Create é chamado então o turno não é criado",
+			args: args{
+				ctx: mockedContext,
+				sh:  &shift.Shift{DriverID: 1},
+			},
+			want:    0,
+			wantErr: shift.ErrInvalidShift,
+		},
+		{
+			name: "Dado um erro do repositório quando o método Create é chamado então o erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				sh:  &shift.Shift{DriverID: 1, PlannedStartAt: clockInAt, PlannedEndAt: clockInAt.Add(8 * time.Hour)},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Create(p.ctx, p.sh).Return(int64(0), errMocked)
+			},
+			want:    0,
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    shift_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				drivers: shift_mocks.NewMockDriverReading(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := shift.NewService(sm.repo, sm.auditor, sm.drivers, sm.logger)
+
+			actualID, err := s.Create(test.args.ctx, test.args.sh)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, shift.SCHEDULED, test.args.sh.Status)
+			}
+		})
+	}
+}
+
+func TestService_ClockIn(t *testing.T) {
+	type serviceMocks struct {
+		repo    *shift_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		drivers *shift_mocks.MockDriverReading
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	adminContext := actor.WithActor(mockedContext, &actor.Actor{UserID: 11, Role: user.ADMINISTRATOR.String()})
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado o motorista do turno quando o método ClockIn é chamado então o turno é aberto",
+			args: args{
+				ctx: driverContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.SCHEDULED}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), shift.SCHEDULED).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado outro motorista quando o método ClockIn é chamado então um erro é retornado",
+			args: args{
+				ctx: driverContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.SCHEDULED}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 3}, nil)
+			},
+			wantErr: shift.ErrNotShiftDriver,
+		},
+		{
+			name: "Dado um administrador quando o método ClockIn é chamado então um erro é retornado",
+			args: args{
+				ctx: adminContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.SCHEDULED}, nil)
+			},
+			wantErr: shift.ErrNotShiftDriver,
+		},
+		{
+			name: "Dado um turno aberto quando o método ClockIn é chamado então um erro é retornado",
+			args: args{
+				ctx: driverContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.OPEN}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+			},
+			wantErr: shift.ErrInvalidTransition,
+		},
+		{
+			name: "Dado um motorista com outro turno aberto quando o método ClockIn é chamado então um erro é retornado",
+			args: args{
+				ctx: driverContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.SCHEDULED}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), shift.SCHEDULED).Return(shift.ErrShiftOpen)
+			},
+			wantErr: shift.ErrShiftOpen,
+		},
+		{
+			name: "Dado um turno inexistente quando o método ClockIn é chamado então o erro é retornado",
+			args: args{
+				ctx: driverContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    shift_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				drivers: shift_mocks.NewMockDriverReading(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := shift.NewService(sm.repo, sm.auditor, sm.drivers, sm.logger)
+
+			actual, err := s.ClockIn(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, shift.OPEN, actual.Status)
+				assert.Equal(tt, false, actual.ClockInAt.IsZero())
+			}
+		})
+	}
+}
+
+func TestService_ClockOut(t *testing.T) {
+	type serviceMocks struct {
+		repo    *shift_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		drivers *shift_mocks.MockDriverReading
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	open := &shift.Shift{
+		ID:        5,
+		DriverID:  1,
+		Status:    shift.OPEN,
+		ClockInAt: time.Now().Add(-time.Hour),
+		Periods:   []shift.Period{{ID: 1, ShiftID: 5, Activity: shift.DRIVING, StartedAt: time.Now().Add(-time.Hour)}},
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado um turno aberto quando o método ClockOut é chamado então o turno e a atividade atual são encerrados",
+			args: args{
+				ctx: driverContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(open, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+				m.repo.EXPECT().EndPeriod(p.ctx, p.id, gomock.Any()).Return(nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), shift.OPEN).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um turno agendado quando o método ClockOut é chamado então um erro é retornado",
+			args: args{
+				ctx: driverContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.SCHEDULED}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+			},
+			wantErr: shift.ErrInvalidTransition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    shift_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				drivers: shift_mocks.NewMockDriverReading(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := shift.NewService(sm.repo, sm.auditor, sm.drivers, sm.logger)
+
+			actual, err := s.ClockOut(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			assert.Equal(tt, true, open.Periods[0].EndedAt.IsZero())
+			if err == nil {
+				assert.Equal(tt, shift.CLOSED, actual.Status)
+				assert.Equal(tt, actual.ClockOutAt, actual.Periods[0].EndedAt)
+			}
+		})
+	}
+}
+
+func TestService_Switch(t *testing.T) {
+	type serviceMocks struct {
+		repo    *shift_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		drivers *shift_mocks.MockDriverReading
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx      context.Context
+		id       int64
+		activity shift.Activity
+	}
+
+	driving := shift.Period{ID: 1, ShiftID: 5, Activity: shift.DRIVING, StartedAt: time.Now().Add(-time.Hour)}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantPeriods int
+		wantErr     error
+	}{
+		{
+			name: "Dado um turno aberto quando o método Switch é chamado então a atividade atual é encerrada e a nova é iniciada",
+			args: args{
+				ctx:      driverContext,
+				id:       5,
+				activity: shift.REST,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.OPEN, Periods: []shift.Period{driving}}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+				m.repo.EXPECT().EndPeriod(p.ctx, p.id, gomock.Any()).Return(nil)
+				m.repo.EXPECT().StartPeriod(p.ctx, gomock.Any()).Return(int64(2), nil)
+			},
+			wantPeriods: 2,
+			wantErr:     nil,
+		},
+		{
+			name: "Dado a mesma atividade quando o método Switch é chamado então nada muda",
+			args: args{
+				ctx:      driverContext,
+				id:       5,
+				activity: shift.DRIVING,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.OPEN, Periods: []shift.Period{driving}}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+			},
+			wantPeriods: 1,
+			wantErr:     nil,
+		},
+		{
+			name: "Dado um turno agendado quando o método Switch é chamado então um erro é retornado",
+			args: args{
+				ctx:      driverContext,
+				id:       5,
+				activity: shift.DRIVING,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.SCHEDULED}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+			},
+			wantErr: shift.ErrShiftNotOpen,
+		},
+		{
+			name: "Dado uma troca simultânea quando o método Switch é chamado então um erro é retornado",
+			args: args{
+				ctx:      driverContext,
+				id:       5,
+				activity: shift.REST,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&shift.Shift{ID: 5, DriverID: 1, Status: shift.OPEN, Periods: []shift.Period{driving}}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+				m.repo.EXPECT().EndPeriod(p.ctx, p.id, gomock.Any()).Return(nil)
+				m.repo.EXPECT().StartPeriod(p.ctx, gomock.Any()).Return(int64(0), shift.ErrActivityChanged)
+			},
+			wantErr: shift.ErrActivityChanged,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    shift_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				drivers: shift_mocks.NewMockDriverReading(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := shift.NewService(sm.repo, sm.auditor, sm.drivers, sm.logger)
+
+			actual, err := s.Switch(test.args.ctx, test.args.id, test.args.activity)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, test.wantPeriods, len(actual.Periods))
+				assert.Equal(tt, test.args.activity, actual.Periods[len(actual.Periods)-1].Activity)
+				assert.Equal(tt, true, actual.Periods[len(actual.Periods)-1].EndedAt.IsZero())
+			}
+		})
+	}
+}
+
+func TestService_Report(t *testing.T) {
+	type serviceMocks struct {
+		repo    *shift_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		drivers *shift_mocks.MockDriverReading
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx      context.Context
+		driverID int64
+		from     time.Time
+		to       time.Time
+	}
+
+	from := clockInAt
+	to := clockInAt.Add(24 * time.Hour)
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado um período quando o método Report é chamado então os turnos desde um dia antes são avaliados",
+			args: args{
+				ctx:      mockedContext,
+				driverID: 1,
+				from:     from,
+				to:       to,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListWorked(p.ctx, p.driverID, p.from.Add(-shift.REPORT_LOOKBACK), p.to).Return(&[]shift.Shift{}, nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um período invertido quando o método Report é chamado então um erro é retornado",
+			args: args{
+				ctx:      mockedContext,
+				driverID: 1,
+				from:     to,
+				to:       from,
+			},
+			wantErr: shift.ErrInvalidPeriod,
+		},
+		{
+			name: "Dado um erro do repositório quando o método Report é chamado então o erro é retornado",
+			args: args{
+				ctx:      mockedContext,
+				driverID: 1,
+				from:     from,
+				to:       to,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListWorked(p.ctx, p.driverID, gomock.Any(), p.to).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    shift_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				drivers: shift_mocks.NewMockDriverReading(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := shift.NewService(sm.repo, sm.auditor, sm.drivers, sm.logger)
+
+			actual, err := s.Report(test.args.ctx, test.args.driverID, test.args.from, test.args.to)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, test.args.driverID, actual.DriverID)
+				assert.Equal(tt, test.args.from, actual.From)
+				assert.Equal(tt, test.args.to, actual.To)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package shift
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/driver"
+)
+
+type Status string
+
+const (
+	SCHEDULED Status = "SCHEDULED"
+	OPEN      Status = "OPEN"
+	CLOSED    Status = "CLOSED"
+)
+
+// Activity is what the driver is doing during a period of an open shift.
+// Time of the shift outside any period counts as work other than driving.
+type Activity string
+
+const (
+	DRIVING Activity = "DRIVING"
+	REST    Activity = "REST"
+	WORK    Activity = "WORK"
+)
+
+var (
+	statuses   = []Status{SCHEDULED, OPEN, CLOSED}
+	activities = []Activity{DRIVING, REST, WORK}
+
+	// transitions are the statuses each status may move to: a driver clocks
+	// in to a scheduled shift and then clocks out of it.
+	transitions = map[Status][]Status{
+		SCHEDULED: {OPEN},
+		OPEN:      {CLOSED},
+	}
+
+	ErrInvalidStatus     = errors.New("the shift status must be one of SCHEDULED, OPEN or CLOSED")
+	ErrInvalidActivity   = errors.New("the shift activity must be one of DRIVING, REST or WORK")
+	ErrInvalidTransition = errors.New("the shift cannot move to the requested status")
+	ErrShiftOpen         = errors.New("the driver is already clocked in to another shift")
+	ErrShiftNotOpen      = errors.New("the activity can only change while the driver is clocked in to the shift")
+	ErrActivityChanged   = errors.New("the activity of the shift changed meanwhile")
+	ErrNotShiftDriver    = errors.New("only the driver of the shift can clock in, clock out and change its activity")
+	ErrInvalidPeriod     = errors.New("the start of the period must be before its end")
+)
+
+func GetStatus(name string) (Status, error) {
+	status := Status(name)
+	if !slices.Contains(statuses, status) {
+		return "", ErrInvalidStatus
+	}
+
+	return status, nil
+}
+
+func GetActivity(name string) (Activity, error) {
+	activity := Activity(name)
+	if !slices.Contains(activities, activity) {
+		return "", ErrInvalidActivity
+	}
+
+	return activity, nil
+}
+
+// Transition returns an error unless a shift in status s may move to next.
+func (s Status) Transition(next Status) error {
+	if !slices.Contains(transitions[s], next) {
+		return fmt.Errorf("%w: from [%s] to [%s]", ErrInvalidTransition, s, next)
+	}
+
+	return nil
+}
+
+// Period is a stretch of a single activity within a shift. EndedAt is zero
+// while the period is the current activity of the driver.
+type Period struct {
+	ID        int64
+	ShiftID   int64
+	Activity  Activity
+	StartedAt time.Time
+	EndedAt   time.Time
+}
+
+// Duration is how long the period lasted, or has lasted by now when it has
+// not ended.
+func (p *Period) Duration(now time.Time) time.Duration {
+	if p.EndedAt.IsZero() {
+		return now.Sub(p.StartedAt)
+	}
+
+	return p.EndedAt.Sub(p.StartedAt)
+}
+
+// Shift is a journey of a driver, scheduled ahead and then clocked in and
+// out by the driver. Periods are sorted by their start.
+type Shift struct {
+	ID             int64
+	DriverID       int64
+	PlannedStartAt time.Time
+	PlannedEndAt   time.Time
+	ClockInAt      time.Time
+	ClockOutAt     time.Time
+	Status         Status
+	Periods        []Period
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Journey is how long the driver has been clocked in, up to now when the
+// shift is still open.
+func (s *Shift) Journey(now time.Time) time.Duration {
+	if s.ClockInAt.IsZero() {
+		return 0
+	}
+
+	if s.ClockOutAt.IsZero() {
+		return now.Sub(s.ClockInAt)
+	}
+
+	return s.ClockOutAt.Sub(s.ClockInAt)
+}
+
+// Time is how long the driver spent on activity during the shift.
+func (s *Shift) Time(activity Activity, now time.Time) time.Duration {
+	var total time.Duration
+	for _, p := range s.Periods {
+		if p.Activity == activity {
+			total += p.Duration(now)
+		}
+	}
+
+	return total
+}
+
+// ShiftSpecification filters the shifts. From and To bound the planned
+// start, either of which may be zero to leave the period open.
+type ShiftSpecification struct {
+	DriverID       int64
+	Status         Status
+	From, To       time.Time
+	Page, PageSize int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Shift, error)
+	List(ctx context.Context, specification *ShiftSpecification) (*[]Shift, error)
+	// ListWorked lists the shifts of the driver clocked in between from and
+	// to, with their periods, the earliest first.
+	ListWorked(ctx context.Context, driverID int64, from, to time.Time) (*[]Shift, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, s *Shift) (int64, error)
+	// Update saves the status, the clock in and the clock out of the shift,
+	// provided it is still in the status from. It fails with
+	// ErrInvalidTransition when the shift moved meanwhile and with
+	// ErrShiftOpen when the driver clocked in to another shift.
+	Update(ctx context.Context, s *Shift, from Status) error
+	// StartPeriod fails with ErrActivityChanged when the shift already has
+	// a current period.
+	StartPeriod(ctx context.Context, p *Period) (int64, error)
+	// EndPeriod ends the current period of the shift at at, if there is one.
+	EndPeriod(ctx context.Context, shiftID int64, at time.Time) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// DriverReading is the part of the driver use case needed to find the
+// driver of the authenticated user.
+type DriverReading interface {
+	GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error)
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Shift, error)
+	List(ctx context.Context, specification *ShiftSpecification) (*[]Shift, error)
+	Create(ctx context.Context, s *Shift) (int64, error)
+	ClockIn(ctx context.Context, id int64) (*Shift, error)
+	ClockOut(ctx context.Context, id int64) (*Shift, error)
+	Switch(ctx context.Context, id int64, activity Activity) (*Shift, error)
+	Report(ctx context.Context, driverID int64, from, to time.Time) (*Report, error)
+}
@@ -0,0 +1,37 @@
+package shift
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidShift = errors.New("the given shift is invalid")
+
+	ErrMissingDriver       = errors.New("the shift must have a driver")
+	ErrMissingPlannedStart = errors.New("the shift must have a planned start")
+	ErrInvalidPlannedEnd   = errors.New("the planned end of the shift must be after its planned start")
+)
+
+// Validate returns every rule broken by the shift joined in a single error.
+func (s *Shift) Validate() error {
+	var errs []error
+
+	if s.DriverID <= 0 {
+		errs = append(errs, ErrMissingDriver)
+	}
+
+	if s.PlannedStartAt.IsZero() {
+		errs = append(errs, ErrMissingPlannedStart)
+	}
+
+	if !s.PlannedEndAt.After(s.PlannedStartAt) {
+		errs = append(errs, ErrInvalidPlannedEnd)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidShift, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package shift_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/shift"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestShift_Validate(t *testing.T) {
+	plannedStartAt := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		shift    shift.Shift
+		wantErrs []error
+	}{
+		{
+			name:  "Dado um turno válido quando a validação é chamada então nenhum erro é retornado",
+			shift: shift.Shift{DriverID: 1, PlannedStartAt: plannedStartAt, PlannedEndAt: plannedStartAt.Add(8 * time.Hour)},
+		},
+		{
+			name:     "Dado um fim previsto antes do início quando a validação é chamada então um erro é retornado",
+			shift:    shift.Shift{DriverID: 1, PlannedStartAt: plannedStartAt, PlannedEndAt: plannedStartAt.Add(-time.Hour)},
+			wantErrs: []error{shift.ErrInvalidShift, shift.ErrInvalidPlannedEnd},
+		},
+		{
+			name:     "Dado um turno vazio quando a validação é chamada então todas as regras quebradas são retornadas",
+			shift:    shift.Shift{},
+			wantErrs: []error{shift.ErrInvalidShift, shift.ErrMissingDriver, shift.ErrMissingPlannedStart, shift.ErrInvalidPlannedEnd},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.shift.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
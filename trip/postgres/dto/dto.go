@@ -0,0 +1,39 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/uptrace/bun"
+)
+
+// PlaceDTO holds the location fields of an address, embedded in the trip
+// once for the origin and once for the destination.
+type PlaceDTO struct {
+	Locality     string `bun:"locality,notnull"`
+	Number       string `bun:"number,notnull"`
+	Complement   string `bun:"complement"`
+	Neighborhood string `bun:"neighborhood,notnull"`
+	City         string `bun:"city,notnull"`
+	State        string `bun:"state,notnull"`
+	CEP          string `bun:"cep,notnull"`
+	Country      string `bun:"country,notnull"`
+}
+
+type TripDTO struct {
+	bun.BaseModel `bun:"table:trips"`
+
+	ID             int64     `bun:"id,pk,autoincrement"`
+	DriverID       int64     `bun:"driver_id,notnull"`
+	VehicleID      int64     `bun:"vehicle_id,notnull"`
+	Origin         PlaceDTO  `bun:"embed:origin_"`
+	Destination    PlaceDTO  `bun:"embed:destination_"`
+	PlannedStartAt time.Time `bun:"planned_start_at,notnull"`
+	PlannedEndAt   time.Time `bun:"planned_end_at,nullzero"`
+	StartedAt      time.Time `bun:"started_at,nullzero"`
+	EndedAt        time.Time `bun:"ended_at,nullzero"`
+	StartOdometer  int64     `bun:"start_odometer,nullzero"`
+	EndOdometer    int64     `bun:"end_odometer,nullzero"`
+	Status         string    `bun:"status,notnull"`
+	CreatedAt      time.Time `bun:"created_at,nullzero,notnull,default:current_timestamp"`
+	UpdatedAt      time.Time `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
+}
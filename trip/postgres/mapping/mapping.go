@@ -0,0 +1,86 @@
+package mapping
+
+import (
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/trip"
+	"github.com/LucasMateus-eng/operations-service/trip/postgres/dto"
+)
+
+func MapTripToDTO(t *trip.Trip) *dto.TripDTO {
+	return &dto.TripDTO{
+		ID:             t.ID,
+		DriverID:       t.DriverID,
+		VehicleID:      t.VehicleID,
+		Origin:         mapAddressToPlaceDTO(t.Origin),
+		Destination:    mapAddressToPlaceDTO(t.Destination),
+		PlannedStartAt: t.PlannedStartAt,
+		PlannedEndAt:   t.PlannedEndAt,
+		StartedAt:      t.StartedAt,
+		EndedAt:        t.EndedAt,
+		StartOdometer:  t.StartOdometer,
+		EndOdometer:    t.EndOdometer,
+		Status:         string(t.Status),
+		CreatedAt:      t.CreatedAt,
+		UpdatedAt:      t.UpdatedAt,
+	}
+}
+
+func MapDTOToTrip(tripDTO *dto.TripDTO) (*trip.Trip, error) {
+	origin, err := mapPlaceDTOToAddress(tripDTO.Origin)
+	if err != nil {
+		return nil, err
+	}
+
+	destination, err := mapPlaceDTOToAddress(tripDTO.Destination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &trip.Trip{
+		ID:             tripDTO.ID,
+		DriverID:       tripDTO.DriverID,
+		VehicleID:      tripDTO.VehicleID,
+		Origin:         *origin,
+		Destination:    *destination,
+		PlannedStartAt: tripDTO.PlannedStartAt,
+		PlannedEndAt:   tripDTO.PlannedEndAt,
+		StartedAt:      tripDTO.StartedAt,
+		EndedAt:        tripDTO.EndedAt,
+		StartOdometer:  tripDTO.StartOdometer,
+		EndOdometer:    tripDTO.EndOdometer,
+		Status:         trip.Status(tripDTO.Status),
+		CreatedAt:      tripDTO.CreatedAt,
+		UpdatedAt:      tripDTO.UpdatedAt,
+	}, nil
+}
+
+func mapAddressToPlaceDTO(a address.Address) dto.PlaceDTO {
+	return dto.PlaceDTO{
+		Locality:     a.Locality,
+		Number:       a.Number,
+		Complement:   a.Complement,
+		Neighborhood: a.Neighborhood,
+		City:         a.City,
+		State:        a.State.String(),
+		CEP:          a.CEP,
+		Country:      a.Country,
+	}
+}
+
+func mapPlaceDTOToAddress(placeDTO dto.PlaceDTO) (*address.Address, error) {
+	state, err := address.GetBrazilianState(placeDTO.State)
+	if err != nil {
+		return nil, err
+	}
+
+	return &address.Address{
+		Locality:     placeDTO.Locality,
+		Number:       placeDTO.Number,
+		Complement:   placeDTO.Complement,
+		Neighborhood: placeDTO.Neighborhood,
+		City:         placeDTO.City,
+		State:        state,
+		CEP:          placeDTO.CEP,
+		Country:      placeDTO.Country,
+	}, nil
+}
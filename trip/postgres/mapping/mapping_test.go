@@ -0,0 +1,64 @@
+package mapping
+
+import (
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/trip"
+	trip_dto "github.com/LucasMateus-eng/operations-service/trip/postgres/dto"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	mockedTime = time.Now()
+)
+
+func TestMapTripToDTO(t *testing.T) {
+	tr := &trip.Trip{
+		ID:             1,
+		DriverID:       2,
+		VehicleID:      3,
+		Origin:         address.Address{Locality: "Av. Paulista", Number: "1000", Neighborhood: "Bela Vista", City: "São Paulo", State: address.SP, CEP: "01310-100", Country: "Brasil"},
+		Destination:    address.Address{Locality: "Av. Atlântica", Number: "1702", Complement: "Loja 1", Neighborhood: "Copacabana", City: "Rio de Janeiro", State: address.RJ, CEP: "22021-001", Country: "Brasil"},
+		PlannedStartAt: mockedTime,
+		PlannedEndAt:   mockedTime,
+		StartedAt:      mockedTime,
+		EndedAt:        mockedTime,
+		StartOdometer:  1000,
+		EndOdometer:    1430,
+		Status:         trip.COMPLETED,
+		CreatedAt:      mockedTime,
+		UpdatedAt:      mockedTime,
+	}
+
+	expectedDTO := &trip_dto.TripDTO{
+		ID:             1,
+		DriverID:       2,
+		VehicleID:      3,
+		Origin:         trip_dto.PlaceDTO{Locality: "Av. Paulista", Number: "1000", Neighborhood: "Bela Vista", City: "São Paulo", State: "SÃO PAULO", CEP: "01310-100", Country: "Brasil"},
+		Destination:    trip_dto.PlaceDTO{Locality: "Av. Atlântica", Number: "1702", Complement: "Loja 1", Neighborhood: "Copacabana", City: "Rio de Janeiro", State: "RIO DE JANEIRO", CEP: "22021-001", Country: "Brasil"},
+		PlannedStartAt: mockedTime,
+		PlannedEndAt:   mockedTime,
+		StartedAt:      mockedTime,
+		EndedAt:        mockedTime,
+		StartOdometer:  1000,
+		EndOdometer:    1430,
+		Status:         "COMPLETED",
+		CreatedAt:      mockedTime,
+		UpdatedAt:      mockedTime,
+	}
+
+	actualDTO := MapTripToDTO(tr)
+	assert.Equal(t, expectedDTO, actualDTO)
+
+	actual, err := MapDTOToTrip(actualDTO)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, tr, actual)
+}
+
+func TestMapDTOToTrip_InvalidState(t *testing.T) {
+	_, err := MapDTOToTrip(&trip_dto.TripDTO{Origin: trip_dto.PlaceDTO{State: "GUANABARA"}})
+
+	assert.NotEqual(t, nil, err)
+}
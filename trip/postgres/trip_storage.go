@@ -0,0 +1,133 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
+	"github.com/LucasMateus-eng/operations-service/trip"
+	"github.com/LucasMateus-eng/operations-service/trip/postgres/dto"
+	"github.com/LucasMateus-eng/operations-service/trip/postgres/mapping"
+	"github.com/uptrace/bun"
+)
+
+type tripPostgresRepo struct {
+	db *bun.DB
+}
+
+func New(db *bun.DB) *tripPostgresRepo {
+	return &tripPostgresRepo{
+		db: db,
+	}
+}
+
+func (tr *tripPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, tr.db)
+}
+
+func (tr *tripPostgresRepo) GetByID(ctx context.Context, id int64) (*trip.Trip, error) {
+	tripDTO := new(dto.TripDTO)
+
+	err := tr.conn(ctx).NewSelect().Model(tripDTO).Where("id = ?", id).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return mapping.MapDTOToTrip(tripDTO)
+}
+
+func (tr *tripPostgresRepo) List(ctx context.Context, specification *trip.TripSpecification) (*[]trip.Trip, error) {
+	var tripDTOs []dto.TripDTO
+
+	query := tr.conn(ctx).NewSelect().Model(&tripDTOs).Order("planned_start_at DESC", "id DESC")
+
+	if specification.DriverID != 0 {
+		query = query.Where("driver_id = ?", specification.DriverID)
+	}
+
+	if specification.VehicleID != 0 {
+		query = query.Where("vehicle_id = ?", specification.VehicleID)
+	}
+
+	if specification.Status != "" {
+		query = query.Where("status = ?", specification.Status)
+	}
+
+	if !specification.From.IsZero() {
+		query = query.Where("planned_start_at >= ?", specification.From)
+	}
+
+	if !specification.To.IsZero() {
+		query = query.Where("planned_start_at < ?", specification.To)
+	}
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		query = query.Offset((specification.Page - 1) * specification.PageSize).Limit(specification.PageSize)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	trips := make([]trip.Trip, 0, len(tripDTOs))
+	for _, dto := range tripDTOs {
+		t, err := mapping.MapDTOToTrip(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		trips = append(trips, *t)
+	}
+
+	return &trips, nil
+}
+
+func (tr *tripPostgresRepo) Create(ctx context.Context, t *trip.Trip) (int64, error) {
+	tripDTO := mapping.MapTripToDTO(t)
+
+	_, err := tr.conn(ctx).NewInsert().Model(tripDTO).Returning("id").Exec(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return tripDTO.ID, nil
+}
+
+// Update relies on the partial unique indexes of the trips in progress to
+// keep a driver or a vehicle from being on two trips at once.
+func (tr *tripPostgresRepo) Update(ctx context.Context, t *trip.Trip, from trip.Status) error {
+	tripDTO := mapping.MapTripToDTO(t)
+
+	res, err := tr.conn(ctx).NewUpdate().
+		Model(tripDTO).
+		Column("status", "started_at", "ended_at", "start_odometer", "end_odometer", "updated_at").
+		Value("updated_at", "current_timestamp").
+		WherePK().
+		Where("status = ?", from).
+		Exec(ctx)
+	if err != nil {
+		if db_postgres.IsUniqueViolation(err) {
+			return trip.ErrTripInProgress
+		}
+
+		return err
+	}
+
+	return checkMoved(res)
+}
+
+// checkMoved tells a trip that moved to another status since it was read
+// apart from a successful update.
+func checkMoved(res sql.Result) error {
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		return trip.ErrInvalidTransition
+	}
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package trip
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/user"
+)
+
+type Service struct {
+	repo        Repository
+	auditor     audit.Recorder
+	assignments AssignmentReading
+	drivers     DriverReading
+	odometer    OdometerWriting
+	logger      *logging.Logging
+}
+
+func NewService(r Repository, au audit.Recorder, ar AssignmentReading, dr DriverReading, ow OdometerWriting, l *logging.Logging) *Service {
+	return &Service{
+		repo:        r,
+		auditor:     au,
+		assignments: ar,
+		drivers:     dr,
+		odometer:    ow,
+		logger:      l,
+	}
+}
+
+func (s *Service) GetByID(ctx context.Context, id int64) (*Trip, error) {
+	s.logger.Debug("[TRIP] GetByID - DEBUG: ", map[string]any{
+		"tripID": id,
+	})
+	trip, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error("[TRIP] GetByID - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return trip, nil
+}
+
+func (s *Service) List(ctx context.Context, specification *TripSpecification) (*[]Trip, error) {
+	s.logger.Debug("[TRIP] List - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	trips, err := s.repo.List(ctx, specification)
+	if err != nil {
+		s.logger.Error("[TRIP] List - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return trips, nil
+}
+
+// Create schedules the trip, which the driver must be assigned to the
+// vehicle for.
+func (s *Service) Create(ctx context.Context, t *Trip) (int64, error) {
+	s.logger.Debug("[TRIP] Create - DEBUG: ", map[string]any{
+		"trip": t,
+	})
+	if err := t.Validate(); err != nil {
+		return 0, err
+	}
+
+	t.Status = SCHEDULED
+
+	var tripID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.assigned(ctx, t); err != nil {
+			return nil, err
+		}
+
+		var err error
+		tripID, err = s.repo.Create(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.TRIP, tripID, audit.CREATE, nil, t)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[TRIP] Create - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return 0, err
+	}
+
+	return tripID, nil
+}
+
+// Start puts the trip in progress, recording the odometer of the vehicle.
+// The driver must still be assigned to the vehicle.
+func (s *Service) Start(ctx context.Context, id, startOdometer int64) (*Trip, error) {
+	s.logger.Debug("[TRIP] Start - DEBUG: ", map[string]any{
+		"tripID":        id,
+		"startOdometer": startOdometer,
+	})
+
+	trip, err := s.move(ctx, id, IN_PROGRESS, func(ctx context.Context, t *Trip) error {
+		if err := s.assigned(ctx, t); err != nil {
+			return err
+		}
+
+		t.StartedAt = time.Now()
+		t.StartOdometer = startOdometer
+
+		return s.recordOdometer(ctx, t.VehicleID, t.StartedAt, startOdometer)
+	})
+	if err != nil {
+		s.logger.Error("[TRIP] Start - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return trip, nil
+}
+
+// Finish completes the trip, recording the odometer of the vehicle.
+func (s *Service) Finish(ctx context.Context, id, endOdometer int64) (*Trip, error) {
+	s.logger.Debug("[TRIP] Finish - DEBUG: ", map[string]any{
+		"tripID":      id,
+		"endOdometer": endOdometer,
+	})
+
+	trip, err := s.move(ctx, id, COMPLETED, func(ctx context.Context, t *Trip) error {
+		if endOdometer < t.StartOdometer {
+			return fmt.Errorf("%w: [%d] is lower than [%d]", ErrInvalidOdometer, endOdometer, t.StartOdometer)
+		}
+
+		t.EndedAt = time.Now()
+		t.EndOdometer = endOdometer
+
+		return s.recordOdometer(ctx, t.VehicleID, t.EndedAt, endOdometer)
+	})
+	if err != nil {
+		s.logger.Error("[TRIP] Finish - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return trip, nil
+}
+
+func (s *Service) Cancel(ctx context.Context, id int64) (*Trip, error) {
+	s.logger.Debug("[TRIP] Cancel - DEBUG: ", map[string]any{
+		"tripID": id,
+	})
+
+	trip, err := s.move(ctx, id, CANCELLED, func(context.Context, *Trip) error { return nil })
+	if err != nil {
+		s.logger.Error("[TRIP] Cancel - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return trip, nil
+}
+
+// move takes the trip to status next, letting change fill in what else
+// changes with it, once the actor is allowed to and the transition is
+// valid.
+func (s *Service) move(ctx context.Context, id int64, next Status, change func(ctx context.Context, t *Trip) error) (*Trip, error) {
+	var after *Trip
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.authorize(ctx, before); err != nil {
+			return nil, err
+		}
+
+		if err := before.Status.Transition(next); err != nil {
+			return nil, err
+		}
+
+		after = new(Trip)
+		*after = *before
+		after.Status = next
+
+		if err := change(ctx, after); err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Update(ctx, after, before.Status); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.TRIP, id, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return after, nil
+}
+
+// authorize lets a driver move only their own trips. Other roles, and
+// requests without an actor, may move any trip.
+func (s *Service) authorize(ctx context.Context, t *Trip) error {
+	a, ok := actor.FromContext(ctx)
+	if !ok || a.Role != user.DRIVER.String() {
+		return nil
+	}
+
+	d, err := s.drivers.GetByUserID(ctx, a.UserID)
+	if err != nil {
+		return err
+	}
+
+	if d == nil || d.ID != t.DriverID {
+		return fmt.Errorf("%w: [%d]", ErrNotTripDriver, t.ID)
+	}
+
+	return nil
+}
+
+func (s *Service) assigned(ctx context.Context, t *Trip) error {
+	assignment, err := s.assignments.GetByID(ctx, t.DriverID, t.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	if assignment == nil {
+		return fmt.Errorf("%w: driver [%d], vehicle [%d]", ErrNotAssigned, t.DriverID, t.VehicleID)
+	}
+
+	return nil
+}
+
+func (s *Service) recordOdometer(ctx context.Context, vehicleID int64, readAt time.Time, km int64) error {
+	_, err := s.odometer.Create(ctx, &odometer.Record{
+		VehicleID: vehicleID,
+		ReadAt:    readAt,
+		Km:        km,
+		Source:    odometer.TRIP,
+	})
+
+	return err
+}
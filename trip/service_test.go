@@ -0,0 +1,487 @@
+package trip_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/internal/actor"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	trip_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/trip"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+	"github.com/LucasMateus-eng/operations-service/trip"
+	"github.com/LucasMateus-eng/operations-service/user"
+	"github.com/go-playground/assert/v2"
+	"go.uber.org/mock/gomock"
+)
+
+var (
+	errMocked     = errors.New("some error")
+	mockedContext = context.Background()
+	assignment    = &drivervehicle.DriverVehicle{DriverID: 1, VehicleID: 2}
+)
+
+func TestService_Create(t *testing.T) {
+	type serviceMocks struct {
+		repo        *trip_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		assignments *trip_mocks.MockAssignmentReading
+		drivers     *trip_mocks.MockDriverReading
+		odometer    *trip_mocks.MockOdometerWriting
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		t   *trip.Trip
+	}
+
+	plannedStartAt := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        int64
+		wantErr     error
+	}{
+		{
+			name: "Dado um motorista vinculado ao veículo quando o método Create é chamado então a viagem é agendada",
+			args: args{
+				ctx: mockedContext,
+				t:   &trip.Trip{DriverID: 1, VehicleID: 2, Origin: origin, Destination: destination, PlannedStartAt: plannedStartAt},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, p.t.DriverID, p.t.VehicleID).Return(assignment, nil)
+				m.repo.EXPECT().Create(p.ctx, p.t).Return(int64(5), nil)
+			},
+			want:    5,
+			wantErr: nil,
+		},
+		{
+			name: "Dado um motorista não vinculado ao veículo quando o método Create é chamado então a viagem não é criada",
+			args: args{
+				ctx: mockedContext,
+				t:   &trip.Trip{DriverID: 1, VehicleID: 2, Origin: origin, Destination: destination, PlannedStartAt: plannedStartAt},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, p.t.DriverID, p.t.VehicleID).Return(nil, nil)
+			},
+			want:    0,
+			wantErr: trip.ErrNotAssigned,
+		},
+		{
+			name: "Dado uma falha ao buscar o vínculo quando o método Create é chamado então a viagem não é criada",
+			args: args{
+				ctx: mockedContext,
+				t:   &trip.Trip{DriverID: 1, VehicleID: 2, Origin: origin, Destination: destination, PlannedStartAt: plannedStartAt},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.assignments.EXPECT().GetByID(p.ctx, p.t.DriverID, p.t.VehicleID).Return(nil, errMocked)
+			},
+			want:    0,
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado uma viagem inválida quando o método Create é chamado então a viagem não é criada",
+			args: args{
+				ctx: mockedContext,
+				t:   &trip.Trip{DriverID: 1, VehicleID: 2},
+			},
+			want:    0,
+			wantErr: trip.ErrInvalidTrip,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        trip_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				assignments: trip_mocks.NewMockAssignmentReading(ctrl),
+				drivers:     trip_mocks.NewMockDriverReading(ctrl),
+				odometer:    trip_mocks.NewMockOdometerWriting(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := trip.NewService(sm.repo, sm.auditor, sm.assignments, sm.drivers, sm.odometer, sm.logger)
+
+			actualID, err := s.Create(test.args.ctx, test.args.t)
+
+			assert.Equal(tt, test.want, actualID)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, trip.SCHEDULED, test.args.t.Status)
+			}
+		})
+	}
+}
+
+func TestService_Start(t *testing.T) {
+	type serviceMocks struct {
+		repo        *trip_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		assignments *trip_mocks.MockAssignmentReading
+		drivers     *trip_mocks.MockDriverReading
+		odometer    *trip_mocks.MockOdometerWriting
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx           context.Context
+		id            int64
+		startOdometer int64
+	}
+
+	driverContext := actor.WithActor(mockedContext, &actor.Actor{UserID: 10, Role: user.DRIVER.String()})
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado uma viagem agendada quando o método Start é chamado então ela é iniciada e o odômetro é registrado",
+			args: args{
+				ctx:           mockedContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Cond(func(x any) bool {
+					r, ok := x.(*odometer.Record)
+					return ok && r.VehicleID == 2 && r.Km == p.startOdometer && r.Source == odometer.TRIP
+				})).Return(int64(1), nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), trip.SCHEDULED).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado o motorista da viagem quando o método Start é chamado então ela é iniciada",
+			args: args{
+				ctx:           driverContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 1}, nil)
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(1), nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), trip.SCHEDULED).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado outro motorista quando o método Start é chamado então um erro é retornado",
+			args: args{
+				ctx:           driverContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+				m.drivers.EXPECT().GetByUserID(p.ctx, int64(10)).Return(&driver.Driver{ID: 3}, nil)
+			},
+			wantErr: trip.ErrNotTripDriver,
+		},
+		{
+			name: "Dado uma viagem em andamento quando o método Start é chamado então um erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.IN_PROGRESS}, nil)
+			},
+			wantErr: trip.ErrInvalidTransition,
+		},
+		{
+			name: "Dado um motorista desvinculado do veículo quando o método Start é chamado então um erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(nil, nil)
+			},
+			wantErr: trip.ErrNotAssigned,
+		},
+		{
+			name: "Dado uma falha ao buscar o vínculo quando o método Start é chamado então a viagem não é iniciada",
+			args: args{
+				ctx:           mockedContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(nil, errMocked)
+			},
+			wantErr: errMocked,
+		},
+		{
+			name: "Dado um odômetro menor que a última leitura quando o método Start é chamado então um erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+				m.assignments.EXPECT().GetByID(p.ctx, int64(1), int64(2)).Return(assignment, nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(0), odometer.ErrDecreasingReading)
+			},
+			wantErr: odometer.ErrDecreasingReading,
+		},
+		{
+			name: "Dado uma viagem inexistente quando o método Start é chamado então o erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				id:            5,
+				startOdometer: 1000,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(nil, sql.ErrNoRows)
+			},
+			wantErr: sql.ErrNoRows,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        trip_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				assignments: trip_mocks.NewMockAssignmentReading(ctrl),
+				drivers:     trip_mocks.NewMockDriverReading(ctrl),
+				odometer:    trip_mocks.NewMockOdometerWriting(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := trip.NewService(sm.repo, sm.auditor, sm.assignments, sm.drivers, sm.odometer, sm.logger)
+
+			actual, err := s.Start(test.args.ctx, test.args.id, test.args.startOdometer)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, trip.IN_PROGRESS, actual.Status)
+				assert.Equal(tt, test.args.startOdometer, actual.StartOdometer)
+				assert.Equal(tt, false, actual.StartedAt.IsZero())
+			}
+		})
+	}
+}
+
+func TestService_Finish(t *testing.T) {
+	type serviceMocks struct {
+		repo        *trip_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		assignments *trip_mocks.MockAssignmentReading
+		drivers     *trip_mocks.MockDriverReading
+		odometer    *trip_mocks.MockOdometerWriting
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx         context.Context
+		id          int64
+		endOdometer int64
+	}
+
+	startedAt := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name         string
+		args         args
+		prepareMock  func(p args, m serviceMocks)
+		wantDistance int64
+		wantErr      error
+	}{
+		{
+			name: "Dado uma viagem em andamento quando o método Finish é chamado então ela é finalizada com a distância percorrida",
+			args: args{
+				ctx:         mockedContext,
+				id:          5,
+				endOdometer: 1250,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.IN_PROGRESS, StartedAt: startedAt, StartOdometer: 1000}, nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(1), nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), trip.IN_PROGRESS).Return(nil)
+			},
+			wantDistance: 250,
+			wantErr:      nil,
+		},
+		{
+			name: "Dado um odômetro menor que o do início quando o método Finish é chamado então um erro é retornado",
+			args: args{
+				ctx:         mockedContext,
+				id:          5,
+				endOdometer: 900,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.IN_PROGRESS, StartedAt: startedAt, StartOdometer: 1000}, nil)
+			},
+			wantErr: trip.ErrInvalidOdometer,
+		},
+		{
+			name: "Dado uma viagem agendada quando o método Finish é chamado então um erro é retornado",
+			args: args{
+				ctx:         mockedContext,
+				id:          5,
+				endOdometer: 1250,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+			},
+			wantErr: trip.ErrInvalidTransition,
+		},
+		{
+			name: "Dado um erro do repositório quando o método Finish é chamado então o erro é retornado",
+			args: args{
+				ctx:         mockedContext,
+				id:          5,
+				endOdometer: 1250,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.IN_PROGRESS, StartedAt: startedAt, StartOdometer: 1000}, nil)
+				m.odometer.EXPECT().Create(p.ctx, gomock.Any()).Return(int64(1), nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), trip.IN_PROGRESS).Return(errMocked)
+			},
+			wantErr: errMocked,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        trip_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				assignments: trip_mocks.NewMockAssignmentReading(ctrl),
+				drivers:     trip_mocks.NewMockDriverReading(ctrl),
+				odometer:    trip_mocks.NewMockOdometerWriting(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := trip.NewService(sm.repo, sm.auditor, sm.assignments, sm.drivers, sm.odometer, sm.logger)
+
+			actual, err := s.Finish(test.args.ctx, test.args.id, test.args.endOdometer)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, trip.COMPLETED, actual.Status)
+				assert.Equal(tt, test.wantDistance, actual.Distance())
+			}
+		})
+	}
+}
+
+func TestService_Cancel(t *testing.T) {
+	type serviceMocks struct {
+		repo        *trip_mocks.MockRepository
+		auditor     *audit_mocks.MockRecorder
+		assignments *trip_mocks.MockAssignmentReading
+		drivers     *trip_mocks.MockDriverReading
+		odometer    *trip_mocks.MockOdometerWriting
+		logger      *logging.Logging
+	}
+
+	type args struct {
+		ctx context.Context
+		id  int64
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     error
+	}{
+		{
+			name: "Dado uma viagem agendada quando o método Cancel é chamado então ela é cancelada",
+			args: args{
+				ctx: mockedContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.SCHEDULED}, nil)
+				m.repo.EXPECT().Update(p.ctx, gomock.Any(), trip.SCHEDULED).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado uma viagem em andamento quando o método Cancel é chamado então um erro é retornado",
+			args: args{
+				ctx: mockedContext,
+				id:  5,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&trip.Trip{ID: 5, DriverID: 1, VehicleID: 2, Status: trip.IN_PROGRESS}, nil)
+			},
+			wantErr: trip.ErrInvalidTransition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:        trip_mocks.NewMockRepository(ctrl),
+				auditor:     audit_mocks.NewPassThroughRecorder(ctrl),
+				assignments: trip_mocks.NewMockAssignmentReading(ctrl),
+				drivers:     trip_mocks.NewMockDriverReading(ctrl),
+				odometer:    trip_mocks.NewMockOdometerWriting(ctrl),
+				logger:      logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := trip.NewService(sm.repo, sm.auditor, sm.assignments, sm.drivers, sm.odometer, sm.logger)
+
+			actual, err := s.Cancel(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+			if err == nil {
+				assert.Equal(tt, trip.CANCELLED, actual.Status)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package trip
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/driver"
+	drivervehicle "github.com/LucasMateus-eng/operations-service/driver-vehicle"
+	"github.com/LucasMateus-eng/operations-service/odometer"
+)
+
+type Status string
+
+const (
+	SCHEDULED   Status = "SCHEDULED"
+	IN_PROGRESS Status = "IN_PROGRESS"
+	COMPLETED   Status = "COMPLETED"
+	CANCELLED   Status = "CANCELLED"
+)
+
+var (
+	statuses = []Status{SCHEDULED, IN_PROGRESS, COMPLETED, CANCELLED}
+
+	// transitions are the statuses each status may move to. A trip is
+	// cancelled before it starts; once started it can only be finished.
+	transitions = map[Status][]Status{
+		SCHEDULED:   {IN_PROGRESS, CANCELLED},
+		IN_PROGRESS: {COMPLETED},
+	}
+
+	ErrInvalidStatus     = errors.New("the trip status must be one of SCHEDULED, IN_PROGRESS, COMPLETED or CANCELLED")
+	ErrInvalidTransition = errors.New("the trip cannot move to the requested status")
+	ErrNotAssigned       = errors.New("the driver is not assigned to the vehicle")
+	ErrTripInProgress    = errors.New("the driver or the vehicle is already on a trip in progress")
+	ErrNotTripDriver     = errors.New("a driver can only start and finish their own trips")
+	ErrInvalidOdometer   = errors.New("the odometer at the end of the trip cannot be lower than at its start")
+)
+
+func GetStatus(name string) (Status, error) {
+	status := Status(name)
+	if !slices.Contains(statuses, status) {
+		return "", ErrInvalidStatus
+	}
+
+	return status, nil
+}
+
+// Transition returns an error unless a trip in status s may move to next.
+func (s Status) Transition(next Status) error {
+	if !slices.Contains(transitions[s], next) {
+		return fmt.Errorf("%w: from [%s] to [%s]", ErrInvalidTransition, s, next)
+	}
+
+	return nil
+}
+
+// Trip is a journey of a driver with a vehicle the driver is assigned to,
+// planned ahead and then started and finished by the driver. Origin and
+// Destination only use the location fields of the address.
+type Trip struct {
+	ID             int64
+	DriverID       int64
+	VehicleID      int64
+	Origin         address.Address
+	Destination    address.Address
+	PlannedStartAt time.Time
+	PlannedEndAt   time.Time
+	StartedAt      time.Time
+	EndedAt        time.Time
+	StartOdometer  int64
+	EndOdometer    int64
+	Status         Status
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// Distance is how far the vehicle went on a completed trip.
+func (t *Trip) Distance() int64 {
+	if t.Status != COMPLETED {
+		return 0
+	}
+
+	return t.EndOdometer - t.StartOdometer
+}
+
+// TripSpecification filters the trips. From and To bound the planned start,
+// either of which may be zero to leave the period open.
+type TripSpecification struct {
+	DriverID, VehicleID int64
+	Status              Status
+	From, To            time.Time
+	Page, PageSize      int
+}
+
+type Reading interface {
+	GetByID(ctx context.Context, id int64) (*Trip, error)
+	List(ctx context.Context, specification *TripSpecification) (*[]Trip, error)
+}
+
+type Writing interface {
+	Create(ctx context.Context, t *Trip) (int64, error)
+	// Update saves the status and the actual start and end of the trip,
+	// provided it is still in the status from. It fails with
+	// ErrInvalidTransition when the trip moved meanwhile and with
+	// ErrTripInProgress when the driver or the vehicle started another trip.
+	Update(ctx context.Context, t *Trip, from Status) error
+}
+
+type Repository interface {
+	Reading
+	Writing
+}
+
+// AssignmentReading is the part of the driver-vehicle use case needed to
+// check that the driver may drive the vehicle. GetByID returns nil when the
+// driver is not assigned to the vehicle.
+type AssignmentReading interface {
+	GetByID(ctx context.Context, driverID, vehicleID int64) (*drivervehicle.DriverVehicle, error)
+}
+
+// DriverReading is the part of the driver use case needed to find the
+// driver of the authenticated user.
+type DriverReading interface {
+	GetByUserID(ctx context.Context, userId int64) (*driver.Driver, error)
+}
+
+// OdometerWriting is the part of the odometer use case needed to record the
+// odometer at the start and end of a trip.
+type OdometerWriting interface {
+	Create(ctx context.Context, r *odometer.Record) (int64, error)
+}
+
+type UseCase interface {
+	GetByID(ctx context.Context, id int64) (*Trip, error)
+	List(ctx context.Context, specification *TripSpecification) (*[]Trip, error)
+	Create(ctx context.Context, t *Trip) (int64, error)
+	Start(ctx context.Context, id, startOdometer int64) (*Trip, error)
+	Finish(ctx context.Context, id, endOdometer int64) (*Trip, error)
+	Cancel(ctx context.Context, id int64) (*Trip, error)
+}
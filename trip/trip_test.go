@@ -0,0 +1,85 @@
+package trip_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/LucasMateus-eng/operations-service/trip"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestStatus_Transition(t *testing.T) {
+	tests := []struct {
+		name    string
+		from    trip.Status
+		next    trip.Status
+		wantErr error
+	}{
+		{
+			name: "Dado uma viagem agendada quando ela é iniciada então a transição é válida",
+			from: trip.SCHEDULED,
+			next: trip.IN_PROGRESS,
+		},
+		{
+			name: "Dado uma viagem agendada quando ela é cancelada então a transição é válida",
+			from: trip.SCHEDULED,
+			next: trip.CANCELLED,
+		},
+		{
+			name: "Dado uma viagem em andamento quando ela é finalizada então a transição é válida",
+			from: trip.IN_PROGRESS,
+			next: trip.COMPLETED,
+		},
+		{
+			name:    "Dado uma viagem agendada quando ela é finalizada então um erro é retornado",
+			from:    trip.SCHEDULED,
+			next:    trip.COMPLETED,
+			wantErr: trip.ErrInvalidTransition,
+		},
+		{
+			name:    "Dado uma viagem em andamento quando ela é cancelada então um erro é retornado",
+			from:    trip.IN_PROGRESS,
+			next:    trip.CANCELLED,
+			wantErr: trip.ErrInvalidTransition,
+		},
+		{
+			name:    "Dado uma viagem finalizada quando ela é iniciada então um erro é retornado",
+			from:    trip.COMPLETED,
+			next:    trip.IN_PROGRESS,
+			wantErr: trip.ErrInvalidTransition,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.from.Transition(test.next)
+
+			assert.Equal(tt, test.wantErr == nil, err == nil)
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestTrip_Distance(t *testing.T) {
+	tests := []struct {
+		name string
+		trip trip.Trip
+		want int64
+	}{
+		{
+			name: "Dado uma viagem finalizada quando a distância é calculada então ela é a diferença dos odômetros",
+			trip: trip.Trip{Status: trip.COMPLETED, StartOdometer: 1000, EndOdometer: 1250},
+			want: 250,
+		},
+		{
+			name: "Dado uma viagem em andamento quando a distância é calculada então ela é zero",
+			trip: trip.Trip{Status: trip.IN_PROGRESS, StartOdometer: 1000},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, test.trip.Distance())
+		})
+	}
+}
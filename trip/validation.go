@@ -0,0 +1,52 @@
+package trip
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrInvalidTrip = errors.New("the given trip is invalid")
+
+	ErrMissingDriver       = errors.New("the trip must have a driver")
+	ErrMissingVehicle      = errors.New("the trip must have a vehicle")
+	ErrInvalidOrigin       = errors.New("the trip origin is invalid")
+	ErrInvalidDestination  = errors.New("the trip destination is invalid")
+	ErrMissingPlannedStart = errors.New("the trip must have a planned start")
+	ErrInvalidPlannedEnd   = errors.New("the planned end of the trip must be after its planned start")
+)
+
+// Validate returns every rule broken by the trip joined in a single error.
+func (t *Trip) Validate() error {
+	var errs []error
+
+	if t.DriverID <= 0 {
+		errs = append(errs, ErrMissingDriver)
+	}
+
+	if t.VehicleID <= 0 {
+		errs = append(errs, ErrMissingVehicle)
+	}
+
+	if err := t.Origin.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("%w: %w", ErrInvalidOrigin, err))
+	}
+
+	if err := t.Destination.Validate(); err != nil {
+		errs = append(errs, fmt.Errorf("%w: %w", ErrInvalidDestination, err))
+	}
+
+	if t.PlannedStartAt.IsZero() {
+		errs = append(errs, ErrMissingPlannedStart)
+	}
+
+	if !t.PlannedEndAt.IsZero() && !t.PlannedEndAt.After(t.PlannedStartAt) {
+		errs = append(errs, ErrInvalidPlannedEnd)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("%w: %w", ErrInvalidTrip, errors.Join(errs...))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package trip_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/address"
+	"github.com/LucasMateus-eng/operations-service/trip"
+	"github.com/go-playground/assert/v2"
+)
+
+var (
+	origin      = address.Address{Locality: "Av. Paulista", Number: "1000", Neighborhood: "Bela Vista", City: "São Paulo", State: address.SP, CEP: "01310-100", Country: "Brasil"}
+	destination = address.Address{Locality: "Av. Atlântica", Number: "1702", Neighborhood: "Copacabana", City: "Rio de Janeiro", State: address.RJ, CEP: "22021-001", Country: "Brasil"}
+)
+
+func TestTrip_Validate(t *testing.T) {
+	plannedStartAt := time.Now().Add(24 * time.Hour)
+
+	tests := []struct {
+		name     string
+		trip     trip.Trip
+		wantErrs []error
+	}{
+		{
+			name: "Dado uma viagem válida quando a validação é chamada então nenhum erro é retornado",
+			trip: trip.Trip{DriverID: 1, VehicleID: 2, Origin: origin, Destination: destination, PlannedStartAt: plannedStartAt, PlannedEndAt: plannedStartAt.Add(6 * time.Hour)},
+		},
+		{
+			name:     "Dado um fim previsto antes do início quando a validação é chamada então um erro é retornado",
+			trip:     trip.Trip{DriverID: 1, VehicleID: 2, Origin: origin, Destination: destination, PlannedStartAt: plannedStartAt, PlannedEndAt: plannedStartAt.Add(-time.Hour)},
+			wantErrs: []error{trip.ErrInvalidTrip, trip.ErrInvalidPlannedEnd},
+		},
+		{
+			name: "Dado uma viagem vazia quando a validação é chamada então todas as regras quebradas são retornadas",
+			trip: trip.Trip{Destination: address.Address{Locality: "Av. Atlântica"}},
+			wantErrs: []error{
+				trip.ErrInvalidTrip, trip.ErrMissingDriver, trip.ErrMissingVehicle, trip.ErrInvalidOrigin, trip.ErrInvalidDestination,
+				trip.ErrMissingPlannedStart, address.ErrEmptyNumber,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := test.trip.Validate()
+
+			assert.Equal(tt, len(test.wantErrs) > 0, err != nil)
+			for _, wantErr := range test.wantErrs {
+				assert.Equal(tt, true, errors.Is(err, wantErr))
+			}
+		})
+	}
+}
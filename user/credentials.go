@@ -0,0 +1,42 @@
+package user
+
+import (
+	"crypto/rand"
+	"math/big"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	GENERATED_PASSWORD_LENGTH = 12
+	passwordAlphabet          = "abcdefghijkmnopqrstuvwxyzABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// GeneratePassword returns a random password without look-alike characters
+// (0/O, 1/l/I), meant to be handed to the user as an initial credential.
+func GeneratePassword() (string, error) {
+	password := make([]byte, GENERATED_PASSWORD_LENGTH)
+	for i := range password {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(passwordAlphabet))))
+		if err != nil {
+			return "", err
+		}
+		password[i] = passwordAlphabet[n.Int64()]
+	}
+
+	return string(password), nil
+}
+
+func HashPassword(password string) (string, error) {
+	hashedPassword, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hashedPassword), nil
+}
+
+// CheckPassword reports whether password matches the stored bcrypt hash.
+func CheckPassword(hashedPassword, password string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(hashedPassword), []byte(password)) == nil
+}
@@ -14,8 +14,9 @@ type UserDTO struct {
 	Username       string                  `bun:"username,unique,notnull"`
 	HashedPassword string                  `bun:"hashed_password,notnull"`
 	Role           string                  `bun:"role,notnull"`
+	Version        int64                   `bun:"version,nullzero,notnull,default:1"`
 	CreatedAt      time.Time               `bun:"created_at,nullzero,notnull,default:current_timestamp"`
 	UpdatedAt      time.Time               `bun:"updated_at,nullzero,notnull,default:current_timestamp"`
-	DeletedAt      time.Time               `bun:"deleted_at,soft_delete,nullzero,notnull,default:'0001-01-01 00:00:00+00'"`
+	DeletedAt      time.Time               `bun:"deleted_at,soft_delete,notnull,default:'0001-01-01 00:00:00+00'"`
 	AddressDTO     *address_dto.AddressDTO `bun:"rel:has-one,join:id=user_id"`
 }
@@ -12,6 +12,7 @@ func MapUserToDTO(user *user.User) *user_dto.UserDTO {
 		Username:       user.Username,
 		HashedPassword: user.HashedPassword,
 		Role:           user.Role.String(),
+		Version:        user.Version,
 		CreatedAt:      user.CreatedAt,
 		UpdatedAt:      user.UpdatedAt,
 		DeletedAt:      user.DeletedAt,
@@ -30,6 +31,7 @@ func MapDTOToUser(userDTO *user_dto.UserDTO) (*user.User, error) {
 		Username:       userDTO.Username,
 		HashedPassword: userDTO.HashedPassword,
 		Role:           role,
+		Version:        userDTO.Version,
 		CreatedAt:      userDTO.CreatedAt,
 		UpdatedAt:      userDTO.UpdatedAt,
 		DeletedAt:      userDTO.DeletedAt,
@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
 	"github.com/LucasMateus-eng/operations-service/user"
 	"github.com/LucasMateus-eng/operations-service/user/postgres/dto"
 	"github.com/LucasMateus-eng/operations-service/user/postgres/mapping"
@@ -20,10 +21,14 @@ func New(db *bun.DB) *userPostgresRepo {
 	}
 }
 
+func (ur *userPostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, ur.db)
+}
+
 func (ur *userPostgresRepo) GetByID(ctx context.Context, id int64) (*user.User, error) {
 	var userDTO dto.UserDTO
 
-	err := ur.db.NewSelect().Model(&userDTO).Where("id = ?", id).Scan(ctx)
+	err := ur.conn(ctx).NewSelect().Model(&userDTO).Where("id = ?", id).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +44,7 @@ func (ur *userPostgresRepo) GetByID(ctx context.Context, id int64) (*user.User,
 func (ur *userPostgresRepo) GetByUsername(ctx context.Context, username string) (*user.User, error) {
 	var userDTO dto.UserDTO
 
-	err := ur.db.NewSelect().Model(&userDTO).Where("username = ?", username).Scan(ctx)
+	err := ur.conn(ctx).NewSelect().Model(&userDTO).Where("username = ?", username).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +60,7 @@ func (ur *userPostgresRepo) GetByUsername(ctx context.Context, username string)
 func (ur *userPostgresRepo) GetByRole(ctx context.Context, role user.Role) (*user.User, error) {
 	var userDTO dto.UserDTO
 
-	err := ur.db.NewSelect().Model(&userDTO).Where("role = ?", role.String()).Scan(ctx)
+	err := ur.conn(ctx).NewSelect().Model(&userDTO).Where("role = ?", role.String()).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -68,12 +73,64 @@ func (ur *userPostgresRepo) GetByRole(ctx context.Context, role user.Role) (*use
 	return mappedValue, nil
 }
 
+// ListDeleted returns the users in the trash, most recently deleted first.
+// ListByIDs returns the users with the given ids in a single query. Ids
+// without a user are left out of the result.
+func (ur *userPostgresRepo) ListByIDs(ctx context.Context, ids []int64) (*[]user.User, error) {
+	var userDTOs []dto.UserDTO
+
+	err := ur.conn(ctx).NewSelect().Model(&userDTOs).Where("id IN (?)", bun.In(ids)).Order("id ASC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []user.User
+	for _, dto := range userDTOs {
+		mappedValue, err := mapping.MapDTOToUser(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, *mappedValue)
+	}
+
+	return &users, nil
+}
+
+func (ur *userPostgresRepo) ListDeleted(ctx context.Context, specification *user.UserSpecification) (*[]user.User, error) {
+	var userDTOs []dto.UserDTO
+
+	query := ur.conn(ctx).NewSelect().Model(&userDTOs).WhereDeleted().Order("deleted_at DESC", "id ASC")
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		offset := (specification.Page - 1) * specification.PageSize
+		query = query.Offset(offset).Limit(specification.PageSize)
+	}
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []user.User
+	for _, dto := range userDTOs {
+		mappedValue, err := mapping.MapDTOToUser(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, *mappedValue)
+	}
+
+	return &users, nil
+}
+
 func (ur *userPostgresRepo) Create(ctx context.Context, u *user.User) (int64, error) {
 	var userID int64
 
 	userDTO := mapping.MapUserToDTO(u)
 
-	query := ur.db.NewInsert().Model(userDTO).On("CONFLICT (id) DO UPDATE").Returning("id")
+	query := ur.conn(ctx).NewInsert().Model(userDTO).Returning("id")
 
 	err := query.Scan(ctx, &userID)
 	if err != nil {
@@ -86,16 +143,73 @@ func (ur *userPostgresRepo) Create(ctx context.Context, u *user.User) (int64, er
 func (ur *userPostgresRepo) Update(ctx context.Context, u *user.User) error {
 	userDTO := mapping.MapUserToDTO(u)
 
-	_, err := ur.db.NewUpdate().Model(userDTO).
+	res, err := ur.conn(ctx).NewUpdate().Model(userDTO).
 		OmitZero().
-		ExcludeColumn("plate", "renavam", "deleted_at").
+		ExcludeColumn("deleted_at").
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
 		Where("id = ?", userDTO.ID).
+		Where("version = ?", u.Version).
+		Returning("version").
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, ur.conn(ctx), (*dto.UserDTO)(nil), userDTO.ID, res); err != nil {
+		return err
+	}
+
+	u.Version = userDTO.Version
+
+	return nil
+}
+
+func (ur *userPostgresRepo) Patch(ctx context.Context, u *user.User, fields []string) error {
+	columns, err := db_postgres.PatchColumns(fields, "username", "hashed_password", "role")
+	if err != nil {
+		return err
+	}
+
+	userDTO := mapping.MapUserToDTO(u)
+
+	res, err := ur.conn(ctx).NewUpdate().Model(userDTO).
+		Column(columns...).
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
+		Where("id = ?", userDTO.ID).
+		Where("version = ?", u.Version).
+		Returning("version").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, ur.conn(ctx), (*dto.UserDTO)(nil), userDTO.ID, res); err != nil {
+		return err
+	}
+
+	u.Version = userDTO.Version
+
+	return nil
+}
+
+func (ur *userPostgresRepo) Delete(ctx context.Context, id, version int64) error {
+	res, err := ur.conn(ctx).NewDelete().Model((*dto.UserDTO)(nil)).
+		Where("id = ?", id).
+		Where("version = ?", version).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db_postgres.CheckVersion(ctx, ur.conn(ctx), (*dto.UserDTO)(nil), id, res)
+}
 
-	return err
+func (ur *userPostgresRepo) Restore(ctx context.Context, id int64) error {
+	return db_postgres.Restore(ctx, ur.conn(ctx), (*dto.UserDTO)(nil), id)
 }
 
-func (ur *userPostgresRepo) Delete(ctx context.Context, id int64) error {
-	_, err := ur.db.NewDelete().Model((*dto.UserDTO)(nil)).Where("id = ?", id).Exec(ctx)
-	return err
+func (ur *userPostgresRepo) Purge(ctx context.Context, id int64) error {
+	return db_postgres.Purge(ctx, ur.conn(ctx), (*dto.UserDTO)(nil), id)
 }
@@ -3,18 +3,22 @@ package user
 import (
 	"context"
 
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
 )
 
 type Service struct {
-	repo   Repository
-	logger *logging.Logging
+	repo    Repository
+	auditor audit.Recorder
+	logger  *logging.Logging
 }
 
-func NewService(r Repository, l *logging.Logging) *Service {
+func NewService(r Repository, au audit.Recorder, l *logging.Logging) *Service {
 	return &Service{
-		repo:   r,
-		logger: l,
+		repo:    r,
+		auditor: au,
+		logger:  l,
 	}
 }
 
@@ -63,11 +67,35 @@ func (s *Service) GetByRole(ctx context.Context, role Role) (*User, error) {
 	return user, nil
 }
 
+func (s *Service) ListByIDs(ctx context.Context, ids []int64) (*[]User, error) {
+	s.logger.Debug("[USER] ListByIDs - DEBUG: ", map[string]any{
+		"ids": ids,
+	})
+	users, err := s.repo.ListByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("[USER] ListByIDs - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return users, nil
+}
+
 func (s *Service) Create(ctx context.Context, u *User) (int64, error) {
 	s.logger.Debug("[USER] Create - DEBUG: ", map[string]any{
 		"user": u,
 	})
-	userID, err := s.repo.Create(ctx, u)
+	var userID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		userID, err = s.repo.Create(ctx, u)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.USER, userID, audit.CREATE, nil, u)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[USER] Create - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -82,7 +110,23 @@ func (s *Service) Update(ctx context.Context, u *User) error {
 	s.logger.Debug("[USER] Update - DEBUG: ", map[string]any{
 		"user": u,
 	})
-	err := s.repo.Update(ctx, u)
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, u.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Update(ctx, u); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetByID(ctx, u.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.USER, u.ID, audit.UPDATE, before, after)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[USER] Update - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -93,11 +137,61 @@ func (s *Service) Update(ctx context.Context, u *User) error {
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context, id int64) error {
+// Patch writes only the given fields of the user, which must carry the
+// version it was read with.
+func (s *Service) Patch(ctx context.Context, u *User, fields []string) error {
+	s.logger.Debug("[USER] Patch - DEBUG: ", map[string]any{
+		"user":   u,
+		"fields": fields,
+	})
+	if err := mergepatch.CheckImmutable(fields, ImmutableFields...); err != nil {
+		return err
+	}
+
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, u.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Patch(ctx, u, fields); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetByID(ctx, u.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.USER, u.ID, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[USER] Patch - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, id, version int64) error {
 	s.logger.Debug("[USER] Delete - DEBUG: ", map[string]any{
-		"userID": id,
+		"userID":  id,
+		"version": version,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Delete(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.USER, id, audit.DELETE, before, nil)}, nil
 	})
-	err := s.repo.Delete(ctx, id)
 	if err != nil {
 		s.logger.Error("[USER] Delete - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -107,3 +201,60 @@ func (s *Service) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (s *Service) ListDeleted(ctx context.Context, specification *UserSpecification) (*[]User, error) {
+	s.logger.Debug("[USER] ListDeleted - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	users, err := s.repo.ListDeleted(ctx, specification)
+	if err != nil {
+		s.logger.Error("[USER] ListDeleted - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return users, nil
+}
+
+func (s *Service) Restore(ctx context.Context, id int64) error {
+	s.logger.Debug("[USER] Restore - DEBUG: ", map[string]any{
+		"userID": id,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.repo.Restore(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.USER, id, audit.RESTORE, nil, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[USER] Restore - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Purge(ctx context.Context, id int64) error {
+	s.logger.Debug("[USER] Purge - DEBUG: ", map[string]any{
+		"userID": id,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.repo.Purge(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.USER, id, audit.PURGE, nil, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[USER] Purge - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
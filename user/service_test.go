@@ -7,7 +7,9 @@ import (
 
 	"github.com/LucasMateus-eng/operations-service/config"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
 	user_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/user"
+	"github.com/LucasMateus-eng/operations-service/internal/trash"
 	"github.com/LucasMateus-eng/operations-service/user"
 	"github.com/go-playground/assert/v2"
 	"go.uber.org/mock/gomock"
@@ -23,8 +25,9 @@ var (
 
 func TestService_GetByID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *user_mocks.MockRepository
-		logger *logging.Logging
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -71,15 +74,16 @@ func TestService_GetByID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   user_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := user.NewService(sm.repo, sm.logger)
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
 
 			actualUser, err := s.GetByID(test.args.ctx, test.args.id)
 
@@ -91,8 +95,9 @@ func TestService_GetByID(t *testing.T) {
 
 func TestService_GetByUsername(t *testing.T) {
 	type serviceMocks struct {
-		repo   *user_mocks.MockRepository
-		logger *logging.Logging
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -139,15 +144,16 @@ func TestService_GetByUsername(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   user_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := user.NewService(sm.repo, sm.logger)
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
 
 			actualUser, err := s.GetByUsername(test.args.ctx, test.args.username)
 
@@ -159,8 +165,9 @@ func TestService_GetByUsername(t *testing.T) {
 
 func TestService_GetByRole(t *testing.T) {
 	type serviceMocks struct {
-		repo   *user_mocks.MockRepository
-		logger *logging.Logging
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -207,15 +214,16 @@ func TestService_GetByRole(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   user_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := user.NewService(sm.repo, sm.logger)
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
 
 			actualUser, err := s.GetByRole(test.args.ctx, test.args.role)
 
@@ -227,8 +235,9 @@ func TestService_GetByRole(t *testing.T) {
 
 func TestService_Create(t *testing.T) {
 	type serviceMocks struct {
-		repo   *user_mocks.MockRepository
-		logger *logging.Logging
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -279,15 +288,16 @@ func TestService_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   user_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := user.NewService(sm.repo, sm.logger)
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
 
 			actualUserID, err := s.Create(test.args.ctx, test.args.u)
 
@@ -299,8 +309,9 @@ func TestService_Create(t *testing.T) {
 
 func TestService_Update(t *testing.T) {
 	type serviceMocks struct {
-		repo   *user_mocks.MockRepository
-		logger *logging.Logging
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -321,6 +332,7 @@ func TestService_Update(t *testing.T) {
 				u:   &user.User{ID: 1, Username: "newUsername"},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.u.ID).Return(p.u, nil).Times(2)
 				m.repo.EXPECT().Update(p.ctx, p.u).Return(nil)
 			},
 			wantErr: false,
@@ -332,6 +344,7 @@ func TestService_Update(t *testing.T) {
 				u:   &user.User{ID: 0},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.u.ID).Return(p.u, nil)
 				m.repo.EXPECT().Update(p.ctx, p.u).Return(errMocked)
 			},
 			wantErr: true,
@@ -344,15 +357,16 @@ func TestService_Update(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   user_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := user.NewService(sm.repo, sm.logger)
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
 
 			err := s.Update(test.args.ctx, test.args.u)
 
@@ -361,10 +375,91 @@ func TestService_Update(t *testing.T) {
 	}
 }
 
-func TestService_Delete(t *testing.T) {
+func TestService_Patch(t *testing.T) {
+	type serviceMocks struct {
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		u      *user.User
+		fields []string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado campos mutáveis quando o método Patch é chamado então apenas esses campos são gravados",
+			args: args{
+				ctx:    mockedContext,
+				u:      &user.User{ID: 1, Version: 1},
+				fields: []string{"role"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.u.ID).Return(p.u, nil).Times(2)
+				m.repo.EXPECT().Patch(p.ctx, p.u, p.fields).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado um campo imutável quando o método Patch é chamado então um erro é retornado sem gravar nada",
+			args: args{
+				ctx:    mockedContext,
+				u:      &user.User{ID: 1, Version: 1},
+				fields: []string{"id"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Patch é chamado então o erro é retornado",
+			args: args{
+				ctx:    mockedContext,
+				u:      &user.User{ID: 1, Version: 1},
+				fields: []string{"role"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.u.ID).Return(p.u, nil)
+				m.repo.EXPECT().Patch(p.ctx, p.u, p.fields).Return(errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
+
+			err := s.Patch(test.args.ctx, test.args.u, test.args.fields)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestService_Restore(t *testing.T) {
 	type serviceMocks struct {
-		repo   *user_mocks.MockRepository
-		logger *logging.Logging
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -376,27 +471,98 @@ func TestService_Delete(t *testing.T) {
 		name        string
 		args        args
 		prepareMock func(p args, m serviceMocks)
-		wantErr     bool
+		wantErr     error
 	}{
 		{
-			name: "Dado um ID válido quando o método Delete é chamado então o usuário é excluído",
+			name: "Dado um ID na lixeira quando o método Restore é chamado então o registro é restaurado",
 			args: args{
 				ctx: mockedContext,
 				id:  1,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(nil)
+				m.repo.EXPECT().Restore(p.ctx, p.id).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um registro ativo com os mesmos dados únicos quando o método Restore é chamado então um conflito é retornado",
+			args: args{
+				ctx: mockedContext,
+				id:  1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Restore(p.ctx, p.id).Return(trash.ErrRestoreConflict)
+			},
+			wantErr: trash.ErrRestoreConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
+
+			err := s.Restore(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	type serviceMocks struct {
+		repo    *user_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx     context.Context
+		id      int64
+		version int64
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado um ID válido quando o método Delete é chamado então o usuário é excluído",
+			args: args{
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&user.User{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "Dado um ID inválido quando o método Delete é chamado então um erro é retornado",
 			args: args{
-				ctx: mockedContext,
-				id:  0,
+				ctx:     mockedContext,
+				id:      0,
+				version: 1,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(errMocked)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&user.User{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(errMocked)
 			},
 			wantErr: true,
 		},
@@ -408,17 +574,18 @@ func TestService_Delete(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   user_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    user_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := user.NewService(sm.repo, sm.logger)
+			s := user.NewService(sm.repo, sm.auditor, sm.logger)
 
-			err := s.Delete(test.args.ctx, test.args.id)
+			err := s.Delete(test.args.ctx, test.args.id, test.args.version)
 
 			assert.Equal(tt, test.wantErr, err != nil)
 		})
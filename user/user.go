@@ -105,21 +105,34 @@ type User struct {
 	Username       string
 	HashedPassword string
 	Role           Role
+	Version        int64
 	CreatedAt      time.Time
 	UpdatedAt      time.Time
 	DeletedAt      time.Time
 }
 
+type UserSpecification struct {
+	Page, PageSize int
+}
+
+// ImmutableFields cannot be changed by a patch once the user is created.
+var ImmutableFields = []string{"id"}
+
 type Reading interface {
 	GetByID(ctx context.Context, id int64) (*User, error)
 	GetByUsername(ctx context.Context, username string) (*User, error)
 	GetByRole(ctx context.Context, role Role) (*User, error)
+	ListByIDs(ctx context.Context, ids []int64) (*[]User, error)
+	ListDeleted(ctx context.Context, specification *UserSpecification) (*[]User, error)
 }
 
 type Writing interface {
 	Create(ctx context.Context, u *User) (int64, error)
 	Update(ctx context.Context, u *User) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, u *User, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
+	Restore(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
 }
 
 type Repository interface {
@@ -131,7 +144,12 @@ type UseCase interface {
 	GetByID(ctx context.Context, id int64) (*User, error)
 	GetByUsername(ctx context.Context, username string) (*User, error)
 	GetByRole(ctx context.Context, role Role) (*User, error)
+	ListByIDs(ctx context.Context, ids []int64) (*[]User, error)
+	ListDeleted(ctx context.Context, specification *UserSpecification) (*[]User, error)
 	Create(ctx context.Context, u *User) (int64, error)
 	Update(ctx context.Context, u *User) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, u *User, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
+	Restore(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
 }
@@ -0,0 +1,161 @@
+package vehicle
+
+import (
+	"context"
+
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
+	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/transaction"
+)
+
+// AssignmentReading is the part of the driver-vehicle repository needed to
+// tell who is unassigned when a vehicle is decommissioned.
+type AssignmentReading interface {
+	ListDriverIDsByVehicleID(ctx context.Context, vehicleID int64) ([]int64, error)
+}
+
+// AssignmentWriting is the part of the driver-vehicle repository needed to
+// decommission a vehicle. It is declared here because the driver-vehicle
+// package already depends on this one.
+type AssignmentWriting interface {
+	EndByVehicleID(ctx context.Context, vehicleID int64) error
+	PurgeByVehicleID(ctx context.Context, vehicleID int64) error
+}
+
+// DocumentPurging is the part of the document use case needed to purge a
+// vehicle, whose documents cascade away with it. It is declared here because
+// the document package already depends on this one.
+type DocumentPurging interface {
+	VehicleFiles(ctx context.Context, vehicleID int64) ([]string, error)
+	DiscardFiles(ctx context.Context, keys []string)
+}
+
+type DecommissioningUseCase interface {
+	Decommission(ctx context.Context, id, version int64) error
+	Purge(ctx context.Context, id int64) error
+}
+
+// DecommissioningService ends every driver assignment of a vehicle, each
+// one unassigned with an event and an audit entry, and deletes the vehicle
+// as a single unit of work. Purging a vehicle from the trash removes its
+// assignments for good as well, and the files of its documents once the
+// purge is committed.
+type DecommissioningService struct {
+	transactor     transaction.Transactor
+	auditor        audit.Recorder
+	events         outbox.Emitter
+	assignments    AssignmentReading
+	assignmentRepo AssignmentWriting
+	documents      DocumentPurging
+	repo           Repository
+	logger         *logging.Logging
+}
+
+func NewDecommissioningService(t transaction.Transactor, au audit.Recorder, em outbox.Emitter, arr AssignmentReading, ar AssignmentWriting, dp DocumentPurging, r Repository, l *logging.Logging) *DecommissioningService {
+	return &DecommissioningService{
+		transactor:     t,
+		auditor:        au,
+		events:         em,
+		assignments:    arr,
+		assignmentRepo: ar,
+		documents:      dp,
+		repo:           r,
+		logger:         l,
+	}
+}
+
+func (s *DecommissioningService) Decommission(ctx context.Context, id, version int64) error {
+	s.logger.Debug("[VEHICLE] Decommission - DEBUG: ", map[string]any{
+		"vehicleID": id,
+		"version":   version,
+	})
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		driverIDs, err := s.assignments.ListDriverIDsByVehicleID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.assignmentRepo.EndByVehicleID(ctx, id); err != nil {
+			return err
+		}
+
+		if err := s.repo.Delete(ctx, id, version); err != nil {
+			return err
+		}
+
+		event, err := deletedEvent(before)
+		if err != nil {
+			return err
+		}
+
+		events := []*outbox.Event{event}
+		entries := []*audit.Entry{audit.NewEntry(audit.VEHICLE, id, audit.DELETE, before, nil)}
+		for _, driverID := range driverIDs {
+			event, err := outbox.NewUnassignedEvent(driverID, id)
+			if err != nil {
+				return err
+			}
+
+			events = append(events, event)
+			entries = append(entries, audit.NewAssignmentEntry(driverID, id, audit.DELETE, nil, nil))
+		}
+
+		if err := s.events.Emit(ctx, events...); err != nil {
+			return err
+		}
+
+		return s.auditor.Record(ctx, entries...)
+	})
+	if err != nil {
+		s.logger.Error("[VEHICLE] Decommission - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return err
+	}
+
+	return nil
+}
+
+func (s *DecommissioningService) Purge(ctx context.Context, id int64) error {
+	s.logger.Debug("[VEHICLE] Purge - DEBUG: ", map[string]any{
+		"vehicleID": id,
+	})
+
+	var files []string
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		var err error
+		files, err = s.documents.VehicleFiles(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := s.repo.Purge(ctx, id); err != nil {
+			return err
+		}
+
+		if err := s.auditor.Record(ctx, audit.NewEntry(audit.VEHICLE, id, audit.PURGE, nil, nil)); err != nil {
+			return err
+		}
+
+		return s.assignmentRepo.PurgeByVehicleID(ctx, id)
+	})
+	if err != nil {
+		s.logger.Error("[VEHICLE] Purge - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+
+		return err
+	}
+
+	s.documents.DiscardFiles(ctx, files)
+
+	return nil
+}
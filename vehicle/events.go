@@ -0,0 +1,77 @@
+package vehicle
+
+import (
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+)
+
+// EventPayload is the body of the vehicle.created, vehicle.updated and
+// vehicle.deleted events.
+type EventPayload struct {
+	ID                  int64           `json:"id"`
+	Brand               string          `json:"brand"`
+	Model               string          `json:"model"`
+	YearOfManufacture   time.Time       `json:"year_of_manufacture"`
+	Category            Category        `json:"category,omitempty"`
+	Plate               string          `json:"plate"`
+	Renavam             string          `json:"renavam"`
+	LicensingExpiryDate time.Time       `json:"licensing_expiry_date"`
+	LicensingStatus     LicensingStatus `json:"licensing_status"`
+}
+
+// LicensingStatusChangedPayload is the body of the
+// vehicle.licensing_status_changed event.
+type LicensingStatusChangedPayload struct {
+	ID             int64           `json:"id"`
+	Plate          string          `json:"plate"`
+	PreviousStatus LicensingStatus `json:"previous_status"`
+	Status         LicensingStatus `json:"status"`
+}
+
+func newEventPayload(id int64, v *Vehicle) EventPayload {
+	return EventPayload{
+		ID:                  id,
+		Brand:               v.Attributes.Brand,
+		Model:               v.Attributes.Model,
+		YearOfManufacture:   v.Attributes.YearOfManufacture,
+		Category:            v.Attributes.Category,
+		Plate:               v.LegalInformation.Plate,
+		Renavam:             v.LegalInformation.Renavam,
+		LicensingExpiryDate: v.LegalInformation.Licensing.ExpiryDate,
+		LicensingStatus:     v.LegalInformation.Licensing.Status,
+	}
+}
+
+func createdEvent(id int64, v *Vehicle) (*outbox.Event, error) {
+	return outbox.NewEvent(outbox.VEHICLE, id, outbox.VEHICLE_CREATED, newEventPayload(id, v))
+}
+
+// updatedEvents describes the change from before to after. A change of the
+// licensing status is also published on its own.
+func updatedEvents(before, after *Vehicle) ([]*outbox.Event, error) {
+	updated, err := outbox.NewEvent(outbox.VEHICLE, after.ID, outbox.VEHICLE_UPDATED, newEventPayload(after.ID, after))
+	if err != nil {
+		return nil, err
+	}
+
+	events := []*outbox.Event{updated}
+	if before.LegalInformation.Licensing.Status != after.LegalInformation.Licensing.Status {
+		changed, err := outbox.NewEvent(outbox.VEHICLE, after.ID, outbox.VEHICLE_LICENSING_STATUS_CHANGED, LicensingStatusChangedPayload{
+			ID:             after.ID,
+			Plate:          after.LegalInformation.Plate,
+			PreviousStatus: before.LegalInformation.Licensing.Status,
+			Status:         after.LegalInformation.Licensing.Status,
+		})
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, changed)
+	}
+
+	return events, nil
+}
+
+func deletedEvent(v *Vehicle) (*outbox.Event, error) {
+	return outbox.NewEvent(outbox.VEHICLE, v.ID, outbox.VEHICLE_DELETED, newEventPayload(v.ID, v))
+}
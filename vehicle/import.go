@@ -0,0 +1,112 @@
+package vehicle
+
+import (
+	"fmt"
+
+	"github.com/LucasMateus-eng/operations-service/internal/validation"
+)
+
+// ImportRow is a single vehicle read from a spreadsheet. Err carries any
+// failure that happened while the row was being parsed, before validation.
+type ImportRow struct {
+	Line    int
+	Vehicle *Vehicle
+	Err     error
+}
+
+type ImportRowError struct {
+	Line   int      `json:"line"`
+	Plate  string   `json:"plate,omitempty"`
+	Errors []string `json:"errors"`
+}
+
+type ImportReport struct {
+	DryRun     bool             `json:"dry_run"`
+	Committed  bool             `json:"committed"`
+	TotalRows  int              `json:"total_rows"`
+	ValidRows  int              `json:"valid_rows"`
+	VehicleIDs []int64          `json:"vehicle_ids,omitempty"`
+	Errors     []ImportRowError `json:"errors,omitempty"`
+}
+
+func (r *ImportReport) HasErrors() bool {
+	return len(r.Errors) > 0
+}
+
+func newImportRowError(line int, plate string, errs ...error) ImportRowError {
+	return ImportRowError{
+		Line:   line,
+		Plate:  plate,
+		Errors: validation.Messages(errs...),
+	}
+}
+
+// importKeys returns the canonical plates and the renavams of the rows that
+// were parsed, to look up the vehicles that already hold them.
+func importKeys(rows []ImportRow) ([]string, []string) {
+	plates := make([]string, 0, len(rows))
+	renavams := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.Err != nil {
+			continue
+		}
+
+		plates = append(plates, NormalizePlate(row.Vehicle.LegalInformation.Plate))
+		renavams = append(renavams, row.Vehicle.LegalInformation.Renavam)
+	}
+
+	return plates, renavams
+}
+
+// validateImportRows validates each row on its own and also looks for plates
+// and renavams repeated inside the same file or already held by one of the
+// existing vehicles.
+func validateImportRows(rows []ImportRow, existing []Vehicle) []ImportRowError {
+	var rowErrors []ImportRowError
+
+	plates := make(map[string]int, len(rows))
+	renavams := make(map[string]int, len(rows))
+
+	existingPlates := make(map[string]int64, len(existing))
+	existingRenavams := make(map[string]int64, len(existing))
+	for _, v := range existing {
+		existingPlates[NormalizePlate(v.LegalInformation.Plate)] = v.ID
+		existingRenavams[v.LegalInformation.Renavam] = v.ID
+	}
+
+	for _, row := range rows {
+		if row.Err != nil {
+			rowErrors = append(rowErrors, newImportRowError(row.Line, "", row.Err))
+			continue
+		}
+
+		var errs []error
+		if err := row.Vehicle.Validate(); err != nil {
+			errs = append(errs, err)
+		}
+
+		plate := NormalizePlate(row.Vehicle.LegalInformation.Plate)
+		if vehicleID, ok := existingPlates[plate]; ok {
+			errs = append(errs, fmt.Errorf("the vehicle plate already belongs to vehicle %d", vehicleID))
+		} else if line, ok := plates[plate]; ok {
+			errs = append(errs, fmt.Errorf("the vehicle plate is duplicated in the file, first seen on line %d", line))
+		} else {
+			plates[plate] = row.Line
+		}
+
+		renavam := row.Vehicle.LegalInformation.Renavam
+		if vehicleID, ok := existingRenavams[renavam]; ok {
+			errs = append(errs, fmt.Errorf("the vehicle renavam already belongs to vehicle %d", vehicleID))
+		} else if line, ok := renavams[renavam]; ok {
+			errs = append(errs, fmt.Errorf("the vehicle renavam is duplicated in the file, first seen on line %d", line))
+		} else {
+			renavams[renavam] = row.Line
+		}
+
+		if len(errs) > 0 {
+			rowErrors = append(rowErrors, newImportRowError(row.Line, plate, errs...))
+		}
+	}
+
+	return rowErrors
+}
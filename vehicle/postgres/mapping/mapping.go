@@ -11,10 +11,13 @@ func MapVehicleToDTO(vehicle *vehicle.Vehicle) *vehicle_dto.VehicleDTO {
 		Brand:               vehicle.Attributes.Brand,
 		Model:               vehicle.Attributes.Model,
 		YearOfManufacture:   vehicle.Attributes.YearOfManufacture,
+		Category:            string(vehicle.Attributes.Category),
 		Plate:               vehicle.LegalInformation.Plate,
 		Renavam:             vehicle.LegalInformation.Renavam,
 		LicensingExpiryDate: vehicle.LegalInformation.Licensing.ExpiryDate,
 		LicensingStatus:     vehicle.LegalInformation.Licensing.Status.String(),
+		Odometer:            vehicle.Odometer,
+		Version:             vehicle.Version,
 		CreatedAt:           vehicle.CreatedAt,
 		UpdatedAt:           vehicle.UpdatedAt,
 		DeletedAt:           vehicle.DeletedAt,
@@ -33,6 +36,7 @@ func MapDTOToVehicle(vehicleDTO *vehicle_dto.VehicleDTO) (*vehicle.Vehicle, erro
 			Brand:             vehicleDTO.Brand,
 			Model:             vehicleDTO.Model,
 			YearOfManufacture: vehicleDTO.YearOfManufacture,
+			Category:          vehicle.Category(vehicleDTO.Category),
 		},
 		LegalInformation: vehicle.VehicleLegalInformation{
 			Plate:   vehicleDTO.Plate,
@@ -42,6 +46,8 @@ func MapDTOToVehicle(vehicleDTO *vehicle_dto.VehicleDTO) (*vehicle.Vehicle, erro
 				Status:     licensingStatus,
 			},
 		},
+		Odometer:  vehicleDTO.Odometer,
+		Version:   vehicleDTO.Version,
 		CreatedAt: vehicleDTO.CreatedAt,
 		UpdatedAt: vehicleDTO.UpdatedAt,
 		DeletedAt: vehicleDTO.DeletedAt,
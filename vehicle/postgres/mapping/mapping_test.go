@@ -62,6 +62,7 @@ func TestMapDTOToVehicle(t *testing.T) {
 		Renavam:             "123456789",
 		LicensingExpiryDate: mockedTime,
 		LicensingStatus:     "REGULAR",
+		Odometer:            42000,
 		CreatedAt:           mockedTime,
 		UpdatedAt:           mockedTime,
 		DeletedAt:           mockedTime,
@@ -96,6 +97,7 @@ func TestMapDTOToVehicle(t *testing.T) {
 				Status:     vehicle.REGULAR,
 			},
 		},
+		Odometer:  vehicleDTO.Odometer,
 		CreatedAt: vehicleDTO.CreatedAt,
 		UpdatedAt: vehicleDTO.UpdatedAt,
 		DeletedAt: vehicleDTO.DeletedAt,
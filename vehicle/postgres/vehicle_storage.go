@@ -2,8 +2,10 @@ package postgres
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
+	db_postgres "github.com/LucasMateus-eng/operations-service/internal/db/postgres"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 	"github.com/LucasMateus-eng/operations-service/vehicle/postgres/dto"
 	"github.com/LucasMateus-eng/operations-service/vehicle/postgres/mapping"
@@ -20,10 +22,27 @@ func New(db *bun.DB) *vehiclePostgresRepo {
 	}
 }
 
+func (vr *vehiclePostgresRepo) conn(ctx context.Context) bun.IDB {
+	return db_postgres.Conn(ctx, vr.db)
+}
+
+// selectVehicles reads the vehicles along with their current odometer, the
+// km of their latest odometer record.
+func (vr *vehiclePostgresRepo) selectVehicles(ctx context.Context, model any) *bun.SelectQuery {
+	return vr.conn(ctx).NewSelect().
+		Model(model).
+		ColumnExpr("?TableAlias.*").
+		ColumnExpr(`COALESCE((
+			SELECT o.km FROM odometer_records AS o
+			WHERE o.vehicle_id = ?TableAlias.id
+			ORDER BY o.read_at DESC, o.id DESC LIMIT 1
+		), 0) AS odometer`)
+}
+
 func (vr *vehiclePostgresRepo) GetByID(ctx context.Context, id int64) (*vehicle.Vehicle, error) {
 	var vehicleDTO dto.VehicleDTO
 
-	err := vr.db.NewSelect().Model(&vehicleDTO).Where("id = ?", id).Scan(ctx)
+	err := vr.selectVehicles(ctx, &vehicleDTO).Where("id = ?", id).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +58,7 @@ func (vr *vehiclePostgresRepo) GetByID(ctx context.Context, id int64) (*vehicle.
 func (vr *vehiclePostgresRepo) GetByPlate(ctx context.Context, plate string) (*vehicle.Vehicle, error) {
 	var vehicleDTO dto.VehicleDTO
 
-	err := vr.db.NewSelect().Model(&vehicleDTO).Where("plate = ?", plate).Scan(ctx)
+	err := vr.selectVehicles(ctx, &vehicleDTO).Where("plate = ?", plate).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +74,7 @@ func (vr *vehiclePostgresRepo) GetByPlate(ctx context.Context, plate string) (*v
 func (vr *vehiclePostgresRepo) GetByRenavam(ctx context.Context, renavam string) (*vehicle.Vehicle, error) {
 	var vehicleDTO dto.VehicleDTO
 
-	err := vr.db.NewSelect().Model(&vehicleDTO).Where("renavam = ?", renavam).Scan(ctx)
+	err := vr.selectVehicles(ctx, &vehicleDTO).Where("renavam = ?", renavam).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -68,36 +87,163 @@ func (vr *vehiclePostgresRepo) GetByRenavam(ctx context.Context, renavam string)
 	return mappedValue, nil
 }
 
+// ListByIDs returns the vehicles with the given ids in a single query. Ids
+// without a vehicle are left out of the result.
+func (vr *vehiclePostgresRepo) ListByIDs(ctx context.Context, ids []int64) (*[]vehicle.Vehicle, error) {
+	var vehicleDTOs []dto.VehicleDTO
+
+	err := vr.selectVehicles(ctx, &vehicleDTOs).Where("id IN (?)", bun.In(ids)).Order("id ASC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vehicles []vehicle.Vehicle
+	for _, dto := range vehicleDTOs {
+		mappedValue, err := mapping.MapDTOToVehicle(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		vehicles = append(vehicles, *mappedValue)
+	}
+
+	return &vehicles, nil
+}
+
+// ListByPlatesOrRenavams returns the active vehicles that hold any of the
+// given plates or renavams in a single query.
+func (vr *vehiclePostgresRepo) ListByPlatesOrRenavams(ctx context.Context, plates, renavams []string) (*[]vehicle.Vehicle, error) {
+	var vehicleDTOs []dto.VehicleDTO
+
+	err := vr.selectVehicles(ctx, &vehicleDTOs).
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Where("plate IN (?)", bun.In(plates)).WhereOr("renavam IN (?)", bun.In(renavams))
+		}).
+		Order("id ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vehicles []vehicle.Vehicle
+	for _, dto := range vehicleDTOs {
+		mappedValue, err := mapping.MapDTOToVehicle(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		vehicles = append(vehicles, *mappedValue)
+	}
+
+	return &vehicles, nil
+}
+
 func (vr *vehiclePostgresRepo) List(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
 	var vehicleDTOs []dto.VehicleDTO
 
-	query := vr.db.NewSelect().Model(&vehicleDTOs).Order("id ASC")
+	query := applySpecification(vr.selectVehicles(ctx, &vehicleDTOs), specification)
+
+	err := query.Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vehicles []vehicle.Vehicle
+	for _, dto := range vehicleDTOs {
+		mappedValue, err := mapping.MapDTOToVehicle(&dto)
+		if err != nil {
+			return nil, err
+		}
+
+		vehicles = append(vehicles, *mappedValue)
+	}
+
+	return &vehicles, nil
+}
+
+// Iterate walks through the vehicles matching the specification one row at
+// a time, so that exports do not need to hold every vehicle in memory.
+func (vr *vehiclePostgresRepo) Iterate(ctx context.Context, specification *vehicle.VehicleSpectification, fn func(v *vehicle.Vehicle) error) error {
+	query := applySpecification(vr.selectVehicles(ctx, (*dto.VehicleDTO)(nil)), specification)
+
+	rows, err := query.Rows(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var vehicleDTO dto.VehicleDTO
+		if err := vr.db.ScanRow(ctx, rows, &vehicleDTO); err != nil {
+			return err
+		}
+
+		mappedValue, err := mapping.MapDTOToVehicle(&vehicleDTO)
+		if err != nil {
+			return err
+		}
+
+		if err := fn(mappedValue); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func applySpecification(query *bun.SelectQuery, specification *vehicle.VehicleSpectification) *bun.SelectQuery {
+	query = query.Order("id ASC")
 
 	if specification.Page > 0 && specification.PageSize > 0 {
 		offset := (specification.Page - 1) * specification.PageSize
 		query = query.Offset(offset).Limit(specification.PageSize)
 	}
 
-	if len(strings.TrimSpace(specification.Attributes.Brand)) == 0 {
+	if len(strings.TrimSpace(specification.Attributes.Brand)) > 0 {
 		query = query.Where("brand = ?", specification.Attributes.Brand)
 	}
 
-	if len(strings.TrimSpace(specification.Attributes.Model)) == 0 {
+	if len(strings.TrimSpace(specification.Attributes.Model)) > 0 {
 		query = query.Where("model = ?", specification.Attributes.Model)
 	}
 
-	if len(strings.TrimSpace(specification.Attributes.YearOfManufacture.Format("2006/01/02"))) == 0 {
-		query = query.Where("year_of_manufacture = ?", specification.Attributes.YearOfManufacture.Format("2006/01/02"))
+	if !specification.Attributes.YearOfManufacture.IsZero() {
+		query = query.Where("year_of_manufacture = ?", specification.Attributes.YearOfManufacture.Format("2006-01-02"))
+	}
+
+	if specification.Attributes.Category != "" {
+		query = query.Where("category = ?", specification.Attributes.Category)
 	}
 
-	if len(strings.TrimSpace(specification.Licensing.ExpiryDate.Format("2006-01-02 -07:00:00"))) == 0 {
-		query = query.Where("licensing_expiry_date = ?", specification.Licensing.ExpiryDate.Format("2006-01-02 -07:00:00"))
+	if !specification.Licensing.ExpiryDate.IsZero() {
+		query = query.Where("licensing_expiry_date::date = ?", specification.Licensing.ExpiryDate.Format("2006-01-02"))
 	}
 
-	if len(strings.TrimSpace(specification.Licensing.Status.String())) == 0 {
+	if specification.Licensing.Status != vehicle.UNDEFINED {
 		query = query.Where("licensing_status = ?", specification.Licensing.Status.String())
 	}
 
+	if specification.Uninsured {
+		query = query.Where(`NOT EXISTS (
+			SELECT 1 FROM insurance_policies AS p
+			WHERE p.vehicle_id = ?TableAlias.id AND p.starts_at <= current_timestamp AND p.ends_at > current_timestamp
+		)`)
+	}
+
+	return query
+}
+
+// ListDeleted returns the vehicles in the trash, most recently deleted first.
+func (vr *vehiclePostgresRepo) ListDeleted(ctx context.Context, specification *vehicle.VehicleSpectification) (*[]vehicle.Vehicle, error) {
+	var vehicleDTOs []dto.VehicleDTO
+
+	query := vr.selectVehicles(ctx, &vehicleDTOs).WhereDeleted().Order("deleted_at DESC", "id ASC")
+
+	if specification.Page > 0 && specification.PageSize > 0 {
+		offset := (specification.Page - 1) * specification.PageSize
+		query = query.Offset(offset).Limit(specification.PageSize)
+	}
+
 	err := query.Scan(ctx)
 	if err != nil {
 		return nil, err
@@ -121,7 +267,7 @@ func (vr *vehiclePostgresRepo) Create(ctx context.Context, v *vehicle.Vehicle) (
 
 	vehicleDTO := mapping.MapVehicleToDTO(v)
 
-	query := vr.db.NewInsert().Model(vehicleDTO).On("CONFLICT (id) DO UPDATE").Returning("id")
+	query := vr.conn(ctx).NewInsert().Model(vehicleDTO).Returning("id")
 
 	err := query.Scan(ctx, &vehicleID)
 	if err != nil {
@@ -131,19 +277,102 @@ func (vr *vehiclePostgresRepo) Create(ctx context.Context, v *vehicle.Vehicle) (
 	return vehicleID, nil
 }
 
+func (vr *vehiclePostgresRepo) CreateBatch(ctx context.Context, vs []vehicle.Vehicle) ([]int64, error) {
+	vehicleIDs := make([]int64, 0, len(vs))
+
+	err := db_postgres.WithinTransaction(ctx, vr.db, func(ctx context.Context) error {
+		for i := range vs {
+			var vehicleID int64
+
+			vehicleDTO := mapping.MapVehicleToDTO(&vs[i])
+
+			err := vr.conn(ctx).NewInsert().Model(vehicleDTO).Returning("id").Scan(ctx, &vehicleID)
+			if err != nil {
+				return fmt.Errorf("vehicle with plate [%s]: %w", vehicleDTO.Plate, err)
+			}
+
+			vehicleIDs = append(vehicleIDs, vehicleID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vehicleIDs, nil
+}
+
 func (vr *vehiclePostgresRepo) Update(ctx context.Context, v *vehicle.Vehicle) error {
 	vehicleDTO := mapping.MapVehicleToDTO(v)
 
-	_, err := vr.db.NewUpdate().Model(vehicleDTO).
+	res, err := vr.conn(ctx).NewUpdate().Model(vehicleDTO).
 		OmitZero().
 		ExcludeColumn("plate", "renavam", "deleted_at").
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
+		Where("id = ?", vehicleDTO.ID).
+		Where("version = ?", v.Version).
+		Returning("version").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, vr.conn(ctx), (*dto.VehicleDTO)(nil), vehicleDTO.ID, res); err != nil {
+		return err
+	}
+
+	v.Version = vehicleDTO.Version
+
+	return nil
+}
+
+func (vr *vehiclePostgresRepo) Patch(ctx context.Context, v *vehicle.Vehicle, fields []string) error {
+	columns, err := db_postgres.PatchColumns(fields, "brand", "model", "year_of_manufacture", "category", "licensing_expiry_date", "licensing_status")
+	if err != nil {
+		return err
+	}
+
+	vehicleDTO := mapping.MapVehicleToDTO(v)
+
+	res, err := vr.conn(ctx).NewUpdate().Model(vehicleDTO).
+		Column(columns...).
+		Value("version", "version + 1").
+		Value("updated_at", "current_timestamp").
 		Where("id = ?", vehicleDTO.ID).
+		Where("version = ?", v.Version).
+		Returning("version").
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := db_postgres.CheckVersion(ctx, vr.conn(ctx), (*dto.VehicleDTO)(nil), vehicleDTO.ID, res); err != nil {
+		return err
+	}
+
+	v.Version = vehicleDTO.Version
+
+	return nil
+}
+
+func (vr *vehiclePostgresRepo) Delete(ctx context.Context, id, version int64) error {
+	res, err := vr.conn(ctx).NewDelete().Model((*dto.VehicleDTO)(nil)).
+		Where("id = ?", id).
+		Where("version = ?", version).
 		Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	return db_postgres.CheckVersion(ctx, vr.conn(ctx), (*dto.VehicleDTO)(nil), id, res)
+}
 
-	return err
+func (vr *vehiclePostgresRepo) Restore(ctx context.Context, id int64) error {
+	return db_postgres.Restore(ctx, vr.conn(ctx), (*dto.VehicleDTO)(nil), id)
 }
 
-func (vr *vehiclePostgresRepo) Delete(ctx context.Context, id int64) error {
-	_, err := vr.db.NewDelete().Model((*dto.VehicleDTO)(nil)).Where("id = ?", id).Exec(ctx)
-	return err
+func (vr *vehiclePostgresRepo) Purge(ctx context.Context, id int64) error {
+	return db_postgres.Purge(ctx, vr.conn(ctx), (*dto.VehicleDTO)(nil), id)
 }
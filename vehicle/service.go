@@ -3,18 +3,25 @@ package vehicle
 import (
 	"context"
 
+	"github.com/LucasMateus-eng/operations-service/internal/audit"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	"github.com/LucasMateus-eng/operations-service/internal/mergepatch"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
 )
 
 type Service struct {
-	repo   Repository
-	logger *logging.Logging
+	repo    Repository
+	auditor audit.Recorder
+	events  outbox.Emitter
+	logger  *logging.Logging
 }
 
-func NewService(r Repository, l *logging.Logging) *Service {
+func NewService(r Repository, au audit.Recorder, em outbox.Emitter, l *logging.Logging) *Service {
 	return &Service{
-		repo:   r,
-		logger: l,
+		repo:    r,
+		auditor: au,
+		events:  em,
+		logger:  l,
 	}
 }
 
@@ -37,7 +44,7 @@ func (s *Service) GetByPlate(ctx context.Context, plate string) (*Vehicle, error
 	s.logger.Debug("[VEHICLE] GetByPlate - DEBUG: ", map[string]any{
 		"vehiclePlate": plate,
 	})
-	vehicle, err := s.repo.GetByPlate(ctx, plate)
+	vehicle, err := s.repo.GetByPlate(ctx, NormalizePlate(plate))
 	if err != nil {
 		s.logger.Error("[VEHICLE] GetByPlate - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -63,6 +70,21 @@ func (s *Service) GetByRenavam(ctx context.Context, renavam string) (*Vehicle, e
 	return vehicle, nil
 }
 
+func (s *Service) ListByIDs(ctx context.Context, ids []int64) (*[]Vehicle, error) {
+	s.logger.Debug("[VEHICLE] ListByIDs - DEBUG: ", map[string]any{
+		"ids": ids,
+	})
+	vehicles, err := s.repo.ListByIDs(ctx, ids)
+	if err != nil {
+		s.logger.Error("[VEHICLE] ListByIDs - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return vehicles, nil
+}
+
 func (s *Service) List(ctx context.Context, specification *VehicleSpectification) (*[]Vehicle, error) {
 	s.logger.Debug("[VEHICLE] List - DEBUG: ", map[string]any{
 		"specification": specification,
@@ -78,11 +100,46 @@ func (s *Service) List(ctx context.Context, specification *VehicleSpectification
 	return vehicles, nil
 }
 
+func (s *Service) Export(ctx context.Context, specification *VehicleSpectification, fn func(v *Vehicle) error) error {
+	s.logger.Debug("[VEHICLE] Export - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	err := s.repo.Iterate(ctx, specification, fn)
+	if err != nil {
+		s.logger.Error("[VEHICLE] Export - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
 func (s *Service) Create(ctx context.Context, v *Vehicle) (int64, error) {
 	s.logger.Debug("[VEHICLE] Create - DEBUG: ", map[string]any{
 		"vehicle": v,
 	})
-	vehicleID, err := s.repo.Create(ctx, v)
+	v.LegalInformation.Plate = NormalizePlate(v.LegalInformation.Plate)
+
+	var vehicleID int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		vehicleID, err = s.repo.Create(ctx, v)
+		if err != nil {
+			return nil, err
+		}
+
+		event, err := createdEvent(vehicleID, v)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.VEHICLE, vehicleID, audit.CREATE, nil, v)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[VEHICLE] Create - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -93,11 +150,115 @@ func (s *Service) Create(ctx context.Context, v *Vehicle) (int64, error) {
 	return vehicleID, nil
 }
 
+func (s *Service) Import(ctx context.Context, rows []ImportRow, dryRun bool) (*ImportReport, error) {
+	s.logger.Debug("[VEHICLE] Import - DEBUG: ", map[string]any{
+		"rows":   len(rows),
+		"dryRun": dryRun,
+	})
+
+	var existing []Vehicle
+	if plates, renavams := importKeys(rows); len(plates) > 0 {
+		vehicles, err := s.repo.ListByPlatesOrRenavams(ctx, plates, renavams)
+		if err != nil {
+			s.logger.Error("[VEHICLE] Import - ERROR: ", map[string]any{
+				"err": err.Error(),
+			})
+			return nil, err
+		}
+
+		existing = *vehicles
+	}
+
+	report := &ImportReport{
+		DryRun:    dryRun,
+		TotalRows: len(rows),
+		Errors:    validateImportRows(rows, existing),
+	}
+	report.ValidRows = report.TotalRows - len(report.Errors)
+
+	if report.HasErrors() || dryRun {
+		return report, nil
+	}
+
+	vehicles := make([]Vehicle, 0, len(rows))
+	for _, row := range rows {
+		v := *row.Vehicle
+		v.LegalInformation.Plate = NormalizePlate(v.LegalInformation.Plate)
+		vehicles = append(vehicles, v)
+	}
+
+	var vehicleIDs []int64
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		var err error
+		vehicleIDs, err = s.repo.CreateBatch(ctx, vehicles)
+		if err != nil {
+			return nil, err
+		}
+
+		entries := make([]*audit.Entry, len(vehicleIDs))
+		events := make([]*outbox.Event, len(vehicleIDs))
+		for i, vehicleID := range vehicleIDs {
+			entries[i] = audit.NewEntry(audit.VEHICLE, vehicleID, audit.CREATE, nil, &vehicles[i])
+
+			events[i], err = createdEvent(vehicleID, &vehicles[i])
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := s.events.Emit(ctx, events...); err != nil {
+			return nil, err
+		}
+
+		return entries, nil
+	})
+	if err != nil {
+		s.logger.Error("[VEHICLE] Import - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	report.VehicleIDs = vehicleIDs
+	report.Committed = true
+
+	return report, nil
+}
+
 func (s *Service) Update(ctx context.Context, v *Vehicle) error {
 	s.logger.Debug("[VEHICLE] Update - DEBUG: ", map[string]any{
 		"vehicle": v,
 	})
-	err := s.repo.Update(ctx, v)
+	v.LegalInformation.Plate = NormalizePlate(v.LegalInformation.Plate)
+
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Update(ctx, v); err != nil {
+			return nil, err
+		}
+
+		// The stored row is read back because the caller's value does not
+		// carry every column, e.g. those a patch leaves untouched.
+		after, err := s.repo.GetByID(ctx, v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		events, err := updatedEvents(before, after)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, events...); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.VEHICLE, v.ID, audit.UPDATE, before, after)}, nil
+	})
 	if err != nil {
 		s.logger.Error("[VEHICLE] Update - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -108,11 +269,81 @@ func (s *Service) Update(ctx context.Context, v *Vehicle) error {
 	return nil
 }
 
-func (s *Service) Delete(ctx context.Context, id int64) error {
+// Patch writes only the given fields of the vehicle, which must carry the
+// version it was read with.
+func (s *Service) Patch(ctx context.Context, v *Vehicle, fields []string) error {
+	s.logger.Debug("[VEHICLE] Patch - DEBUG: ", map[string]any{
+		"vehicle": v,
+		"fields":  fields,
+	})
+	if err := mergepatch.CheckImmutable(fields, ImmutableFields...); err != nil {
+		return err
+	}
+
+	v.LegalInformation.Plate = NormalizePlate(v.LegalInformation.Plate)
+
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Patch(ctx, v, fields); err != nil {
+			return nil, err
+		}
+
+		after, err := s.repo.GetByID(ctx, v.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		events, err := updatedEvents(before, after)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, events...); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.VEHICLE, v.ID, audit.UPDATE, before, after)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[VEHICLE] Patch - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) Delete(ctx context.Context, id, version int64) error {
 	s.logger.Debug("[VEHICLE] Delete - DEBUG: ", map[string]any{
 		"vehicleID": id,
+		"version":   version,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		before, err := s.repo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.repo.Delete(ctx, id, version); err != nil {
+			return nil, err
+		}
+
+		event, err := deletedEvent(before)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := s.events.Emit(ctx, event); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.VEHICLE, id, audit.DELETE, before, nil)}, nil
 	})
-	err := s.repo.Delete(ctx, id)
 	if err != nil {
 		s.logger.Error("[VEHICLE] Delete - ERROR: ", map[string]any{
 			"err": err.Error(),
@@ -122,3 +353,39 @@ func (s *Service) Delete(ctx context.Context, id int64) error {
 
 	return nil
 }
+
+func (s *Service) ListDeleted(ctx context.Context, specification *VehicleSpectification) (*[]Vehicle, error) {
+	s.logger.Debug("[VEHICLE] ListDeleted - DEBUG: ", map[string]any{
+		"specification": specification,
+	})
+	vehicles, err := s.repo.ListDeleted(ctx, specification)
+	if err != nil {
+		s.logger.Error("[VEHICLE] ListDeleted - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return nil, err
+	}
+
+	return vehicles, nil
+}
+
+func (s *Service) Restore(ctx context.Context, id int64) error {
+	s.logger.Debug("[VEHICLE] Restore - DEBUG: ", map[string]any{
+		"vehicleID": id,
+	})
+	err := s.auditor.Track(ctx, func(ctx context.Context) ([]*audit.Entry, error) {
+		if err := s.repo.Restore(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return []*audit.Entry{audit.NewEntry(audit.VEHICLE, id, audit.RESTORE, nil, nil)}, nil
+	})
+	if err != nil {
+		s.logger.Error("[VEHICLE] Restore - ERROR: ", map[string]any{
+			"err": err.Error(),
+		})
+		return err
+	}
+
+	return nil
+}
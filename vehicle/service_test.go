@@ -4,10 +4,16 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/LucasMateus-eng/operations-service/config"
+	"github.com/LucasMateus-eng/operations-service/internal/concurrency"
 	"github.com/LucasMateus-eng/operations-service/internal/logging"
+	audit_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/audit"
+	outbox_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/outbox"
 	vehicle_mocks "github.com/LucasMateus-eng/operations-service/internal/mocks/vehicle"
+	"github.com/LucasMateus-eng/operations-service/internal/outbox"
+	"github.com/LucasMateus-eng/operations-service/internal/trash"
 	"github.com/LucasMateus-eng/operations-service/vehicle"
 	"github.com/go-playground/assert/v2"
 	"go.uber.org/mock/gomock"
@@ -22,12 +28,37 @@ var (
 	expectedVehicles = &[]vehicle.Vehicle{
 		*expectedVehicle,
 	}
+	validVehicle = vehicle.Vehicle{
+		Attributes: vehicle.VehicleAttributes{
+			Brand:             "Toyota",
+			Model:             "Corolla",
+			YearOfManufacture: time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		},
+		LegalInformation: vehicle.VehicleLegalInformation{
+			Plate:   "ABC1D23",
+			Renavam: "00639884962",
+			Licensing: vehicle.Licensing{
+				ExpiryDate: time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+				Status:     vehicle.REGULAR,
+			},
+		},
+	}
 )
 
+// newEmitter accepts every event emitted by a test that does not check them.
+func newEmitter(ctrl *gomock.Controller) *outbox_mocks.MockEmitter {
+	events := outbox_mocks.NewMockEmitter(ctrl)
+	events.EXPECT().Emit(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	return events
+}
+
 func TestService_GetByID(t *testing.T) {
 	type serviceMocks struct {
-		repo   *vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -74,15 +105,17 @@ func TestService_GetByID(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := vehicle.NewService(sm.repo, sm.logger)
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualVehicle, err := s.GetByID(test.args.ctx, test.args.id)
 
@@ -94,8 +127,10 @@ func TestService_GetByID(t *testing.T) {
 
 func TestService_GetByPlate(t *testing.T) {
 	type serviceMocks struct {
-		repo   *vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -111,13 +146,13 @@ func TestService_GetByPlate(t *testing.T) {
 		wantErr     bool
 	}{
 		{
-			name: "Dado uma placa válida quando o método GetByPlate é chamado então o veículo é retornado",
+			name: "Dado uma placa com hífen quando o método GetByPlate é chamado então o veículo é buscado pela placa canônica",
 			args: args{
 				ctx:   mockedContext,
-				plate: "ABC-1234",
+				plate: "abc-1234",
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().GetByPlate(p.ctx, p.plate).Return(expectedVehicle, nil)
+				m.repo.EXPECT().GetByPlate(p.ctx, "ABC1234").Return(expectedVehicle, nil)
 			},
 			want:    expectedVehicle,
 			wantErr: false,
@@ -142,15 +177,17 @@ func TestService_GetByPlate(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := vehicle.NewService(sm.repo, sm.logger)
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualVehicle, err := s.GetByPlate(test.args.ctx, test.args.plate)
 
@@ -162,8 +199,10 @@ func TestService_GetByPlate(t *testing.T) {
 
 func TestService_GetByRenavam(t *testing.T) {
 	type serviceMocks struct {
-		repo   *vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -210,15 +249,17 @@ func TestService_GetByRenavam(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := vehicle.NewService(sm.repo, sm.logger)
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualVehicle, err := s.GetByRenavam(test.args.ctx, test.args.renavam)
 
@@ -230,8 +271,10 @@ func TestService_GetByRenavam(t *testing.T) {
 
 func TestService_List(t *testing.T) {
 	type serviceMocks struct {
-		repo   *vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -285,15 +328,17 @@ func TestService_List(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := vehicle.NewService(sm.repo, sm.logger)
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualVehicles, err := s.List(test.args.ctx, test.args.specification)
 
@@ -303,10 +348,96 @@ func TestService_List(t *testing.T) {
 	}
 }
 
+func TestService_Export(t *testing.T) {
+	type serviceMocks struct {
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx           context.Context
+		specification *vehicle.VehicleSpectification
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        []vehicle.Vehicle
+		wantErr     bool
+	}{
+		{
+			name: "Dado uma especificação válida quando o método Export é chamado então cada veículo é repassado ao callback",
+			args: args{
+				ctx:           mockedContext,
+				specification: &vehicle.VehicleSpectification{},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Iterate(p.ctx, p.specification, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ *vehicle.VehicleSpectification, fn func(v *vehicle.Vehicle) error) error {
+						for i := range *expectedVehicles {
+							if err := fn(&(*expectedVehicles)[i]); err != nil {
+								return err
+							}
+						}
+						return nil
+					})
+			},
+			want:    *expectedVehicles,
+			wantErr: false,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Export é chamado então o erro é retornado",
+			args: args{
+				ctx:           mockedContext,
+				specification: &vehicle.VehicleSpectification{},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Iterate(p.ctx, p.specification, gomock.Any()).Return(errMocked)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
+
+			var actualVehicles []vehicle.Vehicle
+			err := s.Export(test.args.ctx, test.args.specification, func(v *vehicle.Vehicle) error {
+				actualVehicles = append(actualVehicles, *v)
+				return nil
+			})
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, actualVehicles)
+		})
+	}
+}
+
 func TestService_Create(t *testing.T) {
 	type serviceMocks struct {
-		repo   *vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -353,15 +484,17 @@ func TestService_Create(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := vehicle.NewService(sm.repo, sm.logger)
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			actualVehicleID, err := s.Create(test.args.ctx, test.args.v)
 
@@ -371,10 +504,209 @@ func TestService_Create(t *testing.T) {
 	}
 }
 
+func TestService_Import(t *testing.T) {
+	type serviceMocks struct {
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		rows   []vehicle.ImportRow
+		dryRun bool
+	}
+
+	anotherValidVehicle := validVehicle
+	anotherValidVehicle.LegalInformation.Plate = "ABC-1234"
+	anotherValidVehicle.LegalInformation.Renavam = "12345678900"
+
+	invalidVehicle := validVehicle
+	invalidVehicle.LegalInformation.Plate = "AB-12"
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		want        *vehicle.ImportReport
+		wantErr     bool
+	}{
+		{
+			name: "Dado linhas válidas quando o método Import é chamado então os veículos são criados em lote com as placas canônicas",
+			args: args{
+				ctx: mockedContext,
+				rows: []vehicle.ImportRow{
+					{Line: 2, Vehicle: &validVehicle},
+					{Line: 3, Vehicle: &anotherValidVehicle},
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				canonicalVehicle := anotherValidVehicle
+				canonicalVehicle.LegalInformation.Plate = "ABC1234"
+
+				m.repo.EXPECT().ListByPlatesOrRenavams(p.ctx, []string{"ABC1D23", "ABC1234"}, []string{"00639884962", "12345678900"}).Return(&[]vehicle.Vehicle{}, nil)
+				m.repo.EXPECT().CreateBatch(p.ctx, []vehicle.Vehicle{validVehicle, canonicalVehicle}).Return([]int64{1, 2}, nil)
+			},
+			want: &vehicle.ImportReport{
+				Committed:  true,
+				TotalRows:  2,
+				ValidRows:  2,
+				VehicleIDs: []int64{1, 2},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado linhas válidas e o modo dry-run quando o método Import é chamado então nada é persistido",
+			args: args{
+				ctx:    mockedContext,
+				rows:   []vehicle.ImportRow{{Line: 2, Vehicle: &validVehicle}},
+				dryRun: true,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListByPlatesOrRenavams(p.ctx, []string{"ABC1D23"}, []string{"00639884962"}).Return(&[]vehicle.Vehicle{}, nil)
+			},
+			want: &vehicle.ImportReport{
+				DryRun:    true,
+				TotalRows: 1,
+				ValidRows: 1,
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado linhas inválidas ou duplicadas quando o método Import é chamado então o relatório de erros por linha é retornado",
+			args: args{
+				ctx: mockedContext,
+				rows: []vehicle.ImportRow{
+					{Line: 2, Vehicle: &validVehicle},
+					{Line: 3, Vehicle: &validVehicle},
+					{Line: 4, Vehicle: &invalidVehicle},
+					{Line: 5, Err: errMocked},
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListByPlatesOrRenavams(p.ctx, gomock.Len(3), gomock.Len(3)).Return(&[]vehicle.Vehicle{}, nil)
+			},
+			want: &vehicle.ImportReport{
+				TotalRows: 4,
+				ValidRows: 1,
+				Errors: []vehicle.ImportRowError{
+					{
+						Line:  3,
+						Plate: "ABC1D23",
+						Errors: []string{
+							"the vehicle plate is duplicated in the file, first seen on line 2",
+							"the vehicle renavam is duplicated in the file, first seen on line 2",
+						},
+					},
+					{
+						Line:  4,
+						Plate: "AB-12",
+						Errors: []string{
+							vehicle.ErrInvalidPlate.Error() + ": [AB-12]",
+							"the vehicle renavam is duplicated in the file, first seen on line 2",
+						},
+					},
+					{
+						Line:   5,
+						Errors: []string{errMocked.Error()},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado uma falha na persistência quando o método Import é chamado então um erro é retornado",
+			args: args{
+				ctx:  mockedContext,
+				rows: []vehicle.ImportRow{{Line: 2, Vehicle: &validVehicle}},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListByPlatesOrRenavams(p.ctx, []string{"ABC1D23"}, []string{"00639884962"}).Return(&[]vehicle.Vehicle{}, nil)
+				m.repo.EXPECT().CreateBatch(p.ctx, []vehicle.Vehicle{validVehicle}).Return(nil, errMocked)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+		{
+			name: "Dado placas e renavams de veículos já cadastrados quando o método Import é chamado então o conflito é relatado na linha",
+			args: args{
+				ctx: mockedContext,
+				rows: []vehicle.ImportRow{
+					{Line: 2, Vehicle: &validVehicle},
+					{Line: 3, Vehicle: &anotherValidVehicle},
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListByPlatesOrRenavams(p.ctx, []string{"ABC1D23", "ABC1234"}, []string{"00639884962", "12345678900"}).Return(&[]vehicle.Vehicle{
+					{ID: 7, LegalInformation: vehicle.VehicleLegalInformation{Plate: "ABC1D23", Renavam: "98765432100"}},
+					{ID: 8, LegalInformation: vehicle.VehicleLegalInformation{Plate: "XYZ9876", Renavam: "12345678900"}},
+				}, nil)
+			},
+			want: &vehicle.ImportReport{
+				TotalRows: 2,
+				ValidRows: 0,
+				Errors: []vehicle.ImportRowError{
+					{
+						Line:   2,
+						Plate:  "ABC1D23",
+						Errors: []string{"the vehicle plate already belongs to vehicle 7"},
+					},
+					{
+						Line:   3,
+						Plate:  "ABC1234",
+						Errors: []string{"the vehicle renavam already belongs to vehicle 8"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado uma falha ao buscar os veículos já cadastrados quando o método Import é chamado então um erro é retornado",
+			args: args{
+				ctx:  mockedContext,
+				rows: []vehicle.ImportRow{{Line: 2, Vehicle: &validVehicle}},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().ListByPlatesOrRenavams(p.ctx, []string{"ABC1D23"}, []string{"00639884962"}).Return(nil, errMocked)
+			},
+			want:    nil,
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
+
+			actualReport, err := s.Import(test.args.ctx, test.args.rows, test.args.dryRun)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.want, actualReport)
+		})
+	}
+}
+
 func TestService_Update(t *testing.T) {
 	type serviceMocks struct {
-		repo   *vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -386,6 +718,7 @@ func TestService_Update(t *testing.T) {
 		name        string
 		args        args
 		prepareMock func(p args, m serviceMocks)
+		wantEvents  []string
 		wantErr     bool
 	}{
 		{
@@ -395,9 +728,34 @@ func TestService_Update(t *testing.T) {
 				v:   &vehicle.Vehicle{ID: 1},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.v.ID).Return(p.v, nil).Times(2)
 				m.repo.EXPECT().Update(p.ctx, p.v).Return(nil)
 			},
-			wantErr: false,
+			wantEvents: []string{outbox.VEHICLE_UPDATED},
+			wantErr:    false,
+		},
+		{
+			name: "Dado uma mudança do status de licenciamento quando o método Update é chamado então o evento da mudança também é emitido",
+			args: args{
+				ctx: mockedContext,
+				v: &vehicle.Vehicle{
+					ID:               1,
+					LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.BLOCKED}},
+				},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				before := &vehicle.Vehicle{
+					ID:               1,
+					LegalInformation: vehicle.VehicleLegalInformation{Licensing: vehicle.Licensing{Status: vehicle.REGULAR}},
+				}
+				gomock.InOrder(
+					m.repo.EXPECT().GetByID(p.ctx, p.v.ID).Return(before, nil),
+					m.repo.EXPECT().Update(p.ctx, p.v).Return(nil),
+					m.repo.EXPECT().GetByID(p.ctx, p.v.ID).Return(p.v, nil),
+				)
+			},
+			wantEvents: []string{outbox.VEHICLE_UPDATED, outbox.VEHICLE_LICENSING_STATUS_CHANGED},
+			wantErr:    false,
 		},
 		{
 			name: "Dado um veículo inválido quando o método Update é chamado então um erro é retornado",
@@ -406,6 +764,7 @@ func TestService_Update(t *testing.T) {
 				v:   &vehicle.Vehicle{},
 			},
 			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.v.ID).Return(p.v, nil)
 				m.repo.EXPECT().Update(p.ctx, p.v).Return(errMocked)
 			},
 			wantErr: true,
@@ -418,27 +777,122 @@ func TestService_Update(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  outbox_mocks.NewMockEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
+			var emittedEvents []string
+			sm.events.EXPECT().Emit(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, events ...*outbox.Event) error {
+				for _, e := range events {
+					emittedEvents = append(emittedEvents, e.Type)
+				}
+				return nil
+			}).AnyTimes()
+
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := vehicle.NewService(sm.repo, sm.logger)
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
 			err := s.Update(test.args.ctx, test.args.v)
 
 			assert.Equal(tt, test.wantErr, err != nil)
+			assert.Equal(tt, test.wantEvents, emittedEvents)
 		})
 	}
 }
 
-func TestService_Delete(t *testing.T) {
+func TestService_Patch(t *testing.T) {
+	type serviceMocks struct {
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx    context.Context
+		v      *vehicle.Vehicle
+		fields []string
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado campos mutáveis quando o método Patch é chamado então apenas esses campos são gravados",
+			args: args{
+				ctx:    mockedContext,
+				v:      &vehicle.Vehicle{ID: 1, Version: 1},
+				fields: []string{"model"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.v.ID).Return(p.v, nil).Times(2)
+				m.repo.EXPECT().Patch(p.ctx, p.v, p.fields).Return(nil)
+			},
+			wantErr: false,
+		},
+		{
+			name: "Dado um campo imutável quando o método Patch é chamado então um erro é retornado sem gravar nada",
+			args: args{
+				ctx:    mockedContext,
+				v:      &vehicle.Vehicle{ID: 1, Version: 1},
+				fields: []string{"model", "plate"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado um erro no repositório quando o método Patch é chamado então o erro é retornado",
+			args: args{
+				ctx:    mockedContext,
+				v:      &vehicle.Vehicle{ID: 1, Version: 1},
+				fields: []string{"model"},
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.v.ID).Return(p.v, nil)
+				m.repo.EXPECT().Patch(p.ctx, p.v, p.fields).Return(errMocked)
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
+
+			err := s.Patch(test.args.ctx, test.args.v, test.args.fields)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestService_Restore(t *testing.T) {
 	type serviceMocks struct {
-		repo   *vehicle_mocks.MockRepository
-		logger *logging.Logging
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
 	}
 
 	type args struct {
@@ -450,27 +904,113 @@ func TestService_Delete(t *testing.T) {
 		name        string
 		args        args
 		prepareMock func(p args, m serviceMocks)
-		wantErr     bool
+		wantErr     error
 	}{
 		{
-			name: "Dado um ID válido quando o método Delete é chamado então o veículo é excluído",
+			name: "Dado um ID na lixeira quando o método Restore é chamado então o registro é restaurado",
+			args: args{
+				ctx: mockedContext,
+				id:  1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().Restore(p.ctx, p.id).Return(nil)
+			},
+			wantErr: nil,
+		},
+		{
+			name: "Dado um registro ativo com os mesmos dados únicos quando o método Restore é chamado então um conflito é retornado",
 			args: args{
 				ctx: mockedContext,
 				id:  1,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(nil)
+				m.repo.EXPECT().Restore(p.ctx, p.id).Return(trash.ErrRestoreConflict)
+			},
+			wantErr: trash.ErrRestoreConflict,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			ctrl := gomock.NewController(tt)
+			defer ctrl.Finish()
+
+			sm := serviceMocks{
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
+			}
+
+			if test.prepareMock != nil {
+				test.prepareMock(test.args, sm)
+			}
+
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
+
+			err := s.Restore(test.args.ctx, test.args.id)
+
+			assert.Equal(tt, true, errors.Is(err, test.wantErr))
+		})
+	}
+}
+
+func TestService_Delete(t *testing.T) {
+	type serviceMocks struct {
+		repo    *vehicle_mocks.MockRepository
+		auditor *audit_mocks.MockRecorder
+		events  *outbox_mocks.MockEmitter
+		logger  *logging.Logging
+	}
+
+	type args struct {
+		ctx     context.Context
+		id      int64
+		version int64
+	}
+
+	tests := []struct {
+		name        string
+		args        args
+		prepareMock func(p args, m serviceMocks)
+		wantErr     bool
+	}{
+		{
+			name: "Dado um ID válido quando o método Delete é chamado então o veículo é excluído",
+			args: args{
+				ctx:     mockedContext,
+				id:      1,
+				version: 1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&vehicle.Vehicle{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(nil)
 			},
 			wantErr: false,
 		},
 		{
 			name: "Dado um ID inválido quando o método Delete é chamado então um erro é retornado",
 			args: args{
-				ctx: mockedContext,
-				id:  0,
+				ctx:     mockedContext,
+				id:      0,
+				version: 1,
+			},
+			prepareMock: func(p args, m serviceMocks) {
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&vehicle.Vehicle{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(errMocked)
+			},
+			wantErr: true,
+		},
+		{
+			name: "Dado uma versão desatualizada quando o método Delete é chamado então o conflito de versão é retornado",
+			args: args{
+				ctx:     mockedContext,
+				id:      1,
+				version: 2,
 			},
 			prepareMock: func(p args, m serviceMocks) {
-				m.repo.EXPECT().Delete(p.ctx, p.id).Return(errMocked)
+				m.repo.EXPECT().GetByID(p.ctx, p.id).Return(&vehicle.Vehicle{ID: p.id}, nil)
+				m.repo.EXPECT().Delete(p.ctx, p.id, p.version).Return(concurrency.ErrVersionConflict)
 			},
 			wantErr: true,
 		},
@@ -482,17 +1022,19 @@ func TestService_Delete(t *testing.T) {
 			defer ctrl.Finish()
 
 			sm := serviceMocks{
-				repo:   vehicle_mocks.NewMockRepository(ctrl),
-				logger: logging.InitializerLogging(&config.Config{}),
+				repo:    vehicle_mocks.NewMockRepository(ctrl),
+				auditor: audit_mocks.NewPassThroughRecorder(ctrl),
+				events:  newEmitter(ctrl),
+				logger:  logging.InitializerLogging(&config.Config{}),
 			}
 
 			if test.prepareMock != nil {
 				test.prepareMock(test.args, sm)
 			}
 
-			s := vehicle.NewService(sm.repo, sm.logger)
+			s := vehicle.NewService(sm.repo, sm.auditor, sm.events, sm.logger)
 
-			err := s.Delete(test.args.ctx, test.args.id)
+			err := s.Delete(test.args.ctx, test.args.id, test.args.version)
 
 			assert.Equal(tt, test.wantErr, err != nil)
 		})
@@ -0,0 +1,119 @@
+package vehicle
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	RENAVAM_LENGTH = 11
+)
+
+var (
+	ErrInvalidPlate             = errors.New("the vehicle plate must follow the old (AAA-9999) or the Mercosul (AAA9A99) pattern")
+	ErrInvalidRenavam           = errors.New("the vehicle renavam must have up to 11 digits and a valid check digit")
+	ErrEmptyBrand               = errors.New("the vehicle brand cannot be empty")
+	ErrEmptyModel               = errors.New("the vehicle model cannot be empty")
+	ErrInvalidYearOfManufacture = errors.New("the vehicle year of manufacture cannot be empty or in the future")
+	ErrInvalidLicensingExpiry   = errors.New("the vehicle licensing expiry date cannot be empty")
+	ErrUndefinedLicensingStatus = errors.New("the vehicle licensing status cannot be equal to undefined")
+
+	oldPlatePattern      = regexp.MustCompile(`^[A-Z]{3}-?[0-9]{4}$`)
+	mercosulPlatePattern = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+	renavamPattern       = regexp.MustCompile(`^[0-9]{9,11}$`)
+
+	renavamWeights = []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+)
+
+// NormalizePlate returns the plate in its canonical form, the one stored and
+// searched: in upper case, without surrounding spaces and, for the old
+// pattern, without the hyphen, so that ABC-1234 and abc1234 are one plate.
+func NormalizePlate(plate string) string {
+	normalized := strings.ToUpper(strings.TrimSpace(plate))
+	if oldPlatePattern.MatchString(normalized) {
+		return strings.Replace(normalized, "-", "", 1)
+	}
+
+	return normalized
+}
+
+// ValidatePlate accepts both the old brazilian pattern and the Mercosul one.
+func ValidatePlate(plate string) error {
+	normalized := NormalizePlate(plate)
+	if oldPlatePattern.MatchString(normalized) || mercosulPlatePattern.MatchString(normalized) {
+		return nil
+	}
+
+	return fmt.Errorf("%w: [%s]", ErrInvalidPlate, plate)
+}
+
+// ValidateRenavam checks the size and the check digit of a RENAVAM. Old
+// 9-digit numbers are left padded with zeros, as issued by DENATRAN.
+func ValidateRenavam(renavam string) error {
+	normalized := strings.TrimSpace(renavam)
+	if !renavamPattern.MatchString(normalized) {
+		return fmt.Errorf("%w: [%s]", ErrInvalidRenavam, renavam)
+	}
+
+	normalized = strings.Repeat("0", RENAVAM_LENGTH-len(normalized)) + normalized
+
+	sum := 0
+	for i, weight := range renavamWeights {
+		sum += int(normalized[i]-'0') * weight
+	}
+
+	checkDigit := (sum * 10) % 11
+	if checkDigit == 10 {
+		checkDigit = 0
+	}
+
+	if checkDigit != int(normalized[RENAVAM_LENGTH-1]-'0') {
+		return fmt.Errorf("%w: [%s]", ErrInvalidRenavam, renavam)
+	}
+
+	return nil
+}
+
+// Validate returns every rule broken by the vehicle joined in a single error.
+func (v *Vehicle) Validate() error {
+	var errs []error
+
+	if len(strings.TrimSpace(v.Attributes.Brand)) == 0 {
+		errs = append(errs, ErrEmptyBrand)
+	}
+
+	if len(strings.TrimSpace(v.Attributes.Model)) == 0 {
+		errs = append(errs, ErrEmptyModel)
+	}
+
+	if v.Attributes.YearOfManufacture.IsZero() || v.Attributes.YearOfManufacture.After(time.Now()) {
+		errs = append(errs, ErrInvalidYearOfManufacture)
+	}
+
+	if v.Attributes.Category != "" {
+		if _, err := GetCategory(string(v.Attributes.Category)); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := ValidatePlate(v.LegalInformation.Plate); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := ValidateRenavam(v.LegalInformation.Renavam); err != nil {
+		errs = append(errs, err)
+	}
+
+	if v.LegalInformation.Licensing.ExpiryDate.IsZero() {
+		errs = append(errs, ErrInvalidLicensingExpiry)
+	}
+
+	if v.LegalInformation.Licensing.Status == UNDEFINED {
+		errs = append(errs, ErrUndefinedLicensingStatus)
+	}
+
+	return errors.Join(errs...)
+}
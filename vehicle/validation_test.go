@@ -0,0 +1,136 @@
+package vehicle_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/LucasMateus-eng/operations-service/vehicle"
+	"github.com/go-playground/assert/v2"
+)
+
+func TestValidatePlate(t *testing.T) {
+	tests := []struct {
+		name    string
+		plate   string
+		wantErr bool
+	}{
+		{
+			name:    "Dado uma placa no padrão antigo quando a validação é chamada então a placa é aceita",
+			plate:   "ABC-1234",
+			wantErr: false,
+		},
+		{
+			name:    "Dado uma placa no padrão Mercosul quando a validação é chamada então a placa é aceita",
+			plate:   "abc1d23",
+			wantErr: false,
+		},
+		{
+			name:    "Dado uma placa fora dos padrões quando a validação é chamada então um erro é retornado",
+			plate:   "AB12345",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := vehicle.ValidatePlate(test.plate)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestNormalizePlate(t *testing.T) {
+	tests := []struct {
+		name  string
+		plate string
+		want  string
+	}{
+		{
+			name:  "Dado uma placa no padrão antigo com hífen quando ela é normalizada então o hífen é removido",
+			plate: " abc-1234 ",
+			want:  "ABC1234",
+		},
+		{
+			name:  "Dado uma placa no padrão antigo sem hífen quando ela é normalizada então ela é a mesma",
+			plate: "ABC1234",
+			want:  "ABC1234",
+		},
+		{
+			name:  "Dado uma placa no padrão Mercosul quando ela é normalizada então ela fica em maiúsculas",
+			plate: "abc1d23",
+			want:  "ABC1D23",
+		},
+		{
+			name:  "Dado uma placa fora dos padrões quando ela é normalizada então o hífen é mantido",
+			plate: "ab-12",
+			want:  "AB-12",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			assert.Equal(tt, test.want, vehicle.NormalizePlate(test.plate))
+		})
+	}
+}
+
+func TestValidateRenavam(t *testing.T) {
+	tests := []struct {
+		name    string
+		renavam string
+		wantErr bool
+	}{
+		{
+			name:    "Dado um RENAVAM com dígito verificador válido quando a validação é chamada então o RENAVAM é aceito",
+			renavam: "00639884962",
+			wantErr: false,
+		},
+		{
+			name:    "Dado um RENAVAM antigo de 9 dígitos quando a validação é chamada então o RENAVAM é aceito",
+			renavam: "639884962",
+			wantErr: false,
+		},
+		{
+			name:    "Dado um RENAVAM com dígito verificador inválido quando a validação é chamada então um erro é retornado",
+			renavam: "00639884961",
+			wantErr: true,
+		},
+		{
+			name:    "Dado um RENAVAM com letras quando a validação é chamada então um erro é retornado",
+			renavam: "0063988496A",
+			wantErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(tt *testing.T) {
+			err := vehicle.ValidateRenavam(test.renavam)
+
+			assert.Equal(tt, test.wantErr, err != nil)
+		})
+	}
+}
+
+func TestVehicle_Validate(t *testing.T) {
+	v := &vehicle.Vehicle{
+		Attributes: vehicle.VehicleAttributes{
+			Brand:             "Fiat",
+			YearOfManufacture: time.Now().AddDate(1, 0, 0),
+		},
+		LegalInformation: vehicle.VehicleLegalInformation{
+			Plate:   "ABC1D23",
+			Renavam: "00639884962",
+		},
+	}
+
+	err := v.Validate()
+
+	assert.Equal(t, true, errors.Is(err, vehicle.ErrEmptyModel))
+	assert.Equal(t, true, errors.Is(err, vehicle.ErrInvalidYearOfManufacture))
+	assert.Equal(t, true, errors.Is(err, vehicle.ErrInvalidLicensingExpiry))
+	assert.Equal(t, true, errors.Is(err, vehicle.ErrUndefinedLicensingStatus))
+	assert.Equal(t, false, errors.Is(err, vehicle.ErrInvalidPlate))
+	assert.Equal(t, false, errors.Is(err, vehicle.ErrEmptyBrand))
+}
@@ -98,6 +98,33 @@ func (ls *LicensingStatus) UnmarshalJSON(data []byte) (err error) {
 	return nil
 }
 
+// Category is the kind of vehicle, which picks the checklists the drivers
+// fill in. A vehicle without one uses the default checklists.
+type Category string
+
+const (
+	CAR        Category = "CAR"
+	MOTORCYCLE Category = "MOTORCYCLE"
+	VAN        Category = "VAN"
+	TRUCK      Category = "TRUCK"
+	BUS        Category = "BUS"
+)
+
+var (
+	categories = []Category{CAR, MOTORCYCLE, VAN, TRUCK, BUS}
+
+	ErrInvalidCategory = errors.New("the vehicle category must be one of CAR, MOTORCYCLE, VAN, TRUCK or BUS")
+)
+
+func GetCategory(name string) (Category, error) {
+	category := Category(name)
+	if !slices.Contains(categories, category) {
+		return "", ErrInvalidCategory
+	}
+
+	return category, nil
+}
+
 type Licensing struct {
 	ExpiryDate time.Time
 	Status     LicensingStatus
@@ -113,34 +140,53 @@ type VehicleAttributes struct {
 	Brand             string
 	Model             string
 	YearOfManufacture time.Time
+	Category          Category
 }
 
+// Vehicle carries its current odometer, which is read from the odometer
+// records and never written along with the vehicle.
 type Vehicle struct {
 	ID               int64
 	Attributes       VehicleAttributes
 	LegalInformation VehicleLegalInformation
+	Odometer         int64
+	Version          int64
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
 	DeletedAt        time.Time
 }
 
+// VehicleSpectification filters the vehicles. Uninsured keeps the vehicles
+// without an insurance policy in force.
 type VehicleSpectification struct {
 	Attributes     VehicleAttributes
 	Licensing      Licensing
+	Uninsured      bool
 	Page, PageSize int
 }
 
+// ImmutableFields cannot be changed by a patch once the vehicle is created.
+var ImmutableFields = []string{"id", "plate", "renavam"}
+
 type Reading interface {
 	GetByID(ctx context.Context, id int64) (*Vehicle, error)
 	GetByPlate(ctx context.Context, plate string) (*Vehicle, error)
 	GetByRenavam(ctx context.Context, renavam string) (*Vehicle, error)
+	ListByIDs(ctx context.Context, ids []int64) (*[]Vehicle, error)
+	ListByPlatesOrRenavams(ctx context.Context, plates, renavams []string) (*[]Vehicle, error)
 	List(ctx context.Context, specification *VehicleSpectification) (*[]Vehicle, error)
+	Iterate(ctx context.Context, specification *VehicleSpectification, fn func(v *Vehicle) error) error
+	ListDeleted(ctx context.Context, specification *VehicleSpectification) (*[]Vehicle, error)
 }
 
 type Writing interface {
 	Create(ctx context.Context, v *Vehicle) (int64, error)
+	CreateBatch(ctx context.Context, vs []Vehicle) ([]int64, error)
 	Update(ctx context.Context, v *Vehicle) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, v *Vehicle, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
+	Restore(ctx context.Context, id int64) error
+	Purge(ctx context.Context, id int64) error
 }
 
 type Repository interface {
@@ -152,8 +198,14 @@ type UseCase interface {
 	GetByID(ctx context.Context, id int64) (*Vehicle, error)
 	GetByPlate(ctx context.Context, plate string) (*Vehicle, error)
 	GetByRenavam(ctx context.Context, renavam string) (*Vehicle, error)
+	ListByIDs(ctx context.Context, ids []int64) (*[]Vehicle, error)
 	List(ctx context.Context, specification *VehicleSpectification) (*[]Vehicle, error)
+	Export(ctx context.Context, specification *VehicleSpectification, fn func(v *Vehicle) error) error
+	ListDeleted(ctx context.Context, specification *VehicleSpectification) (*[]Vehicle, error)
 	Create(ctx context.Context, v *Vehicle) (int64, error)
+	Import(ctx context.Context, rows []ImportRow, dryRun bool) (*ImportReport, error)
 	Update(ctx context.Context, v *Vehicle) error
-	Delete(ctx context.Context, id int64) error
+	Patch(ctx context.Context, v *Vehicle, fields []string) error
+	Delete(ctx context.Context, id, version int64) error
+	Restore(ctx context.Context, id int64) error
 }